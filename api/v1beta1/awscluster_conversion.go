@@ -66,6 +66,12 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Bastion.HostAffinity = restored.Status.Bastion.HostAffinity
 		dst.Status.Bastion.HostID = restored.Status.Bastion.HostID
 		dst.Status.Bastion.CapacityReservationPreference = restored.Status.Bastion.CapacityReservationPreference
+		dst.Status.Bastion.HibernationOptions = restored.Status.Bastion.HibernationOptions
+		dst.Status.Bastion.CPUOptions = restored.Status.Bastion.CPUOptions
+		dst.Status.Bastion.EnclaveOptions = restored.Status.Bastion.EnclaveOptions
+		dst.Status.Bastion.ENAExpress = restored.Status.Bastion.ENAExpress
+		restoreVolume(restored.Status.Bastion.RootVolume, dst.Status.Bastion.RootVolume)
+		restoreVolumes(restored.Status.Bastion.NonRootVolumes, dst.Status.Bastion.NonRootVolumes)
 	}
 	dst.Spec.Partition = restored.Spec.Partition
 
@@ -73,6 +79,12 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Network.SecurityGroups[role] = sg
 	}
 	dst.Status.Network.NatGatewaysIPs = restored.Status.Network.NatGatewaysIPs
+	dst.Status.Network.PrefixListID = restored.Status.Network.PrefixListID
+	dst.Status.Network.VPCEndpoints = restored.Status.Network.VPCEndpoints
+	dst.Status.Network.TransitGatewayAttachment = restored.Status.Network.TransitGatewayAttachment
+	dst.Status.Network.FlowLogIDs = restored.Status.Network.FlowLogIDs
+	dst.Status.Network.NetworkACLIDs = restored.Status.Network.NetworkACLIDs
+	dst.Status.Network.APIServerDNSRecord = restored.Status.Network.APIServerDNSRecord
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -93,6 +105,16 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.NetworkSpec.AdditionalControlPlaneIngressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneIngressRules
 	dst.Spec.NetworkSpec.AdditionalNodeIngressRules = restored.Spec.NetworkSpec.AdditionalNodeIngressRules
 	dst.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks = restored.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks
+	dst.Spec.NetworkSpec.AdditionalControlPlaneEgressRules = restored.Spec.NetworkSpec.AdditionalControlPlaneEgressRules
+	dst.Spec.NetworkSpec.AdditionalNodeEgressRules = restored.Spec.NetworkSpec.AdditionalNodeEgressRules
+	dst.Spec.NetworkSpec.SecurityGroupEgressRules = restored.Spec.NetworkSpec.SecurityGroupEgressRules
+	dst.Spec.NetworkSpec.NodePortIngressRulePrefixListIDs = restored.Spec.NetworkSpec.NodePortIngressRulePrefixListIDs
+	dst.Spec.NetworkSpec.PrefixList = restored.Spec.NetworkSpec.PrefixList
+	dst.Spec.NetworkSpec.VPCEndpoints = restored.Spec.NetworkSpec.VPCEndpoints
+	dst.Spec.NetworkSpec.VPC.TransitGateway = restored.Spec.NetworkSpec.VPC.TransitGateway
+	dst.Spec.NetworkSpec.VPC.FlowLogs = restored.Spec.NetworkSpec.VPC.FlowLogs
+	dst.Spec.NetworkSpec.NetworkACLs = restored.Spec.NetworkSpec.NetworkACLs
+	dst.Spec.Bastion.AllowedPrefixListIDs = restored.Spec.Bastion.AllowedPrefixListIDs
 
 	if restored.Spec.NetworkSpec.VPC.IPAMPool != nil {
 		if dst.Spec.NetworkSpec.VPC.IPAMPool == nil {
@@ -128,7 +150,7 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 
-	// Restore SubnetSpec.ResourceID, SubnetSpec.ParentZoneName, and SubnetSpec.ZoneType fields, if any.
+	// Restore SubnetSpec.ResourceID, SubnetSpec.ParentZoneName, SubnetSpec.ZoneType, and SubnetSpec.NetworkACL fields, if any.
 	for _, subnet := range restored.Spec.NetworkSpec.Subnets {
 		for i, dstSubnet := range dst.Spec.NetworkSpec.Subnets {
 			if dstSubnet.ID == subnet.ID {
@@ -141,6 +163,9 @@ func (src *AWSCluster) ConvertTo(dstRaw conversion.Hub) error {
 				if subnet.ZoneType != nil {
 					dstSubnet.ZoneType = subnet.ZoneType
 				}
+				if subnet.NetworkACL != nil {
+					dstSubnet.NetworkACL = subnet.NetworkACL
+				}
 				dstSubnet.DeepCopyInto(&dst.Spec.NetworkSpec.Subnets[i])
 			}
 		}
@@ -158,6 +183,7 @@ func restoreControlPlaneLoadBalancerStatus(restored, dst *infrav1.LoadBalancer)
 	dst.ELBListeners = restored.ELBListeners
 	dst.Name = restored.Name
 	dst.DNSName = restored.DNSName
+	dst.CanonicalHostedZoneID = restored.CanonicalHostedZoneID
 	dst.Scheme = restored.Scheme
 	dst.SubnetIDs = restored.SubnetIDs
 	dst.SecurityGroupIDs = restored.SecurityGroupIDs
@@ -191,6 +217,7 @@ func restoreControlPlaneLoadBalancer(restored, dst *infrav1.AWSLoadBalancerSpec)
 	dst.Scheme = restored.Scheme
 	dst.CrossZoneLoadBalancing = restored.CrossZoneLoadBalancing
 	dst.Subnets = restored.Subnets
+	dst.DNS = restored.DNS
 }
 
 // ConvertFrom converts the v1beta1 AWSCluster receiver to a v1beta1 AWSCluster.
@@ -226,3 +253,18 @@ func (r *AWSClusterList) ConvertFrom(srcRaw conversion.Hub) error {
 func Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
 }
+
+func restoreVolume(restored, dst *infrav1.Volume) {
+	if restored == nil || dst == nil {
+		return
+	}
+	dst.Snapshot = restored.Snapshot
+}
+
+func restoreVolumes(restored, dst []infrav1.Volume) {
+	for i := range dst {
+		if i < len(restored) {
+			restoreVolume(&restored[i], &dst[i])
+		}
+	}
+}
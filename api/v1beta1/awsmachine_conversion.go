@@ -48,6 +48,17 @@ func (src *AWSMachine) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.HostAffinity = restored.Spec.HostAffinity
 	dst.Spec.CapacityReservationPreference = restored.Spec.CapacityReservationPreference
 	dst.Spec.NetworkInterfaceType = restored.Spec.NetworkInterfaceType
+	dst.Spec.HibernationOptions = restored.Spec.HibernationOptions
+	dst.Spec.CPUOptions = restored.Spec.CPUOptions
+	dst.Spec.EnclaveOptions = restored.Spec.EnclaveOptions
+	dst.Spec.ENAExpress = restored.Spec.ENAExpress
+	dst.Spec.PowerState = restored.Spec.PowerState
+	dst.Status.PowerState = restored.Status.PowerState
+	dst.Status.VolumeModifications = restored.Status.VolumeModifications
+	dst.Spec.PreTerminationSnapshots = restored.Spec.PreTerminationSnapshots
+	dst.Status.PreTerminationSnapshots = restored.Status.PreTerminationSnapshots
+	restoreVolume(restored.Spec.RootVolume, dst.Spec.RootVolume)
+	restoreVolumes(restored.Spec.NonRootVolumes, dst.Spec.NonRootVolumes)
 	if restored.Spec.ElasticIPPool != nil {
 		if dst.Spec.ElasticIPPool == nil {
 			dst.Spec.ElasticIPPool = &infrav1.ElasticIPPool{}
@@ -114,7 +125,15 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.Template.Spec.HostID = restored.Spec.Template.Spec.HostID
 	dst.Spec.Template.Spec.HostAffinity = restored.Spec.Template.Spec.HostAffinity
 	dst.Spec.Template.Spec.CapacityReservationPreference = restored.Spec.Template.Spec.CapacityReservationPreference
+	dst.Spec.Template.Spec.HibernationOptions = restored.Spec.Template.Spec.HibernationOptions
+	dst.Spec.Template.Spec.CPUOptions = restored.Spec.Template.Spec.CPUOptions
+	dst.Spec.Template.Spec.EnclaveOptions = restored.Spec.Template.Spec.EnclaveOptions
+	dst.Spec.Template.Spec.ENAExpress = restored.Spec.Template.Spec.ENAExpress
+	dst.Spec.Template.Spec.PowerState = restored.Spec.Template.Spec.PowerState
 	dst.Spec.Template.Spec.NetworkInterfaceType = restored.Spec.Template.Spec.NetworkInterfaceType
+	dst.Spec.Template.Spec.PreTerminationSnapshots = restored.Spec.Template.Spec.PreTerminationSnapshots
+	restoreVolume(restored.Spec.Template.Spec.RootVolume, dst.Spec.Template.Spec.RootVolume)
+	restoreVolumes(restored.Spec.Template.Spec.NonRootVolumes, dst.Spec.Template.Spec.NonRootVolumes)
 	if restored.Spec.Template.Spec.ElasticIPPool != nil {
 		if dst.Spec.Template.Spec.ElasticIPPool == nil {
 			dst.Spec.Template.Spec.ElasticIPPool = &infrav1.ElasticIPPool{}
@@ -126,6 +145,7 @@ func (r *AWSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 			dst.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder = restored.Spec.Template.Spec.ElasticIPPool.PublicIpv4PoolFallBackOrder
 		}
 	}
+	dst.Status.NodeInfo = restored.Status.NodeInfo
 
 	return nil
 }
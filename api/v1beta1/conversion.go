@@ -48,6 +48,14 @@ func Convert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AWSMac
 	return autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in, out, s)
 }
 
+func Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta2.AWSMachineStatus, out *AWSMachineStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in, out, s)
+}
+
+func Convert_v1beta2_Volume_To_v1beta1_Volume(in *v1beta2.Volume, out *Volume, s conversion.Scope) error {
+	return autoConvert_v1beta2_Volume_To_v1beta1_Volume(in, out, s)
+}
+
 func Convert_v1beta2_Instance_To_v1beta1_Instance(in *v1beta2.Instance, out *Instance, s conversion.Scope) error {
 	return autoConvert_v1beta2_Instance_To_v1beta1_Instance(in, out, s)
 }
@@ -84,6 +92,14 @@ func Convert_v1beta2_IngressRule_To_v1beta1_IngressRule(in *v1beta2.IngressRule,
 	return autoConvert_v1beta2_IngressRule_To_v1beta1_IngressRule(in, out, s)
 }
 
+func Convert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Bastion, s conversion.Scope) error {
+	return autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in, out, s)
+}
+
+func Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in *v1beta2.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in, out, s)
+}
+
 func Convert_v1beta2_VPCSpec_To_v1beta1_VPCSpec(in *v1beta2.VPCSpec, out *VPCSpec, s conversion.Scope) error {
 	return autoConvert_v1beta2_VPCSpec_To_v1beta1_VPCSpec(in, out, s)
 }
@@ -103,3 +119,7 @@ func Convert_v1beta2_S3Bucket_To_v1beta1_S3Bucket(in *v1beta2.S3Bucket, out *S3B
 func Convert_v1beta2_Ignition_To_v1beta1_Ignition(in *v1beta2.Ignition, out *Ignition, s conversion.Scope) error {
 	return autoConvert_v1beta2_Ignition_To_v1beta1_Ignition(in, out, s)
 }
+
+func Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in *v1beta2.AWSMachineTemplateStatus, out *AWSMachineTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in, out, s)
+}
@@ -264,11 +264,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSMachineStatus)(nil), (*AWSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(a.(*v1beta2.AWSMachineStatus), b.(*AWSMachineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AWSMachineTemplate)(nil), (*v1beta2.AWSMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSMachineTemplate_To_v1beta2_AWSMachineTemplate(a.(*AWSMachineTemplate), b.(*v1beta2.AWSMachineTemplate), scope)
 	}); err != nil {
@@ -314,11 +309,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSMachineTemplateStatus)(nil), (*AWSMachineTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(a.(*v1beta2.AWSMachineTemplateStatus), b.(*AWSMachineTemplateStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*v1beta2.AWSResourceReference)(nil), (*AWSResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_AWSResourceReference_To_v1beta1_AWSResourceReference(a.(*v1beta2.AWSResourceReference), b.(*AWSResourceReference), scope)
 	}); err != nil {
@@ -349,11 +339,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*BuildParams)(nil), (*v1beta2.BuildParams)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BuildParams_To_v1beta2_BuildParams(a.(*BuildParams), b.(*v1beta2.BuildParams), scope)
 	}); err != nil {
@@ -484,11 +469,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(a.(*v1beta2.SecurityGroup), b.(*SecurityGroup), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*SpotMarketOptions)(nil), (*v1beta2.SpotMarketOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SpotMarketOptions_To_v1beta2_SpotMarketOptions(a.(*SpotMarketOptions), b.(*v1beta2.SpotMarketOptions), scope)
 	}); err != nil {
@@ -514,11 +494,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Volume_To_v1beta1_Volume(a.(*v1beta2.Volume), b.(*Volume), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddConversionFunc((*AWSMachineSpec)(nil), (*v1beta2.AWSMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSMachineSpec_To_v1beta2_AWSMachineSpec(a.(*AWSMachineSpec), b.(*v1beta2.AWSMachineSpec), scope)
 	}); err != nil {
@@ -549,6 +524,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.AWSMachineStatus)(nil), (*AWSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(a.(*v1beta2.AWSMachineStatus), b.(*AWSMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.AWSMachineTemplateStatus)(nil), (*AWSMachineTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(a.(*v1beta2.AWSMachineTemplateStatus), b.(*AWSMachineTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IPv6)(nil), (*IPv6)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPv6_To_v1beta1_IPv6(a.(*v1beta2.IPv6), b.(*IPv6), scope)
 	}); err != nil {
@@ -589,6 +579,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.SecurityGroup)(nil), (*SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(a.(*v1beta2.SecurityGroup), b.(*SecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(a.(*v1beta2.SubnetSpec), b.(*SubnetSpec), scope)
 	}); err != nil {
@@ -599,6 +594,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Volume)(nil), (*Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Volume_To_v1beta1_Volume(a.(*v1beta2.Volume), b.(*Volume), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1241,6 +1241,7 @@ func autoConvert_v1beta2_AWSLoadBalancerSpec_To_v1beta1_AWSLoadBalancerSpec(in *
 	// WARNING: in.LoadBalancerType requires manual conversion: does not exist in peer-type
 	// WARNING: in.DisableHostsRewrite requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreserveClientIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1353,8 +1354,26 @@ func autoConvert_v1beta1_AWSMachineSpec_To_v1beta2_AWSMachineSpec(in *AWSMachine
 		out.Subnet = nil
 	}
 	out.SSHKeyName = (*string)(unsafe.Pointer(in.SSHKeyName))
-	out.RootVolume = (*v1beta2.Volume)(unsafe.Pointer(in.RootVolume))
-	out.NonRootVolumes = *(*[]v1beta2.Volume)(unsafe.Pointer(&in.NonRootVolumes))
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(v1beta2.Volume)
+		if err := Convert_v1beta1_Volume_To_v1beta2_Volume(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RootVolume = nil
+	}
+	if in.NonRootVolumes != nil {
+		in, out := &in.NonRootVolumes, &out.NonRootVolumes
+		*out = make([]v1beta2.Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Volume_To_v1beta2_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NonRootVolumes = nil
+	}
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.UncompressedUserData = (*bool)(unsafe.Pointer(in.UncompressedUserData))
 	if err := Convert_v1beta1_CloudInit_To_v1beta2_CloudInit(&in.CloudInit, &out.CloudInit, s); err != nil {
@@ -1411,8 +1430,26 @@ func autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AW
 	}
 	// WARNING: in.SecurityGroupOverrides requires manual conversion: does not exist in peer-type
 	out.SSHKeyName = (*string)(unsafe.Pointer(in.SSHKeyName))
-	out.RootVolume = (*Volume)(unsafe.Pointer(in.RootVolume))
-	out.NonRootVolumes = *(*[]Volume)(unsafe.Pointer(&in.NonRootVolumes))
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(Volume)
+		if err := Convert_v1beta2_Volume_To_v1beta1_Volume(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RootVolume = nil
+	}
+	if in.NonRootVolumes != nil {
+		in, out := &in.NonRootVolumes, &out.NonRootVolumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_Volume_To_v1beta1_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NonRootVolumes = nil
+	}
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
 	// WARNING: in.NetworkInterfaceType requires manual conversion: does not exist in peer-type
 	out.UncompressedUserData = (*bool)(unsafe.Pointer(in.UncompressedUserData))
@@ -1438,6 +1475,12 @@ func autoConvert_v1beta2_AWSMachineSpec_To_v1beta1_AWSMachineSpec(in *v1beta2.AW
 	// WARNING: in.HostID requires manual conversion: does not exist in peer-type
 	// WARNING: in.HostAffinity requires manual conversion: does not exist in peer-type
 	// WARNING: in.CapacityReservationPreference requires manual conversion: does not exist in peer-type
+	// WARNING: in.HibernationOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnclaveOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.ENAExpress requires manual conversion: does not exist in peer-type
+	// WARNING: in.PowerState requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreTerminationSnapshots requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1462,17 +1505,15 @@ func autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta
 	out.Interruptible = in.Interruptible
 	out.Addresses = *(*[]apiv1beta1.MachineAddress)(unsafe.Pointer(&in.Addresses))
 	out.InstanceState = (*InstanceState)(unsafe.Pointer(in.InstanceState))
+	// WARNING: in.PowerState requires manual conversion: does not exist in peer-type
+	// WARNING: in.VolumeModifications requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreTerminationSnapshots requires manual conversion: does not exist in peer-type
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in *v1beta2.AWSMachineStatus, out *AWSMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSMachineStatus_To_v1beta1_AWSMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSMachineTemplate_To_v1beta2_AWSMachineTemplate(in *AWSMachineTemplate, out *v1beta2.AWSMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_AWSMachineTemplateSpec_To_v1beta2_AWSMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1609,14 +1650,10 @@ func Convert_v1beta1_AWSMachineTemplateStatus_To_v1beta2_AWSMachineTemplateStatu
 
 func autoConvert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in *v1beta2.AWSMachineTemplateStatus, out *AWSMachineTemplateStatus, s conversion.Scope) error {
 	out.Capacity = *(*v1.ResourceList)(unsafe.Pointer(&in.Capacity))
+	// WARNING: in.NodeInfo requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus is an autogenerated conversion function.
-func Convert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in *v1beta2.AWSMachineTemplateStatus, out *AWSMachineTemplateStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_AWSMachineTemplateStatus_To_v1beta1_AWSMachineTemplateStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_AWSResourceReference_To_v1beta2_AWSResourceReference(in *AWSResourceReference, out *v1beta2.AWSResourceReference, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	// WARNING: in.ARN requires manual conversion: does not exist in peer-type
@@ -1703,16 +1740,12 @@ func autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Ba
 	out.Enabled = in.Enabled
 	out.DisableIngressRules = in.DisableIngressRules
 	out.AllowedCIDRBlocks = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRBlocks))
+	// WARNING: in.AllowedPrefixListIDs requires manual conversion: does not exist in peer-type
 	out.InstanceType = in.InstanceType
 	out.AMI = in.AMI
 	return nil
 }
 
-// Convert_v1beta2_Bastion_To_v1beta1_Bastion is an autogenerated conversion function.
-func Convert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Bastion, s conversion.Scope) error {
-	return autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in, out, s)
-}
-
 func autoConvert_v1beta1_BuildParams_To_v1beta2_BuildParams(in *BuildParams, out *v1beta2.BuildParams, s conversion.Scope) error {
 	out.Lifecycle = v1beta2.ResourceLifecycle(in.Lifecycle)
 	out.ClusterName = in.ClusterName
@@ -1977,6 +2010,8 @@ func autoConvert_v1beta2_IngressRule_To_v1beta1_IngressRule(in *v1beta2.IngressR
 	out.SourceSecurityGroupIDs = *(*[]string)(unsafe.Pointer(&in.SourceSecurityGroupIDs))
 	// WARNING: in.SourceSecurityGroupRoles requires manual conversion: does not exist in peer-type
 	// WARNING: in.NatGatewaysIPsSource requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourcePrefixListIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourcePrefixListNames requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1995,8 +2030,26 @@ func autoConvert_v1beta1_Instance_To_v1beta2_Instance(in *Instance, out *v1beta2
 	out.PublicIP = (*string)(unsafe.Pointer(in.PublicIP))
 	out.ENASupport = (*bool)(unsafe.Pointer(in.ENASupport))
 	out.EBSOptimized = (*bool)(unsafe.Pointer(in.EBSOptimized))
-	out.RootVolume = (*v1beta2.Volume)(unsafe.Pointer(in.RootVolume))
-	out.NonRootVolumes = *(*[]v1beta2.Volume)(unsafe.Pointer(&in.NonRootVolumes))
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(v1beta2.Volume)
+		if err := Convert_v1beta1_Volume_To_v1beta2_Volume(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RootVolume = nil
+	}
+	if in.NonRootVolumes != nil {
+		in, out := &in.NonRootVolumes, &out.NonRootVolumes
+		*out = make([]v1beta2.Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Volume_To_v1beta2_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NonRootVolumes = nil
+	}
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
 	out.AvailabilityZone = in.AvailabilityZone
@@ -2026,8 +2079,26 @@ func autoConvert_v1beta2_Instance_To_v1beta1_Instance(in *v1beta2.Instance, out
 	out.PublicIP = (*string)(unsafe.Pointer(in.PublicIP))
 	out.ENASupport = (*bool)(unsafe.Pointer(in.ENASupport))
 	out.EBSOptimized = (*bool)(unsafe.Pointer(in.EBSOptimized))
-	out.RootVolume = (*Volume)(unsafe.Pointer(in.RootVolume))
-	out.NonRootVolumes = *(*[]Volume)(unsafe.Pointer(&in.NonRootVolumes))
+	if in.RootVolume != nil {
+		in, out := &in.RootVolume, &out.RootVolume
+		*out = new(Volume)
+		if err := Convert_v1beta2_Volume_To_v1beta1_Volume(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RootVolume = nil
+	}
+	if in.NonRootVolumes != nil {
+		in, out := &in.NonRootVolumes, &out.NonRootVolumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_Volume_To_v1beta1_Volume(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.NonRootVolumes = nil
+	}
 	out.NetworkInterfaces = *(*[]string)(unsafe.Pointer(&in.NetworkInterfaces))
 	// WARNING: in.NetworkInterfaceType requires manual conversion: does not exist in peer-type
 	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
@@ -2045,6 +2116,10 @@ func autoConvert_v1beta2_Instance_To_v1beta1_Instance(in *v1beta2.Instance, out
 	// WARNING: in.HostAffinity requires manual conversion: does not exist in peer-type
 	// WARNING: in.HostID requires manual conversion: does not exist in peer-type
 	// WARNING: in.CapacityReservationPreference requires manual conversion: does not exist in peer-type
+	// WARNING: in.HibernationOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnclaveOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.ENAExpress requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2093,6 +2168,13 @@ func autoConvert_v1beta2_NetworkSpec_To_v1beta1_NetworkSpec(in *v1beta2.NetworkS
 	// WARNING: in.AdditionalControlPlaneIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalNodeIngressRules requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodePortIngressRuleCidrBlocks requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodePortIngressRulePrefixListIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalControlPlaneEgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalNodeEgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.SecurityGroupEgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrefixList requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCEndpoints requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkACLs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2139,7 +2221,13 @@ func autoConvert_v1beta2_NetworkStatus_To_v1beta1_NetworkStatus(in *v1beta2.Netw
 		return err
 	}
 	// WARNING: in.SecondaryAPIServerELB requires manual conversion: does not exist in peer-type
+	// WARNING: in.APIServerDNSRecord requires manual conversion: does not exist in peer-type
 	// WARNING: in.NatGatewaysIPs requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrefixListID requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCEndpoints requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGatewayAttachment requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLogIDs requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkACLIDs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2221,15 +2309,12 @@ func autoConvert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in *v1beta2.Secu
 	} else {
 		out.IngressRules = nil
 	}
+	// WARNING: in.EgressRules requires manual conversion: does not exist in peer-type
+	// WARNING: in.EgressRulesManaged requires manual conversion: does not exist in peer-type
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	return nil
 }
 
-// Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup is an autogenerated conversion function.
-func Convert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in *v1beta2.SecurityGroup, out *SecurityGroup, s conversion.Scope) error {
-	return autoConvert_v1beta2_SecurityGroup_To_v1beta1_SecurityGroup(in, out, s)
-}
-
 func autoConvert_v1beta1_SpotMarketOptions_To_v1beta2_SpotMarketOptions(in *SpotMarketOptions, out *v1beta2.SpotMarketOptions, s conversion.Scope) error {
 	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
 	return nil
@@ -2277,6 +2362,7 @@ func autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *v1beta2.SubnetSpec
 	out.IsPublic = in.IsPublic
 	out.IsIPv6 = in.IsIPv6
 	out.RouteTableID = (*string)(unsafe.Pointer(in.RouteTableID))
+	// WARNING: in.NetworkACL requires manual conversion: does not exist in peer-type
 	out.NatGatewayID = (*string)(unsafe.Pointer(in.NatGatewayID))
 	out.Tags = *(*Tags)(unsafe.Pointer(&in.Tags))
 	// WARNING: in.ZoneType requires manual conversion: does not exist in peer-type
@@ -2331,6 +2417,8 @@ func autoConvert_v1beta2_VPCSpec_To_v1beta1_VPCSpec(in *v1beta2.VPCSpec, out *VP
 	// WARNING: in.PrivateDNSHostnameTypeOnLaunch requires manual conversion: does not exist in peer-type
 	// WARNING: in.ElasticIPPool requires manual conversion: does not exist in peer-type
 	// WARNING: in.SubnetSchema requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.FlowLogs requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -2358,10 +2446,6 @@ func autoConvert_v1beta2_Volume_To_v1beta1_Volume(in *v1beta2.Volume, out *Volum
 	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
 	out.EncryptionKey = in.EncryptionKey
+	// WARNING: in.Snapshot requires manual conversion: does not exist in peer-type
 	return nil
 }
-
-// Convert_v1beta2_Volume_To_v1beta1_Volume is an autogenerated conversion function.
-func Convert_v1beta2_Volume_To_v1beta1_Volume(in *v1beta2.Volume, out *Volume, s conversion.Scope) error {
-	return autoConvert_v1beta2_Volume_To_v1beta1_Volume(in, out, s)
-}
@@ -146,7 +146,7 @@ type Bastion struct {
 	Enabled bool `json:"enabled"`
 
 	// DisableIngressRules will ensure there are no Ingress rules in the bastion host's security group.
-	// Requires AllowedCIDRBlocks to be empty.
+	// Requires AllowedCIDRBlocks and AllowedPrefixListIDs to be empty.
 	// +optional
 	DisableIngressRules bool `json:"disableIngressRules,omitempty"`
 
@@ -155,6 +155,11 @@ type Bastion struct {
 	// +optional
 	AllowedCIDRBlocks []string `json:"allowedCIDRBlocks,omitempty"`
 
+	// AllowedPrefixListIDs is a list of managed prefix list IDs allowed to access the bastion host.
+	// They are set as ingress rules for the Bastion host's Security Group in addition to AllowedCIDRBlocks.
+	// +optional
+	AllowedPrefixListIDs []string `json:"allowedPrefixListIds,omitempty"`
+
 	// InstanceType will use the specified instance type for the bastion. If not specified,
 	// Cluster API Provider AWS will use t3.micro for all regions except us-east-1, where t2.micro
 	// will be the default.
@@ -252,6 +257,38 @@ type AWSLoadBalancerSpec struct {
 	// PreserveClientIP lets the user control if preservation of client ips must be retained or not.
 	// If this is enabled 6443 will be opened to 0.0.0.0/0.
 	PreserveClientIP bool `json:"preserveClientIP,omitempty"`
+
+	// DNS configures a Route53 alias record pointing at the load balancer. When set, the record
+	// is used as the control plane endpoint instead of the DNS name of the load balancer, and
+	// is deleted with the cluster. It can only be set on the primary control plane load balancer,
+	// and cannot be changed once the cluster is created.
+	// +optional
+	DNS *LoadBalancerDNSSpec `json:"dns,omitempty"`
+}
+
+// LoadBalancerDNSSpec defines the Route53 alias record of a load balancer.
+type LoadBalancerDNSSpec struct {
+	// HostedZoneID is the ID of the Route53 hosted zone in which the record is created.
+	// Exactly one of HostedZoneID and HostedZoneName must be set.
+	// +optional
+	HostedZoneID string `json:"hostedZoneID,omitempty"`
+
+	// HostedZoneName is the name of the Route53 hosted zone in which the record is created.
+	// The hosted zone is looked up by name, and must be unique amongst the public or private
+	// hosted zones of the account, depending on PrivateZone.
+	// Exactly one of HostedZoneID and HostedZoneName must be set.
+	// +optional
+	HostedZoneName string `json:"hostedZoneName,omitempty"`
+
+	// PrivateZone selects a private hosted zone when looking up the hosted zone by name.
+	// Defaults to false, selecting a public hosted zone.
+	// +optional
+	PrivateZone bool `json:"privateZone,omitempty"`
+
+	// RecordName is the fully qualified domain name of the record, which must belong to the
+	// hosted zone. It is used as the control plane endpoint of the cluster.
+	// +kubebuilder:validation:MinLength=1
+	RecordName string `json:"recordName"`
 }
 
 // AdditionalListenerSpec defines the desired state of an
@@ -20,11 +20,13 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -34,6 +36,15 @@ import (
 	"sigs.k8s.io/cluster-api/util/annotations"
 )
 
+// awsClusterSecurityGroupRoles are the roles of the security groups reconciled for an AWSCluster.
+var awsClusterSecurityGroupRoles = []SecurityGroupRole{
+	SecurityGroupBastion,
+	SecurityGroupAPIServerLB,
+	SecurityGroupLB,
+	SecurityGroupControlPlane,
+	SecurityGroupNode,
+}
+
 const (
 	warningClassicELB                = "%s load balancer is using a classic elb which is deprecated & support will be removed in a future release, please consider using another type of load balancer instead"
 	warningHealthCheckProtocolNotSet = "healthcheck protocol is not set, the default value has changed from SSL to TCP. Health checks for existing clusters will be updated to TCP"
@@ -168,6 +179,8 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.Spec.S3Bucket.Validate()...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.PrefixList.Validate(field.NewPath("spec", "network", "prefixList"))...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.PrefixList.ValidateUpdate(field.NewPath("spec", "network", "prefixList"), oldC.Spec.NetworkSpec.PrefixList)...)
 
 	if r.Spec.ControlPlaneLoadBalancer != nil {
 		if r.Spec.ControlPlaneLoadBalancer.LoadBalancerType == LoadBalancerTypeClassic {
@@ -191,6 +204,18 @@ func (*awsClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runti
 func (r *AWSCluster) validateControlPlaneLoadBalancerUpdate(oldlb, newlb *AWSLoadBalancerSpec) field.ErrorList {
 	var allErrs field.ErrorList
 
+	// The DNS record is used as the control plane endpoint, which is immutable.
+	var oldDNS *LoadBalancerDNSSpec
+	if oldlb != nil {
+		oldDNS = oldlb.DNS
+	}
+	if !cmp.Equal(oldDNS, newlb.DNS) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"),
+				newlb.DNS, "field is immutable"),
+		)
+	}
+
 	if oldlb == nil {
 		// If old scheme was nil, the only value accepted here is the default value: internet-facing
 		if newlb.Scheme != nil && newlb.Scheme.String() != ELBSchemeInternetFacing.String() {
@@ -325,6 +350,30 @@ func (r *AWSCluster) validateNetwork() field.ErrorList {
 
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalControlPlaneIngressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneIngressRules)...)
 	allErrs = append(allErrs, r.validateIngressRules(field.NewPath("spec", "network", "additionalNodeIngressRules"), r.Spec.NetworkSpec.AdditionalNodeIngressRules)...)
+	allErrs = append(allErrs, r.validateEgressRules(field.NewPath("spec", "network", "additionalControlPlaneEgressRules"), r.Spec.NetworkSpec.AdditionalControlPlaneEgressRules)...)
+	allErrs = append(allErrs, r.validateEgressRules(field.NewPath("spec", "network", "additionalNodeEgressRules"), r.Spec.NetworkSpec.AdditionalNodeEgressRules)...)
+	for role, rules := range r.Spec.NetworkSpec.SecurityGroupEgressRules {
+		rolePath := field.NewPath("spec", "network", "securityGroupEgressRules").Key(string(role))
+		if !slices.Contains(awsClusterSecurityGroupRoles, role) {
+			allErrs = append(allErrs, field.NotSupported(rolePath, role, awsClusterSecurityGroupRoles))
+		}
+		allErrs = append(allErrs, r.validateEgressRules(rolePath, rules)...)
+	}
+	allErrs = append(allErrs, r.Spec.NetworkSpec.PrefixList.Validate(field.NewPath("spec", "network", "prefixList"))...)
+	allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.TransitGateway.Validate(field.NewPath("spec", "network", "vpc", "transitGateway"))...)
+	for i := range r.Spec.NetworkSpec.VPCEndpoints {
+		allErrs = append(allErrs, r.Spec.NetworkSpec.VPCEndpoints[i].Validate(field.NewPath("spec", "network", "vpcEndpoints").Index(i))...)
+	}
+	if r.Spec.S3Bucket != nil {
+		allErrs = append(allErrs, validateVPCEndpointsWithBucket(r.Spec.NetworkSpec.VPCEndpoints, field.NewPath("spec", "network", "vpcEndpoints"))...)
+	}
+	for i := range r.Spec.NetworkSpec.VPC.FlowLogs {
+		allErrs = append(allErrs, r.Spec.NetworkSpec.VPC.FlowLogs[i].Validate(field.NewPath("spec", "network", "vpc", "flowLogs").Index(i))...)
+	}
+	allErrs = append(allErrs, r.Spec.NetworkSpec.NetworkACLs.Validate(field.NewPath("spec", "network", "networkACLs"))...)
+	for i := range r.Spec.NetworkSpec.Subnets {
+		allErrs = append(allErrs, r.Spec.NetworkSpec.Subnets[i].NetworkACL.Validate(field.NewPath("spec", "network", "subnets").Index(i).Child("networkACL"))...)
+	}
 
 	for cidrBlockIndex, cidrBlock := range r.Spec.NetworkSpec.NodePortIngressRuleCidrBlocks {
 		if _, _, err := net.ParseCIDR(cidrBlock); err != nil {
@@ -443,23 +492,76 @@ func (r *AWSCluster) validateControlPlaneLBs() (admission.Warnings, field.ErrorL
 		if r.Spec.ControlPlaneLoadBalancer.DisableHostsRewrite {
 			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "disableHostsRewrite"), r.Spec.ControlPlaneLoadBalancer.DisableHostsRewrite, "cannot disable hosts rewrite if the LoadBalancer reconciliation is disabled"))
 		}
+
+		if r.Spec.ControlPlaneLoadBalancer.DNS != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"), r.Spec.ControlPlaneLoadBalancer.DNS, "cannot configure a DNS record if the LoadBalancer reconciliation is disabled"))
+		}
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer != nil && r.Spec.ControlPlaneLoadBalancer.DNS != nil {
+		allErrs = append(allErrs, r.validateLoadBalancerDNS(field.NewPath("spec", "controlPlaneLoadBalancer", "dns"), r.Spec.ControlPlaneLoadBalancer.DNS)...)
+	}
+
+	if r.Spec.SecondaryControlPlaneLoadBalancer != nil && r.Spec.SecondaryControlPlaneLoadBalancer.DNS != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "secondaryControlPlaneLoadBalancer", "dns"), "DNS records can only be configured on the primary control plane load balancer"))
 	}
 
 	return allWarnings, allErrs
 }
 
+func (r *AWSCluster) validateLoadBalancerDNS(path *field.Path, dns *LoadBalancerDNSSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case dns.HostedZoneID != "" && dns.HostedZoneName != "":
+		allErrs = append(allErrs, field.Forbidden(path.Child("hostedZoneName"), "hostedZoneID and hostedZoneName are mutually exclusive"))
+	case dns.HostedZoneID == "" && dns.HostedZoneName == "":
+		allErrs = append(allErrs, field.Required(path.Child("hostedZoneID"), "either hostedZoneID or hostedZoneName must be set"))
+	}
+
+	if dns.PrivateZone && dns.HostedZoneName == "" {
+		allErrs = append(allErrs, field.Forbidden(path.Child("privateZone"), "privateZone can only be set when the hosted zone is looked up by name"))
+	}
+
+	recordName := strings.TrimSuffix(dns.RecordName, ".")
+	for _, msg := range validation.IsDNS1123Subdomain(recordName) {
+		allErrs = append(allErrs, field.Invalid(path.Child("recordName"), dns.RecordName, msg))
+	}
+
+	if zoneName := strings.TrimSuffix(dns.HostedZoneName, "."); zoneName != "" && recordName != zoneName && !strings.HasSuffix(recordName, "."+zoneName) {
+		allErrs = append(allErrs, field.Invalid(path.Child("recordName"), dns.RecordName, "must belong to the hosted zone"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSCluster) validateIngressRules(path *field.Path, rules []IngressRule) field.ErrorList {
 	var allErrs field.ErrorList
 	for ruleIndex, rule := range rules {
 		rulePath := path.Index(ruleIndex)
 		if rule.NatGatewaysIPsSource {
-			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil {
-				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, security group IDs or security group roles"))
+			if rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil || rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil ||
+				rule.SourcePrefixListIDs != nil || rule.SourcePrefixListNames != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "natGatewaysIPsSource cannot be used together with CIDR blocks, security group IDs, security group roles or prefix lists"))
 			}
 		} else {
 			if (rule.CidrBlocks != nil || rule.IPv6CidrBlocks != nil) && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
 				allErrs = append(allErrs, field.Invalid(rulePath, rules, "CIDR blocks and security group IDs or security group roles cannot be used together"))
 			}
+			if (rule.SourcePrefixListIDs != nil || rule.SourcePrefixListNames != nil) && (rule.SourceSecurityGroupIDs != nil || rule.SourceSecurityGroupRoles != nil) {
+				allErrs = append(allErrs, field.Invalid(rulePath, rules, "prefix lists and security group IDs or security group roles cannot be used together"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func (r *AWSCluster) validateEgressRules(path *field.Path, rules []EgressRule) field.ErrorList {
+	var allErrs field.ErrorList
+	for ruleIndex, rule := range rules {
+		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.DestinationSecurityGroupIDs) == 0 &&
+			len(rule.DestinationSecurityGroupRoles) == 0 && len(rule.PrefixListIDs) == 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(ruleIndex), rule, "at least one of CIDR blocks, IPv6 CIDR blocks, security group IDs, security group roles or prefix list IDs must be set"))
 		}
 	}
 	return allErrs
@@ -409,6 +409,40 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "rejects ingress rules with prefix list and source security group role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:                 SecurityGroupProtocolTCP,
+								SourcePrefixListNames:    []string{"office"},
+								SourceSecurityGroupRoles: []SecurityGroupRole{SecurityGroupBastion},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts ingress rules with prefix list and cidr block",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						IngressRules: []IngressRule{
+							{
+								Protocol:            SecurityGroupProtocolTCP,
+								CidrBlocks:          []string{"10.0.0.0/16"},
+								SourcePrefixListIDs: []string{"pl-1"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "rejects ingress rules with cidr block, source security group id, role and nat gateway IP source",
 			cluster: &AWSCluster{
@@ -724,6 +758,113 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "accepts CP egress rules with cidr block and destination security group role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalControlPlaneEgressRules: []EgressRule{
+							{
+								Protocol:                      SecurityGroupProtocolTCP,
+								FromPort:                      443,
+								ToPort:                        443,
+								CidrBlocks:                    []string{"10.0.0.0/16"},
+								DestinationSecurityGroupRoles: []SecurityGroupRole{SecurityGroupNode},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects node egress rules without destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						AdditionalNodeEgressRules: []EgressRule{
+							{
+								Protocol: SecurityGroupProtocolTCP,
+								FromPort: 443,
+								ToPort:   443,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "accepts security group egress rules override with prefix list",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupBastion: {
+								{
+									Protocol:      SecurityGroupProtocolAll,
+									PrefixListIDs: []string{"pl-1234"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rejects security group egress rules override without destination",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupBastion: {
+								{
+									Protocol: SecurityGroupProtocolAll,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules override of an unknown role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							"nodes": {
+								{
+									Protocol:   SecurityGroupProtocolAll,
+									CidrBlocks: []string{"10.0.0.0/16"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects security group egress rules override of the additional EKS node role",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					NetworkSpec: NetworkSpec{
+						SecurityGroupEgressRules: map[SecurityGroupRole]EgressRules{
+							SecurityGroupEKSNodeAdditional: {
+								{
+									Protocol:   SecurityGroupProtocolAll,
+									CidrBlocks: []string{"10.0.0.0/16"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "accepts cidrBlock for default node port ingress rule",
 			cluster: &AWSCluster{
@@ -746,6 +887,85 @@ func TestAWSClusterValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "control plane load balancer DNS record with hosted zone ID is accepted",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						DNS: &LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789ABCDEFGHIJ",
+							RecordName:   "api.cluster.example.com",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "control plane load balancer DNS record outside of the hosted zone is rejected",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						DNS: &LoadBalancerDNSSpec{
+							HostedZoneName: "example.com",
+							RecordName:     "api.cluster.example.org",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "control plane load balancer DNS record without hosted zone is rejected",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						DNS: &LoadBalancerDNSSpec{
+							RecordName: "api.cluster.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "control plane load balancer DNS record is rejected when the load balancer is disabled",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeDisabled,
+						DNS: &LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789ABCDEFGHIJ",
+							RecordName:   "api.cluster.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "secondary control plane load balancer DNS record is rejected",
+			cluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+					SecondaryControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						Name:             ptr.To("test-lb"),
+						LoadBalancerType: LoadBalancerTypeNLB,
+						Scheme:           &ELBSchemeInternal,
+						DNS: &LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789ABCDEFGHIJ",
+							RecordName:   "api-internal.cluster.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1190,6 +1410,28 @@ func TestAWSClusterValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Control Plane LB DNS record is immutable",
+			oldCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+					},
+				},
+			},
+			newCluster: &AWSCluster{
+				Spec: AWSClusterSpec{
+					ControlPlaneLoadBalancer: &AWSLoadBalancerSpec{
+						LoadBalancerType: LoadBalancerTypeNLB,
+						DNS: &LoadBalancerDNSSpec{
+							HostedZoneID: "Z0123456789ABCDEFGHIJ",
+							RecordName:   "api.cluster.example.com",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "incorrect GC tasks annotation",
 			oldCluster: &AWSCluster{
@@ -253,6 +253,64 @@ type AWSMachineSpec struct {
 	// +kubebuilder:validation:Enum="";None;CapacityReservationsOnly;Open
 	// +optional
 	CapacityReservationPreference CapacityReservationPreference `json:"capacityReservationPreference,omitempty"`
+
+	// HibernationOptions configures hibernation support of the instance, which is required for
+	// the Hibernated power state.
+	// +optional
+	HibernationOptions *HibernationOptions `json:"hibernationOptions,omitempty"`
+
+	// CPUOptions configures the CPU core count, threads per core and AMD SEV-SNP of the instance.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// EnclaveOptions configures AWS Nitro Enclaves support of the instance.
+	// +optional
+	EnclaveOptions *EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// ENAExpress configures ENA Express on the primary network interface of the instance.
+	// It can't be set together with NetworkInterfaces.
+	// +optional
+	ENAExpress *ENAExpress `json:"enaExpress,omitempty"`
+
+	// PowerState is the desired power state of the instance. Stopped and Hibernated stop the instance,
+	// which keeps its volumes and network interfaces. While the instance is stopped, the Machine is
+	// excluded from remediation by MachineHealthChecks.
+	// If not set, the power state requested by the aws.cluster.x-k8s.io/power-state annotation
+	// on the Cluster is used for worker machines, and Running otherwise.
+	// +optional
+	PowerState PowerState `json:"powerState,omitempty"`
+
+	// PreTerminationSnapshots configures snapshots of the EBS volumes of the instance taken before it is terminated,
+	// e.g. to rebuild stateful nodes from backups by referencing the snapshots in NonRootVolumes.
+	// +optional
+	PreTerminationSnapshots *PreTerminationSnapshots `json:"preTerminationSnapshots,omitempty"`
+}
+
+// PreTerminationSnapshots configures the snapshots taken of the EBS volumes of an instance before it is terminated.
+type PreTerminationSnapshots struct {
+	// DeviceNames are the device names of the volumes to snapshot, e.g. /dev/sdb.
+	// Devices that aren't attached to the instance are skipped.
+	// +kubebuilder:validation:MinItems=1
+	DeviceNames []string `json:"deviceNames"`
+
+	// RetentionDays is the number of days the snapshots should be retained for. It is recorded in the
+	// sigs.k8s.io/cluster-api-provider-aws/retain-until tag of the snapshots, which are never deleted
+	// by the controller.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+}
+
+// VolumeSnapshot describes a snapshot taken of an EBS volume.
+type VolumeSnapshot struct {
+	// DeviceName is the device name of the volume.
+	DeviceName string `json:"deviceName"`
+
+	// VolumeID is the ID of the volume.
+	VolumeID string `json:"volumeID"`
+
+	// SnapshotID is the ID of the snapshot.
+	SnapshotID string `json:"snapshotID"`
 }
 
 // CloudInit defines options related to the bootstrapping systems where
@@ -392,6 +450,19 @@ type AWSMachineStatus struct {
 	// +optional
 	InstanceState *InstanceState `json:"instanceState,omitempty"`
 
+	// PowerState is the power state the instance was last brought into.
+	// +optional
+	PowerState PowerState `json:"powerState,omitempty"`
+
+	// VolumeModifications reports the latest in-place modification of each EBS volume attached to the
+	// instance, if any.
+	// +optional
+	VolumeModifications []VolumeModification `json:"volumeModifications,omitempty"`
+
+	// PreTerminationSnapshots are the snapshots taken of the EBS volumes of the instance before it was terminated.
+	// +optional
+	PreTerminationSnapshots []VolumeSnapshot `json:"preTerminationSnapshots,omitempty"`
+
 	// FailureReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
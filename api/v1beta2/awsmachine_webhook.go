@@ -80,6 +80,9 @@ func (*awsMachineWebhook) ValidateCreate(_ context.Context, obj runtime.Object)
 	allErrs = append(allErrs, r.validateNetworkElasticIPPool()...)
 	allErrs = append(allErrs, r.validateInstanceMarketType()...)
 	allErrs = append(allErrs, r.validateCapacityReservation()...)
+	allErrs = append(allErrs, r.validatePowerState()...)
+	allErrs = append(allErrs, r.validatePreTerminationSnapshots()...)
+	allErrs = append(allErrs, r.validateENAExpress()...)
 
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
@@ -110,6 +113,11 @@ func (*awsMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj run
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateHostAffinity()...)
+	allErrs = append(allErrs, r.validatePowerState()...)
+	allErrs = append(allErrs, r.validateRootVolume()...)
+	allErrs = append(allErrs, r.validateNonRootVolumes()...)
+	allErrs = append(allErrs, r.validateVolumeSizes(oldObj.(*AWSMachine))...)
+	allErrs = append(allErrs, r.validatePreTerminationSnapshots()...)
 
 	newAWSMachineSpec := newAWSMachine["spec"].(map[string]interface{})
 	oldAWSMachineSpec := oldAWSMachine["spec"].(map[string]interface{})
@@ -130,6 +138,18 @@ func (*awsMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj run
 	delete(oldAWSMachineSpec, "additionalSecurityGroups")
 	delete(newAWSMachineSpec, "additionalSecurityGroups")
 
+	// allow changes to powerState
+	delete(oldAWSMachineSpec, "powerState")
+	delete(newAWSMachineSpec, "powerState")
+
+	// allow changes to preTerminationSnapshots
+	delete(oldAWSMachineSpec, "preTerminationSnapshots")
+	delete(newAWSMachineSpec, "preTerminationSnapshots")
+
+	// allow changes to the size, type, IOPS and throughput of volumes, which are modified in place
+	deleteModifiableVolumeFields(oldAWSMachineSpec)
+	deleteModifiableVolumeFields(newAWSMachineSpec)
+
 	// allow changes to secretPrefix, secretCount, and secureSecretsBackend
 	if cloudInit, ok := oldAWSMachineSpec["cloudInit"].(map[string]interface{}); ok {
 		delete(cloudInit, "secretPrefix")
@@ -161,6 +181,43 @@ func (*awsMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj run
 	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
+// deleteModifiableVolumeFields removes the fields of the root and non-root volumes of an unstructured AWSMachine
+// spec that can be modified on attached EBS volumes.
+func deleteModifiableVolumeFields(spec map[string]interface{}) {
+	volumes := []interface{}{spec["rootVolume"]}
+	if nonRootVolumes, ok := spec["nonRootVolumes"].([]interface{}); ok {
+		volumes = append(volumes, nonRootVolumes...)
+	}
+
+	for _, v := range volumes {
+		if volume, ok := v.(map[string]interface{}); ok {
+			delete(volume, "size")
+			delete(volume, "type")
+			delete(volume, "iops")
+			delete(volume, "throughput")
+		}
+	}
+}
+
+// validateVolumeSizes ensures volumes are not shrunk, which EBS does not support.
+func (r *AWSMachine) validateVolumeSizes(old *AWSMachine) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.RootVolume != nil && old.Spec.RootVolume != nil && r.Spec.RootVolume.Size < old.Spec.RootVolume.Size {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rootVolume", "size"), r.Spec.RootVolume.Size, "volumes cannot be shrunk"))
+	}
+
+	for i := range r.Spec.NonRootVolumes {
+		for j := range old.Spec.NonRootVolumes {
+			if r.Spec.NonRootVolumes[i].DeviceName == old.Spec.NonRootVolumes[j].DeviceName && r.Spec.NonRootVolumes[i].Size < old.Spec.NonRootVolumes[j].Size {
+				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "nonRootVolumes").Index(i).Child("size"), r.Spec.NonRootVolumes[i].Size, "volumes cannot be shrunk"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -183,6 +240,56 @@ func (r *AWSMachine) validateCloudInitSecret() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachine) validatePowerState() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.PowerState == PowerStateHibernated && (r.Spec.HibernationOptions == nil || !r.Spec.HibernationOptions.Configured) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "powerState"), r.Spec.PowerState, "requires spec.hibernationOptions.configured to be true"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachine) validateENAExpress() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.ENAExpress == nil {
+		return allErrs
+	}
+
+	if r.Spec.ENAExpress.UDPEnabled && !r.Spec.ENAExpress.Enabled {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "enaExpress", "udpEnabled"), "requires spec.enaExpress.enabled to be true"))
+	}
+
+	if len(r.Spec.NetworkInterfaces) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "enaExpress"), "cannot be set together with spec.networkInterfaces"))
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachine) validatePreTerminationSnapshots() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.PreTerminationSnapshots == nil {
+		return allErrs
+	}
+
+	seen := map[string]bool{}
+	for i, deviceName := range r.Spec.PreTerminationSnapshots.DeviceNames {
+		path := field.NewPath("spec", "preTerminationSnapshots", "deviceNames").Index(i)
+		if deviceName == "" {
+			allErrs = append(allErrs, field.Required(path, "device name must not be empty"))
+		}
+		if seen[deviceName] {
+			allErrs = append(allErrs, field.Duplicate(path, deviceName))
+		}
+		seen[deviceName] = true
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachine) cloudInitConfigured() bool {
 	configured := false
 
@@ -353,6 +460,10 @@ func (r *AWSMachine) validateRootVolume() field.ErrorList {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
 
+	if r.Spec.RootVolume.Snapshot != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.rootVolume.snapshot"), "root volume can't be restored from a snapshot"))
+	}
+
 	return allErrs
 }
 
@@ -429,6 +540,10 @@ func (r *AWSMachine) validateNonRootVolumes() field.ErrorList {
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.Snapshot != nil && volume.Snapshot.ID != nil && len(volume.Snapshot.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.nonRootVolumes.snapshot"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
 	}
 
 	return allErrs
@@ -567,6 +567,122 @@ func TestAWSMachineCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "hibernated power state with hibernation configured is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:       "type",
+					PowerState:         PowerStateHibernated,
+					HibernationOptions: &HibernationOptions{Configured: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "hibernated power state without hibernation configured is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					PowerState:   PowerStateHibernated,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "non root volume restored from a snapshot is accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       50,
+							Snapshot: &AWSResourceReference{
+								Filters: []Filter{{Name: "tag:Name", Values: []string{"etcd"}}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "non root volume snapshot with both ID and filters is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       50,
+							Snapshot: &AWSResourceReference{
+								ID:      aws.String("snap-1"),
+								Filters: []Filter{{Name: "tag:Name", Values: []string{"etcd"}}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "root volume restored from a snapshot is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					RootVolume: &Volume{
+						Size:     20,
+						Snapshot: &AWSResourceReference{ID: aws.String("snap-1")},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate pre-termination snapshot device names are rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					PreTerminationSnapshots: &PreTerminationSnapshots{
+						DeviceNames: []string{"/dev/sdb", "/dev/sdb"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cpu, enclave and ENA Express options are accepted",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:   "type",
+					CPUOptions:     &CPUOptions{CoreCount: aws.Int32(2), ThreadsPerCore: aws.Int32(1)},
+					EnclaveOptions: &EnclaveOptions{Enabled: true},
+					ENAExpress:     &ENAExpress{Enabled: true, UDPEnabled: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ENA Express UDP without ENA Express is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "type",
+					ENAExpress:   &ENAExpress{UDPEnabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ENA Express with network interfaces is rejected",
+			machine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType:      "type",
+					NetworkInterfaces: []string{"eni-1"},
+					ENAExpress:        &ENAExpress{Enabled: true},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -681,6 +797,152 @@ func TestAWSMachineUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "change in power state",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PowerState:   PowerStateStopped,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change in pre-termination snapshots",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PreTerminationSnapshots: &PreTerminationSnapshots{
+						DeviceNames:   []string{"/dev/sdb"},
+						RetentionDays: ptr.To[int32](30),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "change to hibernated power state without hibernation configured",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					PowerState:   PowerStateHibernated,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "growing and retyping volumes is allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+						Type: VolumeTypeGP2,
+					},
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       50,
+						},
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size:       40,
+						Type:       VolumeTypeGP3,
+						Throughput: aws.Int64(250),
+					},
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       100,
+							Type:       VolumeTypeIO2,
+							IOPS:       4000,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "shrinking volumes is not allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 40,
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "adding volumes is not allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					NonRootVolumes: []Volume{
+						{
+							DeviceName: "/dev/sdb",
+							Size:       50,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "changing volume encryption is not allowed",
+			oldMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size: 20,
+					},
+				},
+			},
+			newMachine: &AWSMachine{
+				Spec: AWSMachineSpec{
+					InstanceType: "test",
+					RootVolume: &Volume{
+						Size:      20,
+						Encrypted: aws.Bool(true),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		ctx := context.TODO()
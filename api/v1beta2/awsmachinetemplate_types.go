@@ -30,6 +30,44 @@ type AWSMachineTemplateStatus struct {
 	// https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20210310-opt-in-autoscaling-from-zero.md
 	// +optional
 	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// NodeInfo contains information about the nodes created from this template, used for
+	// autoscaling from zero operations alongside Capacity.
+	// +optional
+	NodeInfo *NodeInfo `json:"nodeInfo,omitempty"`
+}
+
+// Architecture is the CPU architecture of a node.
+type Architecture string
+
+const (
+	// ArchitectureAmd64 is the amd64 architecture.
+	ArchitectureAmd64 = Architecture("amd64")
+	// ArchitectureArm64 is the arm64 architecture.
+	ArchitectureArm64 = Architecture("arm64")
+)
+
+// OperatingSystem is the operating system of a node.
+type OperatingSystem string
+
+const (
+	// OperatingSystemLinux is the Linux operating system.
+	OperatingSystemLinux = OperatingSystem("linux")
+	// OperatingSystemWindows is the Windows operating system.
+	OperatingSystemWindows = OperatingSystem("windows")
+)
+
+// NodeInfo contains information about the architecture and operating system of a node.
+type NodeInfo struct {
+	// Architecture is the CPU architecture of the node.
+	// +kubebuilder:validation:Enum=amd64;arm64
+	// +optional
+	Architecture Architecture `json:"architecture,omitempty"`
+
+	// OperatingSystem is the operating system of the node.
+	// +kubebuilder:validation:Enum=linux;windows
+	// +optional
+	OperatingSystem OperatingSystem `json:"operatingSystem,omitempty"`
 }
 
 // AWSMachineTemplateSpec defines the desired state of AWSMachineTemplate.
@@ -40,6 +78,7 @@ type AWSMachineTemplateSpec struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=awsmachinetemplates,scope=Namespaced,categories=cluster-api,shortName=awsmt
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 // +k8s:defaulter-gen=true
 
 // AWSMachineTemplate is the schema for the Amazon EC2 Machine Templates API.
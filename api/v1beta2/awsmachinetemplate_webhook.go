@@ -74,6 +74,10 @@ func (r *AWSMachineTemplate) validateRootVolume() field.ErrorList {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
 
+	if spec.RootVolume.Snapshot != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.template.spec.rootVolume.snapshot"), "root volume can't be restored from a snapshot"))
+	}
+
 	return allErrs
 }
 
@@ -99,6 +103,10 @@ func (r *AWSMachineTemplate) validateNonRootVolumes() field.ErrorList {
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.Snapshot != nil && volume.Snapshot.ID != nil && len(volume.Snapshot.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.template.spec.nonRootVolumes.snapshot"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
 	}
 
 	return allErrs
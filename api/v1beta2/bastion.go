@@ -39,6 +39,13 @@ func (b *Bastion) Validate() []*field.Error {
 		return errs
 	}
 
+	if b.DisableIngressRules && len(b.AllowedPrefixListIDs) > 0 {
+		errs = append(errs,
+			field.Forbidden(field.NewPath("spec", "bastion", "allowedPrefixListIds"), "cannot be set if spec.bastion.disableIngressRules is true"),
+		)
+		return errs
+	}
+
 	for i, cidr := range b.AllowedCIDRBlocks {
 		if _, _, err := net.ParseCIDR(cidr); err != nil {
 			errs = append(errs,
@@ -103,6 +103,35 @@ const (
 	VpcEndpointsReconciliationFailedReason = "VpcEndpointsReconciliationFailed"
 )
 
+const (
+	// PrefixListReadyCondition reports successful reconciliation of the managed prefix list owned by the cluster.
+	PrefixListReadyCondition clusterv1.ConditionType = "PrefixListReady"
+	// PrefixListReconciliationFailedReason used when any errors occur during reconciliation of the managed prefix list.
+	PrefixListReconciliationFailedReason = "PrefixListReconciliationFailed"
+)
+
+const (
+	// NetworkACLsReadyCondition reports successful reconciliation of the network ACLs owned by the cluster.
+	// Only applicable to managed clusters.
+	NetworkACLsReadyCondition clusterv1.ConditionType = "NetworkACLsReady"
+	// NetworkACLsReconciliationFailedReason used when any errors occur during reconciliation of network ACLs.
+	NetworkACLsReconciliationFailedReason = "NetworkACLsReconciliationFailed"
+)
+
+const (
+	// TransitGatewayAttachmentReadyCondition reports on the successful reconciliation of the attachment
+	// of the VPC to a transit gateway.
+	TransitGatewayAttachmentReadyCondition clusterv1.ConditionType = "TransitGatewayAttachmentReady"
+	// TransitGatewayAttachmentFailedReason used when errors occur during reconciliation of the transit gateway attachment,
+	// or when the attachment was rejected or failed.
+	TransitGatewayAttachmentFailedReason = "TransitGatewayAttachmentFailed"
+	// TransitGatewayAttachmentPendingReason used while the transit gateway attachment is being created or modified.
+	TransitGatewayAttachmentPendingReason = "TransitGatewayAttachmentPending"
+	// TransitGatewayAttachmentPendingAcceptanceReason used while the transit gateway attachment waits
+	// for the owner of the transit gateway to accept it.
+	TransitGatewayAttachmentPendingAcceptanceReason = "TransitGatewayAttachmentPendingAcceptance"
+)
+
 const (
 	// SecondaryCidrsReadyCondition reports successful reconciliation of secondary CIDR blocks.
 	// Only applicable to managed clusters.
@@ -140,6 +169,11 @@ const (
 	WaitForDNSNameResolveReason = "WaitForDNSNameResolve"
 	// LoadBalancerFailedReason used when an error occurs during load balancer reconciliation.
 	LoadBalancerFailedReason = "LoadBalancerFailed"
+	// WaitForDNSRecordReason used while waiting for the DNS record of the API server load balancer to be created.
+	WaitForDNSRecordReason = "WaitForDNSRecord"
+	// LoadBalancerDNSRecordFailedReason used when an error occurs during the reconciliation of the DNS record of
+	// the API server load balancer.
+	LoadBalancerDNSRecordFailedReason = "LoadBalancerDNSRecordFailed"
 )
 
 const (
@@ -152,6 +186,10 @@ const (
 	InstanceTerminatedReason = "InstanceTerminated"
 	// InstanceStoppedReason instance is in a stopped state.
 	InstanceStoppedReason = "InstanceStopped"
+	// InstanceParkedReason instance is stopped or hibernated as requested by its power state.
+	InstanceParkedReason = "InstanceParked"
+	// InstanceStartingReason used when a parked instance is being started.
+	InstanceStartingReason = "InstanceStarting"
 	// InstanceNotReadyReason used when the instance is in a pending state.
 	InstanceNotReadyReason = "InstanceNotReady"
 	// InstanceProvisionStartedReason set when the provisioning of an instance started.
@@ -164,6 +202,32 @@ const (
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
 )
 
+const (
+	// InstanceHealthyCondition reports whether AWS announced that the EC2 instance is about to be interrupted,
+	// retired or terminated. It is only set when the EventBridgeInterruptionHandling feature is enabled.
+	InstanceHealthyCondition clusterv1.ConditionType = "InstanceHealthy"
+
+	// SpotInterruptionWarningReason used when a Spot instance interruption warning was received for the instance.
+	SpotInterruptionWarningReason = "SpotInterruptionWarning"
+	// RebalanceRecommendationReason used when a rebalance recommendation was received for the Spot instance.
+	RebalanceRecommendationReason = "RebalanceRecommendation"
+	// ScheduledMaintenanceReason used when an AWS Health scheduled change event was received for the instance.
+	ScheduledMaintenanceReason = "ScheduledMaintenance"
+	// AutoScalingTerminationReason used when the Auto Scaling group started terminating the instance.
+	AutoScalingTerminationReason = "AutoScalingTermination"
+)
+
+const (
+	// VolumesReadyCondition reports whether the EBS volumes attached to the instance match the volumes of the
+	// AWSMachine spec, or are being modified to match them.
+	VolumesReadyCondition clusterv1.ConditionType = "VolumesReady"
+
+	// VolumeModificationInProgressReason used while an EBS volume of the instance is being modified.
+	VolumeModificationInProgressReason = "VolumeModificationInProgress"
+	// VolumeModificationFailedReason used when an EBS volume of the instance could not be modified.
+	VolumeModificationFailedReason = "VolumeModificationFailed"
+)
+
 const (
 	// SecurityGroupsReadyCondition indicates the security groups are up to date on the AWSMachine.
 	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
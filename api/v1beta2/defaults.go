@@ -24,8 +24,8 @@ import (
 
 // SetDefaults_Bastion is used by defaulter-gen.
 func SetDefaults_Bastion(obj *Bastion) { //nolint:golint,stylecheck
-	// Default to allow open access to the bastion host if no CIDR Blocks have been set
-	if len(obj.AllowedCIDRBlocks) == 0 && !obj.DisableIngressRules {
+	// Default to allow open access to the bastion host if no CIDR Blocks or prefix lists have been set
+	if len(obj.AllowedCIDRBlocks) == 0 && len(obj.AllowedPrefixListIDs) == 0 && !obj.DisableIngressRules {
 		obj.AllowedCIDRBlocks = []string{"0.0.0.0/0"}
 	}
 }
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate will validate the VPC flow log fields.
+func (f *VPCFlowLogSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	destination, err := arn.Parse(f.Destination)
+	if err != nil {
+		errs = append(errs, field.Invalid(path.Child("destination"), f.Destination, "must be a valid ARN"))
+	}
+
+	switch f.DestinationType {
+	case FlowLogDestinationTypeS3:
+		if err == nil && destination.Service != "s3" {
+			errs = append(errs, field.Invalid(path.Child("destination"), f.Destination, "must be the ARN of an S3 bucket"))
+		}
+		if f.DeliverLogsPermissionARN != "" {
+			errs = append(errs, field.Forbidden(path.Child("deliverLogsPermissionArn"), "deliverLogsPermissionArn can only be set for cloud-watch-logs destinations"))
+		}
+	default:
+		if err == nil && destination.Service != "logs" {
+			errs = append(errs, field.Invalid(path.Child("destination"), f.Destination, "must be the ARN of a CloudWatch Logs group"))
+		}
+		if f.DeliverLogsPermissionARN == "" {
+			errs = append(errs, field.Required(path.Child("deliverLogsPermissionArn"), "deliverLogsPermissionArn is required for cloud-watch-logs destinations"))
+		} else if _, err := arn.Parse(f.DeliverLogsPermissionARN); err != nil {
+			errs = append(errs, field.Invalid(path.Child("deliverLogsPermissionArn"), f.DeliverLogsPermissionARN, "must be a valid ARN"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestVPCFlowLogSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flowLog VPCFlowLogSpec
+		wantErr bool
+	}{
+		{
+			name: "cloudwatch logs flow log is valid",
+			flowLog: VPCFlowLogSpec{
+				Destination:              "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+				DeliverLogsPermissionARN: "arn:aws:iam::123456789012:role/flow-logs",
+			},
+		},
+		{
+			name: "s3 flow log is valid",
+			flowLog: VPCFlowLogSpec{
+				DestinationType: FlowLogDestinationTypeS3,
+				Destination:     "arn:aws:s3:::flow-logs/cluster/",
+			},
+		},
+		{
+			name: "invalid destination is not valid",
+			flowLog: VPCFlowLogSpec{
+				Destination:              "flow-logs",
+				DeliverLogsPermissionARN: "arn:aws:iam::123456789012:role/flow-logs",
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 destination for cloudwatch logs flow log is not valid",
+			flowLog: VPCFlowLogSpec{
+				DestinationType:          FlowLogDestinationTypeCloudWatchLogs,
+				Destination:              "arn:aws:s3:::flow-logs",
+				DeliverLogsPermissionARN: "arn:aws:iam::123456789012:role/flow-logs",
+			},
+			wantErr: true,
+		},
+		{
+			name: "cloudwatch logs flow log without delivery role is not valid",
+			flowLog: VPCFlowLogSpec{
+				Destination: "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+			},
+			wantErr: true,
+		},
+		{
+			name: "s3 flow log with delivery role is not valid",
+			flowLog: VPCFlowLogSpec{
+				DestinationType:          FlowLogDestinationTypeS3,
+				Destination:              "arn:aws:s3:::flow-logs",
+				DeliverLogsPermissionARN: "arn:aws:iam::123456789012:role/flow-logs",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.flowLog.Validate(field.NewPath("spec", "network", "vpc", "flowLogs").Index(0))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -61,8 +61,62 @@ type NetworkStatus struct {
 	// SecondaryAPIServerELB is the secondary Kubernetes api server load balancer.
 	SecondaryAPIServerELB LoadBalancer `json:"secondaryAPIServerELB,omitempty"`
 
+	// APIServerDNSRecord is the Route53 alias record of the Kubernetes api server load balancer
+	// owned by the cluster.
+	// +optional
+	APIServerDNSRecord *DNSRecord `json:"apiServerDnsRecord,omitempty"`
+
 	// NatGatewaysIPs contains the public IPs of the NAT Gateways
 	NatGatewaysIPs []string `json:"natGatewaysIPs,omitempty"`
+
+	// PrefixListID is the ID of the managed prefix list owned by the cluster.
+	// +optional
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// VPCEndpoints are the VPC endpoints owned by the cluster.
+	// +optional
+	VPCEndpoints []VPCEndpoint `json:"vpcEndpoints,omitempty"`
+
+	// TransitGatewayAttachment is the attachment of the VPC to a transit gateway.
+	// +optional
+	TransitGatewayAttachment *TransitGatewayAttachment `json:"transitGatewayAttachment,omitempty"`
+
+	// FlowLogIDs are the IDs of the VPC flow logs owned by the cluster.
+	// +optional
+	FlowLogIDs []string `json:"flowLogIds,omitempty"`
+
+	// NetworkACLIDs are the IDs of the network ACLs owned by the cluster.
+	// +optional
+	NetworkACLIDs []string `json:"networkAclIds,omitempty"`
+}
+
+// TransitGatewayAttachment defines the attachment of the VPC to a transit gateway.
+type TransitGatewayAttachment struct {
+	// ID is the ID of the transit gateway attachment.
+	ID string `json:"id"`
+
+	// TransitGatewayID is the ID of the transit gateway.
+	TransitGatewayID string `json:"transitGatewayId"`
+
+	// State is the state of the transit gateway attachment.
+	// +optional
+	State string `json:"state,omitempty"`
+}
+
+// VPCEndpoint defines a VPC endpoint owned by the cluster.
+type VPCEndpoint struct {
+	// ID is the ID of the VPC endpoint.
+	ID string `json:"id"`
+
+	// ServiceName is the fully qualified name of the AWS service of the VPC endpoint.
+	ServiceName string `json:"serviceName"`
+
+	// Type is the type of the VPC endpoint.
+	Type VPCEndpointType `json:"type"`
+
+	// State is the state of the VPC endpoint.
+	// +optional
+	State string `json:"state,omitempty"`
 }
 
 // ELBScheme defines the scheme of a load balancer.
@@ -251,6 +305,15 @@ type Listener struct {
 	TargetGroup TargetGroupSpec `json:"targetGroup"`
 }
 
+// DNSRecord defines a Route53 record.
+type DNSRecord struct {
+	// HostedZoneID is the ID of the Route53 hosted zone of the record.
+	HostedZoneID string `json:"hostedZoneId"`
+
+	// Name is the fully qualified domain name of the record.
+	Name string `json:"name"`
+}
+
 // LoadBalancer defines an AWS load balancer.
 type LoadBalancer struct {
 	// ARN of the load balancer. Unlike the ClassicLB, ARN is used mostly
@@ -264,6 +327,11 @@ type LoadBalancer struct {
 	// DNSName is the dns name of the load balancer.
 	DNSName string `json:"dnsName,omitempty"`
 
+	// CanonicalHostedZoneID is the ID of the Route53 hosted zone of the load balancer,
+	// used as the target of alias records.
+	// +optional
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneId,omitempty"`
+
 	// Scheme is the load balancer scheme, either internet-facing or private.
 	Scheme ELBScheme `json:"scheme,omitempty"`
 
@@ -368,6 +436,220 @@ type NetworkSpec struct {
 	// If none are specified here, all IPs are allowed to connect.
 	// +optional
 	NodePortIngressRuleCidrBlocks []string `json:"nodePortIngressRuleCidrBlocks,omitempty"`
+
+	// NodePortIngressRulePrefixListIDs is an optional set of managed prefix list IDs to allow traffic
+	// to nodes' NodePort services. If set without NodePortIngressRuleCidrBlocks, only the prefix lists
+	// are allowed to connect.
+	// +optional
+	NodePortIngressRulePrefixListIDs []string `json:"nodePortIngressRulePrefixListIds,omitempty"`
+
+	// AdditionalControlPlaneEgressRules is an optional set of egress rules for the control plane.
+	// When set, they replace the default rule of the control plane security group allowing all
+	// outbound traffic.
+	// +optional
+	AdditionalControlPlaneEgressRules []EgressRule `json:"additionalControlPlaneEgressRules,omitempty"`
+
+	// AdditionalNodeEgressRules is an optional set of egress rules for every node.
+	// When set, they replace the default rule of the node security group allowing all
+	// outbound traffic.
+	// +optional
+	AdditionalNodeEgressRules []EgressRule `json:"additionalNodeEgressRules,omitempty"`
+
+	// SecurityGroupEgressRules is an optional set of egress rules per security group role.
+	// When set for a role, they replace the egress rules of the security group of the role,
+	// including the additional control plane and node egress rules.
+	// +optional
+	SecurityGroupEgressRules map[SecurityGroupRole]EgressRules `json:"securityGroupEgressRules,omitempty"`
+
+	// PrefixList is an optional managed prefix list created and owned by the cluster.
+	// It can be used as the source of ingress rules by its name.
+	// +optional
+	PrefixList *PrefixListSpec `json:"prefixList,omitempty"`
+
+	// VPCEndpoints is an optional set of VPC endpoints to create in the VPC, allowing the instances
+	// of the cluster to reach AWS services without internet access. VPC endpoints are only reconciled
+	// in VPCs managed by the cluster.
+	// +listType=map
+	// +listMapKey=serviceName
+	// +optional
+	VPCEndpoints []VPCEndpointSpec `json:"vpcEndpoints,omitempty"`
+
+	// NetworkACLs configures the default network ACLs of the public and private subnets managed by
+	// the cluster. The network ACL of a subnet can be overridden in the subnet spec. Network ACLs are
+	// only reconciled in VPCs managed by the cluster.
+	// +optional
+	NetworkACLs *NetworkACLsSpec `json:"networkACLs,omitempty"`
+}
+
+// NetworkACLsSpec configures the default network ACLs of the subnets by role.
+type NetworkACLsSpec struct {
+	// Public is the network ACL of the public subnets.
+	// +optional
+	Public *NetworkACLSpec `json:"public,omitempty"`
+
+	// Private is the network ACL of the private subnets.
+	// +optional
+	Private *NetworkACLSpec `json:"private,omitempty"`
+}
+
+// NetworkACLSpec configures the entries of a network ACL. Network ACLs are stateless: the traffic
+// that isn't allowed by an entry is denied, including the responses to the allowed traffic.
+type NetworkACLSpec struct {
+	// Ingress are the entries applied to the traffic entering the subnet.
+	// +optional
+	Ingress []NetworkACLEntry `json:"ingress,omitempty"`
+
+	// Egress are the entries applied to the traffic leaving the subnet.
+	// +optional
+	Egress []NetworkACLEntry `json:"egress,omitempty"`
+}
+
+// NetworkACLProtocol defines the protocol of a network ACL entry.
+type NetworkACLProtocol string
+
+const (
+	// NetworkACLProtocolAll matches all the protocols.
+	NetworkACLProtocolAll = NetworkACLProtocol("all")
+	// NetworkACLProtocolTCP matches TCP traffic.
+	NetworkACLProtocolTCP = NetworkACLProtocol("tcp")
+	// NetworkACLProtocolUDP matches UDP traffic.
+	NetworkACLProtocolUDP = NetworkACLProtocol("udp")
+	// NetworkACLProtocolICMP matches ICMP traffic.
+	NetworkACLProtocolICMP = NetworkACLProtocol("icmp")
+)
+
+// NetworkACLRuleAction defines the action of a network ACL entry.
+type NetworkACLRuleAction string
+
+const (
+	// NetworkACLRuleActionAllow allows the matching traffic.
+	NetworkACLRuleActionAllow = NetworkACLRuleAction("allow")
+	// NetworkACLRuleActionDeny denies the matching traffic.
+	NetworkACLRuleActionDeny = NetworkACLRuleAction("deny")
+)
+
+// NetworkACLEntry defines an entry of a network ACL.
+type NetworkACLEntry struct {
+	// RuleNumber is the number of the entry. Entries are evaluated in increasing order of rule number,
+	// and the first matching entry applies. Defaults to 100 times the position of the entry in the list,
+	// starting at 100.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	// +optional
+	RuleNumber *int32 `json:"ruleNumber,omitempty"`
+
+	// Action is the action applied to the matching traffic.
+	// +kubebuilder:validation:Enum=allow;deny
+	Action NetworkACLRuleAction `json:"action"`
+
+	// Protocol is the protocol of the matching traffic.
+	// +kubebuilder:validation:Enum=all;tcp;udp;icmp
+	// +kubebuilder:default=all
+	// +optional
+	Protocol NetworkACLProtocol `json:"protocol,omitempty"`
+
+	// CidrBlock is the IPv4 CIDR block of the matching traffic.
+	// Mutually exclusive with IPv6CidrBlock.
+	// +optional
+	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv6CidrBlock is the IPv6 CIDR block of the matching traffic.
+	// Mutually exclusive with CidrBlock.
+	// +optional
+	IPv6CidrBlock string `json:"ipv6CidrBlock,omitempty"`
+
+	// FromPort is the first port of the matching traffic. Required for the tcp and udp protocols.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// ToPort is the last port of the matching traffic. Required for the tcp and udp protocols.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+}
+
+// VPCEndpointType defines the type of a VPC endpoint.
+type VPCEndpointType string
+
+const (
+	// VPCEndpointTypeGateway is a gateway endpoint, targeted by the route tables of the subnets.
+	// Gateway endpoints are only available for S3 and DynamoDB.
+	VPCEndpointTypeGateway = VPCEndpointType("Gateway")
+
+	// VPCEndpointTypeInterface is an interface endpoint, reached through network interfaces
+	// created in the subnets.
+	VPCEndpointTypeInterface = VPCEndpointType("Interface")
+)
+
+// VPCEndpointSpec defines a VPC endpoint for an AWS service.
+type VPCEndpointSpec struct {
+	// ServiceName is the name of the AWS service, for example "s3", "ecr.api" or "sts".
+	// Names are prefixed with "com.amazonaws.<region>." unless they are fully qualified.
+	// +kubebuilder:validation:MinLength=1
+	ServiceName string `json:"serviceName"`
+
+	// Type is the type of the VPC endpoint.
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	// +kubebuilder:default=Interface
+	// +optional
+	Type VPCEndpointType `json:"type,omitempty"`
+
+	// SubnetIDs are the subnets in which the network interfaces of an interface endpoint
+	// are created, at most one per availability zone. Defaults to a private subnet of the
+	// cluster in each availability zone.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SecurityGroupIDs are the security groups of the network interfaces of an interface
+	// endpoint. They must allow HTTPS traffic from the instances of the cluster.
+	// Defaults to a security group owned by the cluster, allowing HTTPS traffic from the
+	// CIDR blocks of the VPC.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// PrivateDNSEnabled associates a private hosted zone with the VPC for an interface endpoint,
+	// so that the default DNS name of the service resolves to the endpoint. Defaults to true.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+}
+
+// PrefixListSpec defines a managed prefix list owned by the cluster.
+type PrefixListSpec struct {
+	// Name is the name of the prefix list. Defaults to "<cluster-name>-prefix-list".
+	// This field is immutable.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// AddressFamily is the IP address family of the entries of the prefix list.
+	// This field is immutable.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +kubebuilder:default=IPv4
+	// +optional
+	AddressFamily string `json:"addressFamily,omitempty"`
+
+	// MaxEntries is the maximum number of entries of the prefix list. Note that the
+	// maximum number of entries counts towards the quota of rules of the security groups
+	// referencing the prefix list. Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxEntries int32 `json:"maxEntries,omitempty"`
+
+	// Entries are the CIDR blocks of the prefix list.
+	// +optional
+	Entries []PrefixListEntry `json:"entries,omitempty"`
+}
+
+// PrefixListEntry defines an entry of a managed prefix list.
+type PrefixListEntry struct {
+	// CIDR is the CIDR block of the entry.
+	CIDR string `json:"cidr"`
+
+	// Description provides extended information about the entry.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // IPv6 contains ipv6 specific settings for the network.
@@ -501,6 +783,99 @@ type VPCSpec struct {
 	// +kubebuilder:default=PreferPrivate
 	// +kubebuilder:validation:Enum=PreferPrivate;PreferPublic
 	SubnetSchema *SubnetSchemaType `json:"subnetSchema,omitempty"`
+
+	// TransitGateway configures the attachment of the VPC to a transit gateway, and the routes
+	// through the transit gateway. Only applicable to VPCs managed by the cluster.
+	// +optional
+	TransitGateway *TransitGatewaySpec `json:"transitGateway,omitempty"`
+
+	// FlowLogs configures the flow logs published by the VPC. Flow logs are only created in VPCs
+	// managed by the cluster, and deleted with them.
+	// +optional
+	// +listType=map
+	// +listMapKey=destination
+	FlowLogs []VPCFlowLogSpec `json:"flowLogs,omitempty"`
+}
+
+// FlowLogDestinationType defines the type of destination of a VPC flow log.
+type FlowLogDestinationType string
+
+const (
+	// FlowLogDestinationTypeCloudWatchLogs publishes the flow log to a CloudWatch Logs group.
+	FlowLogDestinationTypeCloudWatchLogs = FlowLogDestinationType("cloud-watch-logs")
+	// FlowLogDestinationTypeS3 publishes the flow log to an S3 bucket.
+	FlowLogDestinationTypeS3 = FlowLogDestinationType("s3")
+)
+
+// FlowLogTrafficType defines the type of traffic captured by a VPC flow log.
+type FlowLogTrafficType string
+
+const (
+	// FlowLogTrafficTypeAll captures the accepted and rejected traffic.
+	FlowLogTrafficTypeAll = FlowLogTrafficType("ALL")
+	// FlowLogTrafficTypeAccept captures the accepted traffic.
+	FlowLogTrafficTypeAccept = FlowLogTrafficType("ACCEPT")
+	// FlowLogTrafficTypeReject captures the rejected traffic.
+	FlowLogTrafficTypeReject = FlowLogTrafficType("REJECT")
+)
+
+// VPCFlowLogSpec configures a flow log of the VPC.
+type VPCFlowLogSpec struct {
+	// DestinationType is the type of destination the flow log is published to.
+	// +kubebuilder:validation:Enum=cloud-watch-logs;s3
+	// +kubebuilder:default=cloud-watch-logs
+	// +optional
+	DestinationType FlowLogDestinationType `json:"destinationType,omitempty"`
+
+	// Destination is the ARN of the CloudWatch Logs group, or of the S3 bucket (optionally followed by
+	// a folder) the flow log is published to.
+	Destination string `json:"destination"`
+
+	// TrafficType is the type of traffic captured by the flow log.
+	// +kubebuilder:validation:Enum=ALL;ACCEPT;REJECT
+	// +kubebuilder:default=ALL
+	// +optional
+	TrafficType FlowLogTrafficType `json:"trafficType,omitempty"`
+
+	// LogFormat is the format of the flow log records, e.g. "${version} ${srcaddr} ${dstaddr} ${action}".
+	// Defaults to the default format of AWS.
+	// +optional
+	LogFormat string `json:"logFormat,omitempty"`
+
+	// MaxAggregationInterval is the maximum interval of time, in seconds, during which a flow of packets
+	// is captured and aggregated into a flow log record.
+	// +kubebuilder:validation:Enum=60;600
+	// +kubebuilder:default=600
+	// +optional
+	MaxAggregationInterval int32 `json:"maxAggregationInterval,omitempty"`
+
+	// DeliverLogsPermissionARN is the ARN of the IAM role allowing the flow log to publish to the
+	// CloudWatch Logs group. Required for CloudWatch Logs destinations, and not allowed for S3 destinations.
+	// +optional
+	DeliverLogsPermissionARN string `json:"deliverLogsPermissionArn,omitempty"`
+}
+
+// TransitGatewaySpec configures the attachment of the VPC to a transit gateway.
+type TransitGatewaySpec struct {
+	// ID is the ID of the transit gateway. The transit gateway can be owned by another account
+	// and shared with AWS RAM, in which case the owner may have to accept the attachment.
+	// +kubebuilder:validation:Pattern=`^tgw-[0-9a-f]+$`
+	ID string `json:"id"`
+
+	// SubnetIDs are the subnets of the attachment, at most one per availability zone.
+	// Defaults to a private subnet of the cluster in each availability zone.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// PrivateRouteCidrBlocks are the destination CIDR blocks routed through the transit gateway
+	// in the route tables of the private subnets. The default route 0.0.0.0/0 isn't allowed.
+	// +optional
+	PrivateRouteCidrBlocks []string `json:"privateRouteCidrBlocks,omitempty"`
+
+	// PublicRouteCidrBlocks are the destination CIDR blocks routed through the transit gateway
+	// in the route tables of the public subnets. The default route 0.0.0.0/0 isn't allowed.
+	// +optional
+	PublicRouteCidrBlocks []string `json:"publicRouteCidrBlocks,omitempty"`
 }
 
 // String returns a string representation of the VPC.
@@ -581,6 +956,11 @@ type SubnetSpec struct {
 	// +optional
 	RouteTableID *string `json:"routeTableId,omitempty"`
 
+	// NetworkACL configures the network ACL of the subnet, overriding the default network ACL of
+	// its role in the network spec. Only applicable to subnets managed by the cluster.
+	// +optional
+	NetworkACL *NetworkACLSpec `json:"networkACL,omitempty"`
+
 	// NatGatewayID is the NAT gateway id associated with the subnet.
 	// Ignored unless the subnet is managed by the provider, in which case this is set on the public subnet where the NAT gateway resides. It is then used to determine routes for private subnets in the same AZ as the public subnet.
 	// +optional
@@ -907,6 +1287,15 @@ type SecurityGroup struct {
 	// +optional
 	IngressRules IngressRules `json:"ingressRule,omitempty"`
 
+	// EgressRules is the outbound rules associated with the security group.
+	// +optional
+	EgressRules EgressRules `json:"egressRule,omitempty"`
+
+	// EgressRulesManaged is true when the egress rules of the security group are managed from the
+	// spec. The default egress rules are restored once no egress rules are specified anymore.
+	// +optional
+	EgressRulesManaged bool `json:"egressRulesManaged,omitempty"`
+
 	// Tags is a map of tags associated with the security group.
 	Tags Tags `json:"tags,omitempty"`
 }
@@ -974,6 +1363,17 @@ type IngressRule struct {
 	// NatGatewaysIPsSource use the NAT gateways IPs as the source for the ingress rule.
 	// +optional
 	NatGatewaysIPsSource bool `json:"natGatewaysIPsSource,omitempty"`
+
+	// SourcePrefixListIDs is a list of managed prefix list IDs to allow access from.
+	// Cannot be specified with SourceSecurityGroupIDs or SourceSecurityGroupRoles.
+	// +optional
+	SourcePrefixListIDs []string `json:"sourcePrefixListIds,omitempty"`
+
+	// SourcePrefixListNames is a list of managed prefix list names to allow access from.
+	// The names are resolved to prefix list IDs and combined with source prefix list IDs if specified.
+	// Cannot be specified with SourceSecurityGroupIDs or SourceSecurityGroupRoles.
+	// +optional
+	SourcePrefixListNames []string `json:"sourcePrefixListNames,omitempty"`
 }
 
 // String returns a string representation of the ingress rule.
@@ -1047,6 +1447,19 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 		}
 	}
 
+	if len(i.SourcePrefixListIDs) != len(o.SourcePrefixListIDs) {
+		return false
+	}
+
+	sort.Strings(i.SourcePrefixListIDs)
+	sort.Strings(o.SourcePrefixListIDs)
+
+	for i, v := range i.SourcePrefixListIDs {
+		if v != o.SourcePrefixListIDs[i] {
+			return false
+		}
+	}
+
 	if i.Description != o.Description || i.Protocol != o.Protocol {
 		return false
 	}
@@ -1067,6 +1480,116 @@ func (i *IngressRule) Equals(o *IngressRule) bool {
 	return true
 }
 
+// EgressRule defines an AWS egress rule for security groups.
+type EgressRule struct {
+	// Description provides extended information about the egress rule.
+	Description string `json:"description"`
+	// Protocol is the protocol for the egress rule. Accepted values are "-1" (all), "4" (IP in IP),"tcp", "udp", "icmp", and "58" (ICMPv6), "50" (ESP).
+	// +kubebuilder:validation:Enum="-1";"4";tcp;udp;icmp;"58";"50"
+	Protocol SecurityGroupProtocol `json:"protocol"`
+	// FromPort is the start of port range.
+	FromPort int64 `json:"fromPort"`
+	// ToPort is the end of port range.
+	ToPort int64 `json:"toPort"`
+
+	// List of CIDR blocks to allow access to.
+	// +optional
+	CidrBlocks []string `json:"cidrBlocks,omitempty"`
+
+	// List of IPv6 CIDR blocks to allow access to.
+	// +optional
+	IPv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
+
+	// The security group ids to allow access to.
+	// +optional
+	DestinationSecurityGroupIDs []string `json:"destinationSecurityGroupIds,omitempty"`
+
+	// The security group roles to allow access to.
+	// The field will be combined with destination security group IDs if specified.
+	// +optional
+	DestinationSecurityGroupRoles []SecurityGroupRole `json:"destinationSecurityGroupRoles,omitempty"`
+
+	// The managed prefix list ids to allow access to.
+	// +optional
+	PrefixListIDs []string `json:"prefixListIds,omitempty"`
+}
+
+// String returns a string representation of the egress rule.
+func (e EgressRule) String() string {
+	return fmt.Sprintf("protocol=%s/range=[%d-%d]/description=%s", e.Protocol, e.FromPort, e.ToPort, e.Description)
+}
+
+// EgressRules is a slice of AWS egress rules for security groups.
+type EgressRules []EgressRule
+
+// Difference returns the difference between this slice and the other slice.
+func (e EgressRules) Difference(o EgressRules) (out EgressRules) {
+	for index := range e {
+		x := e[index]
+		found := false
+		for oIndex := range o {
+			y := o[oIndex]
+			if x.Equals(&y) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			out = append(out, x)
+		}
+	}
+
+	return
+}
+
+// Equals returns true if two EgressRule are equal.
+func (e *EgressRule) Equals(o *EgressRule) bool {
+	if !stringSlicesEqual(e.CidrBlocks, o.CidrBlocks) ||
+		!stringSlicesEqual(e.IPv6CidrBlocks, o.IPv6CidrBlocks) ||
+		!stringSlicesEqual(e.DestinationSecurityGroupIDs, o.DestinationSecurityGroupIDs) ||
+		!stringSlicesEqual(e.PrefixListIDs, o.PrefixListIDs) {
+		return false
+	}
+
+	if e.Description != o.Description || e.Protocol != o.Protocol {
+		return false
+	}
+
+	// AWS seems to ignore the From/To port when set on protocols where it doesn't apply, but
+	// we avoid serializing it out for clarity's sake.
+	// See: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_IpPermission.html
+	switch e.Protocol {
+	case SecurityGroupProtocolTCP,
+		SecurityGroupProtocolUDP,
+		SecurityGroupProtocolICMP,
+		SecurityGroupProtocolICMPv6:
+		return e.FromPort == o.FromPort && e.ToPort == o.ToPort
+	case SecurityGroupProtocolAll, SecurityGroupProtocolIPinIP, SecurityGroupProtocolESP:
+		// FromPort / ToPort are not applicable
+	}
+
+	return true
+}
+
+// stringSlicesEqual returns true if both slices contain the same strings, regardless of their order.
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ZoneType defines listener AWS Availability Zone type.
 type ZoneType string
 
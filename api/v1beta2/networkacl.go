@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// GetRuleNumber returns the rule number of the entry at the given position of the list of entries.
+func (e *NetworkACLEntry) GetRuleNumber(index int) int32 {
+	if e.RuleNumber != nil {
+		return *e.RuleNumber
+	}
+	return int32(index+1) * 100 //nolint:gosec // The number of entries is limited by AWS.
+}
+
+// GetProtocol returns the protocol of the entry, defaulting to all the protocols.
+func (e *NetworkACLEntry) GetProtocol() NetworkACLProtocol {
+	if e.Protocol == "" {
+		return NetworkACLProtocolAll
+	}
+	return e.Protocol
+}
+
+// ForSubnet returns the network ACL of the subnet, which is either specified in the subnet spec or
+// inherited from the default network ACL of its role. It returns nil if the subnet has no network ACL.
+func (n *NetworkACLsSpec) ForSubnet(subnet *SubnetSpec) *NetworkACLSpec {
+	if subnet.NetworkACL != nil {
+		return subnet.NetworkACL
+	}
+	if n == nil {
+		return nil
+	}
+	if subnet.IsPublic {
+		return n.Public
+	}
+	return n.Private
+}
+
+// Validate will validate the network ACLs fields.
+func (n *NetworkACLsSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if n == nil {
+		return errs
+	}
+
+	errs = append(errs, n.Public.Validate(path.Child("public"))...)
+	errs = append(errs, n.Private.Validate(path.Child("private"))...)
+
+	return errs
+}
+
+// Validate will validate the network ACL fields.
+func (n *NetworkACLSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if n == nil {
+		return errs
+	}
+
+	errs = append(errs, validateNetworkACLEntries(path.Child("ingress"), n.Ingress)...)
+	errs = append(errs, validateNetworkACLEntries(path.Child("egress"), n.Egress)...)
+
+	return errs
+}
+
+func validateNetworkACLEntries(path *field.Path, entries []NetworkACLEntry) field.ErrorList {
+	var errs field.ErrorList
+
+	ruleNumbers := map[int32]struct{}{}
+	for i := range entries {
+		entry := &entries[i]
+		entryPath := path.Index(i)
+
+		ruleNumber := entry.GetRuleNumber(i)
+		if ruleNumber < 1 || ruleNumber > 32766 {
+			errs = append(errs, field.Invalid(entryPath.Child("ruleNumber"), ruleNumber, "must be between 1 and 32766"))
+		}
+		if _, ok := ruleNumbers[ruleNumber]; ok {
+			errs = append(errs, field.Duplicate(entryPath.Child("ruleNumber"), ruleNumber))
+		}
+		ruleNumbers[ruleNumber] = struct{}{}
+
+		switch {
+		case entry.CidrBlock != "" && entry.IPv6CidrBlock != "":
+			errs = append(errs, field.Forbidden(entryPath.Child("ipv6CidrBlock"), "cidrBlock and ipv6CidrBlock are mutually exclusive"))
+		case entry.CidrBlock != "":
+			if ip, _, err := net.ParseCIDR(entry.CidrBlock); err != nil || ip.To4() == nil {
+				errs = append(errs, field.Invalid(entryPath.Child("cidrBlock"), entry.CidrBlock, "must be a valid IPv4 CIDR block"))
+			}
+		case entry.IPv6CidrBlock != "":
+			if ip, _, err := net.ParseCIDR(entry.IPv6CidrBlock); err != nil || ip.To4() != nil {
+				errs = append(errs, field.Invalid(entryPath.Child("ipv6CidrBlock"), entry.IPv6CidrBlock, "must be a valid IPv6 CIDR block"))
+			}
+		default:
+			errs = append(errs, field.Required(entryPath.Child("cidrBlock"), "either cidrBlock or ipv6CidrBlock must be set"))
+		}
+
+		switch entry.GetProtocol() {
+		case NetworkACLProtocolTCP, NetworkACLProtocolUDP:
+			if entry.FromPort == nil || entry.ToPort == nil {
+				errs = append(errs, field.Required(entryPath.Child("fromPort"), "fromPort and toPort are required for the tcp and udp protocols"))
+			} else if *entry.FromPort > *entry.ToPort {
+				errs = append(errs, field.Invalid(entryPath.Child("fromPort"), *entry.FromPort, "must be lower than or equal to toPort"))
+			}
+		default:
+			if entry.FromPort != nil || entry.ToPort != nil {
+				errs = append(errs, field.Forbidden(entryPath.Child("fromPort"), "fromPort and toPort can only be set for the tcp and udp protocols"))
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestNetworkACLSpecValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		networkACL *NetworkACLSpec
+		wantErr    bool
+	}{
+		{
+			name: "nil network ACL is valid",
+		},
+		{
+			name: "network ACL with defaulted rule numbers is valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						Protocol:  NetworkACLProtocolTCP,
+						CidrBlock: "10.0.0.0/16",
+						FromPort:  ptr.To[int32](443),
+						ToPort:    ptr.To[int32](443),
+					},
+					{
+						Action:        NetworkACLRuleActionDeny,
+						IPv6CidrBlock: "::/0",
+					},
+				},
+				Egress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						CidrBlock: "0.0.0.0/0",
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate rule numbers are not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						CidrBlock: "10.0.0.0/16",
+					},
+					{
+						RuleNumber: ptr.To[int32](100),
+						Action:     NetworkACLRuleActionDeny,
+						CidrBlock:  "0.0.0.0/0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "rule number out of range is not valid",
+			networkACL: &NetworkACLSpec{
+				Egress: []NetworkACLEntry{
+					{
+						RuleNumber: ptr.To[int32](32767),
+						Action:     NetworkACLRuleActionAllow,
+						CidrBlock:  "0.0.0.0/0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "entry without CIDR block is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action: NetworkACLRuleActionAllow,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "entry with both CIDR blocks is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:        NetworkACLRuleActionAllow,
+						CidrBlock:     "0.0.0.0/0",
+						IPv6CidrBlock: "::/0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IPv6 CIDR block in cidrBlock is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						CidrBlock: "::/0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "tcp entry without ports is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						Protocol:  NetworkACLProtocolTCP,
+						CidrBlock: "0.0.0.0/0",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "udp entry with inverted port range is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						Protocol:  NetworkACLProtocolUDP,
+						CidrBlock: "0.0.0.0/0",
+						FromPort:  ptr.To[int32](53),
+						ToPort:    ptr.To[int32](50),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "icmp entry with ports is not valid",
+			networkACL: &NetworkACLSpec{
+				Ingress: []NetworkACLEntry{
+					{
+						Action:    NetworkACLRuleActionAllow,
+						Protocol:  NetworkACLProtocolICMP,
+						CidrBlock: "0.0.0.0/0",
+						FromPort:  ptr.To[int32](0),
+						ToPort:    ptr.To[int32](0),
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.networkACL.Validate(field.NewPath("spec", "network", "networkACLs", "private"))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestNetworkACLsSpecForSubnet(t *testing.T) {
+	public := &NetworkACLSpec{}
+	private := &NetworkACLSpec{}
+	override := &NetworkACLSpec{}
+
+	tests := []struct {
+		name        string
+		networkACLs *NetworkACLsSpec
+		subnet      *SubnetSpec
+		want        *NetworkACLSpec
+	}{
+		{
+			name:   "no network ACLs",
+			subnet: &SubnetSpec{},
+		},
+		{
+			name:        "public subnet inherits the public network ACL",
+			networkACLs: &NetworkACLsSpec{Public: public, Private: private},
+			subnet:      &SubnetSpec{IsPublic: true},
+			want:        public,
+		},
+		{
+			name:        "private subnet inherits the private network ACL",
+			networkACLs: &NetworkACLsSpec{Public: public, Private: private},
+			subnet:      &SubnetSpec{},
+			want:        private,
+		},
+		{
+			name:        "subnet network ACL overrides the network ACL of its role",
+			networkACLs: &NetworkACLsSpec{Public: public, Private: private},
+			subnet:      &SubnetSpec{NetworkACL: override},
+			want:        override,
+		},
+		{
+			name:   "subnet network ACL without network ACLs",
+			subnet: &SubnetSpec{NetworkACL: override},
+			want:   override,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.networkACLs.ForSubnet(tt.subnet)).To(BeIdenticalTo(tt.want))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// PrefixListAddressFamilyIPv4 is the address family of prefix lists with IPv4 entries.
+	PrefixListAddressFamilyIPv4 = "IPv4"
+	// PrefixListAddressFamilyIPv6 is the address family of prefix lists with IPv6 entries.
+	PrefixListAddressFamilyIPv6 = "IPv6"
+)
+
+// Validate will validate the managed prefix list fields.
+func (p *PrefixListSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if p == nil {
+		return errs
+	}
+
+	if p.MaxEntries > 0 && int32(len(p.Entries)) > p.MaxEntries {
+		errs = append(errs, field.Invalid(path.Child("entries"), len(p.Entries), "cannot have more entries than maxEntries"))
+	}
+
+	for i, entry := range p.Entries {
+		ip, _, err := net.ParseCIDR(entry.CIDR)
+		if err != nil {
+			errs = append(errs, field.Invalid(path.Child("entries").Index(i).Child("cidr"), entry.CIDR, "must be a valid CIDR block"))
+			continue
+		}
+		if isIPv4 := ip.To4() != nil; isIPv4 != (p.AddressFamily != PrefixListAddressFamilyIPv6) {
+			errs = append(errs, field.Invalid(path.Child("entries").Index(i).Child("cidr"), entry.CIDR, "must match the address family of the prefix list"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateUpdate will validate the changes of the managed prefix list fields.
+func (p *PrefixListSpec) ValidateUpdate(path *field.Path, old *PrefixListSpec) field.ErrorList {
+	var errs field.ErrorList
+
+	if old == nil || p == nil {
+		return errs
+	}
+
+	if p.Name != old.Name {
+		errs = append(errs, field.Invalid(path.Child("name"), p.Name, "field is immutable"))
+	}
+
+	if p.AddressFamily != old.AddressFamily {
+		errs = append(errs, field.Invalid(path.Child("addressFamily"), p.AddressFamily, "field is immutable"))
+	}
+
+	return errs
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestPrefixListSpecValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefixList *PrefixListSpec
+		wantErr    bool
+	}{
+		{
+			name:       "nil prefix list is valid",
+			prefixList: nil,
+		},
+		{
+			name: "IPv4 entries are valid",
+			prefixList: &PrefixListSpec{
+				Entries: []PrefixListEntry{
+					{CIDR: "10.0.0.0/16"},
+					{CIDR: "192.168.1.1/32", Description: "VPN"},
+				},
+			},
+		},
+		{
+			name: "IPv6 entries are valid for an IPv6 prefix list",
+			prefixList: &PrefixListSpec{
+				AddressFamily: PrefixListAddressFamilyIPv6,
+				Entries: []PrefixListEntry{
+					{CIDR: "2001:db8::/32"},
+				},
+			},
+		},
+		{
+			name: "invalid CIDR block is not valid",
+			prefixList: &PrefixListSpec{
+				Entries: []PrefixListEntry{
+					{CIDR: "10.0.0.0"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IPv6 entry in an IPv4 prefix list is not valid",
+			prefixList: &PrefixListSpec{
+				AddressFamily: PrefixListAddressFamilyIPv4,
+				Entries: []PrefixListEntry{
+					{CIDR: "2001:db8::/32"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "more entries than maxEntries is not valid",
+			prefixList: &PrefixListSpec{
+				MaxEntries: 1,
+				Entries: []PrefixListEntry{
+					{CIDR: "10.0.0.0/16"},
+					{CIDR: "10.1.0.0/16"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.prefixList.Validate(field.NewPath("spec", "network", "prefixList"))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestPrefixListSpecValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     *PrefixListSpec
+		new     *PrefixListSpec
+		wantErr bool
+	}{
+		{
+			name: "changing entries and maxEntries is allowed",
+			old:  &PrefixListSpec{Name: "office", MaxEntries: 10},
+			new: &PrefixListSpec{
+				Name:       "office",
+				MaxEntries: 20,
+				Entries:    []PrefixListEntry{{CIDR: "10.0.0.0/16"}},
+			},
+		},
+		{
+			name:    "changing the name is not allowed",
+			old:     &PrefixListSpec{Name: "office"},
+			new:     &PrefixListSpec{Name: "vpn"},
+			wantErr: true,
+		},
+		{
+			name:    "changing the address family is not allowed",
+			old:     &PrefixListSpec{AddressFamily: PrefixListAddressFamilyIPv4},
+			new:     &PrefixListSpec{AddressFamily: PrefixListAddressFamilyIPv6},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.new.ValidateUpdate(field.NewPath("spec", "network", "prefixList"), tt.old)
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -188,6 +188,9 @@ const (
 	// PrivateRoleTagValue describes the value for the private role.
 	PrivateRoleTagValue = "private"
 
+	// VPCEndpointRoleTagValue describes the value for the VPC endpoint role.
+	VPCEndpointRoleTagValue = "vpc-endpoint"
+
 	// MachineNameTagKey is the key for machine name.
 	MachineNameTagKey = "MachineName"
 
@@ -201,6 +204,14 @@ const (
 	// user data which only references the S3 object. We store this tag on launch template versions
 	// so that S3 bootstrap data objects can be deleted when they get outdated.
 	LaunchTemplateBootstrapDataHash = NameAWSProviderPrefix + "bootstrap-data-hash"
+
+	// SnapshotDeviceNameTagKey is the tag we use to store the device name of the volume
+	// a pre-termination snapshot was taken of.
+	SnapshotDeviceNameTagKey = NameAWSProviderPrefix + "device-name"
+
+	// SnapshotRetainUntilTagKey is the tag we use to store the date until which a pre-termination
+	// snapshot should be retained. CAPA doesn't delete snapshots, this is left to lifecycle tooling.
+	SnapshotRetainUntilTagKey = NameAWSProviderPrefix + "retain-until"
 )
 
 // ClusterTagKey generates the key for resources associated with a cluster.
@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate will validate the transit gateway fields.
+func (t *TransitGatewaySpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if t == nil {
+		return errs
+	}
+
+	errs = append(errs, validateTransitGatewayRouteCidrBlocks(path.Child("privateRouteCidrBlocks"), t.PrivateRouteCidrBlocks)...)
+	errs = append(errs, validateTransitGatewayRouteCidrBlocks(path.Child("publicRouteCidrBlocks"), t.PublicRouteCidrBlocks)...)
+
+	return errs
+}
+
+func validateTransitGatewayRouteCidrBlocks(path *field.Path, cidrBlocks []string) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := map[string]struct{}{}
+	for i, cidrBlock := range cidrBlocks {
+		ip, _, err := net.ParseCIDR(cidrBlock)
+		if err != nil || ip.To4() == nil {
+			errs = append(errs, field.Invalid(path.Index(i), cidrBlock, "must be a valid IPv4 CIDR block"))
+			continue
+		}
+		// The default route goes through the internet or NAT gateways of the cluster, and
+		// isn't replaced by a route through the transit gateway.
+		if cidrBlock == "0.0.0.0/0" {
+			errs = append(errs, field.Invalid(path.Index(i), cidrBlock, "must not be the default route"))
+			continue
+		}
+		if _, ok := seen[cidrBlock]; ok {
+			errs = append(errs, field.Duplicate(path.Index(i), cidrBlock))
+		}
+		seen[cidrBlock] = struct{}{}
+	}
+
+	return errs
+}
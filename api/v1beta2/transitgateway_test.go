@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestTransitGatewaySpecValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		transitGateway *TransitGatewaySpec
+		wantErr        bool
+	}{
+		{
+			name: "no transit gateway is valid",
+		},
+		{
+			name: "transit gateway with routes is valid",
+			transitGateway: &TransitGatewaySpec{
+				ID:                     "tgw-0123456789abcdef0",
+				PrivateRouteCidrBlocks: []string{"10.100.0.0/16", "172.16.0.0/12"},
+				PublicRouteCidrBlocks:  []string{"10.100.0.0/16"},
+			},
+		},
+		{
+			name: "default route is not valid",
+			transitGateway: &TransitGatewaySpec{
+				ID:                     "tgw-0123456789abcdef0",
+				PrivateRouteCidrBlocks: []string{"0.0.0.0/0"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid cidr block is not valid",
+			transitGateway: &TransitGatewaySpec{
+				ID:                     "tgw-0123456789abcdef0",
+				PrivateRouteCidrBlocks: []string{"10.100.0.0"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv6 cidr block is not valid",
+			transitGateway: &TransitGatewaySpec{
+				ID:                    "tgw-0123456789abcdef0",
+				PublicRouteCidrBlocks: []string{"2001:db8::/32"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate cidr block is not valid",
+			transitGateway: &TransitGatewaySpec{
+				ID:                     "tgw-0123456789abcdef0",
+				PrivateRouteCidrBlocks: []string{"10.100.0.0/16", "10.100.0.0/16"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.transitGateway.Validate(field.NewPath("spec", "network", "vpc", "transitGateway"))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
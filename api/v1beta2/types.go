@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -86,6 +87,16 @@ const (
 	// ExternalResourceGCTasksAnnotation is the name of an annotation that indicates what
 	// external resources tasks should be executed by garbage collector for the cluster.
 	ExternalResourceGCTasksAnnotation = "aws.cluster.x-k8s.io/external-resource-tasks-gc"
+
+	// PowerStateAnnotation is the name of an annotation on the Cluster that sets the power state of all
+	// worker AWSMachines of the cluster which do not set a power state themselves. Control plane AWSMachines
+	// are not affected. Valid values are the PowerState values.
+	PowerStateAnnotation = "aws.cluster.x-k8s.io/power-state"
+
+	// VolumesResizedAnnotation is the name of an annotation set on the Node of an AWSMachine after EBS volumes of
+	// its instance were grown in place. Its value is the comma-separated list of the device names of the grown
+	// volumes. Whatever grows the filesystems in the guest is expected to remove the annotation afterwards.
+	VolumesResizedAnnotation = "aws.cluster.x-k8s.io/volumes-resized"
 )
 
 // GCTask defines a task to be executed by the garbage collector.
@@ -293,8 +304,90 @@ type Instance struct {
 	// +kubebuilder:validation:Enum="";None;CapacityReservationsOnly;Open
 	// +optional
 	CapacityReservationPreference CapacityReservationPreference `json:"capacityReservationPreference,omitempty"`
+
+	// HibernationOptions configures hibernation support of the instance.
+	// +optional
+	HibernationOptions *HibernationOptions `json:"hibernationOptions,omitempty"`
+
+	// CPUOptions configures the CPU of the instance.
+	// +optional
+	CPUOptions *CPUOptions `json:"cpuOptions,omitempty"`
+
+	// EnclaveOptions configures AWS Nitro Enclaves support of the instance.
+	// +optional
+	EnclaveOptions *EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// ENAExpress configures ENA Express on the primary network interface of the instance.
+	// +optional
+	ENAExpress *ENAExpress `json:"enaExpress,omitempty"`
 }
 
+// CPUOptions configures the CPU of an instance.
+type CPUOptions struct {
+	// CoreCount is the number of CPU cores of the instance. If not set, the default number of
+	// cores of the instance type is used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	CoreCount *int32 `json:"coreCount,omitempty"`
+
+	// ThreadsPerCore is the number of threads per CPU core. Set it to 1 to disable simultaneous
+	// multithreading. If not set, the default number of threads per core of the instance type is used.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	ThreadsPerCore *int32 `json:"threadsPerCore,omitempty"`
+
+	// AMDSEVSNP enables AMD SEV-SNP, which encrypts and protects the integrity of the memory of the
+	// instance. It is only supported by some AMD based instance types.
+	// +optional
+	AMDSEVSNP bool `json:"amdSevSnp,omitempty"`
+}
+
+// EnclaveOptions configures AWS Nitro Enclaves support of an instance.
+type EnclaveOptions struct {
+	// Enabled enables AWS Nitro Enclaves for the instance. It can only be enabled when launching
+	// the instance, and is only supported by some instance types.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// ENAExpress configures ENA Express, which uses the AWS Scalable Reliable Datagram (SRD) protocol,
+// on a network interface.
+type ENAExpress struct {
+	// Enabled enables ENA Express for TCP traffic.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// UDPEnabled enables ENA Express for UDP traffic. It requires Enabled to be set.
+	// +optional
+	UDPEnabled bool `json:"udpEnabled,omitempty"`
+}
+
+// HibernationOptions configures hibernation support of an instance.
+type HibernationOptions struct {
+	// Configured enables hibernation support for the instance. Hibernation requires an encrypted
+	// root volume that is large enough to store the instance memory, and an instance type and AMI that
+	// support hibernation. It can only be enabled when launching the instance.
+	// +optional
+	Configured bool `json:"configured,omitempty"`
+}
+
+// PowerState describes the desired power state of an instance.
+// +kubebuilder:validation:Enum:=Running;Stopped;Hibernated
+type PowerState string
+
+const (
+	// PowerStateRunning is the power state of a running instance.
+	PowerStateRunning PowerState = "Running"
+
+	// PowerStateStopped is the power state of an instance that is stopped.
+	PowerStateStopped PowerState = "Stopped"
+
+	// PowerStateHibernated is the power state of an instance that is stopped after saving the contents
+	// of its memory to the root volume.
+	PowerStateHibernated PowerState = "Hibernated"
+)
+
 // CapacityReservationPreference describes the preferred use of capacity reservations
 // of an instance
 // +kubebuilder:validation:Enum:="";None;CapacityReservationsOnly;Open
@@ -449,6 +542,68 @@ type Volume struct {
 	// The key must already exist and be accessible by the controller.
 	// +optional
 	EncryptionKey string `json:"encryptionKey,omitempty"`
+
+	// Snapshot is the EBS snapshot to restore the volume from, referenced either by ID or by filters
+	// (e.g. on tags). If filters match several snapshots, the most recent completed snapshot owned by
+	// the account is used. Only applicable to non root volumes.
+	// +optional
+	Snapshot *AWSResourceReference `json:"snapshot,omitempty"`
+}
+
+// VolumeModificationState describes the state of an in-place modification of an EBS volume.
+type VolumeModificationState string
+
+var (
+	// VolumeModificationStateModifying is the string representing a volume modification that is in progress.
+	VolumeModificationStateModifying = VolumeModificationState("modifying")
+
+	// VolumeModificationStateOptimizing is the string representing a volume modification whose new size
+	// is usable, while the performance of the volume is still being optimized.
+	VolumeModificationStateOptimizing = VolumeModificationState("optimizing")
+
+	// VolumeModificationStateCompleted is the string representing a completed volume modification.
+	VolumeModificationStateCompleted = VolumeModificationState("completed")
+
+	// VolumeModificationStateFailed is the string representing a failed volume modification.
+	VolumeModificationStateFailed = VolumeModificationState("failed")
+)
+
+// VolumeModification describes an in-place modification of an EBS volume attached to an instance.
+type VolumeModification struct {
+	// VolumeID is the ID of the modified volume.
+	VolumeID string `json:"volumeID"`
+
+	// DeviceName is the device name the volume is attached to the instance as.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// State is the state of the modification.
+	State VolumeModificationState `json:"state"`
+
+	// Progress is the progress of the modification, in percent.
+	// +optional
+	Progress int64 `json:"progress,omitempty"`
+
+	// OriginalSize is the size (in Gi) of the volume before the modification.
+	// +optional
+	OriginalSize int64 `json:"originalSize,omitempty"`
+
+	// TargetSize is the size (in Gi) the volume is modified to.
+	// +optional
+	TargetSize int64 `json:"targetSize,omitempty"`
+
+	// StartTime is the time the modification started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message explains why the modification failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FilesystemResizeRequested is set once the node of the instance has been annotated to grow its
+	// filesystem onto the larger volume.
+	// +optional
+	FilesystemResizeRequested bool `json:"filesystemResizeRequested,omitempty"`
 }
 
 // VolumeType describes the EBS volume type.
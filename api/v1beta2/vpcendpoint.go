@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// gatewayEndpointServices are the AWS services supporting gateway endpoints.
+var gatewayEndpointServices = []string{"s3", "dynamodb"}
+
+// Validate will validate the VPC endpoint fields.
+func (e *VPCEndpointSpec) Validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if e.Type != VPCEndpointTypeGateway {
+		return errs
+	}
+
+	if !e.supportsGateway() {
+		errs = append(errs, field.Invalid(path.Child("type"), e.Type, "gateway endpoints are only available for s3 and dynamodb"))
+	}
+	if len(e.SubnetIDs) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("subnetIds"), "subnetIds can only be set for interface endpoints"))
+	}
+	if len(e.SecurityGroupIDs) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("securityGroupIds"), "securityGroupIds can only be set for interface endpoints"))
+	}
+	if e.PrivateDNSEnabled != nil {
+		errs = append(errs, field.Forbidden(path.Child("privateDnsEnabled"), "privateDnsEnabled can only be set for interface endpoints"))
+	}
+
+	return errs
+}
+
+// supportsGateway returns true if the service of the VPC endpoint supports gateway endpoints.
+func (e *VPCEndpointSpec) supportsGateway() bool {
+	for _, service := range gatewayEndpointServices {
+		if e.isService(service) {
+			return true
+		}
+	}
+	return false
+}
+
+// isService returns true if the VPC endpoint is for the given AWS service, whether its name is
+// fully qualified or not.
+func (e *VPCEndpointSpec) isService(service string) bool {
+	return e.ServiceName == service || strings.HasSuffix(e.ServiceName, "."+service)
+}
+
+// validateVPCEndpointsWithBucket validates the VPC endpoints of a cluster storing its bootstrap data
+// in an S3 bucket. The bucket is reached through an S3 gateway endpoint, so the endpoint for S3 can't
+// be an interface endpoint.
+func validateVPCEndpointsWithBucket(endpoints []VPCEndpointSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i := range endpoints {
+		if endpoints[i].isService("s3") && endpoints[i].Type != VPCEndpointTypeGateway {
+			errs = append(errs, field.Invalid(path.Index(i).Child("type"), endpoints[i].Type,
+				"the S3 endpoint must be a gateway endpoint when s3Bucket is set, as the bucket is reached through it"))
+		}
+	}
+
+	return errs
+}
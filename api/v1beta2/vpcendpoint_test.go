@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestVPCEndpointSpecValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint VPCEndpointSpec
+		wantErr  bool
+	}{
+		{
+			name: "interface endpoint is valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName:       "ecr.api",
+				Type:              VPCEndpointTypeInterface,
+				SubnetIDs:         []string{"subnet-1"},
+				SecurityGroupIDs:  []string{"sg-1"},
+				PrivateDNSEnabled: aws.Bool(true),
+			},
+		},
+		{
+			name: "s3 gateway endpoint is valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName: "s3",
+				Type:        VPCEndpointTypeGateway,
+			},
+		},
+		{
+			name: "fully qualified dynamodb gateway endpoint is valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName: "com.amazonaws.eu-west-1.dynamodb",
+				Type:        VPCEndpointTypeGateway,
+			},
+		},
+		{
+			name: "gateway endpoint for a service without gateway endpoints is not valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName: "ecr.api",
+				Type:        VPCEndpointTypeGateway,
+			},
+			wantErr: true,
+		},
+		{
+			name: "gateway endpoint with subnets is not valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName: "s3",
+				Type:        VPCEndpointTypeGateway,
+				SubnetIDs:   []string{"subnet-1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "gateway endpoint with private dns is not valid",
+			endpoint: VPCEndpointSpec{
+				ServiceName:       "s3",
+				Type:              VPCEndpointTypeGateway,
+				PrivateDNSEnabled: aws.Bool(false),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tt.endpoint.Validate(field.NewPath("spec", "network", "vpcEndpoints").Index(0))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateVPCEndpointsWithBucket(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []VPCEndpointSpec
+		wantErr   bool
+	}{
+		{
+			name: "s3 gateway endpoint is valid",
+			endpoints: []VPCEndpointSpec{
+				{ServiceName: "s3", Type: VPCEndpointTypeGateway},
+				{ServiceName: "ecr.api", Type: VPCEndpointTypeInterface},
+			},
+		},
+		{
+			name: "s3 interface endpoint is not valid",
+			endpoints: []VPCEndpointSpec{
+				{ServiceName: "s3", Type: VPCEndpointTypeInterface},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fully qualified s3 endpoint without a type is not valid",
+			endpoints: []VPCEndpointSpec{
+				{ServiceName: "com.amazonaws.eu-west-1.s3"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateVPCEndpointsWithBucket(tt.endpoints, field.NewPath("spec", "network", "vpcEndpoints"))
+			if tt.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -597,6 +597,11 @@ func (in *AWSLoadBalancerSpec) DeepCopyInto(out *AWSLoadBalancerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(LoadBalancerDNSSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLoadBalancerSpec.
@@ -781,6 +786,31 @@ func (in *AWSMachineSpec) DeepCopyInto(out *AWSMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptions)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(EnclaveOptions)
+		**out = **in
+	}
+	if in.ENAExpress != nil {
+		in, out := &in.ENAExpress, &out.ENAExpress
+		*out = new(ENAExpress)
+		**out = **in
+	}
+	if in.PreTerminationSnapshots != nil {
+		in, out := &in.PreTerminationSnapshots, &out.PreTerminationSnapshots
+		*out = new(PreTerminationSnapshots)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineSpec.
@@ -806,6 +836,18 @@ func (in *AWSMachineStatus) DeepCopyInto(out *AWSMachineStatus) {
 		*out = new(InstanceState)
 		**out = **in
 	}
+	if in.VolumeModifications != nil {
+		in, out := &in.VolumeModifications, &out.VolumeModifications
+		*out = make([]VolumeModification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreTerminationSnapshots != nil {
+		in, out := &in.PreTerminationSnapshots, &out.PreTerminationSnapshots
+		*out = make([]VolumeSnapshot, len(*in))
+		copy(*out, *in)
+	}
 	if in.FailureReason != nil {
 		in, out := &in.FailureReason, &out.FailureReason
 		*out = new(string)
@@ -937,6 +979,11 @@ func (in *AWSMachineTemplateStatus) DeepCopyInto(out *AWSMachineTemplateStatus)
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.NodeInfo != nil {
+		in, out := &in.NodeInfo, &out.NodeInfo
+		*out = new(NodeInfo)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachineTemplateStatus.
@@ -1239,6 +1286,11 @@ func (in *Bastion) DeepCopyInto(out *Bastion) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedPrefixListIDs != nil {
+		in, out := &in.AllowedPrefixListIDs, &out.AllowedPrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
@@ -1337,6 +1389,31 @@ func (in *CNISpec) DeepCopy() *CNISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUOptions) DeepCopyInto(out *CPUOptions) {
+	*out = *in
+	if in.CoreCount != nil {
+		in, out := &in.CoreCount, &out.CoreCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ThreadsPerCore != nil {
+		in, out := &in.ThreadsPerCore, &out.ThreadsPerCore
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUOptions.
+func (in *CPUOptions) DeepCopy() *CPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClassicELBAttributes) DeepCopyInto(out *ClassicELBAttributes) {
 	*out = *in
@@ -1397,6 +1474,97 @@ func (in *CloudInit) DeepCopy() *CloudInit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecord) DeepCopyInto(out *DNSRecord) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecord.
+func (in *DNSRecord) DeepCopy() *DNSRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ENAExpress) DeepCopyInto(out *ENAExpress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ENAExpress.
+func (in *ENAExpress) DeepCopy() *ENAExpress {
+	if in == nil {
+		return nil
+	}
+	out := new(ENAExpress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressRule) DeepCopyInto(out *EgressRule) {
+	*out = *in
+	if in.CidrBlocks != nil {
+		in, out := &in.CidrBlocks, &out.CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CidrBlocks != nil {
+		in, out := &in.IPv6CidrBlocks, &out.IPv6CidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupIDs != nil {
+		in, out := &in.DestinationSecurityGroupIDs, &out.DestinationSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationSecurityGroupRoles != nil {
+		in, out := &in.DestinationSecurityGroupRoles, &out.DestinationSecurityGroupRoles
+		*out = make([]SecurityGroupRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRule.
+func (in *EgressRule) DeepCopy() *EgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressRules) DeepCopyInto(out *EgressRules) {
+	{
+		in := &in
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressRules.
+func (in EgressRules) DeepCopy() EgressRules {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressRules)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticIPPool) DeepCopyInto(out *ElasticIPPool) {
 	*out = *in
@@ -1422,6 +1590,21 @@ func (in *ElasticIPPool) DeepCopy() *ElasticIPPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnclaveOptions) DeepCopyInto(out *EnclaveOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnclaveOptions.
+func (in *EnclaveOptions) DeepCopy() *EnclaveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EnclaveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
@@ -1442,6 +1625,21 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationOptions) DeepCopyInto(out *HibernationOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptions.
+func (in *HibernationOptions) DeepCopy() *HibernationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HibernationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
 	*out = *in
@@ -1580,6 +1778,16 @@ func (in *IngressRule) DeepCopyInto(out *IngressRule) {
 		*out = make([]SecurityGroupRole, len(*in))
 		copy(*out, *in)
 	}
+	if in.SourcePrefixListIDs != nil {
+		in, out := &in.SourcePrefixListIDs, &out.SourcePrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourcePrefixListNames != nil {
+		in, out := &in.SourcePrefixListNames, &out.SourcePrefixListNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressRule.
@@ -1720,6 +1928,26 @@ func (in *Instance) DeepCopyInto(out *Instance) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptions)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(EnclaveOptions)
+		**out = **in
+	}
+	if in.ENAExpress != nil {
+		in, out := &in.ENAExpress, &out.ENAExpress
+		*out = new(ENAExpress)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
@@ -1834,6 +2062,105 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerDNSSpec) DeepCopyInto(out *LoadBalancerDNSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerDNSSpec.
+func (in *LoadBalancerDNSSpec) DeepCopy() *LoadBalancerDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	if in.RuleNumber != nil {
+		in, out := &in.RuleNumber, &out.RuleNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLsSpec) DeepCopyInto(out *NetworkACLsSpec) {
+	*out = *in
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(NetworkACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(NetworkACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLsSpec.
+func (in *NetworkACLsSpec) DeepCopy() *NetworkACLsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -1876,6 +2203,60 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NodePortIngressRulePrefixListIDs != nil {
+		in, out := &in.NodePortIngressRulePrefixListIDs, &out.NodePortIngressRulePrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalControlPlaneEgressRules != nil {
+		in, out := &in.AdditionalControlPlaneEgressRules, &out.AdditionalControlPlaneEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalNodeEgressRules != nil {
+		in, out := &in.AdditionalNodeEgressRules, &out.AdditionalNodeEgressRules
+		*out = make([]EgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroupEgressRules != nil {
+		in, out := &in.SecurityGroupEgressRules, &out.SecurityGroupEgressRules
+		*out = make(map[SecurityGroupRole]EgressRules, len(*in))
+		for key, val := range *in {
+			var outVal []EgressRule
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(EgressRules, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PrefixList != nil {
+		in, out := &in.PrefixList, &out.PrefixList
+		*out = new(PrefixListSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpointSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkACLs != nil {
+		in, out := &in.NetworkACLs, &out.NetworkACLs
+		*out = new(NetworkACLsSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -1900,11 +2281,36 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	}
 	in.APIServerELB.DeepCopyInto(&out.APIServerELB)
 	in.SecondaryAPIServerELB.DeepCopyInto(&out.SecondaryAPIServerELB)
+	if in.APIServerDNSRecord != nil {
+		in, out := &in.APIServerDNSRecord, &out.APIServerDNSRecord
+		*out = new(DNSRecord)
+		**out = **in
+	}
 	if in.NatGatewaysIPs != nil {
 		in, out := &in.NatGatewaysIPs, &out.NatGatewaysIPs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.VPCEndpoints != nil {
+		in, out := &in.VPCEndpoints, &out.VPCEndpoints
+		*out = make([]VPCEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransitGatewayAttachment != nil {
+		in, out := &in.TransitGatewayAttachment, &out.TransitGatewayAttachment
+		*out = new(TransitGatewayAttachment)
+		**out = **in
+	}
+	if in.FlowLogIDs != nil {
+		in, out := &in.FlowLogIDs, &out.FlowLogIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkACLIDs != nil {
+		in, out := &in.NetworkACLIDs, &out.NetworkACLIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -1917,6 +2323,81 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeInfo) DeepCopyInto(out *NodeInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeInfo.
+func (in *NodeInfo) DeepCopy() *NodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreTerminationSnapshots) DeepCopyInto(out *PreTerminationSnapshots) {
+	*out = *in
+	if in.DeviceNames != nil {
+		in, out := &in.DeviceNames, &out.DeviceNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreTerminationSnapshots.
+func (in *PreTerminationSnapshots) DeepCopy() *PreTerminationSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(PreTerminationSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixListEntry) DeepCopyInto(out *PrefixListEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListEntry.
+func (in *PrefixListEntry) DeepCopy() *PrefixListEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixListEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixListSpec) DeepCopyInto(out *PrefixListSpec) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]PrefixListEntry, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListSpec.
+func (in *PrefixListSpec) DeepCopy() *PrefixListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateDNSName) DeepCopyInto(out *PrivateDNSName) {
 	*out = *in
@@ -2002,6 +2483,13 @@ func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make(EgressRules, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
@@ -2049,6 +2537,11 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NetworkACL != nil {
+		in, out := &in.NetworkACL, &out.NetworkACL
+		*out = new(NetworkACLSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NatGatewayID != nil {
 		in, out := &in.NatGatewayID, &out.NatGatewayID
 		*out = new(string)
@@ -2280,6 +2773,111 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayAttachment) DeepCopyInto(out *TransitGatewayAttachment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayAttachment.
+func (in *TransitGatewayAttachment) DeepCopy() *TransitGatewayAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateRouteCidrBlocks != nil {
+		in, out := &in.PrivateRouteCidrBlocks, &out.PrivateRouteCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicRouteCidrBlocks != nil {
+		in, out := &in.PublicRouteCidrBlocks, &out.PublicRouteCidrBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCFlowLogSpec) DeepCopyInto(out *VPCFlowLogSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCFlowLogSpec.
+func (in *VPCFlowLogSpec) DeepCopy() *VPCFlowLogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCFlowLogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
@@ -2340,6 +2938,16 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 		*out = new(SubnetSchemaType)
 		**out = **in
 	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGatewaySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = make([]VPCFlowLogSpec, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
@@ -2365,6 +2973,11 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(AWSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Volume.
@@ -2377,6 +2990,40 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeModification) DeepCopyInto(out *VolumeModification) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeModification.
+func (in *VolumeModification) DeepCopy() *VolumeModification {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeModification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshot) DeepCopyInto(out *VolumeSnapshot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshot.
+func (in *VolumeSnapshot) DeepCopy() *VolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VpcCidrBlock) DeepCopyInto(out *VpcCidrBlock) {
 	*out = *in
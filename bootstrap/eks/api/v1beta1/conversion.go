@@ -62,6 +62,13 @@ func (r *EKSConfig) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.NTP != nil {
 		dst.Spec.NTP = restored.Spec.NTP
 	}
+	dst.Spec.NodeType = restored.Spec.NodeType
+	if restored.Spec.Nodeadm != nil {
+		dst.Spec.Nodeadm = restored.Spec.Nodeadm
+	}
+	if restored.Spec.Bottlerocket != nil {
+		dst.Spec.Bottlerocket = restored.Spec.Bottlerocket
+	}
 
 	return nil
 }
@@ -129,6 +136,13 @@ func (r *EKSConfigTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.Template.Spec.NTP != nil {
 		dst.Spec.Template.Spec.NTP = restored.Spec.Template.Spec.NTP
 	}
+	dst.Spec.Template.Spec.NodeType = restored.Spec.Template.Spec.NodeType
+	if restored.Spec.Template.Spec.Nodeadm != nil {
+		dst.Spec.Template.Spec.Nodeadm = restored.Spec.Template.Spec.Nodeadm
+	}
+	if restored.Spec.Template.Spec.Bottlerocket != nil {
+		dst.Spec.Template.Spec.Bottlerocket = restored.Spec.Template.Spec.Bottlerocket
+	}
 
 	return nil
 }
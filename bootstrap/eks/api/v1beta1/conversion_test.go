@@ -19,13 +19,31 @@ package v1beta1
 import (
 	"testing"
 
+	fuzz "github.com/google/gofuzz"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
 
 	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
+func fuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		NodeadmKubeletOptionsFuzzer,
+	}
+}
+
+// NodeadmKubeletOptionsFuzzer fuzzes the kubelet config with valid JSON, as the key order of a
+// fuzzed RawExtension isn't preserved when it's round-tripped through the conversion annotation.
+func NodeadmKubeletOptionsFuzzer(obj *eksbootstrapv1.NodeadmKubeletOptions, c fuzz.Continue) {
+	c.FuzzNoCustom(obj)
+	if obj.Config != nil {
+		obj.Config = &runtime.RawExtension{Raw: []byte(`{"maxPods":110}`)}
+	}
+}
+
 func TestFuzzyConversion(t *testing.T) {
 	g := NewWithT(t)
 	scheme := runtime.NewScheme()
@@ -33,14 +51,16 @@ func TestFuzzyConversion(t *testing.T) {
 	g.Expect(eksbootstrapv1.AddToScheme(scheme)).To(Succeed())
 
 	t.Run("for EKSConfig", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &eksbootstrapv1.EKSConfig{},
-		Spoke:  &EKSConfig{},
+		Scheme:      scheme,
+		Hub:         &eksbootstrapv1.EKSConfig{},
+		Spoke:       &EKSConfig{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 
 	t.Run("for EKSConfigTemplate", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
-		Scheme: scheme,
-		Hub:    &eksbootstrapv1.EKSConfigTemplate{},
-		Spoke:  &EKSConfigTemplate{},
+		Scheme:      scheme,
+		Hub:         &eksbootstrapv1.EKSConfigTemplate{},
+		Spoke:       &EKSConfigTemplate{},
+		FuzzerFuncs: []fuzzer.FuzzerFuncs{fuzzFuncs},
 	}))
 }
@@ -18,12 +18,23 @@ package v1beta2
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 // EKSConfigSpec defines the desired state of Amazon EKS Bootstrap Configuration.
 type EKSConfigSpec struct {
+	// NodeType specifies the type of node the bootstrap data is generated for. When not set
+	// it is detected from the AMI type of the infrastructure, defaulting to al2.
+	// +optional
+	NodeType NodeType `json:"nodeType,omitempty"`
+	// Nodeadm specifies the nodeadm configuration of al2023 nodes.
+	// +optional
+	Nodeadm *NodeadmConfig `json:"nodeadm,omitempty"`
+	// Bottlerocket specifies the settings of bottlerocket nodes.
+	// +optional
+	Bottlerocket *BottlerocketConfig `json:"bottlerocket,omitempty"`
 	// KubeletExtraArgs passes the specified kubelet args into the Amazon EKS machine bootstrap script
 	// +optional
 	KubeletExtraArgs map[string]string `json:"kubeletExtraArgs,omitempty"`
@@ -76,6 +87,147 @@ type EKSConfigSpec struct {
 	NTP *NTP `json:"ntp,omitempty"`
 }
 
+// NodeType specifies the type of node bootstrap data.
+// +kubebuilder:validation:Enum=al2;al2023;bottlerocket
+type NodeType string
+
+const (
+	// NodeTypeAL2 generates cloud-init user data calling the /etc/eks/bootstrap.sh script of
+	// the Amazon Linux 2 EKS optimized AMIs.
+	NodeTypeAL2 NodeType = "al2"
+	// NodeTypeAL2023 generates MIME multi-part user data with a NodeConfig for nodeadm of
+	// the Amazon Linux 2023 EKS optimized AMIs.
+	NodeTypeAL2023 NodeType = "al2023"
+	// NodeTypeBottlerocket generates Bottlerocket settings in TOML format.
+	NodeTypeBottlerocket NodeType = "bottlerocket"
+)
+
+const (
+	// BottlerocketDataFormat is the format of bootstrap data secrets containing Bottlerocket
+	// settings. It is stored under the format key of the secret.
+	BottlerocketDataFormat = "bottlerocket"
+)
+
+// NodeadmConfig defines the options of the nodeadm NodeConfig.
+type NodeadmConfig struct {
+	// Kubelet contains the options of the kubelet.
+	// +optional
+	Kubelet *NodeadmKubeletOptions `json:"kubelet,omitempty"`
+	// Containerd contains the options of containerd.
+	// +optional
+	Containerd *NodeadmContainerdOptions `json:"containerd,omitempty"`
+}
+
+// NodeadmKubeletOptions defines the kubelet options of the nodeadm NodeConfig.
+type NodeadmKubeletOptions struct {
+	// Config is a KubeletConfiguration that is merged with the defaults of nodeadm.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
+	// Flags are command line arguments passed to the kubelet.
+	// +optional
+	Flags []string `json:"flags,omitempty"`
+}
+
+// NodeadmContainerdOptions defines the containerd options of the nodeadm NodeConfig.
+type NodeadmContainerdOptions struct {
+	// Config is inline containerd configuration in TOML format that is merged with the
+	// defaults of nodeadm.
+	// +optional
+	Config string `json:"config,omitempty"`
+}
+
+// BottlerocketConfig defines the settings of Bottlerocket nodes.
+type BottlerocketConfig struct {
+	// NodeLabels are the labels of the node.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// NodeTaints are the taints of the node.
+	// +optional
+	NodeTaints []BottlerocketTaint `json:"nodeTaints,omitempty"`
+	// MaxPods is the maximum number of pods that can run on the node.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+	// HostContainers are the host containers of the node, such as the admin and control containers.
+	// +optional
+	HostContainers []BottlerocketHostContainer `json:"hostContainers,omitempty"`
+	// BootstrapContainers are the containers that run before the kubelet is started.
+	// +optional
+	BootstrapContainers []BottlerocketBootstrapContainer `json:"bootstrapContainers,omitempty"`
+	// RegistryMirrors are the mirrors of container image registries.
+	// +optional
+	RegistryMirrors []BottlerocketRegistryMirror `json:"registryMirrors,omitempty"`
+	// CertificateBundles are the additional certificate authorities of the node.
+	// +optional
+	CertificateBundles []BottlerocketCertificateBundle `json:"certificateBundles,omitempty"`
+}
+
+// BottlerocketTaint defines a taint of a Bottlerocket node.
+type BottlerocketTaint struct {
+	// Key is the key of the taint.
+	Key string `json:"key"`
+	// Value is the value of the taint.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Effect is the effect of the taint.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute
+	Effect string `json:"effect"`
+}
+
+// BottlerocketHostContainer defines a host container of a Bottlerocket node.
+type BottlerocketHostContainer struct {
+	// Name is the name of the host container, e.g. admin or control.
+	Name string `json:"name"`
+	// Image is the container image of the host container.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Enabled specifies whether the host container runs.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Superpowered specifies whether the host container has elevated privileges.
+	// +optional
+	Superpowered *bool `json:"superpowered,omitempty"`
+	// UserData is the user data passed to the host container. It is base64 encoded when rendered.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+}
+
+// BottlerocketBootstrapContainer defines a bootstrap container of a Bottlerocket node.
+type BottlerocketBootstrapContainer struct {
+	// Name is the name of the bootstrap container.
+	Name string `json:"name"`
+	// Image is the container image of the bootstrap container.
+	Image string `json:"image"`
+	// Mode specifies when the bootstrap container runs.
+	// +kubebuilder:validation:Enum=always;once;off
+	// +kubebuilder:default=always
+	// +optional
+	Mode string `json:"mode,omitempty"`
+	// Essential specifies whether the boot fails when the bootstrap container fails.
+	// +optional
+	Essential bool `json:"essential,omitempty"`
+	// UserData is the user data passed to the bootstrap container. It is base64 encoded when rendered.
+	// +optional
+	UserData string `json:"userData,omitempty"`
+}
+
+// BottlerocketRegistryMirror defines the mirrors of a container image registry.
+type BottlerocketRegistryMirror struct {
+	// Registry is the registry that is mirrored, e.g. docker.io.
+	Registry string `json:"registry"`
+	// Endpoints are the endpoints of the mirrors of the registry.
+	// +kubebuilder:validation:MinItems=1
+	Endpoints []string `json:"endpoints"`
+}
+
+// BottlerocketCertificateBundle defines a bundle of certificate authorities of a Bottlerocket node.
+type BottlerocketCertificateBundle struct {
+	// Name is the name of the certificate bundle.
+	Name string `json:"name"`
+	// Data contains the PEM encoded certificates of the bundle.
+	Data string `json:"data"`
+}
+
 // PauseContainer contains details of pause container.
 type PauseContainer struct {
 	//  AccountNumber is the AWS account number to pull the pause container from.
@@ -25,6 +25,152 @@ import (
 	"sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketBootstrapContainer) DeepCopyInto(out *BottlerocketBootstrapContainer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketBootstrapContainer.
+func (in *BottlerocketBootstrapContainer) DeepCopy() *BottlerocketBootstrapContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketBootstrapContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketCertificateBundle) DeepCopyInto(out *BottlerocketCertificateBundle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketCertificateBundle.
+func (in *BottlerocketCertificateBundle) DeepCopy() *BottlerocketCertificateBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketCertificateBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketConfig) DeepCopyInto(out *BottlerocketConfig) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]BottlerocketTaint, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HostContainers != nil {
+		in, out := &in.HostContainers, &out.HostContainers
+		*out = make([]BottlerocketHostContainer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootstrapContainers != nil {
+		in, out := &in.BootstrapContainers, &out.BootstrapContainers
+		*out = make([]BottlerocketBootstrapContainer, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make([]BottlerocketRegistryMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CertificateBundles != nil {
+		in, out := &in.CertificateBundles, &out.CertificateBundles
+		*out = make([]BottlerocketCertificateBundle, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketConfig.
+func (in *BottlerocketConfig) DeepCopy() *BottlerocketConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketHostContainer) DeepCopyInto(out *BottlerocketHostContainer) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Superpowered != nil {
+		in, out := &in.Superpowered, &out.Superpowered
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketHostContainer.
+func (in *BottlerocketHostContainer) DeepCopy() *BottlerocketHostContainer {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketHostContainer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketRegistryMirror) DeepCopyInto(out *BottlerocketRegistryMirror) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketRegistryMirror.
+func (in *BottlerocketRegistryMirror) DeepCopy() *BottlerocketRegistryMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketRegistryMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BottlerocketTaint) DeepCopyInto(out *BottlerocketTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BottlerocketTaint.
+func (in *BottlerocketTaint) DeepCopy() *BottlerocketTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(BottlerocketTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSetup) DeepCopyInto(out *DiskSetup) {
 	*out = *in
@@ -116,6 +262,16 @@ func (in *EKSConfigList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSConfigSpec) DeepCopyInto(out *EKSConfigSpec) {
 	*out = *in
+	if in.Nodeadm != nil {
+		in, out := &in.Nodeadm, &out.Nodeadm
+		*out = new(NodeadmConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bottlerocket != nil {
+		in, out := &in.Bottlerocket, &out.Bottlerocket
+		*out = new(BottlerocketConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubeletExtraArgs != nil {
 		in, out := &in.KubeletExtraArgs, &out.KubeletExtraArgs
 		*out = make(map[string]string, len(*in))
@@ -447,6 +603,71 @@ func (in *NTP) DeepCopy() *NTP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeadmConfig) DeepCopyInto(out *NodeadmConfig) {
+	*out = *in
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(NodeadmKubeletOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(NodeadmContainerdOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeadmConfig.
+func (in *NodeadmConfig) DeepCopy() *NodeadmConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeadmConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeadmContainerdOptions) DeepCopyInto(out *NodeadmContainerdOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeadmContainerdOptions.
+func (in *NodeadmContainerdOptions) DeepCopy() *NodeadmContainerdOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeadmContainerdOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeadmKubeletOptions) DeepCopyInto(out *NodeadmKubeletOptions) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flags != nil {
+		in, out := &in.Flags, &out.Flags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeadmKubeletOptions.
+func (in *NodeadmKubeletOptions) DeepCopy() *NodeadmKubeletOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeadmKubeletOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Partition) DeepCopyInto(out *Partition) {
 	*out = *in
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/internal/userdata"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// generateBottlerocketUserData generates the settings of Bottlerocket nodes in TOML format.
+func (r *EKSConfigReconciler) generateBottlerocketUserData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane) ([]byte, error) {
+	endpoint, caCert, err := r.getClusterEndpoint(ctx, cluster, controlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &userdata.BottlerocketInput{
+		ClusterName:       controlPlane.Spec.EKSClusterName,
+		APIServerEndpoint: endpoint,
+		CACert:            caCert,
+	}
+	if config.Spec.DNSClusterIP != nil {
+		input.DNSClusterIP = *config.Spec.DNSClusterIP
+	}
+	if bottlerocket := config.Spec.Bottlerocket; bottlerocket != nil {
+		input.MaxPods = bottlerocket.MaxPods
+		input.NodeLabels = bottlerocket.NodeLabels
+		input.NodeTaints = bottlerocket.NodeTaints
+		input.HostContainers = bottlerocket.HostContainers
+		input.BootstrapContainers = bottlerocket.BootstrapContainers
+		input.RegistryMirrors = bottlerocket.RegistryMirrors
+		input.CertificateBundles = bottlerocket.CertificateBundles
+	}
+
+	return userdata.NewBottlerocket(input)
+}
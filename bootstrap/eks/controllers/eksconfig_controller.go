@@ -63,6 +63,7 @@ type EKSConfigReconciler struct {
 // +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machinepools;clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines;awsmachinepools;awsmanagedmachinepools,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;delete;
 
 func (r *EKSConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, rerr error) {
@@ -234,6 +235,46 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		return err
 	}
 
+	nodeType, err := r.resolveNodeType(ctx, config, configOwner)
+	if err != nil {
+		log.Error(err, "Failed to resolve the node type")
+		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+
+	var (
+		userDataScript []byte
+		dataFormat     string
+	)
+	switch nodeType {
+	case eksbootstrapv1.NodeTypeAL2023:
+		userDataScript, err = r.generateNodeadmUserData(ctx, cluster, config, controlPlane, files)
+	case eksbootstrapv1.NodeTypeBottlerocket:
+		userDataScript, err = r.generateBottlerocketUserData(ctx, cluster, config, controlPlane)
+		dataFormat = eksbootstrapv1.BottlerocketDataFormat
+	default:
+		userDataScript, err = generateNodeUserData(ctx, config, controlPlane, files)
+	}
+	if err != nil {
+		log.Error(err, "Failed to create a worker join configuration")
+		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
+		return err
+	}
+
+	// store userdata as secret
+	if err := r.storeBootstrapData(ctx, cluster, config, userDataScript, dataFormat); err != nil {
+		log.Error(err, "Failed to store bootstrap data")
+		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
+		return err
+	}
+
+	return nil
+}
+
+// generateNodeUserData generates the cloud-init user data calling the bootstrap.sh script of AL2 nodes.
+func generateNodeUserData(ctx context.Context, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane, files []eksbootstrapv1.File) ([]byte, error) {
+	log := logger.FromContext(ctx)
+
 	nodeInput := &userdata.NodeInput{
 		// AWSManagedControlPlane webhooks default and validate EKSClusterName
 		ClusterName:              controlPlane.Spec.EKSClusterName,
@@ -271,22 +312,45 @@ func (r *EKSConfigReconciler) joinWorker(ctx context.Context, cluster *clusterv1
 		nodeInput.IPFamily = ptr.To[string]("ipv6")
 	}
 
-	// generate userdata
-	userDataScript, err := userdata.NewNode(nodeInput)
-	if err != nil {
-		log.Error(err, "Failed to create a worker join configuration")
-		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
-		return err
+	return userdata.NewNode(nodeInput)
+}
+
+// generateNodeadmUserData generates the MIME multi-part user data with the NodeConfig of AL2023 nodes.
+func (r *EKSConfigReconciler) generateNodeadmUserData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane, files []eksbootstrapv1.File) ([]byte, error) {
+	if len(config.Spec.PostBootstrapCommands) > 0 {
+		return nil, errors.New("postBootstrapCommands are not supported by al2023 nodes")
 	}
 
-	// store userdata as secret
-	if err := r.storeBootstrapData(ctx, cluster, config, userDataScript); err != nil {
-		log.Error(err, "Failed to store bootstrap data")
-		conditions.MarkFalse(config, eksbootstrapv1.DataSecretAvailableCondition, eksbootstrapv1.DataSecretGenerationFailedReason, clusterv1.ConditionSeverityWarning, "")
-		return err
+	endpoint, caCert, err := r.getClusterEndpoint(ctx, cluster, controlPlane)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeadmInput := &userdata.NodeadmInput{
+		ClusterName:          controlPlane.Spec.EKSClusterName,
+		APIServerEndpoint:    endpoint,
+		CACert:               caCert,
+		ServiceCIDR:          getServiceCIDR(cluster, config, controlPlane),
+		KubeletExtraArgs:     config.Spec.KubeletExtraArgs,
+		DNSClusterIP:         config.Spec.DNSClusterIP,
+		PreBootstrapCommands: config.Spec.PreBootstrapCommands,
+		NTP:                  config.Spec.NTP,
+		Users:                config.Spec.Users,
+		DiskSetup:            config.Spec.DiskSetup,
+		Mounts:               config.Spec.Mounts,
+		Files:                files,
+	}
+	if config.Spec.Nodeadm != nil {
+		if config.Spec.Nodeadm.Kubelet != nil {
+			nodeadmInput.KubeletConfig = config.Spec.Nodeadm.Kubelet.Config
+			nodeadmInput.KubeletFlags = config.Spec.Nodeadm.Kubelet.Flags
+		}
+		if config.Spec.Nodeadm.Containerd != nil {
+			nodeadmInput.ContainerdConfig = config.Spec.Nodeadm.Containerd.Config
+		}
 	}
 
-	return nil
+	return userdata.NewNodeadm(nodeadmInput)
 }
 
 func (r *EKSConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, option controller.Options) error {
@@ -325,7 +389,8 @@ func (r *EKSConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Man
 
 // storeBootstrapData creates a new secret with the data passed in as input,
 // sets the reference in the configuration status and ready to true.
-func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, data []byte) error {
+// The format is stored alongside the data when it is set.
+func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, data []byte, format string) error {
 	log := logger.FromContext(ctx)
 
 	// as secret creation and scope.Config status patch are not atomic operations
@@ -336,7 +401,7 @@ func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *c
 		Namespace: config.Namespace,
 	}, secret); err != nil {
 		if apierrors.IsNotFound(err) {
-			if secret, err = r.createBootstrapSecret(ctx, cluster, config, data); err != nil {
+			if secret, err = r.createBootstrapSecret(ctx, cluster, config, data, format); err != nil {
 				return errors.Wrap(err, "failed to create bootstrap data secret for EKSConfig")
 			}
 			log.Info("created bootstrap data secret for EKSConfig", "secret", klog.KObj(secret))
@@ -344,7 +409,7 @@ func (r *EKSConfigReconciler) storeBootstrapData(ctx context.Context, cluster *c
 			return errors.Wrap(err, "failed to get data secret for EKSConfig")
 		}
 	} else {
-		updated, err := r.updateBootstrapSecret(ctx, secret, data)
+		updated, err := r.updateBootstrapSecret(ctx, secret, data, format)
 		if err != nil {
 			return errors.Wrap(err, "failed to update data secret for EKSConfig")
 		}
@@ -429,7 +494,7 @@ func (r *EKSConfigReconciler) ClusterToEKSConfigs(_ context.Context, o client.Ob
 }
 
 // Create the Secret containing bootstrap userdata.
-func (r *EKSConfigReconciler) createBootstrapSecret(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, data []byte) (*corev1.Secret, error) {
+func (r *EKSConfigReconciler) createBootstrapSecret(ctx context.Context, cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, data []byte, format string) (*corev1.Secret, error) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      config.Name,
@@ -452,16 +517,24 @@ func (r *EKSConfigReconciler) createBootstrapSecret(ctx context.Context, cluster
 		},
 		Type: clusterv1.ClusterSecretType,
 	}
+	if format != "" {
+		secret.Data["format"] = []byte(format)
+	}
 	return secret, r.Client.Create(ctx, secret)
 }
 
 // Update the userdata in the bootstrap Secret.
-func (r *EKSConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte) (bool, error) {
+func (r *EKSConfigReconciler) updateBootstrapSecret(ctx context.Context, secret *corev1.Secret, data []byte, format string) (bool, error) {
 	if secret.Data == nil {
 		secret.Data = make(map[string][]byte)
 	}
-	if !bytes.Equal(secret.Data["value"], data) {
+	if !bytes.Equal(secret.Data["value"], data) || string(secret.Data["format"]) != format {
 		secret.Data["value"] = data
+		if format != "" {
+			secret.Data["format"] = []byte(format)
+		} else {
+			delete(secret.Data, "format")
+		}
 		return true, r.Client.Update(ctx, secret)
 	}
 	return false, nil
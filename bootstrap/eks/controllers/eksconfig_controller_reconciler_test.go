@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	"encoding/base64"
 	"fmt"
 	"testing"
 
@@ -24,6 +25,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
@@ -34,6 +37,7 @@ import (
 	"sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
 )
 
 func TestEKSConfigReconciler(t *testing.T) {
@@ -270,6 +274,75 @@ func TestEKSConfigReconciler(t *testing.T) {
 		}).Should(Succeed())
 		g.Expect(string(gotSecret.Data["value"])).To(Equal(string(expectedUserData)))
 	})
+	t.Run("Should reconcile a Bottlerocket EKSConfig and store the data format", func(t *testing.T) {
+		g := NewWithT(t)
+		amcp := newAMCP("test-cluster")
+		cluster := newCluster(amcp.Name)
+		machine := newMachine(cluster, "test-machine")
+		config := newEKSConfig(machine)
+		config.Spec.NodeType = eksbootstrapv1.NodeTypeBottlerocket
+		config.Spec.Bottlerocket = &eksbootstrapv1.BottlerocketConfig{
+			NodeLabels: map[string]string{"role": "worker"},
+		}
+		kubeconfigSecret, err := newKubeconfigSecret(cluster, amcp.Spec.EKSClusterName)
+		g.Expect(err).To(BeNil())
+		t.Log(dump("amcp", amcp))
+		t.Log(dump("config", config))
+		t.Log(dump("machine", machine))
+		t.Log(dump("cluster", cluster))
+		g.Expect(testEnv.Client.Create(ctx, kubeconfigSecret)).To(Succeed())
+		g.Expect(testEnv.Client.Create(ctx, amcp)).To(Succeed())
+
+		expectedUserData, err := userdata.NewBottlerocket(&userdata.BottlerocketInput{
+			ClusterName:       amcp.Spec.EKSClusterName,
+			APIServerEndpoint: "https://example.eks.amazonaws.com",
+			CACert:            base64.StdEncoding.EncodeToString([]byte("ca")),
+			NodeLabels:        map[string]string{"role": "worker"},
+		})
+		g.Expect(err).To(BeNil())
+		reconciler := EKSConfigReconciler{
+			Client: testEnv.Client,
+		}
+		g.Eventually(func(gomega Gomega) {
+			err := reconciler.joinWorker(ctx, cluster, config, configOwner("Machine"))
+			gomega.Expect(err).NotTo(HaveOccurred())
+		}).Should(Succeed())
+
+		gotSecret := &corev1.Secret{}
+		g.Eventually(func(gomega Gomega) {
+			gomega.Expect(testEnv.Client.Get(ctx, client.ObjectKey{
+				Name:      config.Name,
+				Namespace: "default",
+			}, gotSecret)).To(Succeed())
+		}).Should(Succeed())
+		g.Expect(string(gotSecret.Data["value"])).To(Equal(string(expectedUserData)))
+		g.Expect(string(gotSecret.Data["format"])).To(Equal(eksbootstrapv1.BottlerocketDataFormat))
+	})
+}
+
+// newKubeconfigSecret returns the kubeconfig secret of a cluster containing the EKS cluster.
+func newKubeconfigSecret(cluster *clusterv1.Cluster, eksClusterName string) (*corev1.Secret, error) {
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			eksClusterName: {
+				Server:                   "https://example.eks.amazonaws.com",
+				CertificateAuthorityData: []byte("ca"),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cluster.Namespace,
+			Name:      secret.Name(cluster.Name, secret.Kubeconfig),
+		},
+		Data: map[string][]byte{
+			secret.KubeconfigDataName: kubeconfig,
+		},
+	}, nil
 }
 
 // newCluster return a CAPI cluster object.
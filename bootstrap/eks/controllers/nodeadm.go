@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+const (
+	// defaultServiceCIDR and alternateServiceCIDR are the service CIDRs picked by EKS when none
+	// is specified on cluster creation, depending on the CIDR of the VPC.
+	defaultServiceCIDR   = "10.100.0.0/16"
+	alternateServiceCIDR = "172.20.0.0/16"
+)
+
+// getClusterEndpoint returns the API server endpoint and the base64 encoded certificate authority
+// of the EKS cluster from the kubeconfig secret of the cluster.
+func (r *EKSConfigReconciler) getClusterEndpoint(ctx context.Context, cluster *clusterv1.Cluster, controlPlane *ekscontrolplanev1.AWSManagedControlPlane) (string, string, error) {
+	data, err := secret.GetFromNamespacedName(ctx, r.Client, util.ObjectKey(cluster), secret.Kubeconfig)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to get kubeconfig secret")
+	}
+
+	kubeconfig, err := clientcmd.Load(data.Data[secret.KubeconfigDataName])
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to parse kubeconfig")
+	}
+
+	kubeconfigCluster, ok := kubeconfig.Clusters[controlPlane.Spec.EKSClusterName]
+	if !ok {
+		return "", "", errors.Errorf("kubeconfig has no cluster named %q", controlPlane.Spec.EKSClusterName)
+	}
+
+	return kubeconfigCluster.Server, base64.StdEncoding.EncodeToString(kubeconfigCluster.CertificateAuthorityData), nil
+}
+
+// getServiceCIDR returns the service CIDR of the EKS cluster.
+func getServiceCIDR(cluster *clusterv1.Cluster, config *eksbootstrapv1.EKSConfig, controlPlane *ekscontrolplanev1.AWSManagedControlPlane) string {
+	if config.Spec.ServiceIPV6Cidr != nil && *config.Spec.ServiceIPV6Cidr != "" {
+		return *config.Spec.ServiceIPV6Cidr
+	}
+	if controlPlane.Spec.NetworkSpec.VPC.IsIPv6Enabled() {
+		return controlPlane.Spec.NetworkSpec.VPC.IPv6.CidrBlock
+	}
+
+	if cluster.Spec.ClusterNetwork != nil && cluster.Spec.ClusterNetwork.Services != nil {
+		for _, block := range cluster.Spec.ClusterNetwork.Services.CIDRBlocks {
+			if ip, _, err := net.ParseCIDR(block); err == nil && ip.To4() != nil {
+				return block
+			}
+		}
+	}
+
+	// EKS picks 172.20.0.0/16 when the VPC overlaps with 10.0.0.0/8, otherwise 10.100.0.0/16.
+	if ip, _, err := net.ParseCIDR(controlPlane.Spec.NetworkSpec.VPC.CidrBlock); err == nil {
+		if _, tenNet, _ := net.ParseCIDR("10.0.0.0/8"); tenNet.Contains(ip) {
+			return alternateServiceCIDR
+		}
+	}
+
+	return defaultServiceCIDR
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestGetServiceCIDR(t *testing.T) {
+	tests := []struct {
+		name         string
+		cluster      *clusterv1.Cluster
+		config       *eksbootstrapv1.EKSConfig
+		controlPlane *ekscontrolplanev1.AWSManagedControlPlane
+		expected     string
+	}{
+		{
+			name: "cluster network services",
+			cluster: &clusterv1.Cluster{Spec: clusterv1.ClusterSpec{ClusterNetwork: &clusterv1.ClusterNetwork{
+				Services: &clusterv1.NetworkRanges{CIDRBlocks: []string{"192.168.0.0/16"}},
+			}}},
+			config:       &eksbootstrapv1.EKSConfig{},
+			controlPlane: &ekscontrolplanev1.AWSManagedControlPlane{},
+			expected:     "192.168.0.0/16",
+		},
+		{
+			name:    "VPC in 10.0.0.0/8",
+			cluster: &clusterv1.Cluster{},
+			config:  &eksbootstrapv1.EKSConfig{},
+			controlPlane: &ekscontrolplanev1.AWSManagedControlPlane{Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{CidrBlock: "10.0.0.0/16"}},
+			}},
+			expected: "172.20.0.0/16",
+		},
+		{
+			name:    "VPC outside of 10.0.0.0/8",
+			cluster: &clusterv1.Cluster{},
+			config:  &eksbootstrapv1.EKSConfig{},
+			controlPlane: &ekscontrolplanev1.AWSManagedControlPlane{Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				NetworkSpec: infrav1.NetworkSpec{VPC: infrav1.VPCSpec{CidrBlock: "172.16.0.0/16"}},
+			}},
+			expected: "10.100.0.0/16",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getServiceCIDR(tc.cluster, tc.config, tc.controlPlane)).To(Equal(tc.expected))
+		})
+	}
+}
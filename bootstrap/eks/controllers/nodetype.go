@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
+)
+
+const (
+	// amiTypeAL2023Prefix is the prefix of the AL2023 AMI types of EKS managed node groups.
+	amiTypeAL2023Prefix = "AL2023"
+	// amiTypeBottlerocketPrefix is the prefix of the Bottlerocket AMI types of EKS managed node groups.
+	amiTypeBottlerocketPrefix = "BOTTLEROCKET"
+)
+
+// resolveNodeType returns the node type of the EKSConfig. When it isn't set explicitly, it's
+// derived from the AMI type of the infrastructure the owner of the config references.
+func (r *EKSConfigReconciler) resolveNodeType(ctx context.Context, config *eksbootstrapv1.EKSConfig, configOwner *bsutil.ConfigOwner) (eksbootstrapv1.NodeType, error) {
+	if config.Spec.NodeType != "" {
+		return config.Spec.NodeType, nil
+	}
+
+	infraRef := ownerInfrastructureRef(configOwner)
+	if infraRef == nil {
+		return eksbootstrapv1.NodeTypeAL2, nil
+	}
+
+	infra := &unstructured.Unstructured{}
+	infra.SetGroupVersionKind(schema.FromAPIVersionAndKind(infraRef.apiVersion, infraRef.kind))
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: configOwner.GetNamespace(), Name: infraRef.name}, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			return eksbootstrapv1.NodeTypeAL2, nil
+		}
+		return "", errors.Wrapf(err, "failed to get infrastructure %s %s", infraRef.kind, infraRef.name)
+	}
+
+	return nodeTypeFromInfrastructure(infra), nil
+}
+
+type infrastructureRef struct {
+	apiVersion string
+	kind       string
+	name       string
+}
+
+// ownerInfrastructureRef returns the infrastructure reference of a Machine or MachinePool owner.
+func ownerInfrastructureRef(configOwner *bsutil.ConfigOwner) *infrastructureRef {
+	if configOwner == nil || configOwner.Unstructured == nil {
+		return nil
+	}
+
+	path := []string{"spec", "infrastructureRef"}
+	if configOwner.IsMachinePool() {
+		path = []string{"spec", "template", "spec", "infrastructureRef"}
+	}
+
+	ref, found, err := unstructured.NestedStringMap(configOwner.Object, path...)
+	if err != nil || !found || ref["kind"] == "" || ref["name"] == "" {
+		return nil
+	}
+
+	return &infrastructureRef{
+		apiVersion: ref["apiVersion"],
+		kind:       ref["kind"],
+		name:       ref["name"],
+	}
+}
+
+// nodeTypeFromInfrastructure returns the node type matching the AMI of an AWSMachine,
+// AWSMachinePool or AWSManagedMachinePool.
+func nodeTypeFromInfrastructure(infra *unstructured.Unstructured) eksbootstrapv1.NodeType {
+	var (
+		lookupType string
+		amiType    string
+	)
+
+	switch infra.GetKind() {
+	case "AWSMachine":
+		lookupType, _, _ = unstructured.NestedString(infra.Object, "spec", "ami", "eksLookupType")
+	case "AWSMachinePool":
+		lookupType, _, _ = unstructured.NestedString(infra.Object, "spec", "awsLaunchTemplate", "ami", "eksLookupType")
+	case "AWSManagedMachinePool":
+		amiType, _, _ = unstructured.NestedString(infra.Object, "spec", "amiType")
+	}
+
+	switch {
+	case lookupType == string(infrav1.AmazonLinux2023), lookupType == string(infrav1.AmazonLinux2023GPU):
+		return eksbootstrapv1.NodeTypeAL2023
+	case strings.HasPrefix(amiType, amiTypeAL2023Prefix):
+		return eksbootstrapv1.NodeTypeAL2023
+	case strings.HasPrefix(amiType, amiTypeBottlerocketPrefix):
+		return eksbootstrapv1.NodeTypeBottlerocket
+	default:
+		return eksbootstrapv1.NodeTypeAL2
+	}
+}
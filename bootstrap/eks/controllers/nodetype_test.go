@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+	bsutil "sigs.k8s.io/cluster-api/bootstrap/util"
+)
+
+func TestNodeTypeFromInfrastructure(t *testing.T) {
+	tests := []struct {
+		name     string
+		infra    map[string]interface{}
+		expected eksbootstrapv1.NodeType
+	}{
+		{
+			name: "AWSMachine with AL2023 lookup type",
+			infra: map[string]interface{}{
+				"kind": "AWSMachine",
+				"spec": map[string]interface{}{"ami": map[string]interface{}{"eksLookupType": "AmazonLinux2023"}},
+			},
+			expected: eksbootstrapv1.NodeTypeAL2023,
+		},
+		{
+			name: "AWSMachine with AL2 lookup type",
+			infra: map[string]interface{}{
+				"kind": "AWSMachine",
+				"spec": map[string]interface{}{"ami": map[string]interface{}{"eksLookupType": "AmazonLinux"}},
+			},
+			expected: eksbootstrapv1.NodeTypeAL2,
+		},
+		{
+			name: "AWSMachinePool with AL2023 GPU lookup type",
+			infra: map[string]interface{}{
+				"kind": "AWSMachinePool",
+				"spec": map[string]interface{}{"awsLaunchTemplate": map[string]interface{}{"ami": map[string]interface{}{"eksLookupType": "AmazonLinux2023GPU"}}},
+			},
+			expected: eksbootstrapv1.NodeTypeAL2023,
+		},
+		{
+			name: "AWSManagedMachinePool with AL2023 AMI type",
+			infra: map[string]interface{}{
+				"kind": "AWSManagedMachinePool",
+				"spec": map[string]interface{}{"amiType": "AL2023_ARM_64_STANDARD"},
+			},
+			expected: eksbootstrapv1.NodeTypeAL2023,
+		},
+		{
+			name: "AWSManagedMachinePool with Bottlerocket AMI type",
+			infra: map[string]interface{}{
+				"kind": "AWSManagedMachinePool",
+				"spec": map[string]interface{}{"amiType": "BOTTLEROCKET_x86_64"},
+			},
+			expected: eksbootstrapv1.NodeTypeBottlerocket,
+		},
+		{
+			name: "AWSMachine without AMI",
+			infra: map[string]interface{}{
+				"kind": "AWSMachine",
+				"spec": map[string]interface{}{},
+			},
+			expected: eksbootstrapv1.NodeTypeAL2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nodeTypeFromInfrastructure(&unstructured.Unstructured{Object: tc.infra})).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestOwnerInfrastructureRef(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ownerInfrastructureRef(configOwner("Machine"))).To(BeNil())
+
+	machine := &bsutil.ConfigOwner{Unstructured: &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "Machine",
+		"spec": map[string]interface{}{"infrastructureRef": map[string]interface{}{
+			"apiVersion": infrav1.GroupVersion.String(),
+			"kind":       "AWSMachine",
+			"name":       "machine-1",
+		}},
+	}}}
+	g.Expect(ownerInfrastructureRef(machine)).To(Equal(&infrastructureRef{apiVersion: infrav1.GroupVersion.String(), kind: "AWSMachine", name: "machine-1"}))
+
+	machinePool := &bsutil.ConfigOwner{Unstructured: &unstructured.Unstructured{Object: map[string]interface{}{
+		"kind": "MachinePool",
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"infrastructureRef": map[string]interface{}{
+			"apiVersion": infrav1.GroupVersion.String(),
+			"kind":       "AWSMachinePool",
+			"name":       "pool-1",
+		}}}},
+	}}}
+	g.Expect(ownerInfrastructureRef(machinePool)).To(Equal(&infrastructureRef{apiVersion: infrav1.GroupVersion.String(), kind: "AWSMachinePool", name: "pool-1"}))
+}
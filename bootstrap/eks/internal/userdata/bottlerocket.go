@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+const (
+	bottlerocketTemplate = `[settings.kubernetes]
+cluster-name = {{ quote .ClusterName }}
+api-server = {{ quote .APIServerEndpoint }}
+cluster-certificate = {{ quote .CACert }}
+{{- if .DNSClusterIP }}
+cluster-dns-ip = {{ quote .DNSClusterIP }}
+{{- end }}
+{{- if .MaxPods }}
+max-pods = {{ .MaxPods }}
+{{- end }}
+{{- if .NodeLabels }}
+
+[settings.kubernetes.node-labels]
+{{- range .NodeLabels }}
+{{ quote .Key }} = {{ quote .Value }}
+{{- end }}
+{{- end }}
+{{- if .NodeTaints }}
+
+[settings.kubernetes.node-taints]
+{{- range .NodeTaints }}
+{{ quote .Key }} = [{{ quoteList .Values }}]
+{{- end }}
+{{- end }}
+{{- range .HostContainers }}
+
+[settings.host-containers.{{ quote .Name }}]
+{{- if .Image }}
+source = {{ quote .Image }}
+{{- end }}
+{{- if .Enabled }}
+enabled = {{ .Enabled }}
+{{- end }}
+{{- if .Superpowered }}
+superpowered = {{ .Superpowered }}
+{{- end }}
+{{- if .UserData }}
+user-data = {{ quote (b64enc .UserData) }}
+{{- end }}
+{{- end }}
+{{- range .BootstrapContainers }}
+
+[settings.bootstrap-containers.{{ quote .Name }}]
+source = {{ quote .Image }}
+mode = {{ quote (bootstrapContainerMode .Mode) }}
+essential = {{ .Essential }}
+{{- if .UserData }}
+user-data = {{ quote (b64enc .UserData) }}
+{{- end }}
+{{- end }}
+{{- range .RegistryMirrors }}
+
+[[settings.container-registry.mirrors]]
+registry = {{ quote .Registry }}
+endpoint = [{{ quoteList .Endpoints }}]
+{{- end }}
+{{- range .CertificateBundles }}
+
+[settings.pki.{{ quote .Name }}]
+data = {{ quote (b64enc .Data) }}
+trusted = true
+{{- end }}
+`
+)
+
+// BottlerocketInput defines the context to generate the settings of a Bottlerocket node.
+type BottlerocketInput struct {
+	ClusterName         string
+	APIServerEndpoint   string
+	CACert              string
+	DNSClusterIP        string
+	MaxPods             *int32
+	NodeLabels          map[string]string
+	NodeTaints          []eksbootstrapv1.BottlerocketTaint
+	HostContainers      []eksbootstrapv1.BottlerocketHostContainer
+	BootstrapContainers []eksbootstrapv1.BottlerocketBootstrapContainer
+	RegistryMirrors     []eksbootstrapv1.BottlerocketRegistryMirror
+	CertificateBundles  []eksbootstrapv1.BottlerocketCertificateBundle
+}
+
+type bottlerocketLabel struct {
+	Key   string
+	Value string
+}
+
+type bottlerocketTaint struct {
+	Key    string
+	Values []string
+}
+
+type bottlerocketHostContainer struct {
+	eksbootstrapv1.BottlerocketHostContainer
+	Enabled      string
+	Superpowered string
+}
+
+type bottlerocketTemplateInput struct {
+	*BottlerocketInput
+	MaxPods        int32
+	NodeLabels     []bottlerocketLabel
+	NodeTaints     []bottlerocketTaint
+	HostContainers []bottlerocketHostContainer
+}
+
+// NewBottlerocket returns the settings of a Bottlerocket node in TOML format.
+func NewBottlerocket(input *BottlerocketInput) ([]byte, error) {
+	tm := template.New("Bottlerocket").Funcs(template.FuncMap{
+		"quote":                  tomlQuote,
+		"quoteList":              tomlQuoteList,
+		"b64enc":                 func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"bootstrapContainerMode": bootstrapContainerMode,
+	})
+
+	t, err := tm.Parse(bottlerocketTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Bottlerocket template: %w", err)
+	}
+
+	in := bottlerocketTemplateInput{
+		BottlerocketInput: input,
+		NodeLabels:        bottlerocketLabels(input.NodeLabels),
+		NodeTaints:        bottlerocketTaints(input.NodeTaints),
+		HostContainers:    bottlerocketHostContainers(input.HostContainers),
+	}
+	if input.MaxPods != nil {
+		in.MaxPods = *input.MaxPods
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, in); err != nil {
+		return nil, fmt.Errorf("failed to generate Bottlerocket template: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+// bottlerocketLabels returns the labels sorted by key so that the settings don't change between reconciles.
+func bottlerocketLabels(labels map[string]string) []bottlerocketLabel {
+	out := make([]bottlerocketLabel, 0, len(labels))
+	for k, v := range labels {
+		out = append(out, bottlerocketLabel{Key: k, Value: v})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+// bottlerocketTaints groups the taints by key, as Bottlerocket expects a list of value:effect
+// entries per taint key.
+func bottlerocketTaints(taints []eksbootstrapv1.BottlerocketTaint) []bottlerocketTaint {
+	values := map[string][]string{}
+	keys := []string{}
+	for _, taint := range taints {
+		if _, ok := values[taint.Key]; !ok {
+			keys = append(keys, taint.Key)
+		}
+		values[taint.Key] = append(values[taint.Key], fmt.Sprintf("%s:%s", taint.Value, taint.Effect))
+	}
+	sort.Strings(keys)
+
+	out := make([]bottlerocketTaint, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, bottlerocketTaint{Key: key, Values: values[key]})
+	}
+	return out
+}
+
+func bottlerocketHostContainers(containers []eksbootstrapv1.BottlerocketHostContainer) []bottlerocketHostContainer {
+	out := make([]bottlerocketHostContainer, 0, len(containers))
+	for _, container := range containers {
+		c := bottlerocketHostContainer{BottlerocketHostContainer: container}
+		if container.Enabled != nil {
+			c.Enabled = fmt.Sprintf("%t", *container.Enabled)
+		}
+		if container.Superpowered != nil {
+			c.Superpowered = fmt.Sprintf("%t", *container.Superpowered)
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func bootstrapContainerMode(mode string) string {
+	if mode == "" {
+		return "always"
+	}
+	return mode
+}
+
+// tomlQuote returns s as a TOML basic string. The escape sequences of JSON strings are a
+// subset of the ones of TOML basic strings.
+func tomlQuote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	// Encoding a string can't fail.
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func tomlQuoteList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, tomlQuote(v))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"k8s.io/utils/ptr"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestNewBottlerocket(t *testing.T) {
+	format.TruncatedDiff = false
+	g := NewWithT(t)
+
+	tests := []struct {
+		name          string
+		input         *BottlerocketInput
+		expectedBytes []byte
+	}{
+		{
+			name: "only cluster details",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.eks.amazonaws.com",
+				CACert:            "Y2VydA==",
+			},
+			expectedBytes: []byte(`[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://example.eks.amazonaws.com"
+cluster-certificate = "Y2VydA=="
+`),
+		},
+		{
+			name: "with all settings",
+			input: &BottlerocketInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.eks.amazonaws.com",
+				CACert:            "Y2VydA==",
+				DNSClusterIP:      "10.100.0.10",
+				MaxPods:           ptr.To[int32](110),
+				NodeLabels: map[string]string{
+					"team": "security",
+					"role": "worker",
+				},
+				NodeTaints: []eksbootstrapv1.BottlerocketTaint{
+					{Key: "dedicated", Value: "security", Effect: "NoSchedule"},
+					{Key: "dedicated", Value: "security", Effect: "NoExecute"},
+				},
+				HostContainers: []eksbootstrapv1.BottlerocketHostContainer{
+					{Name: "admin", Enabled: ptr.To[bool](true), Superpowered: ptr.To[bool](true), UserData: "{}"},
+				},
+				BootstrapContainers: []eksbootstrapv1.BottlerocketBootstrapContainer{
+					{Name: "setup", Image: "example.com/setup:v1", Essential: true},
+				},
+				RegistryMirrors: []eksbootstrapv1.BottlerocketRegistryMirror{
+					{Registry: "docker.io", Endpoints: []string{"https://mirror.example.com"}},
+				},
+				CertificateBundles: []eksbootstrapv1.BottlerocketCertificateBundle{
+					{Name: "corporate", Data: "pem"},
+				},
+			},
+			expectedBytes: []byte(`[settings.kubernetes]
+cluster-name = "test-cluster"
+api-server = "https://example.eks.amazonaws.com"
+cluster-certificate = "Y2VydA=="
+cluster-dns-ip = "10.100.0.10"
+max-pods = 110
+
+[settings.kubernetes.node-labels]
+"role" = "worker"
+"team" = "security"
+
+[settings.kubernetes.node-taints]
+"dedicated" = ["security:NoSchedule", "security:NoExecute"]
+
+[settings.host-containers."admin"]
+enabled = true
+superpowered = true
+user-data = "e30="
+
+[settings.bootstrap-containers."setup"]
+source = "example.com/setup:v1"
+mode = "always"
+essential = true
+
+[[settings.container-registry.mirrors]]
+registry = "docker.io"
+endpoint = ["https://mirror.example.com"]
+
+[settings.pki."corporate"]
+data = "cGVt"
+trusted = true
+`),
+		},
+	}
+
+	for _, testcase := range tests {
+		t.Run(testcase.name, func(t *testing.T) {
+			bytes, err := NewBottlerocket(testcase.input)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(bytes)).To(Equal(string(testcase.expectedBytes)))
+		})
+	}
+}
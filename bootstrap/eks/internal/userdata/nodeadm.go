@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+const (
+	nodeadmBoundary = "//"
+
+	nodeConfigAPIVersion = "node.eks.aws/v1alpha1"
+	nodeConfigKind       = "NodeConfig"
+
+	nodeadmUserData = `MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="{{.Boundary}}"
+
+--{{.Boundary}}
+Content-Type: application/node.eks.aws
+
+{{.NodeConfig}}
+{{- if .HasCloudConfig }}
+--{{.Boundary}}
+Content-Type: text/cloud-config; charset="us-ascii"
+
+#cloud-config
+{{template "files" .Files}}
+{{- if .PreBootstrapCommands }}
+runcmd:
+{{- template "commands" .PreBootstrapCommands }}
+{{- end }}
+{{- template "ntp" .NTP }}
+{{- template "users" .Users }}
+{{- template "disk_setup" .DiskSetup}}
+{{- template "fs_setup" .DiskSetup}}
+{{- template "mounts" .Mounts}}
+{{- end }}
+--{{.Boundary}}--
+`
+)
+
+// NodeadmInput defines the context to generate the user data of a node bootstrapped by nodeadm.
+type NodeadmInput struct {
+	ClusterName          string
+	APIServerEndpoint    string
+	CACert               string
+	ServiceCIDR          string
+	KubeletExtraArgs     map[string]string
+	DNSClusterIP         *string
+	KubeletConfig        *runtime.RawExtension
+	KubeletFlags         []string
+	ContainerdConfig     string
+	PreBootstrapCommands []string
+	Files                []eksbootstrapv1.File
+	DiskSetup            *eksbootstrapv1.DiskSetup
+	Mounts               []eksbootstrapv1.MountPoints
+	Users                []eksbootstrapv1.User
+	NTP                  *eksbootstrapv1.NTP
+}
+
+// Boundary returns the boundary of the MIME multi-part user data.
+func (ni *NodeadmInput) Boundary() string {
+	return nodeadmBoundary
+}
+
+// HasCloudConfig returns true when a cloud-config part is required in the user data.
+func (ni *NodeadmInput) HasCloudConfig() bool {
+	return len(ni.Files) > 0 || len(ni.PreBootstrapCommands) > 0 || len(ni.Users) > 0 ||
+		ni.NTP != nil || ni.DiskSetup != nil || len(ni.Mounts) > 0
+}
+
+type nodeadmTemplateInput struct {
+	*NodeadmInput
+	NodeConfig string
+}
+
+type nodeConfig struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Spec       nodeConfigSpec `json:"spec"`
+}
+
+type nodeConfigSpec struct {
+	Cluster    nodeConfigCluster     `json:"cluster"`
+	Kubelet    *nodeConfigKubelet    `json:"kubelet,omitempty"`
+	Containerd *nodeConfigContainerd `json:"containerd,omitempty"`
+}
+
+type nodeConfigCluster struct {
+	Name                 string `json:"name"`
+	APIServerEndpoint    string `json:"apiServerEndpoint"`
+	CertificateAuthority string `json:"certificateAuthority"`
+	CIDR                 string `json:"cidr"`
+}
+
+type nodeConfigKubelet struct {
+	Config map[string]interface{} `json:"config,omitempty"`
+	Flags  []string               `json:"flags,omitempty"`
+}
+
+type nodeConfigContainerd struct {
+	Config string `json:"config,omitempty"`
+}
+
+// NewNodeadm returns the MIME multi-part user data of a node bootstrapped by nodeadm. It contains
+// the NodeConfig of the node and a cloud-config part for the files, users and commands.
+func NewNodeadm(input *NodeadmInput) ([]byte, error) {
+	config, err := newNodeConfig(input)
+	if err != nil {
+		return nil, err
+	}
+
+	tm := template.New("Nodeadm").Funcs(defaultTemplateFuncMap)
+	if _, err := tm.Parse(filesTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse files template: %w", err)
+	}
+
+	if _, err := tm.Parse(commandsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse commandsTemplate template: %w", err)
+	}
+
+	if _, err := tm.Parse(ntpTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse ntp template: %w", err)
+	}
+
+	if _, err := tm.Parse(usersTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse users template: %w", err)
+	}
+
+	if _, err := tm.Parse(diskSetupTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse disk setup template: %w", err)
+	}
+
+	if _, err := tm.Parse(fsSetupTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse fs setup template: %w", err)
+	}
+
+	if _, err := tm.Parse(mountsTemplate); err != nil {
+		return nil, fmt.Errorf("failed to parse mounts template: %w", err)
+	}
+
+	t, err := tm.Parse(nodeadmUserData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Nodeadm template: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := t.Execute(&out, nodeadmTemplateInput{NodeadmInput: input, NodeConfig: config}); err != nil {
+		return nil, fmt.Errorf("failed to generate Nodeadm template: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+func newNodeConfig(input *NodeadmInput) (string, error) {
+	config := nodeConfig{
+		APIVersion: nodeConfigAPIVersion,
+		Kind:       nodeConfigKind,
+		Spec: nodeConfigSpec{
+			Cluster: nodeConfigCluster{
+				Name:                 input.ClusterName,
+				APIServerEndpoint:    input.APIServerEndpoint,
+				CertificateAuthority: input.CACert,
+				CIDR:                 input.ServiceCIDR,
+			},
+		},
+	}
+
+	kubelet := &nodeConfigKubelet{}
+	if input.KubeletConfig != nil && len(input.KubeletConfig.Raw) > 0 {
+		if err := json.Unmarshal(input.KubeletConfig.Raw, &kubelet.Config); err != nil {
+			return "", fmt.Errorf("failed to parse kubelet config: %w", err)
+		}
+	}
+	if input.DNSClusterIP != nil && *input.DNSClusterIP != "" {
+		if kubelet.Config == nil {
+			kubelet.Config = map[string]interface{}{}
+		}
+		if _, ok := kubelet.Config["clusterDNS"]; !ok {
+			kubelet.Config["clusterDNS"] = []string{*input.DNSClusterIP}
+		}
+	}
+
+	// The flags are sorted so that the user data doesn't change between reconciles.
+	extraArgs := make([]string, 0, len(input.KubeletExtraArgs))
+	for k, v := range input.KubeletExtraArgs {
+		extraArgs = append(extraArgs, fmt.Sprintf("--%s=%s", k, v))
+	}
+	sort.Strings(extraArgs)
+	kubelet.Flags = append(extraArgs, input.KubeletFlags...)
+
+	if len(kubelet.Config) > 0 || len(kubelet.Flags) > 0 {
+		config.Spec.Kubelet = kubelet
+	}
+	if input.ContainerdConfig != "" {
+		config.Spec.Containerd = &nodeConfigContainerd{Config: input.ContainerdConfig}
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal NodeConfig: %w", err)
+	}
+
+	return "---\n" + strings.TrimSuffix(string(out), "\n"), nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userdata
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	eksbootstrapv1 "sigs.k8s.io/cluster-api-provider-aws/v2/bootstrap/eks/api/v1beta2"
+)
+
+func TestNewNodeadm(t *testing.T) {
+	format.TruncatedDiff = false
+	g := NewWithT(t)
+
+	tests := []struct {
+		name          string
+		input         *NodeadmInput
+		expectedBytes []byte
+		expectErr     bool
+	}{
+		{
+			name: "only cluster details",
+			input: &NodeadmInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.eks.amazonaws.com",
+				CACert:            "Y2VydA==",
+				ServiceCIDR:       "10.96.0.0/12",
+			},
+			expectedBytes: []byte(`MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="//"
+
+--//
+Content-Type: application/node.eks.aws
+
+---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  cluster:
+    apiServerEndpoint: https://example.eks.amazonaws.com
+    certificateAuthority: Y2VydA==
+    cidr: 10.96.0.0/12
+    name: test-cluster
+--//--
+`),
+		},
+		{
+			name: "with kubelet and containerd options",
+			input: &NodeadmInput{
+				ClusterName:       "test-cluster",
+				APIServerEndpoint: "https://example.eks.amazonaws.com",
+				CACert:            "Y2VydA==",
+				ServiceCIDR:       "10.96.0.0/12",
+				KubeletExtraArgs: map[string]string{
+					"register-with-taints": "dedicated=infra:NoSchedule",
+					"node-labels":          "role=infra",
+				},
+				DNSClusterIP:     ptr.To[string]("10.96.0.10"),
+				KubeletConfig:    &runtime.RawExtension{Raw: []byte(`{"maxPods":110}`)},
+				KubeletFlags:     []string{"--v=2"},
+				ContainerdConfig: "[plugins.\"io.containerd.grpc.v1.cri\".containerd]\ndiscard_unpacked_layers = false\n",
+			},
+			expectedBytes: []byte(`MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="//"
+
+--//
+Content-Type: application/node.eks.aws
+
+---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  cluster:
+    apiServerEndpoint: https://example.eks.amazonaws.com
+    certificateAuthority: Y2VydA==
+    cidr: 10.96.0.0/12
+    name: test-cluster
+  containerd:
+    config: |
+      [plugins."io.containerd.grpc.v1.cri".containerd]
+      discard_unpacked_layers = false
+  kubelet:
+    config:
+      clusterDNS:
+      - 10.96.0.10
+      maxPods: 110
+    flags:
+    - --node-labels=role=infra
+    - --register-with-taints=dedicated=infra:NoSchedule
+    - --v=2
+--//--
+`),
+		},
+		{
+			name: "with pre bootstrap commands and files",
+			input: &NodeadmInput{
+				ClusterName:          "test-cluster",
+				APIServerEndpoint:    "https://example.eks.amazonaws.com",
+				CACert:               "Y2VydA==",
+				ServiceCIDR:          "10.96.0.0/12",
+				PreBootstrapCommands: []string{"echo hello"},
+				Files: []eksbootstrapv1.File{
+					{
+						Path:    "/etc/test.conf",
+						Content: "test",
+					},
+				},
+			},
+			expectedBytes: []byte(`MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="//"
+
+--//
+Content-Type: application/node.eks.aws
+
+---
+apiVersion: node.eks.aws/v1alpha1
+kind: NodeConfig
+spec:
+  cluster:
+    apiServerEndpoint: https://example.eks.amazonaws.com
+    certificateAuthority: Y2VydA==
+    cidr: 10.96.0.0/12
+    name: test-cluster
+--//
+Content-Type: text/cloud-config; charset="us-ascii"
+
+#cloud-config
+write_files:
+  - path: /etc/test.conf
+    content: |
+      test
+runcmd:
+  - "echo hello"
+--//--
+`),
+		},
+		{
+			name: "invalid kubelet config",
+			input: &NodeadmInput{
+				ClusterName:   "test-cluster",
+				KubeletConfig: &runtime.RawExtension{Raw: []byte(`[`)},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testcase := range tests {
+		t.Run(testcase.name, func(t *testing.T) {
+			bytes, err := NewNodeadm(testcase.input)
+			if testcase.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(bytes)).To(Equal(string(testcase.expectedBytes)))
+		})
+	}
+}
@@ -93,30 +93,45 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:AssociateVpcCidrBlock",
 				"ec2:AttachInternetGateway",
 				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:AuthorizeSecurityGroupEgress",
 				"ec2:CreateCarrierGateway",
 				"ec2:CreateInternetGateway",
+				"ec2:CreateManagedPrefixList",
 				"ec2:CreateEgressOnlyInternetGateway",
+				"ec2:CreateFlowLogs",
 				"ec2:CreateNatGateway",
+				"ec2:CreateNetworkAcl",
+				"ec2:CreateNetworkAclEntry",
 				"ec2:CreateNetworkInterface",
 				"ec2:CreateRoute",
 				"ec2:CreateRouteTable",
 				"ec2:CreateSecurityGroup",
+				"ec2:CreateSnapshot",
 				"ec2:CreateSubnet",
 				"ec2:CreateTags",
+				"ec2:CreateTransitGatewayVpcAttachment",
 				"ec2:CreateVpc",
 				"ec2:CreateVpcEndpoint",
 				"ec2:DisassociateVpcCidrBlock",
 				"ec2:ModifyVpcAttribute",
 				"ec2:ModifyVpcEndpoint",
+				"ec2:ModifyManagedPrefixList",
+				"ec2:ModifyTransitGatewayVpcAttachment",
 				"ec2:DeleteCarrierGateway",
 				"ec2:DeleteInternetGateway",
+				"ec2:DeleteManagedPrefixList",
 				"ec2:DeleteEgressOnlyInternetGateway",
+				"ec2:DeleteFlowLogs",
 				"ec2:DeleteNatGateway",
+				"ec2:DeleteNetworkAcl",
+				"ec2:DeleteNetworkAclEntry",
+				"ec2:DeleteRoute",
 				"ec2:DeleteRouteTable",
 				"ec2:ReplaceRoute",
 				"ec2:DeleteSecurityGroup",
 				"ec2:DeleteSubnet",
 				"ec2:DeleteTags",
+				"ec2:DeleteTransitGatewayVpcAttachment",
 				"ec2:DeleteVpc",
 				"ec2:DeleteVpcEndpoints",
 				"ec2:DescribeAccountAttributes",
@@ -126,30 +141,43 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"ec2:DescribeInstances",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeInternetGateways",
+				"ec2:DescribeManagedPrefixLists",
+				"ec2:GetManagedPrefixListEntries",
 				"ec2:DescribeEgressOnlyInternetGateways",
+				"ec2:DescribeFlowLogs",
 				"ec2:DescribeInstanceTypes",
 				"ec2:DescribeImages",
 				"ec2:DescribeNatGateways",
+				"ec2:DescribeNetworkAcls",
 				"ec2:DescribeNetworkInterfaces",
 				"ec2:DescribeNetworkInterfaceAttribute",
 				"ec2:DescribeRouteTables",
 				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSnapshots",
 				"ec2:DescribeSubnets",
 				"ec2:DescribeVpcs",
 				"ec2:DescribeDhcpOptions",
 				"ec2:DescribeVpcAttribute",
 				"ec2:DescribeVpcEndpoints",
 				"ec2:DescribeVolumes",
+				"ec2:DescribeVolumesModifications",
 				"ec2:DescribeTags",
+				"ec2:DescribeTransitGatewayVpcAttachments",
 				"ec2:DetachInternetGateway",
 				"ec2:DisassociateRouteTable",
 				"ec2:DisassociateAddress",
 				"ec2:ModifyInstanceAttribute",
 				"ec2:ModifyNetworkInterfaceAttribute",
 				"ec2:ModifySubnetAttribute",
+				"ec2:ModifyVolume",
+				"ec2:ReplaceNetworkAclAssociation",
+				"ec2:ReplaceNetworkAclEntry",
 				"ec2:ReleaseAddress",
 				"ec2:RevokeSecurityGroupIngress",
+				"ec2:RevokeSecurityGroupEgress",
 				"ec2:RunInstances",
+				"ec2:StartInstances",
+				"ec2:StopInstances",
 				"ec2:TerminateInstances",
 				"ec2:GetSecurityGroupsForVpc",
 				"tag:GetResources",
@@ -177,11 +205,15 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"elasticloadbalancing:RegisterTargets",
 				"elasticloadbalancing:DeregisterTargets",
 				"elasticloadbalancing:DeleteListener",
+				"route53:ListHostedZonesByName",
 				"autoscaling:DescribeAutoScalingGroups",
 				"autoscaling:DescribeInstanceRefreshes",
 				"autoscaling:DeleteLifecycleHook",
 				"autoscaling:DescribeLifecycleHooks",
 				"autoscaling:PutLifecycleHook",
+				"autoscaling:DescribeWarmPool",
+				"autoscaling:DescribeScheduledActions",
+				"autoscaling:DescribePolicies",
 				"ec2:CreateLaunchTemplate",
 				"ec2:CreateLaunchTemplateVersion",
 				"ec2:DescribeLaunchTemplates",
@@ -205,6 +237,23 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"autoscaling:StartInstanceRefresh",
 				"autoscaling:DeleteAutoScalingGroup",
 				"autoscaling:DeleteTags",
+				"autoscaling:PutWarmPool",
+				"autoscaling:DeleteWarmPool",
+				"autoscaling:PutScheduledUpdateGroupAction",
+				"autoscaling:DeleteScheduledAction",
+				"autoscaling:PutScalingPolicy",
+				"autoscaling:DeletePolicy",
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"arn:*:route53:::hostedzone/*",
+			},
+			Action: iamv1.Actions{
+				"route53:ChangeResourceRecordSets",
+				"route53:GetHostedZone",
+				"route53:ListResourceRecordSets",
 			},
 		},
 		{
@@ -250,6 +299,30 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 				"iam:PassRole",
 			},
 		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "vpc-flow-logs.amazonaws.com",
+				},
+			},
+		},
+		{
+			Effect: iamv1.EffectAllow,
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Action: iamv1.Actions{
+				"logs:CreateLogDelivery",
+				"logs:DeleteLogDelivery",
+			},
+		},
 	}
 	for _, secureSecretBackend := range t.Spec.SecureSecretsBackends {
 		switch secureSecretBackend {
@@ -312,6 +385,7 @@ func (t Template) ControllersPolicy() *iamv1.PolicyDocument {
 			Effect:   iamv1.EffectAllow,
 			Resource: iamv1.Resources{iamv1.Any},
 			Action: iamv1.Actions{
+				"autoscaling:CompleteLifecycleAction",
 				"events:DeleteRule",
 				"events:DescribeRule",
 				"events:ListTargetsByRule",
@@ -454,6 +528,8 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:AssociateIdentityProviderConfig",
 				"eks:DescribeIdentityProviderConfig",
 				"eks:DisassociateIdentityProviderConfig",
+				"eks:ListInsights",
+				"eks:ListNodegroups",
 			},
 			Resource: iamv1.Resources{
 				"arn:*:eks:*:*:cluster/*",
@@ -469,12 +545,26 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 				"eks:CreateAddon",
 				"eks:DescribeAddonVersions",
 				"eks:DescribeAddon",
+				"eks:DescribeAddonConfiguration",
 				"eks:DeleteAddon",
 				"eks:UpdateAddon",
 				"eks:TagResource",
 				"eks:DescribeFargateProfile",
 				"eks:CreateFargateProfile",
 				"eks:DeleteFargateProfile",
+				"eks:CreateAccessEntry",
+				"eks:DescribeAccessEntry",
+				"eks:UpdateAccessEntry",
+				"eks:DeleteAccessEntry",
+				"eks:ListAccessEntries",
+				"eks:AssociateAccessPolicy",
+				"eks:DisassociateAccessPolicy",
+				"eks:ListAssociatedAccessPolicies",
+				"eks:CreatePodIdentityAssociation",
+				"eks:DescribePodIdentityAssociation",
+				"eks:UpdatePodIdentityAssociation",
+				"eks:DeletePodIdentityAssociation",
+				"eks:ListPodIdentityAssociations",
 			},
 			Resource: iamv1.Resources{
 				"*",
@@ -495,6 +585,20 @@ func (t Template) ControllersPolicyEKS() *iamv1.PolicyDocument {
 			},
 			Effect: iamv1.EffectAllow,
 		},
+		{
+			Action: iamv1.Actions{
+				"iam:PassRole",
+			},
+			Resource: iamv1.Resources{
+				"*",
+			},
+			Condition: iamv1.Conditions{
+				"StringEquals": map[string]string{
+					"iam:PassedToService": "pods.eks.amazonaws.com",
+				},
+			},
+			Effect: iamv1.EffectAllow,
+		},
 		{
 			Action: iamv1.Actions{
 				"kms:CreateGrant",
@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -44,6 +45,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/gc"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/network"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/s3"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/securitygroup"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
@@ -247,6 +249,10 @@ func (r *AWSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting S3 Bucket"))
 	}
 
+	if err := route53.NewService(clusterScope).DeleteControlPlaneDNSRecord(ctx); err != nil {
+		allErrs = append(allErrs, errors.Wrapf(err, "error deleting load balancer DNS record"))
+	}
+
 	if err := elbsvc.DeleteLoadbalancers(ctx); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "error deleting load balancers"))
 	}
@@ -301,10 +307,29 @@ func (r *AWSClusterReconciler) reconcileLoadBalancer(ctx context.Context, cluste
 		return &retryAfterDuration, nil
 	}
 
+	host := awsCluster.Status.Network.APIServerELB.DNSName
+	if clusterScope.ControlPlaneLoadBalancer().DNS != nil {
+		route53Service := route53.NewService(clusterScope)
+
+		if err := route53Service.ReconcileControlPlaneDNSRecord(ctx); err != nil {
+			clusterScope.Error(err, "failed to reconcile load balancer DNS record")
+			conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.LoadBalancerDNSRecordFailedReason, infrautilconditions.ErrorConditionAfterInit(clusterScope.ClusterObj()), "%s", err.Error())
+			return nil, err
+		}
+
+		if awsCluster.Status.Network.APIServerDNSRecord == nil {
+			conditions.MarkFalse(awsCluster, infrav1.LoadBalancerReadyCondition, infrav1.WaitForDNSRecordReason, clusterv1.ConditionSeverityInfo, "")
+			clusterScope.Info("Waiting on API server load balancer DNS record")
+			return &retryAfterDuration, nil
+		}
+
+		host = strings.TrimSuffix(awsCluster.Status.Network.APIServerDNSRecord.Name, ".")
+	}
+
 	conditions.MarkTrue(awsCluster, infrav1.LoadBalancerReadyCondition)
 
 	awsCluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{
-		Host: awsCluster.Status.Network.APIServerELB.DNSName,
+		Host: host,
 		Port: clusterScope.APIServerPort(),
 	}
 
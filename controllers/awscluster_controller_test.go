@@ -1579,6 +1579,22 @@ func mockedDeleteVPCCalls(m *mocks.MockEC2APIMockRecorder) {
 			},
 		},
 	}), gomock.Any()).Return(&ec2.DescribeVpcEndpointsOutput{}, nil).AnyTimes()
+	m.DescribeSecurityGroups(context.TODO(), gomock.Eq(&ec2.DescribeSecurityGroupsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-exists"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: []string{"vpc-endpoint"},
+			},
+		},
+	})).Return(&ec2.DescribeSecurityGroupsOutput{}, nil).AnyTimes()
 	m.DescribeSubnets(context.TODO(), gomock.Eq(&ec2.DescribeSubnetsInput{
 		Filters: []ec2types.Filter{
 			{
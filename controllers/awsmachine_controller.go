@@ -146,7 +146,7 @@ func (r *AWSMachineReconciler) getObjectStoreService(scope scope.S3Scope) servic
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=create;get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools/finalizers,verbs=update
-// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -295,7 +295,10 @@ func (r *AWSMachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 	return controller.Watch(
 		source.Kind[client.Object](mgr.GetCache(), &clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(requeueAWSMachinesForUnpausedCluster),
-			predicates.ClusterPausedTransitionsOrInfrastructureReady(mgr.GetScheme(), log.GetLogger())),
+			predicates.Any(mgr.GetScheme(), log.GetLogger(),
+				predicates.ClusterPausedTransitionsOrInfrastructureReady(mgr.GetScheme(), log.GetLogger()),
+				clusterPowerStateChanged(),
+			)),
 	)
 }
 
@@ -368,6 +371,12 @@ func (r *AWSMachineReconciler) reconcileDelete(ctx context.Context, machineScope
 	default:
 		machineScope.Info("Terminating EC2 instance", "instance-id", instance.ID)
 
+		if err := r.snapshotVolumes(ec2Service, machineScope, instance); err != nil {
+			machineScope.Error(err, "failed to snapshot volumes before termination")
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+			return ctrl.Result{}, err
+		}
+
 		// Set the InstanceReadyCondition and patch the object before the blocking operation
 		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 		if err := machineScope.PatchObject(); err != nil {
@@ -582,6 +591,16 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 		machineScope.Info("EC2 instance state changed", "state", instance.State, "instance-id", *machineScope.GetInstanceID())
 	}
 
+	if !machineScope.IsMachinePoolMachine() {
+		result, err := r.reconcilePowerState(ctx, ec2svc, machineScope, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if result != nil {
+			return *result, nil
+		}
+	}
+
 	shouldRequeue := false
 	switch instance.State {
 	case infrav1.InstanceStatePending:
@@ -644,6 +663,15 @@ func (r *AWSMachineReconciler) reconcileNormal(ctx context.Context, machineScope
 			r.ensureStorageTags(ec2svc, instance, machineScope.AWSMachine, machineScope.AdditionalTags())
 		}
 
+		if instance != nil && !machineScope.IsMachinePoolMachine() {
+			modifying, err := r.reconcileVolumes(ctx, ec2svc, machineScope, instance)
+			if err != nil {
+				machineScope.Error(err, "failed to reconcile volumes")
+				return ctrl.Result{}, err
+			}
+			shouldRequeue = shouldRequeue || modifying
+		}
+
 		if err := r.reconcileLBAttachment(ctx, machineScope, elbScope, instance); err != nil {
 			// We are tolerating InstanceNotRunning error, so we don't report it as an error condition.
 			// Because we are reconciling all load balancers, attempt to treat the error as a list of errors.
@@ -19,6 +19,7 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"testing"
@@ -55,6 +56,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	kubeadmv1beta1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const providerID = "aws:////myMachine"
@@ -3059,6 +3061,254 @@ func TestAWSMachineReconcilerReconcileDoesntAddSecurityGroupsToNonManagedNetwork
 	g.Expect(err).To(BeNil())
 }
 
+func TestAWSMachineReconcilerReconcileVolumes(t *testing.T) {
+	rootVolume := &infrav1.Volume{Size: 40}
+	applied := func(v *infrav1.Volume) string {
+		b, _ := json.Marshal(appliedVolumes{RootVolume: v})
+		return string(b)
+	}
+	startTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name              string
+		annotations       map[string]string
+		status            []infrav1.VolumeModification
+		expect            func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantModifying     bool
+		wantErr           bool
+		wantModifications []infrav1.VolumeModification
+		wantCondition     *clusterv1.Condition
+	}{
+		{
+			name:          "volumes of new instances are recorded as applied",
+			expect:        func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantCondition: nil,
+		},
+		{
+			name:          "volumes are not looked at again when the spec did not change",
+			annotations:   map[string]string{VolumesLastAppliedAnnotation: applied(rootVolume)},
+			expect:        func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+			wantCondition: nil,
+		},
+		{
+			name:        "volumes are modified when the spec changed",
+			annotations: map[string]string{VolumesLastAppliedAnnotation: applied(&infrav1.Volume{Size: 20})},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.ModifyVolumes("i-1", rootVolume, gomock.Nil()).Return([]infrav1.VolumeModification{
+					{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateModifying, Progress: 10, OriginalSize: 20, TargetSize: 40, StartTime: &startTime},
+				}, nil)
+			},
+			wantModifying: true,
+			wantModifications: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateModifying, Progress: 10, OriginalSize: 20, TargetSize: 40, StartTime: &startTime},
+			},
+			wantCondition: conditions.FalseCondition(infrav1.VolumesReadyCondition, infrav1.VolumeModificationInProgressReason, clusterv1.ConditionSeverityInfo, "volume /dev/xvda is modifying (10%%)"),
+		},
+		{
+			name:        "pending modifications are followed up until they complete",
+			annotations: map[string]string{VolumesLastAppliedAnnotation: applied(rootVolume)},
+			status: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateOptimizing, OriginalSize: 20, TargetSize: 40, StartTime: &startTime, FilesystemResizeRequested: true},
+			},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.ModifyVolumes("i-1", rootVolume, gomock.Nil()).Return([]infrav1.VolumeModification{
+					{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateCompleted, Progress: 100, OriginalSize: 20, TargetSize: 40, StartTime: &startTime},
+				}, nil)
+			},
+			wantModifications: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateCompleted, Progress: 100, OriginalSize: 20, TargetSize: 40, StartTime: &startTime, FilesystemResizeRequested: true},
+			},
+			wantCondition: conditions.TrueCondition(infrav1.VolumesReadyCondition),
+		},
+		{
+			name:        "failed modifications are reported",
+			annotations: map[string]string{VolumesLastAppliedAnnotation: applied(&infrav1.Volume{Size: 20})},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.ModifyVolumes("i-1", rootVolume, gomock.Nil()).Return([]infrav1.VolumeModification{
+					{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateFailed, Message: "not supported"},
+				}, nil)
+			},
+			wantModifications: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateFailed, Message: "not supported"},
+			},
+			wantCondition: conditions.FalseCondition(infrav1.VolumesReadyCondition, infrav1.VolumeModificationFailedReason, clusterv1.ConditionSeverityWarning, "failed to modify volume /dev/xvda: not supported"),
+		},
+		{
+			name:        "errors modifying volumes are returned",
+			annotations: map[string]string{VolumesLastAppliedAnnotation: applied(&infrav1.Volume{Size: 20})},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.ModifyVolumes("i-1", rootVolume, gomock.Nil()).Return(nil, errors.New("rate exceeded"))
+			},
+			wantErr:       true,
+			wantCondition: conditions.FalseCondition(infrav1.VolumesReadyCondition, infrav1.VolumeModificationFailedReason, clusterv1.ConditionSeverityWarning, "rate exceeded"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tc.expect(ec2Svc.EXPECT())
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Annotations: tc.annotations,
+				},
+				Spec: infrav1.AWSMachineSpec{
+					RootVolume: rootVolume,
+				},
+				Status: infrav1.AWSMachineStatus{
+					VolumeModifications: tc.status,
+				},
+			}
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       fake.NewClientBuilder().WithObjects(awsMachine).Build(),
+				Cluster:      &clusterv1.Cluster{},
+				Machine:      &clusterv1.Machine{},
+				InfraCluster: &scope.ClusterScope{AWSCluster: &infrav1.AWSCluster{}},
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSMachineReconciler{
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			modifying, err := reconciler.reconcileVolumes(context.TODO(), ec2Svc, ms, &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateRunning})
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(modifying).To(Equal(tc.wantModifying))
+			g.Expect(ms.AWSMachine.Annotations).To(HaveKey(VolumesLastAppliedAnnotation))
+			if !tc.wantErr {
+				g.Expect(ms.AWSMachine.Annotations[VolumesLastAppliedAnnotation]).To(Equal(applied(rootVolume)))
+			}
+			g.Expect(ms.AWSMachine.Status.VolumeModifications).To(Equal(tc.wantModifications))
+
+			condition := conditions.Get(ms.AWSMachine, infrav1.VolumesReadyCondition)
+			if tc.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.wantCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tc.wantCondition.Reason))
+			g.Expect(condition.Message).To(Equal(tc.wantCondition.Message))
+		})
+	}
+}
+
+func TestAWSMachineReconcilerSnapshotVolumes(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := infrav1.VolumeSnapshot{DeviceName: "/dev/sdb", VolumeID: "vol-data", SnapshotID: "snap-1"}
+
+	testCases := []struct {
+		name          string
+		policy        *infrav1.PreTerminationSnapshots
+		status        []infrav1.VolumeSnapshot
+		expect        func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantErr       bool
+		wantSnapshots []infrav1.VolumeSnapshot
+	}{
+		{
+			name:   "volumes are not snapshotted without a policy",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {},
+		},
+		{
+			name:   "selected volumes are snapshotted",
+			policy: &infrav1.PreTerminationSnapshots{DeviceNames: []string{"/dev/sdb"}},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.SnapshotVolumes("i-1", []string{"/dev/sdb"}, gomock.Any()).Return([]infrav1.VolumeSnapshot{snapshot}, nil)
+			},
+			wantSnapshots: []infrav1.VolumeSnapshot{snapshot},
+		},
+		{
+			name:   "volumes snapshotted already are not snapshotted again",
+			policy: &infrav1.PreTerminationSnapshots{DeviceNames: []string{"/dev/sdb", "/dev/sdc"}},
+			status: []infrav1.VolumeSnapshot{snapshot},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.SnapshotVolumes("i-1", []string{"/dev/sdc"}, gomock.Any()).Return(nil, errors.New("snapshot limit exceeded"))
+			},
+			wantErr:       true,
+			wantSnapshots: []infrav1.VolumeSnapshot{snapshot},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			tc.expect(ec2Svc.EXPECT())
+
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: infrav1.AWSMachineSpec{
+					PreTerminationSnapshots: tc.policy,
+				},
+				Status: infrav1.AWSMachineStatus{
+					PreTerminationSnapshots: tc.status,
+				},
+			}
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       fake.NewClientBuilder().WithObjects(awsMachine).Build(),
+				Cluster:      &clusterv1.Cluster{},
+				Machine:      &clusterv1.Machine{},
+				InfraCluster: &scope.ClusterScope{Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}}, AWSCluster: &infrav1.AWSCluster{}},
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			reconciler := AWSMachineReconciler{
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			err = reconciler.snapshotVolumes(ec2Svc, ms, &infrav1.Instance{ID: "i-1", State: infrav1.InstanceStateRunning})
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(ms.AWSMachine.Status.PreTerminationSnapshots).To(Equal(tc.wantSnapshots))
+		})
+	}
+
+	t.Run("snapshots are tagged with the cluster, machine and retention", func(t *testing.T) {
+		g := NewWithT(t)
+		awsMachine := &infrav1.AWSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSMachineSpec{
+				AdditionalTags: infrav1.Tags{"backup": "etcd"},
+				PreTerminationSnapshots: &infrav1.PreTerminationSnapshots{
+					DeviceNames:   []string{"/dev/sdb"},
+					RetentionDays: ptr.To[int32](30),
+				},
+			},
+		}
+		ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+			Client:       fake.NewClientBuilder().WithObjects(awsMachine).Build(),
+			Cluster:      &clusterv1.Cluster{},
+			Machine:      &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}},
+			InfraCluster: &scope.ClusterScope{Cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}}, AWSCluster: &infrav1.AWSCluster{}},
+			AWSMachine:   awsMachine,
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		g.Expect(snapshotTags(ms, now)).To(Equal(infrav1.Tags{
+			"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "shared",
+			"sigs.k8s.io/cluster-api-provider-aws/role":                 "node",
+			"sigs.k8s.io/cluster-api-provider-aws/retain-until":         "2025-01-31",
+			"Name":        "test",
+			"MachineName": "default/machine",
+			"backup":      "etcd",
+		}))
+	})
+}
+
 func createObject(g *WithT, obj client.Object, namespace string) {
 	if obj.DeepCopyObject() != nil {
 		obj.SetNamespace(namespace)
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// skipRemediationPowerStateValue is the value of the skip remediation annotation set on Machines while
+// their instance is parked, so that annotations set by users are left alone when the instance is started.
+const skipRemediationPowerStateValue = "aws-machine-power-state"
+
+// reconcilePowerState stops or starts the instance to bring it into the desired power state. It returns a
+// non-nil result if the instance is parked or transitioning between power states, in which case the rest
+// of the reconciliation is skipped.
+func (r *AWSMachineReconciler) reconcilePowerState(ctx context.Context, ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (*ctrl.Result, error) {
+	desired := machineScope.DesiredPowerState()
+	parked := isParked(desired)
+
+	if !parked && !isParked(machineScope.GetPowerState()) {
+		return nil, nil
+	}
+
+	if parked {
+		switch instance.State {
+		case infrav1.InstanceStatePending:
+			// Instances can only be stopped once they are running.
+			machineScope.SetNotReady()
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceNotReadyReason, clusterv1.ConditionSeverityInfo, "waiting for instance to be running before stopping it")
+			return &ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+		case infrav1.InstanceStateRunning, infrav1.InstanceStateStopping, infrav1.InstanceStateStopped:
+		default:
+			return nil, nil
+		}
+
+		if err := r.setSkipRemediation(ctx, machineScope.Machine, true); err != nil {
+			return nil, err
+		}
+
+		if instance.State == infrav1.InstanceStateRunning {
+			if err := ec2svc.StopInstance(instance.ID, desired == infrav1.PowerStateHibernated); err != nil {
+				r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStop", "Failed to stop instance %q: %v", instance.ID, err)
+				return nil, err
+			}
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStop", "Stopped instance %q for power state %s", instance.ID, desired)
+		}
+
+		machineScope.SetPowerState(desired)
+		machineScope.SetNotReady()
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceParkedReason, clusterv1.ConditionSeverityInfo, "instance is %s", strings.ToLower(string(desired)))
+
+		if instance.State == infrav1.InstanceStateStopped {
+			return &ctrl.Result{}, nil
+		}
+		return &ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	}
+
+	// The instance was parked and is requested to run again.
+	switch instance.State {
+	case infrav1.InstanceStateStopping:
+		machineScope.SetNotReady()
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStartingReason, clusterv1.ConditionSeverityInfo, "waiting for instance to be stopped before starting it")
+		return &ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	case infrav1.InstanceStateStopped:
+		if err := ec2svc.StartInstance(instance.ID); err != nil {
+			r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedStart", "Failed to start instance %q: %v", instance.ID, err)
+			return nil, err
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulStart", "Started instance %q", instance.ID)
+		machineScope.SetNotReady()
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceStartingReason, clusterv1.ConditionSeverityInfo, "")
+		return &ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	case infrav1.InstanceStateRunning:
+		// The node conditions still report the node as unhealthy since the instance was stopped, so the
+		// Machine is excluded from remediation until the node is healthy again.
+		if machineScope.Machine.Status.NodeRef != nil && !conditions.IsTrue(machineScope.Machine, clusterv1.MachineNodeHealthyCondition) {
+			machineScope.Info("Waiting for node to become healthy after starting the instance", "node", machineScope.Machine.Status.NodeRef.Name)
+			return nil, nil
+		}
+		if err := r.setSkipRemediation(ctx, machineScope.Machine, false); err != nil {
+			return nil, err
+		}
+		machineScope.SetPowerState(infrav1.PowerStateRunning)
+	}
+
+	return nil, nil
+}
+
+// setSkipRemediation excludes the Machine from remediation by MachineHealthChecks, or includes it again.
+func (r *AWSMachineReconciler) setSkipRemediation(ctx context.Context, machine *clusterv1.Machine, skip bool) error {
+	value, ok := machine.Annotations[clusterv1.MachineSkipRemediationAnnotation]
+	if ok == skip || (ok && value != skipRemediationPowerStateValue) {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return err
+	}
+
+	if skip {
+		if machine.Annotations == nil {
+			machine.Annotations = map[string]string{}
+		}
+		machine.Annotations[clusterv1.MachineSkipRemediationAnnotation] = skipRemediationPowerStateValue
+	} else {
+		delete(machine.Annotations, clusterv1.MachineSkipRemediationAnnotation)
+	}
+
+	return patchHelper.Patch(ctx, machine)
+}
+
+// clusterPowerStateChanged returns a predicate that returns true when the power state annotation of a Cluster changed.
+func clusterPowerStateChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetAnnotations()[infrav1.PowerStateAnnotation] != e.ObjectNew.GetAnnotations()[infrav1.PowerStateAnnotation]
+		},
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+func isParked(powerState infrav1.PowerState) bool {
+	return powerState == infrav1.PowerStateStopped || powerState == infrav1.PowerStateHibernated
+}
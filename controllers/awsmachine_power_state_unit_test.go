@@ -0,0 +1,260 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcilePowerState(t *testing.T) {
+	testCases := []struct {
+		name                string
+		desiredPowerState   infrav1.PowerState
+		currentPowerState   infrav1.PowerState
+		instanceState       infrav1.InstanceState
+		skipRemediation     *string
+		nodeHealthy         *bool
+		expect              func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantResult          bool
+		wantRequeue         bool
+		wantPowerState      infrav1.PowerState
+		wantSkipRemediation *string
+	}{
+		{
+			name:              "running instance isn't changed",
+			desiredPowerState: infrav1.PowerStateRunning,
+			instanceState:     infrav1.InstanceStateRunning,
+		},
+		{
+			name:              "running instance is stopped",
+			desiredPowerState: infrav1.PowerStateStopped,
+			instanceState:     infrav1.InstanceStateRunning,
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StopInstance("i-1", false).Return(nil)
+			},
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:              "running instance is hibernated",
+			desiredPowerState: infrav1.PowerStateHibernated,
+			instanceState:     infrav1.InstanceStateRunning,
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StopInstance("i-1", true).Return(nil)
+			},
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateHibernated,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:              "pending instance is stopped once it's running",
+			desiredPowerState: infrav1.PowerStateStopped,
+			instanceState:     infrav1.InstanceStatePending,
+			wantResult:        true,
+			wantRequeue:       true,
+		},
+		{
+			name:                "stopping instance is waited for",
+			desiredPowerState:   infrav1.PowerStateStopped,
+			currentPowerState:   infrav1.PowerStateStopped,
+			instanceState:       infrav1.InstanceStateStopping,
+			skipRemediation:     ptr.To(skipRemediationPowerStateValue),
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:                "stopped instance stays parked",
+			desiredPowerState:   infrav1.PowerStateStopped,
+			currentPowerState:   infrav1.PowerStateStopped,
+			instanceState:       infrav1.InstanceStateStopped,
+			skipRemediation:     ptr.To(skipRemediationPowerStateValue),
+			wantResult:          true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:              "skip remediation annotation set by the user is left untouched when stopping",
+			desiredPowerState: infrav1.PowerStateStopped,
+			instanceState:     infrav1.InstanceStateRunning,
+			skipRemediation:   ptr.To(""),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StopInstance("i-1", false).Return(nil)
+			},
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(""),
+		},
+		{
+			name:                "stopping instance is waited for before starting it",
+			desiredPowerState:   infrav1.PowerStateRunning,
+			currentPowerState:   infrav1.PowerStateStopped,
+			instanceState:       infrav1.InstanceStateStopping,
+			skipRemediation:     ptr.To(skipRemediationPowerStateValue),
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:              "stopped instance is started",
+			desiredPowerState: infrav1.PowerStateRunning,
+			currentPowerState: infrav1.PowerStateStopped,
+			instanceState:     infrav1.InstanceStateStopped,
+			skipRemediation:   ptr.To(skipRemediationPowerStateValue),
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.StartInstance("i-1").Return(nil)
+			},
+			wantResult:          true,
+			wantRequeue:         true,
+			wantPowerState:      infrav1.PowerStateStopped,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:                "started instance keeps the skip remediation annotation until the node is healthy",
+			desiredPowerState:   infrav1.PowerStateRunning,
+			currentPowerState:   infrav1.PowerStateHibernated,
+			instanceState:       infrav1.InstanceStateRunning,
+			skipRemediation:     ptr.To(skipRemediationPowerStateValue),
+			nodeHealthy:         ptr.To(false),
+			wantPowerState:      infrav1.PowerStateHibernated,
+			wantSkipRemediation: ptr.To(skipRemediationPowerStateValue),
+		},
+		{
+			name:              "started instance with a healthy node removes the skip remediation annotation",
+			desiredPowerState: infrav1.PowerStateRunning,
+			currentPowerState: infrav1.PowerStateStopped,
+			instanceState:     infrav1.InstanceStateRunning,
+			skipRemediation:   ptr.To(skipRemediationPowerStateValue),
+			nodeHealthy:       ptr.To(true),
+			wantPowerState:    infrav1.PowerStateRunning,
+		},
+		{
+			name:                "skip remediation annotation set by the user is left untouched when starting",
+			desiredPowerState:   infrav1.PowerStateRunning,
+			currentPowerState:   infrav1.PowerStateStopped,
+			instanceState:       infrav1.InstanceStateRunning,
+			skipRemediation:     ptr.To(""),
+			nodeHealthy:         ptr.To(true),
+			wantPowerState:      infrav1.PowerStateRunning,
+			wantSkipRemediation: ptr.To(""),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Svc.EXPECT())
+			}
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: clusterv1.MachineSpec{ClusterName: "test"},
+			}
+			if tc.skipRemediation != nil {
+				machine.Annotations = map[string]string{clusterv1.MachineSkipRemediationAnnotation: *tc.skipRemediation}
+			}
+			if tc.nodeHealthy != nil {
+				machine.Status.NodeRef = &corev1.ObjectReference{Name: "node-1"}
+				if *tc.nodeHealthy {
+					conditions.MarkTrue(machine, clusterv1.MachineNodeHealthyCondition)
+				} else {
+					conditions.MarkFalse(machine, clusterv1.MachineNodeHealthyCondition, clusterv1.NodeConditionsFailedReason, clusterv1.ConditionSeverityWarning, "")
+				}
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSMachineSpec{
+					InstanceID: ptr.To("i-1"),
+					PowerState: tc.desiredPowerState,
+				},
+				Status: infrav1.AWSMachineStatus{
+					PowerState: tc.currentPowerState,
+				},
+			}
+
+			fakeClient := fake.NewClientBuilder().WithObjects(machine, awsMachine).WithStatusSubresource(machine, awsMachine).Build()
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     fakeClient,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			ms, err := scope.NewMachineScope(scope.MachineScopeParams{
+				Client:       fakeClient,
+				Cluster:      &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+				Machine:      machine,
+				InfraCluster: cs,
+				AWSMachine:   awsMachine,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			r := &AWSMachineReconciler{
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			result, err := r.reconcilePowerState(context.TODO(), ec2Svc, ms, &infrav1.Instance{ID: "i-1", State: tc.instanceState})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			if !tc.wantResult {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).NotTo(BeNil())
+				g.Expect(result.RequeueAfter > 0).To(Equal(tc.wantRequeue))
+			}
+			g.Expect(ms.GetPowerState()).To(Equal(tc.wantPowerState))
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			if tc.wantSkipRemediation == nil {
+				g.Expect(gotMachine.Annotations).NotTo(HaveKey(clusterv1.MachineSkipRemediationAnnotation))
+			} else {
+				g.Expect(gotMachine.Annotations).To(HaveKeyWithValue(clusterv1.MachineSkipRemediationAnnotation, *tc.wantSkipRemediation))
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+)
+
+// snapshotVolumes snapshots the volumes of the instance selected by the pre-termination snapshot policy of the
+// AWSMachine, and records the snapshots in its status. Volumes that were snapshotted already aren't snapshotted again
+// when the deletion is retried.
+func (r *AWSMachineReconciler) snapshotVolumes(ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) error {
+	policy := machineScope.AWSMachine.Spec.PreTerminationSnapshots
+	if policy == nil {
+		return nil
+	}
+
+	deviceNames := []string{}
+	for _, deviceName := range policy.DeviceNames {
+		if !slices.ContainsFunc(machineScope.AWSMachine.Status.PreTerminationSnapshots, func(s infrav1.VolumeSnapshot) bool {
+			return s.DeviceName == deviceName
+		}) {
+			deviceNames = append(deviceNames, deviceName)
+		}
+	}
+	if len(deviceNames) == 0 {
+		return nil
+	}
+
+	snapshots, err := ec2svc.SnapshotVolumes(instance.ID, deviceNames, snapshotTags(machineScope, time.Now()))
+	machineScope.AWSMachine.Status.PreTerminationSnapshots = append(machineScope.AWSMachine.Status.PreTerminationSnapshots, snapshots...)
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedSnapshot", "Failed to snapshot volumes of instance %q: %v", instance.ID, err)
+		return err
+	}
+
+	if len(snapshots) > 0 {
+		snapshotIDs := make([]string, 0, len(snapshots))
+		for _, s := range snapshots {
+			snapshotIDs = append(snapshotIDs, s.SnapshotID)
+		}
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulSnapshot", "Created snapshots %s of instance %q", strings.Join(snapshotIDs, ", "), instance.ID)
+	}
+
+	return nil
+}
+
+// snapshotTags returns the tags of the pre-termination snapshots of an AWSMachine. Snapshots are tagged as shared,
+// as they are meant to outlive the machine and possibly the cluster.
+func snapshotTags(machineScope *scope.MachineScope, now time.Time) infrav1.Tags {
+	tags := infrav1.Build(infrav1.BuildParams{
+		ClusterName: machineScope.InfraCluster.KubernetesClusterName(),
+		Lifecycle:   infrav1.ResourceLifecycleShared,
+		Name:        aws.String(machineScope.Name()),
+		Role:        aws.String(machineScope.Role()),
+		Additional:  machineScope.AdditionalTags(),
+	}.WithMachineName(machineScope.Machine))
+
+	if days := machineScope.AWSMachine.Spec.PreTerminationSnapshots.RetentionDays; days != nil {
+		tags[infrav1.SnapshotRetainUntilTagKey] = now.UTC().AddDate(0, 0, int(*days)).Format(time.DateOnly)
+	}
+
+	return tags
+}
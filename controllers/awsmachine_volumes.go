@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+const (
+	// VolumesLastAppliedAnnotation is the key for the machine object annotation
+	// which tracks the RootVolume and NonRootVolumes last applied to the EBS volumes of the instance.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	VolumesLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-aws-last-applied-volumes"
+)
+
+// appliedVolumes is the content of the VolumesLastAppliedAnnotation.
+type appliedVolumes struct {
+	RootVolume     *infrav1.Volume  `json:"rootVolume,omitempty"`
+	NonRootVolumes []infrav1.Volume `json:"nonRootVolumes,omitempty"`
+}
+
+// reconcileVolumes modifies the EBS volumes of a running instance to match the volumes of the AWSMachine spec,
+// and requests the filesystems of grown volumes to be grown on the node. It returns whether a volume is still
+// being modified.
+func (r *AWSMachineReconciler) reconcileVolumes(ctx context.Context, ec2svc services.EC2Interface, machineScope *scope.MachineScope, instance *infrav1.Instance) (bool, error) {
+	if instance.State != infrav1.InstanceStateRunning {
+		return false, nil
+	}
+
+	b, err := json.Marshal(appliedVolumes{
+		RootVolume:     machineScope.AWSMachine.Spec.RootVolume,
+		NonRootVolumes: machineScope.AWSMachine.Spec.NonRootVolumes,
+	})
+	if err != nil {
+		return false, err
+	}
+	desired := string(b)
+
+	// Instances are launched with the volumes of the spec, so volumes only need to be looked at again after
+	// the spec changed, or while earlier modifications are still in progress.
+	lastApplied := r.machineAnnotation(machineScope.AWSMachine, VolumesLastAppliedAnnotation)
+	if lastApplied == "" || (lastApplied == desired && !hasPendingVolumeModifications(machineScope.AWSMachine.Status.VolumeModifications)) {
+		r.updateMachineAnnotation(machineScope.AWSMachine, VolumesLastAppliedAnnotation, desired)
+		return false, nil
+	}
+
+	modifications, err := ec2svc.ModifyVolumes(instance.ID, machineScope.AWSMachine.Spec.RootVolume, machineScope.AWSMachine.Spec.NonRootVolumes)
+	if err != nil {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeWarning, "FailedModifyVolumes", "Failed to modify volumes of instance %q: %v", instance.ID, err)
+		conditions.MarkFalse(machineScope.AWSMachine, infrav1.VolumesReadyCondition, infrav1.VolumeModificationFailedReason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return false, err
+	}
+
+	modifications = mergeVolumeModifications(machineScope.AWSMachine.Status.VolumeModifications, modifications, func(m infrav1.VolumeModification) {
+		r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "SuccessfulModifyVolume", "Modifying volume %q of instance %q", m.VolumeID, instance.ID)
+	})
+	machineScope.AWSMachine.Status.VolumeModifications = modifications
+	r.updateMachineAnnotation(machineScope.AWSMachine, VolumesLastAppliedAnnotation, desired)
+
+	if err := r.requestFilesystemResize(ctx, machineScope); err != nil {
+		// The volumes are modified already, the request is retried on the next reconciliation.
+		machineScope.Error(err, "failed to request filesystem resize")
+	}
+
+	modifying := false
+	conditions.MarkTrue(machineScope.AWSMachine, infrav1.VolumesReadyCondition)
+	for _, m := range modifications {
+		switch m.State {
+		case infrav1.VolumeModificationStateModifying, infrav1.VolumeModificationStateOptimizing:
+			modifying = modifying || m.State == infrav1.VolumeModificationStateModifying
+			if !conditions.IsFalse(machineScope.AWSMachine, infrav1.VolumesReadyCondition) {
+				conditions.MarkFalse(machineScope.AWSMachine, infrav1.VolumesReadyCondition, infrav1.VolumeModificationInProgressReason, clusterv1.ConditionSeverityInfo, "volume %s is %s (%d%%)", m.DeviceName, m.State, m.Progress)
+			}
+		case infrav1.VolumeModificationStateFailed:
+			conditions.MarkFalse(machineScope.AWSMachine, infrav1.VolumesReadyCondition, infrav1.VolumeModificationFailedReason, clusterv1.ConditionSeverityWarning, "failed to modify volume %s: %s", m.DeviceName, m.Message)
+		}
+	}
+
+	// Optimizing can take hours after the new size is usable, so only the modifying state is polled for.
+	return modifying, nil
+}
+
+// mergeVolumeModifications carries over the filesystem resize requests of known modifications into the latest
+// modifications, and calls started for the modifications that are new.
+func mergeVolumeModifications(previous, latest []infrav1.VolumeModification, started func(infrav1.VolumeModification)) []infrav1.VolumeModification {
+	for i := range latest {
+		idx := slices.IndexFunc(previous, func(m infrav1.VolumeModification) bool {
+			return m.VolumeID == latest[i].VolumeID && m.StartTime.Equal(latest[i].StartTime)
+		})
+		if idx < 0 {
+			if latest[i].State == infrav1.VolumeModificationStateModifying {
+				started(latest[i])
+			}
+			continue
+		}
+		latest[i].FilesystemResizeRequested = previous[idx].FilesystemResizeRequested
+	}
+
+	return latest
+}
+
+// hasPendingVolumeModifications returns whether a modification is in progress or waits for its filesystem to be grown.
+func hasPendingVolumeModifications(modifications []infrav1.VolumeModification) bool {
+	for _, m := range modifications {
+		if m.State == infrav1.VolumeModificationStateModifying || m.State == infrav1.VolumeModificationStateOptimizing {
+			return true
+		}
+	}
+
+	return len(volumesToResize(modifications)) > 0
+}
+
+// volumesToResize returns the device names of the volumes that were grown and whose filesystems haven't been
+// requested to grow yet. The filesystems can be grown as soon as the modification is optimizing.
+func volumesToResize(modifications []infrav1.VolumeModification) []string {
+	var deviceNames []string
+	for _, m := range modifications {
+		if m.FilesystemResizeRequested || m.TargetSize <= m.OriginalSize {
+			continue
+		}
+		if m.State == infrav1.VolumeModificationStateOptimizing || m.State == infrav1.VolumeModificationStateCompleted {
+			deviceNames = append(deviceNames, m.DeviceName)
+		}
+	}
+
+	return deviceNames
+}
+
+// requestFilesystemResize annotates the node of the Machine with the device names of the grown volumes,
+// for whatever grows filesystems in the guest to pick up.
+func (r *AWSMachineReconciler) requestFilesystemResize(ctx context.Context, machineScope *scope.MachineScope) error {
+	deviceNames := volumesToResize(machineScope.AWSMachine.Status.VolumeModifications)
+	if len(deviceNames) == 0 || machineScope.Machine.Status.NodeRef == nil {
+		return nil
+	}
+
+	workloadClient, err := remote.NewClusterClient(ctx, "awsmachine", r.Client, util.ObjectKey(machineScope.Cluster))
+	if err != nil {
+		return errors.Wrap(err, "failed to create workload cluster client")
+	}
+
+	node := &corev1.Node{}
+	if err := workloadClient.Get(ctx, client.ObjectKey{Name: machineScope.Machine.Status.NodeRef.Name}, node); err != nil {
+		return errors.Wrapf(err, "failed to get node %s", machineScope.Machine.Status.NodeRef.Name)
+	}
+
+	patchHelper, err := patch.NewHelper(node, workloadClient)
+	if err != nil {
+		return err
+	}
+
+	// Keep devices of earlier requests that weren't handled yet.
+	requested := deviceNames
+	if value := node.Annotations[infrav1.VolumesResizedAnnotation]; value != "" {
+		requested = append(strings.Split(value, ","), deviceNames...)
+	}
+	slices.Sort(requested)
+	requested = slices.Compact(requested)
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[infrav1.VolumesResizedAnnotation] = strings.Join(requested, ",")
+	if err := patchHelper.Patch(ctx, node); err != nil {
+		return errors.Wrapf(err, "failed to annotate node %s", node.Name)
+	}
+
+	for i := range machineScope.AWSMachine.Status.VolumeModifications {
+		if slices.Contains(deviceNames, machineScope.AWSMachine.Status.VolumeModifications[i].DeviceName) {
+			machineScope.AWSMachine.Status.VolumeModifications[i].FilesystemResizeRequested = true
+		}
+	}
+	r.Recorder.Eventf(machineScope.AWSMachine, corev1.EventTypeNormal, "RequestedFilesystemResize", "Requested node %s to grow the filesystems of %s", node.Name, strings.Join(deviceNames, ", "))
+
+	return nil
+}
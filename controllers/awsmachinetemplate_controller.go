@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/ec2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+)
+
+const (
+	// nvidiaGPUResourceName is the extended resource name advertised by the NVIDIA device plugin.
+	nvidiaGPUResourceName corev1.ResourceName = "nvidia.com/gpu"
+
+	nvidiaGPUManufacturer = "NVIDIA"
+)
+
+// AWSMachineTemplateReconciler reconciles AWSMachineTemplate objects.
+//
+// It fills in the status of the template with the capacity and node information of the
+// configured instance type, so that the cluster-autoscaler can scale node groups from zero.
+type AWSMachineTemplateReconciler struct {
+	client.Client
+	WatchFilterValue             string
+	TagUnmanagedNetworkResources bool
+	ec2ServiceFactory            func(scope.EC2Scope) services.EC2Interface
+}
+
+func (r *AWSMachineTemplateReconciler) getEC2Service(scope scope.EC2Scope) services.EC2Interface {
+	if r.ec2ServiceFactory != nil {
+		return r.ec2ServiceFactory(scope)
+	}
+
+	return ec2.NewService(scope)
+}
+
+// SetupWithManager is used to setup the controller.
+func (r *AWSMachineTemplateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := logger.FromContext(ctx)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.AWSMachineTemplate{}).
+		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), log.GetLogger(), r.WatchFilterValue)).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=controlplane.cluster.x-k8s.io,resources=awsmanagedcontrolplanes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+
+func (r *AWSMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := logger.FromContext(ctx)
+
+	awsMachineTemplate := &infrav1.AWSMachineTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, awsMachineTemplate); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !awsMachineTemplate.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	cluster, err := r.getCluster(ctx, awsMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if cluster == nil {
+		log.Info("AWSMachineTemplate is not associated with a Cluster yet")
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("cluster", klog.KObj(cluster))
+
+	// Unpausing the Cluster doesn't trigger a reconcile of its templates, so the template is requeued.
+	if annotations.IsPaused(cluster, awsMachineTemplate) {
+		log.Info("Reconciliation is paused for this object")
+		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	}
+
+	infraCluster, err := r.getInfraCluster(ctx, log, cluster, awsMachineTemplate)
+	if err != nil {
+		return ctrl.Result{}, errors.Errorf("error getting infra provider cluster or control plane object: %v", err)
+	}
+	if infraCluster == nil {
+		log.Info("AWSCluster or AWSManagedControlPlane is not ready yet")
+		return ctrl.Result{RequeueAfter: DefaultReconcilerRequeue}, nil
+	}
+
+	return ctrl.Result{}, r.reconcileNormal(ctx, awsMachineTemplate, r.getEC2Service(infraCluster))
+}
+
+func (r *AWSMachineTemplateReconciler) reconcileNormal(ctx context.Context, awsMachineTemplate *infrav1.AWSMachineTemplate, ec2svc services.EC2Interface) error {
+	instanceType := awsMachineTemplate.Spec.Template.Spec.InstanceType
+	if instanceType == "" {
+		return nil
+	}
+
+	info, err := ec2svc.GetInstanceTypeInfo(instanceType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get information for instance type %q", instanceType)
+	}
+
+	capacity := instanceTypeCapacity(info, awsMachineTemplate.Spec.Template.Spec.RootVolume)
+	nodeInfo := &infrav1.NodeInfo{
+		Architecture:    instanceTypeArchitecture(info),
+		OperatingSystem: infrav1.OperatingSystemLinux,
+	}
+
+	if apiequality.Semantic.DeepEqual(awsMachineTemplate.Status.Capacity, capacity) &&
+		apiequality.Semantic.DeepEqual(awsMachineTemplate.Status.NodeInfo, nodeInfo) {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(awsMachineTemplate, r.Client)
+	if err != nil {
+		return errors.Wrap(err, "failed to init patch helper")
+	}
+
+	awsMachineTemplate.Status.Capacity = capacity
+	awsMachineTemplate.Status.NodeInfo = nodeInfo
+
+	if err := patchHelper.Patch(ctx, awsMachineTemplate); err != nil {
+		return errors.Wrap(err, "failed to patch AWSMachineTemplate")
+	}
+
+	logger.FromContext(ctx).Info("Updated AWSMachineTemplate capacity", "instanceType", instanceType)
+
+	return nil
+}
+
+// getCluster returns the Cluster the template belongs to, looking at the cluster name label
+// first and falling back to the owner references.
+func (r *AWSMachineTemplateReconciler) getCluster(ctx context.Context, awsMachineTemplate *infrav1.AWSMachineTemplate) (*clusterv1.Cluster, error) {
+	if _, ok := awsMachineTemplate.Labels[clusterv1.ClusterNameLabel]; ok {
+		cluster, err := util.GetClusterFromMetadata(ctx, r.Client, awsMachineTemplate.ObjectMeta)
+		if err != nil {
+			if apierrors.IsNotFound(errors.Cause(err)) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return cluster, nil
+	}
+
+	return util.GetOwnerCluster(ctx, r.Client, awsMachineTemplate.ObjectMeta)
+}
+
+func (r *AWSMachineTemplateReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsMachineTemplate *infrav1.AWSMachineTemplate) (scope.EC2Scope, error) {
+	if cluster.Spec.ControlPlaneRef != nil && cluster.Spec.ControlPlaneRef.Kind == AWSManagedControlPlaneRefKind {
+		controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{}
+		controlPlaneName := client.ObjectKey{
+			Namespace: awsMachineTemplate.Namespace,
+			Name:      cluster.Spec.ControlPlaneRef.Name,
+		}
+
+		if err := r.Get(ctx, controlPlaneName, controlPlane); err != nil {
+			if apierrors.IsNotFound(err) {
+				// AWSManagedControlPlane is not ready
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		return scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+			Client:                       r.Client,
+			Logger:                       log,
+			Cluster:                      cluster,
+			ControlPlane:                 controlPlane,
+			ControllerName:               "awsmachinetemplate",
+			TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+		})
+	}
+
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, nil
+	}
+
+	awsCluster := &infrav1.AWSCluster{}
+	infraClusterName := client.ObjectKey{
+		Namespace: awsMachineTemplate.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+
+	if err := r.Get(ctx, infraClusterName, awsCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			// AWSCluster is not ready
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:                       r.Client,
+		Logger:                       log,
+		Cluster:                      cluster,
+		AWSCluster:                   awsCluster,
+		ControllerName:               "awsmachinetemplate",
+		TagUnmanagedNetworkResources: r.TagUnmanagedNetworkResources,
+	})
+}
+
+// instanceTypeCapacity returns the resources a node of the given instance type provides.
+func instanceTypeCapacity(info *ec2types.InstanceTypeInfo, rootVolume *infrav1.Volume) corev1.ResourceList {
+	capacity := corev1.ResourceList{}
+
+	if info.VCpuInfo != nil && info.VCpuInfo.DefaultVCpus != nil {
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(int64(*info.VCpuInfo.DefaultVCpus), resource.DecimalSI)
+	}
+
+	if info.MemoryInfo != nil && info.MemoryInfo.SizeInMiB != nil {
+		capacity[corev1.ResourceMemory] = *resource.NewQuantity(*info.MemoryInfo.SizeInMiB*1024*1024, resource.BinarySI)
+	}
+
+	if info.GpuInfo != nil {
+		var gpus int64
+		for _, gpu := range info.GpuInfo.Gpus {
+			if gpu.Manufacturer != nil && strings.EqualFold(*gpu.Manufacturer, nvidiaGPUManufacturer) && gpu.Count != nil {
+				gpus += int64(*gpu.Count)
+			}
+		}
+		if gpus > 0 {
+			capacity[nvidiaGPUResourceName] = *resource.NewQuantity(gpus, resource.DecimalSI)
+		}
+	}
+
+	if rootVolume != nil && rootVolume.Size > 0 {
+		capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(rootVolume.Size*1024*1024*1024, resource.BinarySI)
+	}
+
+	return capacity
+}
+
+// instanceTypeArchitecture maps the architectures supported by the instance type to a node architecture.
+func instanceTypeArchitecture(info *ec2types.InstanceTypeInfo) infrav1.Architecture {
+	if info.ProcessorInfo == nil {
+		return ""
+	}
+
+	for _, arch := range info.ProcessorInfo.SupportedArchitectures {
+		switch arch {
+		case ec2types.ArchitectureTypeX8664:
+			return infrav1.ArchitectureAmd64
+		case ec2types.ArchitectureTypeArm64:
+			return infrav1.ArchitectureArm64
+		}
+	}
+
+	return ""
+}
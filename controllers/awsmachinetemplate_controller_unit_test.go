@@ -0,0 +1,290 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestAWSMachineTemplateReconcileNormal(t *testing.T) {
+	m5Large := &ec2types.InstanceTypeInfo{
+		InstanceType:  ec2types.InstanceTypeM5Large,
+		VCpuInfo:      &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)},
+		MemoryInfo:    &ec2types.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+		ProcessorInfo: &ec2types.ProcessorInfo{SupportedArchitectures: []ec2types.ArchitectureType{ec2types.ArchitectureTypeI386, ec2types.ArchitectureTypeX8664}},
+	}
+	g5XLarge := &ec2types.InstanceTypeInfo{
+		InstanceType:  ec2types.InstanceTypeG5Xlarge,
+		VCpuInfo:      &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(4)},
+		MemoryInfo:    &ec2types.MemoryInfo{SizeInMiB: aws.Int64(16384)},
+		ProcessorInfo: &ec2types.ProcessorInfo{SupportedArchitectures: []ec2types.ArchitectureType{ec2types.ArchitectureTypeX8664}},
+		GpuInfo: &ec2types.GpuInfo{Gpus: []ec2types.GpuDeviceInfo{
+			{Manufacturer: aws.String("NVIDIA"), Count: aws.Int32(1)},
+		}},
+	}
+	m7gLarge := &ec2types.InstanceTypeInfo{
+		InstanceType:  ec2types.InstanceTypeM7gLarge,
+		VCpuInfo:      &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)},
+		MemoryInfo:    &ec2types.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+		ProcessorInfo: &ec2types.ProcessorInfo{SupportedArchitectures: []ec2types.ArchitectureType{ec2types.ArchitectureTypeArm64}},
+	}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		rootVolume   *infrav1.Volume
+		status       infrav1.AWSMachineTemplateStatus
+		expect       func(m *mock_services.MockEC2InterfaceMockRecorder)
+		wantStatus   infrav1.AWSMachineTemplateStatus
+		wantErr      bool
+	}{
+		{
+			name:         "should set capacity and node info for an amd64 instance type",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeInfo("m5.large").Return(m5Large, nil)
+			},
+			wantStatus: infrav1.AWSMachineTemplateStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				NodeInfo: &infrav1.NodeInfo{
+					Architecture:    infrav1.ArchitectureAmd64,
+					OperatingSystem: infrav1.OperatingSystemLinux,
+				},
+			},
+		},
+		{
+			name:         "should set gpu and ephemeral storage capacity",
+			instanceType: "g5.xlarge",
+			rootVolume:   &infrav1.Volume{Size: 100},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeInfo("g5.xlarge").Return(g5XLarge, nil)
+			},
+			wantStatus: infrav1.AWSMachineTemplateStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("4"),
+					corev1.ResourceMemory:           resource.MustParse("16Gi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+					nvidiaGPUResourceName:           resource.MustParse("1"),
+				},
+				NodeInfo: &infrav1.NodeInfo{
+					Architecture:    infrav1.ArchitectureAmd64,
+					OperatingSystem: infrav1.OperatingSystemLinux,
+				},
+			},
+		},
+		{
+			name:         "should refresh the status when the instance type changed",
+			instanceType: "m7g.large",
+			status: infrav1.AWSMachineTemplateStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				NodeInfo: &infrav1.NodeInfo{
+					Architecture:    infrav1.ArchitectureAmd64,
+					OperatingSystem: infrav1.OperatingSystemLinux,
+				},
+			},
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeInfo("m7g.large").Return(m7gLarge, nil)
+			},
+			wantStatus: infrav1.AWSMachineTemplateStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				NodeInfo: &infrav1.NodeInfo{
+					Architecture:    infrav1.ArchitectureArm64,
+					OperatingSystem: infrav1.OperatingSystemLinux,
+				},
+			},
+		},
+		{
+			name:         "should return an error if the instance type cannot be described",
+			instanceType: "m5.large",
+			expect: func(m *mock_services.MockEC2InterfaceMockRecorder) {
+				m.GetInstanceTypeInfo("m5.large").Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+		{
+			name:         "should do nothing if the instance type is not set",
+			instanceType: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			template := &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "template",
+					Namespace: "default",
+				},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType: tt.instanceType,
+							RootVolume:   tt.rootVolume,
+						},
+					},
+				},
+				Status: tt.status,
+			}
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(template).WithStatusSubresource(template).Build()
+
+			ec2Svc := mock_services.NewMockEC2Interface(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Svc.EXPECT())
+			}
+
+			r := &AWSMachineTemplateReconciler{Client: fakeClient}
+			err := r.reconcileNormal(context.TODO(), template, ec2Svc)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			got := &infrav1.AWSMachineTemplate{}
+			g.Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(template), got)).To(Succeed())
+			g.Expect(got.Status.NodeInfo).To(Equal(tt.wantStatus.NodeInfo))
+			g.Expect(got.Status.Capacity).To(HaveLen(len(tt.wantStatus.Capacity)))
+			for name, want := range tt.wantStatus.Capacity {
+				g.Expect(got.Status.Capacity).To(HaveKey(name))
+				q := got.Status.Capacity[name]
+				g.Expect(q.Cmp(want)).To(BeZero(), "unexpected %s capacity %s", name, q.String())
+			}
+		})
+	}
+}
+
+func TestAWSMachineTemplateReconcileClusterNotReady(t *testing.T) {
+	tests := []struct {
+		name             string
+		cluster          *clusterv1.Cluster
+		objects          []client.Object
+		registerEKSTypes bool
+		wantResult       ctrl.Result
+		wantErr          bool
+	}{
+		{
+			name: "should requeue if the cluster is paused",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					Paused: true,
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: DefaultReconcilerRequeue},
+		},
+		{
+			name: "should requeue if the AWSCluster doesn't exist",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					InfrastructureRef: &corev1.ObjectReference{Kind: "AWSCluster", Name: "test"},
+				},
+			},
+			wantResult: ctrl.Result{RequeueAfter: DefaultReconcilerRequeue},
+		},
+		{
+			name: "should requeue if the AWSManagedControlPlane doesn't exist",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{Kind: AWSManagedControlPlaneRefKind, Name: "test"},
+				},
+			},
+			registerEKSTypes: true,
+			wantResult:       ctrl.Result{RequeueAfter: DefaultReconcilerRequeue},
+		},
+		{
+			name: "should return an error if the AWSManagedControlPlane can't be read",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: clusterv1.ClusterSpec{
+					ControlPlaneRef: &corev1.ObjectReference{Kind: AWSManagedControlPlaneRefKind, Name: "test"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			template := &infrav1.AWSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "template",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "test"},
+				},
+				Spec: infrav1.AWSMachineTemplateSpec{
+					Template: infrav1.AWSMachineTemplateResource{
+						Spec: infrav1.AWSMachineSpec{
+							InstanceType: "m5.large",
+						},
+					},
+				},
+			}
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			if tt.registerEKSTypes {
+				g.Expect(ekscontrolplanev1.AddToScheme(scheme)).To(Succeed())
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(template, tt.cluster).Build()
+
+			r := &AWSMachineTemplateReconciler{Client: fakeClient}
+			result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(template)})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+		})
+	}
+}
@@ -119,6 +119,21 @@ func (r *AWSManagedControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
 	dst.Status.Version = restored.Status.Version
 	dst.Spec.BootstrapSelfManagedAddons = restored.Spec.BootstrapSelfManagedAddons
+	dst.Spec.AccessConfig = restored.Spec.AccessConfig
+	dst.Spec.AccessEntries = restored.Spec.AccessEntries
+	dst.Status.AccessEntries = restored.Status.AccessEntries
+	dst.Spec.PodIdentityAssociations = restored.Spec.PodIdentityAssociations
+	dst.Status.PodIdentityAssociations = restored.Status.PodIdentityAssociations
+	dst.Spec.AutoMode = restored.Spec.AutoMode
+	dst.Spec.UpgradePolicy = restored.Spec.UpgradePolicy
+	dst.Spec.ZonalShiftConfig = restored.Spec.ZonalShiftConfig
+	dst.Status.Upgrade = restored.Status.Upgrade
+
+	if dst.Spec.Addons != nil && restored.Spec.Addons != nil && len(*dst.Spec.Addons) == len(*restored.Spec.Addons) {
+		for i := range *dst.Spec.Addons {
+			(*dst.Spec.Addons)[i].VersionPolicy = (*restored.Spec.Addons)[i].VersionPolicy
+		}
+	}
 	return nil
 }
 
@@ -244,6 +259,33 @@ func Convert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPl
 	return autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedControlPlaneStatus(in, out, s)
 }
 
+// Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon is a conversion function.
+func Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon(in *[]Addon, out *[]ekscontrolplanev1.Addon, s apiconversion.Scope) error {
+	*out = make([]ekscontrolplanev1.Addon, len(*in))
+	for i := range *in {
+		if err := Convert_v1beta1_Addon_To_v1beta2_Addon(&(*in)[i], &(*out)[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon is a conversion function.
+func Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon(in *[]ekscontrolplanev1.Addon, out *[]Addon, s apiconversion.Scope) error {
+	*out = make([]Addon, len(*in))
+	for i := range *in {
+		if err := Convert_v1beta2_Addon_To_v1beta1_Addon(&(*in)[i], &(*out)[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta2_Addon_To_v1beta1_Addon is a conversion function.
+func Convert_v1beta2_Addon_To_v1beta1_Addon(in *ekscontrolplanev1.Addon, out *Addon, s apiconversion.Scope) error {
+	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
+}
+
 func Convert_v1beta1_EKSTokenMethod_To_v1beta2_EKSTokenMethod(src *EKSTokenMethod, dst **ekscontrolplanev1.EKSTokenMethod) {
 	if src == nil {
 		*dst = nil
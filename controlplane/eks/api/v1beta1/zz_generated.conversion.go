@@ -69,11 +69,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Addon)(nil), (*Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Addon_To_v1beta1_Addon(a.(*v1beta2.Addon), b.(*Addon), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AddonIssue)(nil), (*v1beta2.AddonIssue)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AddonIssue_To_v1beta2_AddonIssue(a.(*AddonIssue), b.(*v1beta2.AddonIssue), scope)
 	}); err != nil {
@@ -209,6 +204,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*[]Addon)(nil), (*[]v1beta2.Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon(a.(*[]Addon), b.(*[]v1beta2.Addon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*[]v1beta2.Addon)(nil), (*[]Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon(a.(*[]v1beta2.Addon), b.(*[]Addon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*AWSManagedControlPlaneSpec)(nil), (*v1beta2.AWSManagedControlPlaneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControlPlaneSpec(a.(*AWSManagedControlPlaneSpec), b.(*v1beta2.AWSManagedControlPlaneSpec), scope)
 	}); err != nil {
@@ -224,6 +229,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Addon)(nil), (*Addon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Addon_To_v1beta1_Addon(a.(*v1beta2.Addon), b.(*Addon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.VpcCni)(nil), (*VpcCni)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(a.(*v1beta2.VpcCni), b.(*VpcCni), scope)
 	}); err != nil {
@@ -330,7 +340,15 @@ func autoConvert_v1beta1_AWSManagedControlPlaneSpec_To_v1beta2_AWSManagedControl
 	out.Bastion = in.Bastion
 	out.TokenMethod = (*v1beta2.EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
-	out.Addons = (*[]v1beta2.Addon)(unsafe.Pointer(in.Addons))
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new([]v1beta2.Addon)
+		if err := Convert_Slice_v1beta1_Addon_To_Slice_v1beta2_Addon(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Addons = nil
+	}
 	out.OIDCIdentityProviderConfig = (*v1beta2.OIDCIdentityProviderConfig)(unsafe.Pointer(in.OIDCIdentityProviderConfig))
 	// WARNING: in.DisableVPCCNI requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta1_VpcCni_To_v1beta2_VpcCni(&in.VpcCni, &out.VpcCni, s); err != nil {
@@ -359,6 +377,8 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.AdditionalTags = *(*apiv1beta2.Tags)(unsafe.Pointer(&in.AdditionalTags))
 	out.IAMAuthenticatorConfig = (*IAMAuthenticatorConfig)(unsafe.Pointer(in.IAMAuthenticatorConfig))
+	// WARNING: in.AccessConfig requires manual conversion: does not exist in peer-type
+	// WARNING: in.AccessEntries requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_EndpointAccess_To_v1beta1_EndpointAccess(&in.EndpointAccess, &out.EndpointAccess, s); err != nil {
 		return err
 	}
@@ -369,7 +389,16 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	out.Bastion = in.Bastion
 	out.TokenMethod = (*EKSTokenMethod)(unsafe.Pointer(in.TokenMethod))
 	out.AssociateOIDCProvider = in.AssociateOIDCProvider
-	out.Addons = (*[]Addon)(unsafe.Pointer(in.Addons))
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new([]Addon)
+		if err := Convert_Slice_v1beta2_Addon_To_Slice_v1beta1_Addon(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Addons = nil
+	}
+	// WARNING: in.PodIdentityAssociations requires manual conversion: does not exist in peer-type
 	out.OIDCIdentityProviderConfig = (*OIDCIdentityProviderConfig)(unsafe.Pointer(in.OIDCIdentityProviderConfig))
 	if err := Convert_v1beta2_VpcCni_To_v1beta1_VpcCni(&in.VpcCni, &out.VpcCni, s); err != nil {
 		return err
@@ -379,6 +408,9 @@ func autoConvert_v1beta2_AWSManagedControlPlaneSpec_To_v1beta1_AWSManagedControl
 	if err := Convert_v1beta2_KubeProxy_To_v1beta1_KubeProxy(&in.KubeProxy, &out.KubeProxy, s); err != nil {
 		return err
 	}
+	// WARNING: in.AutoMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.UpgradePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.ZonalShiftConfig requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -422,6 +454,9 @@ func autoConvert_v1beta2_AWSManagedControlPlaneStatus_To_v1beta1_AWSManagedContr
 	if err := Convert_v1beta2_IdentityProviderStatus_To_v1beta1_IdentityProviderStatus(&in.IdentityProviderStatus, &out.IdentityProviderStatus, s); err != nil {
 		return err
 	}
+	// WARNING: in.AccessEntries requires manual conversion: does not exist in peer-type
+	// WARNING: in.PodIdentityAssociations requires manual conversion: does not exist in peer-type
+	// WARNING: in.Upgrade requires manual conversion: does not exist in peer-type
 	// WARNING: in.Version requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -443,17 +478,13 @@ func Convert_v1beta1_Addon_To_v1beta2_Addon(in *Addon, out *v1beta2.Addon, s con
 func autoConvert_v1beta2_Addon_To_v1beta1_Addon(in *v1beta2.Addon, out *Addon, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
+	// WARNING: in.VersionPolicy requires manual conversion: does not exist in peer-type
 	out.Configuration = in.Configuration
 	out.ConflictResolution = (*AddonResolution)(unsafe.Pointer(in.ConflictResolution))
 	out.ServiceAccountRoleArn = (*string)(unsafe.Pointer(in.ServiceAccountRoleArn))
 	return nil
 }
 
-// Convert_v1beta2_Addon_To_v1beta1_Addon is an autogenerated conversion function.
-func Convert_v1beta2_Addon_To_v1beta1_Addon(in *v1beta2.Addon, out *Addon, s conversion.Scope) error {
-	return autoConvert_v1beta2_Addon_To_v1beta1_Addon(in, out, s)
-}
-
 func autoConvert_v1beta1_AddonIssue_To_v1beta2_AddonIssue(in *AddonIssue, out *v1beta2.AddonIssue, s conversion.Scope) error {
 	out.Code = (*string)(unsafe.Pointer(in.Code))
 	out.Message = (*string)(unsafe.Pointer(in.Message))
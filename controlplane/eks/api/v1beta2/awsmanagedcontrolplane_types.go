@@ -131,6 +131,15 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	IAMAuthenticatorConfig *IAMAuthenticatorConfig `json:"iamAuthenticatorConfig,omitempty"`
 
+	// AccessConfig specifies the access configuration information for the cluster
+	// +optional
+	AccessConfig *AccessConfig `json:"accessConfig,omitempty"`
+
+	// AccessEntries specifies the access entries for the cluster. Access entries are
+	// only reconciled when the authentication mode is API or API_AND_CONFIG_MAP.
+	// +optional
+	AccessEntries []AccessEntry `json:"accessEntries,omitempty"`
+
 	// Endpoints specifies access to this cluster's control plane endpoints
 	// +optional
 	EndpointAccess EndpointAccess `json:"endpointAccess,omitempty"`
@@ -187,6 +196,12 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 	// +optional
 	Addons *[]Addon `json:"addons,omitempty"`
 
+	// PodIdentityAssociations specifies the EKS Pod Identity associations between Kubernetes
+	// service accounts and IAM roles. The eks-pod-identity-agent addon is installed when
+	// associations are specified.
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
+
 	// IdentityProviderconfig is used to specify the oidc provider config
 	// to be attached with this eks cluster
 	// +optional
@@ -208,6 +223,21 @@ type AWSManagedControlPlaneSpec struct { //nolint: maligned
 
 	// KubeProxy defines managed attributes of the kube-proxy daemonset
 	KubeProxy KubeProxy `json:"kubeProxy,omitempty"`
+
+	// AutoMode configures EKS Auto Mode. When enabled, EKS manages the VPC CNI and
+	// kube-proxy of the nodes it launches, and they aren't reconciled by CAPA.
+	// +optional
+	AutoMode *AutoMode `json:"autoMode,omitempty"`
+
+	// UpgradePolicy sets the support policy of the Kubernetes version of the cluster. If not
+	// specified the support type is left unchanged, which for new clusters is EXTENDED.
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// ZonalShiftConfig configures Amazon Application Recovery Controller (ARC) zonal shift
+	// for the cluster. If not specified the zonal shift configuration is left unchanged.
+	// +optional
+	ZonalShiftConfig *ZonalShiftConfig `json:"zonalShiftConfig,omitempty"`
 }
 
 // KubeProxy specifies how the kube-proxy daemonset is managed.
@@ -312,6 +342,18 @@ type AWSManagedControlPlaneStatus struct {
 	// associated identity provider
 	// +optional
 	IdentityProviderStatus IdentityProviderStatus `json:"identityProviderStatus,omitempty"`
+	// AccessEntries holds the current status of the access entries managed
+	// from the spec
+	// +optional
+	AccessEntries []AccessEntryState `json:"accessEntries,omitempty"`
+	// PodIdentityAssociations holds the current status of the pod identity
+	// associations managed from the spec
+	// +optional
+	PodIdentityAssociations []PodIdentityAssociationStatus `json:"podIdentityAssociations,omitempty"`
+	// Upgrade holds the progress of the last upgrade of the Kubernetes version of
+	// the cluster
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
 	// Version represents the minimum Kubernetes version for the control plane machines
 	// in the cluster.
 	// +optional
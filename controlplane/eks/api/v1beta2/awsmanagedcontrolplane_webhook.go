@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/apparentlymart/go-cidr/cidr"
 	"github.com/pkg/errors"
@@ -28,9 +29,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
@@ -99,11 +102,14 @@ func (*awsManagedControlPlaneWebhook) ValidateCreate(_ context.Context, obj runt
 	allErrs = append(allErrs, r.validateEKSVersion(nil)...)
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
+	allErrs = append(allErrs, r.validateAccessConfig(nil)...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAutoMode(nil)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
@@ -141,11 +147,14 @@ func (*awsManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, oldObj
 	allErrs = append(allErrs, r.validateEKSVersion(oldAWSManagedControlplane)...)
 	allErrs = append(allErrs, r.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
+	allErrs = append(allErrs, r.validateAccessConfig(oldAWSManagedControlplane)...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAutoMode(oldAWSManagedControlplane)...)
 	allErrs = append(allErrs, r.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 
@@ -186,6 +195,8 @@ func (*awsManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, oldObj
 			field.Invalid(field.NewPath("spec", "network", "vpc", "enableIPv6"), r.Spec.NetworkSpec.VPC.IsIPv6Enabled(), "changing IP family is not allowed after it has been set"))
 	}
 
+	allErrs = append(allErrs, r.Spec.NetworkSpec.PrefixList.ValidateUpdate(field.NewPath("spec", "network", "prefixList"), oldAWSManagedControlplane.Spec.NetworkSpec.PrefixList)...)
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -270,6 +281,9 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		return allErrs
 	}
 
+	allErrs = append(allErrs, validateEKSAddonVersions(addons, path.Child("addons"))...)
+	allErrs = append(allErrs, validateEKSAddonConfigurations(addons, path.Child("addons"))...)
+
 	// Version is required for addon validation
 	if eksVersion == nil {
 		return allErrs
@@ -300,7 +314,8 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 		}
 
 		for _, addon := range *addons {
-			if addon.Name == vpcCniAddon {
+			// The latest compatible version of the vpc-cni addon supports IPv6
+			if addon.Name == vpcCniAddon && !addon.LatestCompatible() {
 				v, err := version.ParseGeneric(addon.Version)
 				if err != nil {
 					allErrs = append(allErrs, field.Invalid(addonsPath, addon.Version, err.Error()))
@@ -318,6 +333,49 @@ func validateEKSAddons(eksVersion *string, networkSpec infrav1.NetworkSpec, addo
 	return allErrs
 }
 
+// validateEKSAddonVersions validates that addons specify a version unless the latest compatible version is used.
+func validateEKSAddonVersions(addons *[]Addon, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if addons == nil {
+		return allErrs
+	}
+
+	for i, addon := range *addons {
+		versionPath := path.Index(i).Child("version")
+		switch {
+		case addon.LatestCompatible() && addon.Version != "":
+			allErrs = append(allErrs, field.Forbidden(versionPath, "version can't be set when the version policy is latest-compatible"))
+		case !addon.LatestCompatible() && addon.Version == "":
+			allErrs = append(allErrs, field.Required(versionPath, "version is required unless the version policy is latest-compatible"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEKSAddonConfigurations validates that the addon configurations are JSON or YAML objects. They are
+// validated against the configuration schema of the addon version when the addons are reconciled.
+func validateEKSAddonConfigurations(addons *[]Addon, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if addons == nil {
+		return allErrs
+	}
+
+	for i, addon := range *addons {
+		if addon.Configuration == "" {
+			continue
+		}
+		configuration := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(addon.Configuration), &configuration); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("configuration"), addon.Configuration, fmt.Sprintf("must be a JSON or YAML object: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateIAMAuthConfig() field.ErrorList {
 	return validateIAMAuthConfig(r.Spec.IAMAuthenticatorConfig, field.NewPath("spec.iamAuthenticatorConfig"))
 }
@@ -348,6 +406,157 @@ func validateIAMAuthConfig(cfg *IAMAuthenticatorConfig, parentPath *field.Path)
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateAccessConfig(old *AWSManagedControlPlane) field.ErrorList {
+	var oldAccessConfig *AccessConfig
+	if old != nil {
+		oldAccessConfig = old.Spec.AccessConfig
+	}
+	return validateAccessConfig(r.Spec.AccessConfig, oldAccessConfig, r.Spec.AccessEntries, r.Spec.IAMAuthenticatorConfig, field.NewPath("spec"))
+}
+
+// authenticationModeOrder is the order in which EKS allows the authentication mode to change,
+// the mode can only move forward.
+var authenticationModeOrder = map[EKSAuthenticationMode]int{
+	EKSAuthenticationModeConfigMap:       0,
+	EKSAuthenticationModeAPIAndConfigMap: 1,
+	EKSAuthenticationModeAPI:             2,
+}
+
+func validateAccessConfig(accessConfig, oldAccessConfig *AccessConfig, accessEntries []AccessEntry, iamCfg *IAMAuthenticatorConfig, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	mode := EKSAuthenticationModeConfigMap
+	if accessConfig != nil && accessConfig.AuthenticationMode != "" {
+		mode = accessConfig.AuthenticationMode
+	}
+
+	if oldAccessConfig != nil {
+		modePath := path.Child("accessConfig", "authenticationMode")
+		oldMode := oldAccessConfig.AuthenticationMode
+		if oldMode == "" {
+			oldMode = EKSAuthenticationModeConfigMap
+		}
+		if authenticationModeOrder[mode] < authenticationModeOrder[oldMode] {
+			allErrs = append(allErrs, field.Invalid(modePath, mode, fmt.Sprintf("authentication mode cannot be changed from %s to %s", oldMode, mode)))
+		}
+
+		var bootstrapAdmin *bool
+		if accessConfig != nil {
+			bootstrapAdmin = accessConfig.BootstrapClusterCreatorAdminPermissions
+		}
+		if !ptr.Equal(oldAccessConfig.BootstrapClusterCreatorAdminPermissions, bootstrapAdmin) {
+			allErrs = append(allErrs, field.Invalid(path.Child("accessConfig", "bootstrapClusterCreatorAdminPermissions"), bootstrapAdmin, "field is immutable"))
+		}
+	}
+
+	entriesPath := path.Child("accessEntries")
+	if len(accessEntries) > 0 && !mode.UsesAccessEntries() {
+		allErrs = append(allErrs, field.Invalid(entriesPath, len(accessEntries),
+			fmt.Sprintf("access entries require the %s or %s authentication mode", EKSAuthenticationModeAPIAndConfigMap, EKSAuthenticationModeAPI)))
+	}
+
+	principals := map[string]bool{}
+	for i, entry := range accessEntries {
+		entryPath := entriesPath.Index(i)
+		if principals[entry.PrincipalARN] {
+			allErrs = append(allErrs, field.Duplicate(entryPath.Child("principalARN"), entry.PrincipalARN))
+		}
+		principals[entry.PrincipalARN] = true
+		allErrs = append(allErrs, validateAccessEntry(entry, entryPath)...)
+	}
+
+	// In the API mode the aws-iam-authenticator mappings are also turned into access entries,
+	// so they cannot manage the same principals as the access entries.
+	if mode == EKSAuthenticationModeAPI && iamCfg != nil {
+		mappingsPath := path.Child("iamAuthenticatorConfig")
+		for i, roleMapping := range iamCfg.RoleMappings {
+			if principals[roleMapping.RoleARN] {
+				allErrs = append(allErrs, field.Duplicate(mappingsPath.Child("mapRoles").Index(i).Child("rolearn"), roleMapping.RoleARN))
+			}
+		}
+		for i, userMapping := range iamCfg.UserMappings {
+			if principals[userMapping.UserARN] {
+				allErrs = append(allErrs, field.Duplicate(mappingsPath.Child("mapUsers").Index(i).Child("userarn"), userMapping.UserARN))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateAccessEntry(entry AccessEntry, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if entry.Type != "" && entry.Type != AccessEntryTypeStandard {
+		if len(entry.KubernetesGroups) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("kubernetesGroups"), entry.KubernetesGroups, fmt.Sprintf("kubernetes groups can only be set for %s access entries", AccessEntryTypeStandard)))
+		}
+		if entry.Username != "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("username"), entry.Username, fmt.Sprintf("username can only be set for %s access entries", AccessEntryTypeStandard)))
+		}
+		if len(entry.AccessPolicies) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("accessPolicies"), len(entry.AccessPolicies), fmt.Sprintf("access policies can only be associated with %s access entries", AccessEntryTypeStandard)))
+		}
+	}
+
+	for i, group := range entry.KubernetesGroups {
+		if strings.HasPrefix(group, "system:") {
+			allErrs = append(allErrs, field.Invalid(path.Child("kubernetesGroups").Index(i), group, "groups prefixed with system: are reserved, use an access policy instead"))
+		}
+	}
+
+	policies := map[string]bool{}
+	for i, policy := range entry.AccessPolicies {
+		policyPath := path.Child("accessPolicies").Index(i)
+		if policies[policy.PolicyARN] {
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("policyARN"), policy.PolicyARN))
+		}
+		policies[policy.PolicyARN] = true
+
+		scopePath := policyPath.Child("accessScope")
+		switch policy.AccessScope.Type {
+		case AccessScopeTypeNamespace:
+			if len(policy.AccessScope.Namespaces) == 0 {
+				allErrs = append(allErrs, field.Required(scopePath.Child("namespaces"), "namespaces are required for a namespace access scope"))
+			}
+		default:
+			if len(policy.AccessScope.Namespaces) > 0 {
+				allErrs = append(allErrs, field.Invalid(scopePath.Child("namespaces"), policy.AccessScope.Namespaces, "namespaces can only be set for a namespace access scope"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSManagedControlPlane) validatePodIdentityAssociations() field.ErrorList {
+	return validatePodIdentityAssociations(r.Spec.PodIdentityAssociations, field.NewPath("spec", "podIdentityAssociations"))
+}
+
+func validatePodIdentityAssociations(associations []PodIdentityAssociation, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	serviceAccounts := map[string]bool{}
+	for i, association := range associations {
+		associationPath := path.Index(i)
+
+		key := association.ServiceAccountNamespace + "/" + association.ServiceAccountName
+		if serviceAccounts[key] {
+			allErrs = append(allErrs, field.Duplicate(associationPath.Child("serviceAccountName"), key))
+		}
+		serviceAccounts[key] = true
+
+		switch {
+		case association.RoleARN == "" && association.Role == nil:
+			allErrs = append(allErrs, field.Required(associationPath, "one of roleARN or role must be specified"))
+		case association.RoleARN != "" && association.Role != nil:
+			allErrs = append(allErrs, field.Invalid(associationPath.Child("role"), association.Role.Name, "roleARN and role are mutually exclusive"))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateSecondaryCIDR() field.ErrorList {
 	return validateSecondaryCIDR(r.Spec.SecondaryCidrBlock, field.NewPath("spec", "secondaryCidrBlock"))
 }
@@ -400,6 +609,58 @@ func validateKubeProxy(kubeProxy KubeProxy, addons *[]Addon, path *field.Path) f
 	return allErrs
 }
 
+func (r *AWSManagedControlPlane) validateAutoMode(old *AWSManagedControlPlane) field.ErrorList {
+	var oldAutoMode *AutoMode
+	if old != nil {
+		oldAutoMode = old.Spec.AutoMode
+	}
+	return validateAutoMode(r.Spec.AutoMode, oldAutoMode, r.Spec.AccessConfig, r.Spec.VpcCni, r.Spec.KubeProxy, field.NewPath("spec"))
+}
+
+func validateAutoMode(autoMode, oldAutoMode *AutoMode, accessConfig *AccessConfig, vpcCni VpcCni, kubeProxy KubeProxy, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if autoMode == nil {
+		return allErrs
+	}
+
+	computePath := path.Child("autoMode", "compute")
+	nodePools := map[AutoModeNodePool]bool{}
+	for i, nodePool := range autoMode.Compute.NodePools {
+		if nodePools[nodePool] {
+			allErrs = append(allErrs, field.Duplicate(computePath.Child("nodePools").Index(i), nodePool))
+		}
+		nodePools[nodePool] = true
+	}
+
+	if autoMode.Compute.NodeRoleName != nil && len(autoMode.Compute.NodePools) == 0 {
+		allErrs = append(allErrs, field.Invalid(computePath.Child("nodeRoleName"), *autoMode.Compute.NodeRoleName, "node role can only be set together with node pools"))
+	}
+
+	if oldAutoMode != nil && oldAutoMode.Enabled && oldAutoMode.Compute.NodeRoleName != nil && !ptr.Equal(oldAutoMode.Compute.NodeRoleName, autoMode.Compute.NodeRoleName) {
+		allErrs = append(allErrs, field.Invalid(computePath.Child("nodeRoleName"), autoMode.Compute.NodeRoleName, "node role cannot be changed once auto mode is enabled"))
+	}
+
+	if autoMode.Enabled {
+		mode := EKSAuthenticationModeConfigMap
+		if accessConfig != nil && accessConfig.AuthenticationMode != "" {
+			mode = accessConfig.AuthenticationMode
+		}
+		if !mode.UsesAccessEntries() {
+			allErrs = append(allErrs, field.Invalid(path.Child("accessConfig", "authenticationMode"), mode,
+				fmt.Sprintf("auto mode requires the %s or %s authentication mode", EKSAuthenticationModeAPIAndConfigMap, EKSAuthenticationModeAPI)))
+		}
+		if vpcCni.Disable || len(vpcCni.Env) > 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("vpcCni"), vpcCni, "vpc cni is managed by EKS when auto mode is enabled"))
+		}
+		if kubeProxy.Disable {
+			allErrs = append(allErrs, field.Invalid(path.Child("kubeProxy", "disable"), kubeProxy.Disable, "kube-proxy is managed by EKS when auto mode is enabled"))
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedControlPlane) validateDisableVPCCNI() field.ErrorList {
 	return validateDisableVPCCNI(r.Spec.VpcCni, r.Spec.Addons, field.NewPath("spec"))
 }
@@ -537,6 +798,19 @@ func validateNetwork(resourceName string, networkSpec infrav1.NetworkSpec, secon
 		}
 	}
 
+	allErrs = append(allErrs, networkSpec.PrefixList.Validate(path.Child("network", "prefixList"))...)
+	allErrs = append(allErrs, networkSpec.VPC.TransitGateway.Validate(path.Child("network", "vpc", "transitGateway"))...)
+	for i := range networkSpec.VPCEndpoints {
+		allErrs = append(allErrs, networkSpec.VPCEndpoints[i].Validate(path.Child("network", "vpcEndpoints").Index(i))...)
+	}
+	for i := range networkSpec.VPC.FlowLogs {
+		allErrs = append(allErrs, networkSpec.VPC.FlowLogs[i].Validate(path.Child("network", "vpc", "flowLogs").Index(i))...)
+	}
+	allErrs = append(allErrs, networkSpec.NetworkACLs.Validate(path.Child("network", "networkACLs"))...)
+	for i := range networkSpec.Subnets {
+		allErrs = append(allErrs, networkSpec.Subnets[i].NetworkACL.Validate(path.Child("network", "subnets").Index(i).Child("networkACL"))...)
+	}
+
 	return allErrs
 }
 
@@ -959,3 +959,551 @@ func TestValidatingWebhookUpdateSecondaryCidr(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatingWebhookCreateAccessEntries(t *testing.T) {
+	adminARN := "arn:aws:iam::123456789012:role/admin"
+
+	tests := []struct {
+		name          string
+		accessConfig  *AccessConfig
+		accessEntries []AccessEntry
+		iamAuthConfig *IAMAuthenticatorConfig
+		expectError   bool
+	}{
+		{
+			name: "access entries with api mode",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPI,
+			},
+			accessEntries: []AccessEntry{
+				{
+					PrincipalARN: adminARN,
+					AccessPolicies: []AccessPolicyReference{
+						{
+							PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+							AccessScope: AccessScope{Type: AccessScopeTypeCluster},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "access entries without access config",
+			accessEntries: []AccessEntry{
+				{PrincipalARN: adminARN},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate principal",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPIAndConfigMap,
+			},
+			accessEntries: []AccessEntry{
+				{PrincipalARN: adminARN},
+				{PrincipalARN: adminARN},
+			},
+			expectError: true,
+		},
+		{
+			name: "namespace scope without namespaces",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPI,
+			},
+			accessEntries: []AccessEntry{
+				{
+					PrincipalARN: adminARN,
+					AccessPolicies: []AccessPolicyReference{
+						{
+							PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy",
+							AccessScope: AccessScope{Type: AccessScopeTypeNamespace},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "kubernetes groups on node access entry",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPI,
+			},
+			accessEntries: []AccessEntry{
+				{
+					PrincipalARN:     adminARN,
+					Type:             AccessEntryTypeEC2Linux,
+					KubernetesGroups: []string{"nodes"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "reserved kubernetes group",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPI,
+			},
+			accessEntries: []AccessEntry{
+				{
+					PrincipalARN:     adminARN,
+					KubernetesGroups: []string{"system:masters"},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "principal also mapped by iam authenticator in api mode",
+			accessConfig: &AccessConfig{
+				AuthenticationMode: EKSAuthenticationModeAPI,
+			},
+			accessEntries: []AccessEntry{
+				{PrincipalARN: adminARN},
+			},
+			iamAuthConfig: &IAMAuthenticatorConfig{
+				RoleMappings: []RoleMapping{
+					{
+						RoleARN: adminARN,
+						KubernetesMapping: KubernetesMapping{
+							UserName: "admin",
+							Groups:   []string{"admins"},
+						},
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:         "default_cluster1",
+					AccessConfig:           tc.accessConfig,
+					AccessEntries:          tc.accessEntries,
+					IAMAuthenticatorConfig: tc.iamAuthConfig,
+				},
+			}
+
+			_, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookUpdateAccessConfig(t *testing.T) {
+	tests := []struct {
+		name            string
+		oldAccessConfig *AccessConfig
+		newAccessConfig *AccessConfig
+		expectError     bool
+	}{
+		{
+			name:            "config map to api",
+			oldAccessConfig: nil,
+			newAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPI,
+				BootstrapClusterCreatorAdminPermissions: nil,
+			},
+			expectError: false,
+		},
+		{
+			name: "api and config map to api",
+			oldAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPIAndConfigMap,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(true),
+			},
+			newAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPI,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(true),
+			},
+			expectError: false,
+		},
+		{
+			name: "api to config map",
+			oldAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPI,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(true),
+			},
+			newAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeConfigMap,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(true),
+			},
+			expectError: true,
+		},
+		{
+			name: "bootstrap cluster creator admin permissions changed",
+			oldAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPI,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(true),
+			},
+			newAccessConfig: &AccessConfig{
+				AuthenticationMode:                      EKSAuthenticationModeAPI,
+				BootstrapClusterCreatorAdminPermissions: ptr.To(false),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			newMCP := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					AccessConfig:   tc.newAccessConfig,
+				},
+			}
+			oldMCP := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					AccessConfig:   tc.oldAccessConfig,
+				},
+			}
+
+			_, err := (&awsManagedControlPlaneWebhook{}).ValidateUpdate(context.Background(), oldMCP, newMCP)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookCreatePodIdentityAssociations(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/external-dns"
+
+	tests := []struct {
+		name         string
+		associations []PodIdentityAssociation
+		expectError  bool
+	}{
+		{
+			name: "association with role arn",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					RoleARN:                 roleARN,
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "association with managed role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					Role: &PodIdentityRole{
+						Policies: []string{"arn:aws:iam::aws:policy/AmazonRoute53FullAccess"},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "association without role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "association with role arn and managed role",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					RoleARN:                 roleARN,
+					Role:                    &PodIdentityRole{},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate service account",
+			associations: []PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					RoleARN:                 roleARN,
+				},
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					Role:                    &PodIdentityRole{},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName:          "default_cluster1",
+					PodIdentityAssociations: tc.associations,
+				},
+			}
+
+			_, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookAutoMode(t *testing.T) {
+	generalPurpose := AutoModeCompute{NodePools: []AutoModeNodePool{AutoModeNodePoolGeneralPurpose}}
+
+	tests := []struct {
+		name               string
+		oldAutoMode        *AutoMode
+		autoMode           *AutoMode
+		vpcCni             VpcCni
+		kubeProxy          KubeProxy
+		authenticationMode EKSAuthenticationMode
+		expectError        bool
+	}{
+		{
+			name:               "auto mode with built-in node pools and node role",
+			autoMode:           &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: generalPurpose.NodePools, NodeRoleName: aws.String("node-role")}},
+			authenticationMode: EKSAuthenticationModeAPI,
+			expectError:        false,
+		},
+		{
+			name:               "duplicate node pools",
+			autoMode:           &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: []AutoModeNodePool{AutoModeNodePoolSystem, AutoModeNodePoolSystem}}},
+			authenticationMode: EKSAuthenticationModeAPI,
+			expectError:        true,
+		},
+		{
+			name:               "node role without node pools",
+			autoMode:           &AutoMode{Enabled: true, Compute: AutoModeCompute{NodeRoleName: aws.String("node-role")}},
+			authenticationMode: EKSAuthenticationModeAPI,
+			expectError:        true,
+		},
+		{
+			name:               "auto mode with vpc cni disabled",
+			autoMode:           &AutoMode{Enabled: true},
+			authenticationMode: EKSAuthenticationModeAPI,
+			vpcCni:             VpcCni{Disable: true},
+			expectError:        true,
+		},
+		{
+			name:               "auto mode with kube-proxy disabled",
+			autoMode:           &AutoMode{Enabled: true},
+			authenticationMode: EKSAuthenticationModeAPI,
+			kubeProxy:          KubeProxy{Disable: true},
+			expectError:        true,
+		},
+		{
+			name:        "disabled auto mode with kube-proxy disabled",
+			autoMode:    &AutoMode{},
+			kubeProxy:   KubeProxy{Disable: true},
+			expectError: false,
+		},
+		{
+			name:               "node role changed once enabled",
+			oldAutoMode:        &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: generalPurpose.NodePools, NodeRoleName: aws.String("node-role")}},
+			autoMode:           &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: generalPurpose.NodePools, NodeRoleName: aws.String("other-role")}},
+			authenticationMode: EKSAuthenticationModeAPI,
+			expectError:        true,
+		},
+		{
+			name:               "node pools changed once enabled",
+			oldAutoMode:        &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: generalPurpose.NodePools, NodeRoleName: aws.String("node-role")}},
+			autoMode:           &AutoMode{Enabled: true, Compute: AutoModeCompute{NodePools: []AutoModeNodePool{AutoModeNodePoolSystem}, NodeRoleName: aws.String("node-role")}},
+			authenticationMode: EKSAuthenticationModeAPI,
+			expectError:        false,
+		},
+		{
+			name:               "auto mode with the api and config map authentication mode",
+			autoMode:           &AutoMode{Enabled: true},
+			authenticationMode: EKSAuthenticationModeAPIAndConfigMap,
+			expectError:        false,
+		},
+		{
+			name:               "auto mode with the config map authentication mode",
+			autoMode:           &AutoMode{Enabled: true},
+			authenticationMode: EKSAuthenticationModeConfigMap,
+			expectError:        true,
+		},
+		{
+			name:        "auto mode with the default authentication mode",
+			autoMode:    &AutoMode{Enabled: true},
+			expectError: true,
+		},
+		{
+			name:        "disabled auto mode with the default authentication mode",
+			autoMode:    &AutoMode{},
+			expectError: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					AutoMode:       tc.autoMode,
+					VpcCni:         tc.vpcCni,
+					KubeProxy:      tc.kubeProxy,
+				},
+			}
+			if tc.authenticationMode != "" {
+				mcp.Spec.AccessConfig = &AccessConfig{AuthenticationMode: tc.authenticationMode}
+			}
+
+			var err error
+			if tc.oldAutoMode == nil {
+				_, err = (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+			} else {
+				oldMCP := mcp.DeepCopy()
+				oldMCP.Spec.AutoMode = tc.oldAutoMode
+				_, err = (&awsManagedControlPlaneWebhook{}).ValidateUpdate(context.Background(), oldMCP, mcp)
+			}
+
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookAddonVersionPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		addon       Addon
+		expectError bool
+	}{
+		{
+			name:        "pinned version",
+			addon:       Addon{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1"},
+			expectError: false,
+		},
+		{
+			name:        "explicitly pinned version",
+			addon:       Addon{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1", VersionPolicy: ptr.To(AddonVersionPolicyPinned)},
+			expectError: false,
+		},
+		{
+			name:        "latest compatible version",
+			addon:       Addon{Name: "vpc-cni", VersionPolicy: ptr.To(AddonVersionPolicyLatestCompatible)},
+			expectError: false,
+		},
+		{
+			name:        "version missing",
+			addon:       Addon{Name: "vpc-cni"},
+			expectError: true,
+		},
+		{
+			name:        "version set with latest compatible version",
+			addon:       Addon{Name: "vpc-cni", Version: "v1.19.0-eksbuild.1", VersionPolicy: ptr.To(AddonVersionPolicyLatestCompatible)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.30.0"),
+					Addons:         &[]Addon{tc.addon},
+				},
+			}
+
+			_, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestValidatingWebhookAddonConfiguration(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		expectError   bool
+	}{
+		{
+			name:          "no configuration",
+			configuration: "",
+			expectError:   false,
+		},
+		{
+			name:          "JSON configuration",
+			configuration: `{"replicaCount": 3}`,
+			expectError:   false,
+		},
+		{
+			name:          "YAML configuration",
+			configuration: "replicaCount: 3\ntolerations:\n- key: dedicated\n",
+			expectError:   false,
+		},
+		{
+			name:          "malformed configuration",
+			configuration: `{"replicaCount": 3`,
+			expectError:   true,
+		},
+		{
+			name:          "configuration isn't an object",
+			configuration: "- replicaCount",
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mcp := &AWSManagedControlPlane{
+				Spec: AWSManagedControlPlaneSpec{
+					EKSClusterName: "default_cluster1",
+					Version:        ptr.To("v1.30.0"),
+					Addons: &[]Addon{
+						{Name: "coredns", Version: "v1.11.1-eksbuild.4", Configuration: tc.configuration},
+					},
+				},
+			}
+
+			_, err := (&awsManagedControlPlaneWebhook{}).ValidateCreate(context.Background(), mcp)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
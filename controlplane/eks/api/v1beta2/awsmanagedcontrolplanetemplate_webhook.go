@@ -67,11 +67,14 @@ func (*awsManagedControlPlaneTemplateWebhook) ValidateCreate(_ context.Context,
 	allErrs = append(allErrs, r.validateEKSVersion(nil)...)
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
+	allErrs = append(allErrs, r.validateAccessConfig()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAutoMode()...)
 	allErrs = append(allErrs, r.Spec.Template.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validateNetwork()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
@@ -109,11 +112,14 @@ func (*awsManagedControlPlaneTemplateWebhook) ValidateUpdate(ctx context.Context
 	allErrs = append(allErrs, r.validateEKSVersion(oldAWSManagedControlplaneTemplate)...)
 	allErrs = append(allErrs, r.Spec.Template.Spec.Bastion.Validate()...)
 	allErrs = append(allErrs, r.validateIAMAuthConfig()...)
+	allErrs = append(allErrs, r.validateAccessConfig()...)
+	allErrs = append(allErrs, r.validatePodIdentityAssociations()...)
 	allErrs = append(allErrs, r.validateSecondaryCIDR()...)
 	allErrs = append(allErrs, r.validateEKSAddons()...)
 	allErrs = append(allErrs, r.validateDisableVPCCNI()...)
 	allErrs = append(allErrs, r.validateRestrictPrivateSubnets()...)
 	allErrs = append(allErrs, r.validateKubeProxy()...)
+	allErrs = append(allErrs, r.validateAutoMode()...)
 	allErrs = append(allErrs, r.Spec.Template.Spec.AdditionalTags.Validate()...)
 	allErrs = append(allErrs, r.validatePrivateDNSHostnameTypeOnLaunch()...)
 
@@ -212,6 +218,14 @@ func (r *AWSManagedControlPlaneTemplate) validateIAMAuthConfig() field.ErrorList
 	return validateIAMAuthConfig(r.Spec.Template.Spec.IAMAuthenticatorConfig, field.NewPath("spec.template.spec.iamAuthenticatorConfig"))
 }
 
+func (r *AWSManagedControlPlaneTemplate) validateAccessConfig() field.ErrorList {
+	return validateAccessConfig(r.Spec.Template.Spec.AccessConfig, nil, r.Spec.Template.Spec.AccessEntries, r.Spec.Template.Spec.IAMAuthenticatorConfig, field.NewPath("spec", "template", "spec"))
+}
+
+func (r *AWSManagedControlPlaneTemplate) validatePodIdentityAssociations() field.ErrorList {
+	return validatePodIdentityAssociations(r.Spec.Template.Spec.PodIdentityAssociations, field.NewPath("spec", "template", "spec", "podIdentityAssociations"))
+}
+
 func (r *AWSManagedControlPlaneTemplate) validateSecondaryCIDR() field.ErrorList {
 	return validateSecondaryCIDR(r.Spec.Template.Spec.SecondaryCidrBlock, field.NewPath("spec", "template", "spec", "secondaryCidrBlock"))
 }
@@ -220,6 +234,10 @@ func (r *AWSManagedControlPlaneTemplate) validateEKSAddons() field.ErrorList {
 	return validateEKSAddons(r.Spec.Template.Spec.Version, r.Spec.Template.Spec.NetworkSpec, r.Spec.Template.Spec.Addons, field.NewPath("spec.template.spec"))
 }
 
+func (r *AWSManagedControlPlaneTemplate) validateAutoMode() field.ErrorList {
+	return validateAutoMode(r.Spec.Template.Spec.AutoMode, nil, r.Spec.Template.Spec.AccessConfig, r.Spec.Template.Spec.VpcCni, r.Spec.Template.Spec.KubeProxy, field.NewPath("spec.template.spec"))
+}
+
 func (r *AWSManagedControlPlaneTemplate) validateDisableVPCCNI() field.ErrorList {
 	return validateDisableVPCCNI(r.Spec.Template.Spec.VpcCni, r.Spec.Template.Spec.Addons, field.NewPath("spec.template.spec"))
 }
@@ -52,9 +52,43 @@ const (
 	EKSAddonsConfiguredFailedReason = "EKSAddonsConfiguredFailed"
 )
 
+const (
+	// EKSAddonsConfigurationValidCondition condition reports on whether the configuration of the EKS addons
+	// is valid according to the configuration schema of the addon versions. It is only set when addons have a configuration.
+	EKSAddonsConfigurationValidCondition clusterv1.ConditionType = "EKSAddonsConfigurationValid"
+	// EKSAddonsConfigurationInvalidReason used to report that the configuration of an addon doesn't match its schema.
+	EKSAddonsConfigurationInvalidReason = "EKSAddonsConfigurationInvalid"
+	// EKSAddonsConfigurationSchemaFailedReason used to report failures while getting the configuration schema of an addon.
+	EKSAddonsConfigurationSchemaFailedReason = "EKSAddonsConfigurationSchemaFailed"
+)
+
 const (
 	// EKSIdentityProviderConfiguredCondition condition reports on the successful association of identity provider config.
 	EKSIdentityProviderConfiguredCondition clusterv1.ConditionType = "EKSIdentityProviderConfigured"
 	// EKSIdentityProviderConfiguredFailedReason used to report failures while reconciling the identity provider config association.
 	EKSIdentityProviderConfiguredFailedReason = "EKSIdentityProviderConfiguredFailed"
 )
+
+const (
+	// EKSAccessEntriesConfiguredCondition condition reports on the successful reconciliation of EKS access entries.
+	EKSAccessEntriesConfiguredCondition clusterv1.ConditionType = "EKSAccessEntriesConfigured"
+	// EKSAccessEntriesConfiguredFailedReason used to report failures while reconciling the EKS access entries.
+	EKSAccessEntriesConfiguredFailedReason = "EKSAccessEntriesConfiguredFailed"
+)
+
+const (
+	// EKSPodIdentityAssociationsConfiguredCondition condition reports on the successful reconciliation of EKS pod identity associations.
+	EKSPodIdentityAssociationsConfiguredCondition clusterv1.ConditionType = "EKSPodIdentityAssociationsConfigured"
+	// EKSPodIdentityAssociationsConfiguredFailedReason used to report failures while reconciling the EKS pod identity associations.
+	EKSPodIdentityAssociationsConfiguredFailedReason = "EKSPodIdentityAssociationsConfiguredFailed"
+)
+
+const (
+	// EKSUpgradeInsightsPassedCondition condition reports on whether the EKS upgrade insights of the
+	// next Kubernetes version allow upgrading the control plane. It is only set while an upgrade is pending.
+	EKSUpgradeInsightsPassedCondition clusterv1.ConditionType = "EKSUpgradeInsightsPassed"
+	// EKSUpgradeBlockedByInsightsReason used to report that upgrade insights with errors block the upgrade.
+	EKSUpgradeBlockedByInsightsReason = "EKSUpgradeBlockedByInsights"
+	// EKSUpgradeInsightsCheckFailedReason used to report failures while listing the upgrade insights.
+	EKSUpgradeInsightsCheckFailedReason = "EKSUpgradeInsightsCheckFailed"
+)
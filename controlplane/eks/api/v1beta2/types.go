@@ -84,6 +84,18 @@ var (
 	// if no other role is supplied in the spec and if iam role creation is not enabled. The default
 	// can be created using clusterawsadm or created manually.
 	DefaultEKSControlPlaneRole = fmt.Sprintf("eks-controlplane%s", iamv1.DefaultNameSuffix)
+
+	// DefaultEKSAutoModeNodeRole is the name of the default IAM role to use for the nodes of the
+	// built-in EKS Auto Mode node pools if no other role is supplied in the spec and if iam role
+	// creation is not enabled.
+	DefaultEKSAutoModeNodeRole = fmt.Sprintf("eks-auto-mode-node%s", iamv1.DefaultNameSuffix)
+)
+
+const (
+	// SkipUpgradeInsightsAnnotation is the name of an annotation on the AWSManagedControlPlane that
+	// forces upgrades of the control plane when EKS upgrade insights report errors for the next
+	// Kubernetes version.
+	SkipUpgradeInsightsAnnotation = "controlplane.cluster.x-k8s.io/awsmanagedcontrolplane-skip-upgrade-insights"
 )
 
 // IAMAuthenticatorConfig represents an aws-iam-authenticator configuration.
@@ -128,9 +140,19 @@ type Addon struct {
 	// +kubebuilder:validation:MinLength:=2
 	// +kubebuilder:validation:Required
 	Name string `json:"name"`
-	// Version is the version of the addon to use
-	Version string `json:"version"`
-	// Configuration of the EKS addon
+	// Version is the version of the addon to use. It is required unless the
+	// version policy is latest-compatible.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// VersionPolicy defines how the version of the addon is chosen. With pinned,
+	// the version of the addon is used. With latest-compatible, the latest version
+	// of the addon that is compatible with the Kubernetes version of the cluster is
+	// used, and the addon is upgraded along with the control plane. Defaults to pinned.
+	// +kubebuilder:validation:Enum=pinned;latest-compatible
+	// +optional
+	VersionPolicy *AddonVersionPolicy `json:"versionPolicy,omitempty"`
+	// Configuration of the EKS addon as a JSON or YAML object. It is validated against the
+	// configuration schema of the addon version before the addon is created or updated.
 	// +optional
 	Configuration string `json:"configuration,omitempty"`
 	// ConflictResolution is used to declare what should happen if there
@@ -160,6 +182,24 @@ var (
 	AddonResolutionPreserve = AddonResolution("preserve")
 )
 
+// LatestCompatible returns whether the latest version of the addon that is compatible
+// with the Kubernetes version of the cluster is used.
+func (a *Addon) LatestCompatible() bool {
+	return a.VersionPolicy != nil && *a.VersionPolicy == AddonVersionPolicyLatestCompatible
+}
+
+// AddonVersionPolicy defines how the version of an addon is chosen.
+type AddonVersionPolicy string
+
+var (
+	// AddonVersionPolicyPinned indicates that the version of the addon in the spec is used.
+	AddonVersionPolicyPinned = AddonVersionPolicy("pinned")
+
+	// AddonVersionPolicyLatestCompatible indicates that the latest version of the addon
+	// that is compatible with the Kubernetes version of the cluster is used.
+	AddonVersionPolicyLatestCompatible = AddonVersionPolicy("latest-compatible")
+)
+
 // AddonStatus defines the status for an addon.
 type AddonStatus string
 
@@ -283,3 +323,376 @@ type OIDCIdentityProviderConfig struct {
 	// +optional
 	Tags infrav1.Tags `json:"tags,omitempty"`
 }
+
+// EKSAuthenticationMode defines the authentication mode for the cluster.
+type EKSAuthenticationMode string
+
+var (
+	// EKSAuthenticationModeConfigMap indicates that only the aws-auth ConfigMap is used
+	// to authenticate IAM principals.
+	EKSAuthenticationModeConfigMap = EKSAuthenticationMode("CONFIG_MAP")
+
+	// EKSAuthenticationModeAPIAndConfigMap indicates that both access entries and the
+	// aws-auth ConfigMap are used to authenticate IAM principals.
+	EKSAuthenticationModeAPIAndConfigMap = EKSAuthenticationMode("API_AND_CONFIG_MAP")
+
+	// EKSAuthenticationModeAPI indicates that only access entries are used to
+	// authenticate IAM principals.
+	EKSAuthenticationModeAPI = EKSAuthenticationMode("API")
+)
+
+// UsesAccessEntries returns true if the authentication mode enables the EKS access entry API.
+func (m EKSAuthenticationMode) UsesAccessEntries() bool {
+	return m == EKSAuthenticationModeAPI || m == EKSAuthenticationModeAPIAndConfigMap
+}
+
+// UsesConfigMap returns true if the authentication mode reads the aws-auth ConfigMap.
+func (m EKSAuthenticationMode) UsesConfigMap() bool {
+	return m == "" || m == EKSAuthenticationModeConfigMap || m == EKSAuthenticationModeAPIAndConfigMap
+}
+
+// AccessConfig represents the access configuration information for the cluster.
+type AccessConfig struct {
+	// AuthenticationMode specifies the desired authentication mode for the cluster.
+	// The mode can only be moved forward from CONFIG_MAP to API_AND_CONFIG_MAP and
+	// then to API; it can never be switched back to a previous mode.
+	// Defaults to CONFIG_MAP
+	// +kubebuilder:default=CONFIG_MAP
+	// +kubebuilder:validation:Enum=CONFIG_MAP;API;API_AND_CONFIG_MAP
+	AuthenticationMode EKSAuthenticationMode `json:"authenticationMode,omitempty"`
+
+	// BootstrapClusterCreatorAdminPermissions grants cluster admin permissions
+	// to the IAM identity creating the cluster. Only applied during creation,
+	// ignored when updating existing clusters. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	BootstrapClusterCreatorAdminPermissions *bool `json:"bootstrapClusterCreatorAdminPermissions,omitempty"`
+}
+
+// AccessEntryType defines the type of an access entry.
+type AccessEntryType string
+
+var (
+	// AccessEntryTypeStandard is a standard access entry for IAM users and roles.
+	AccessEntryTypeStandard = AccessEntryType("STANDARD")
+
+	// AccessEntryTypeEC2Linux is an access entry for the IAM role of self-managed Linux nodes.
+	AccessEntryTypeEC2Linux = AccessEntryType("EC2_LINUX")
+
+	// AccessEntryTypeEC2Windows is an access entry for the IAM role of self-managed Windows nodes.
+	AccessEntryTypeEC2Windows = AccessEntryType("EC2_WINDOWS")
+
+	// AccessEntryTypeFargateLinux is an access entry for a Fargate pod execution role.
+	AccessEntryTypeFargateLinux = AccessEntryType("FARGATE_LINUX")
+)
+
+// AccessEntry represents an AWS EKS access entry for an IAM principal.
+type AccessEntry struct {
+	// PrincipalARN is the Amazon Resource Name (ARN) of the IAM principal
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=20
+	PrincipalARN string `json:"principalARN"`
+
+	// Type is the type of the access entry. Defaults to STANDARD.
+	// +kubebuilder:default=STANDARD
+	// +kubebuilder:validation:Enum=STANDARD;EC2_LINUX;EC2_WINDOWS;FARGATE_LINUX
+	// +optional
+	Type AccessEntryType `json:"type,omitempty"`
+
+	// KubernetesGroups represents the Kubernetes groups for the access entry.
+	// Cannot be specified if Type is not STANDARD.
+	// +optional
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+
+	// Username represents the Kubernetes username for the access entry.
+	// If not specified, EKS generates one. Cannot be specified if Type is not STANDARD.
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// AccessPolicies specifies the EKS access policies to associate with the access entry.
+	// Cannot be specified if Type is not STANDARD.
+	// +optional
+	AccessPolicies []AccessPolicyReference `json:"accessPolicies,omitempty"`
+}
+
+// AccessPolicyReference represents an EKS access policy associated with an access entry.
+type AccessPolicyReference struct {
+	// PolicyARN is the Amazon Resource Name (ARN) of the access policy, for example
+	// arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=20
+	PolicyARN string `json:"policyARN"`
+
+	// AccessScope specifies the scope for the policy.
+	// +kubebuilder:validation:Required
+	AccessScope AccessScope `json:"accessScope"`
+}
+
+// AccessScopeType defines the scope type for an access policy.
+type AccessScopeType string
+
+var (
+	// AccessScopeTypeCluster grants the policy permissions across the whole cluster.
+	AccessScopeTypeCluster = AccessScopeType("cluster")
+
+	// AccessScopeTypeNamespace grants the policy permissions only in the listed namespaces.
+	AccessScopeTypeNamespace = AccessScopeType("namespace")
+)
+
+// AccessScope represents the scope for an access policy.
+type AccessScope struct {
+	// Type is the type of access scope. Defaults to "cluster".
+	// +kubebuilder:default=cluster
+	// +kubebuilder:validation:Enum=cluster;namespace
+	// +optional
+	Type AccessScopeType `json:"type,omitempty"`
+
+	// Namespaces are the namespaces for the access scope.
+	// Only valid when Type is namespace.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AccessEntryState represents the observed state of an access entry managed
+// from the control plane spec.
+type AccessEntryState struct {
+	// PrincipalARN is the ARN of the IAM principal of the access entry
+	PrincipalARN string `json:"principalARN"`
+	// ARN is the AWS ARN of the access entry
+	ARN string `json:"arn,omitempty"`
+	// Type is the type of the access entry
+	Type string `json:"type,omitempty"`
+	// Username is the Kubernetes username the principal is mapped to
+	Username string `json:"username,omitempty"`
+	// KubernetesGroups is the list of Kubernetes groups the principal is mapped to
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+	// AccessPolicies is the list of ARNs of the access policies associated with the entry
+	AccessPolicies []string `json:"accessPolicies,omitempty"`
+	// CreatedAt is the date and time the access entry was created at
+	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	// ModifiedAt is the date and time the access entry was last modified
+	ModifiedAt metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// PodIdentityAssociation represents an EKS Pod Identity association between a Kubernetes
+// service account and an IAM role.
+type PodIdentityAssociation struct {
+	// ServiceAccountNamespace is the namespace of the Kubernetes service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+
+	// ServiceAccountName is the name of the Kubernetes service account.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// RoleARN is the ARN of an existing IAM role that the service account can assume.
+	// Exactly one of RoleARN or Role must be specified.
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// Role specifies an IAM role to create and manage for the association. The trust
+	// policy of the role allows EKS Pod Identity to assume it. Creating the role
+	// requires the EKSEnableIAM feature flag.
+	// Exactly one of RoleARN or Role must be specified.
+	// +optional
+	Role *PodIdentityRole `json:"role,omitempty"`
+}
+
+// PodIdentityRole defines an IAM role created for a pod identity association.
+type PodIdentityRole struct {
+	// Name is the name of the IAM role. If not specified a name is generated
+	// from the cluster name, namespace and service account name.
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Path sets the path to the role.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// PermissionsBoundary is the ARN of the policy used to set the permissions boundary for the role.
+	// +optional
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+
+	// Policies are the ARNs of the managed IAM policies to attach to the role.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+}
+
+// PodIdentityAssociationStatus represents the observed state of a pod identity
+// association managed from the control plane spec.
+type PodIdentityAssociationStatus struct {
+	// ServiceAccountNamespace is the namespace of the Kubernetes service account
+	ServiceAccountNamespace string `json:"serviceAccountNamespace"`
+	// ServiceAccountName is the name of the Kubernetes service account
+	ServiceAccountName string `json:"serviceAccountName"`
+	// AssociationID is the ID of the pod identity association
+	AssociationID string `json:"associationID,omitempty"`
+	// AssociationARN is the ARN of the pod identity association
+	AssociationARN string `json:"associationARN,omitempty"`
+	// RoleARN is the ARN of the IAM role associated with the service account
+	RoleARN string `json:"roleARN,omitempty"`
+	// Ready is true when the association exists and uses the desired role
+	Ready bool `json:"ready"`
+	// FailureMessage is the error encountered when reconciling the association
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+}
+
+// AutoMode configures EKS Auto Mode, in which EKS manages the compute, block storage and
+// elastic load balancing capabilities of the cluster.
+type AutoMode struct {
+	// Enabled enables the compute, block storage and elastic load balancing capabilities
+	// of EKS Auto Mode.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Compute configures the compute capability of EKS Auto Mode.
+	// +optional
+	Compute AutoModeCompute `json:"compute,omitempty"`
+}
+
+// AutoModeNodePool is a built-in node pool of EKS Auto Mode.
+// +kubebuilder:validation:Enum=general-purpose;system
+type AutoModeNodePool string
+
+const (
+	// AutoModeNodePoolGeneralPurpose is the node pool for general purpose workloads.
+	AutoModeNodePoolGeneralPurpose = AutoModeNodePool("general-purpose")
+
+	// AutoModeNodePoolSystem is the node pool for critical add-ons, tainted with CriticalAddonsOnly.
+	AutoModeNodePoolSystem = AutoModeNodePool("system")
+)
+
+// AutoModeCompute configures the compute capability of EKS Auto Mode.
+type AutoModeCompute struct {
+	// NodePools are the built-in node pools to create. Without built-in node pools, EKS Auto Mode
+	// only launches nodes for the node pools created in the cluster.
+	// +optional
+	NodePools []AutoModeNodePool `json:"nodePools,omitempty"`
+
+	// NodeRoleName specifies the name of the IAM role assigned to the nodes of the built-in
+	// node pools. If the role is pre-existing we will treat it as unmanaged and not delete it
+	// on deletion. If the EKSEnableIAM feature flag is true and no name is supplied then a role
+	// is created. The role can't be changed once the compute capability is enabled.
+	// +kubebuilder:validation:MinLength:=2
+	// +optional
+	NodeRoleName *string `json:"nodeRoleName,omitempty"`
+}
+
+// SupportType is the support type of the Kubernetes version of an EKS cluster.
+// +kubebuilder:validation:Enum=STANDARD;EXTENDED
+type SupportType string
+
+const (
+	// SupportTypeStandard ends the support of the cluster with the standard support of its
+	// Kubernetes version, after which the cluster is upgraded automatically.
+	SupportTypeStandard = SupportType("STANDARD")
+
+	// SupportTypeExtended keeps the cluster on its Kubernetes version during extended support,
+	// which is charged additionally.
+	SupportTypeExtended = SupportType("EXTENDED")
+)
+
+// UpgradePolicy sets the support policy of the Kubernetes version of an EKS cluster.
+type UpgradePolicy struct {
+	// SupportType is the support type of the Kubernetes version of the cluster.
+	// +kubebuilder:validation:Required
+	SupportType SupportType `json:"supportType"`
+}
+
+// ZonalShiftConfig configures Amazon Application Recovery Controller (ARC) zonal shift for an
+// EKS cluster.
+type ZonalShiftConfig struct {
+	// Enabled enables zonal shift for the cluster.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// UpgradeStepPhase is the phase of a step of an upgrade of an EKS cluster.
+type UpgradeStepPhase string
+
+var (
+	// UpgradeStepPhasePending indicates that the step waits for the previous steps to complete.
+	UpgradeStepPhasePending = UpgradeStepPhase("Pending")
+
+	// UpgradeStepPhaseInProgress indicates that the step is being carried out.
+	UpgradeStepPhaseInProgress = UpgradeStepPhase("InProgress")
+
+	// UpgradeStepPhaseCompleted indicates that the step is complete.
+	UpgradeStepPhaseCompleted = UpgradeStepPhase("Completed")
+
+	// UpgradeStepPhaseFailed indicates that the step failed and doesn't complete until the cause
+	// of the failure is fixed.
+	UpgradeStepPhaseFailed = UpgradeStepPhase("Failed")
+)
+
+// NodegroupsUpgradeStepName is the name of the step of an upgrade that upgrades the node groups
+// of the cluster.
+const NodegroupsUpgradeStepName = "nodegroups_upgrade"
+
+// UpgradeStep reports the progress of a step of an upgrade of an EKS cluster.
+type UpgradeStep struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+	// Phase is the phase of the step.
+	Phase UpgradeStepPhase `json:"phase"`
+	// Message describes the progress of the step.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// UpgradeStatus reports the progress of an upgrade of the Kubernetes version of an EKS cluster.
+type UpgradeStatus struct {
+	// Version is the Kubernetes version the cluster is upgraded to.
+	Version string `json:"version"`
+	// Steps are the steps of the upgrade, in the order they are carried out.
+	// +optional
+	Steps []UpgradeStep `json:"steps,omitempty"`
+}
+
+// InProgress returns whether the upgrade has steps which aren't complete.
+func (s *UpgradeStatus) InProgress() bool {
+	if s == nil {
+		return false
+	}
+	for _, step := range s.Steps {
+		if step.Phase != UpgradeStepPhaseCompleted {
+			return true
+		}
+	}
+	return false
+}
+
+// HoldsNodegroupUpgrades returns whether node groups have to keep their Kubernetes version, which
+// is the case until all steps before the node group step are complete, so that node groups never run
+// a newer version than the control plane and are never upgraded before the addons.
+func (s *UpgradeStatus) HoldsNodegroupUpgrades() bool {
+	if s == nil {
+		return false
+	}
+	for _, step := range s.Steps {
+		if step.Name == NodegroupsUpgradeStepName {
+			return false
+		}
+		if step.Phase != UpgradeStepPhaseCompleted {
+			return true
+		}
+	}
+	return false
+}
+
+// UpgradesNodegroups returns whether the node groups are being upgraded to the version of the upgrade.
+func (s *UpgradeStatus) UpgradesNodegroups() bool {
+	if s == nil {
+		return false
+	}
+	for _, step := range s.Steps {
+		if step.Name == NodegroupsUpgradeStepName {
+			return step.Phase == UpgradeStepPhaseInProgress
+		}
+	}
+	return false
+}
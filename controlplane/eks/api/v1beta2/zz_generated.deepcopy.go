@@ -146,6 +146,18 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 		*out = new(IAMAuthenticatorConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AccessConfig != nil {
+		in, out := &in.AccessConfig, &out.AccessConfig
+		*out = new(AccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessEntries != nil {
+		in, out := &in.AccessEntries, &out.AccessEntries
+		*out = make([]AccessEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.EndpointAccess.DeepCopyInto(&out.EndpointAccess)
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	in.Bastion.DeepCopyInto(&out.Bastion)
@@ -165,6 +177,13 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 			}
 		}
 	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.OIDCIdentityProviderConfig != nil {
 		in, out := &in.OIDCIdentityProviderConfig, &out.OIDCIdentityProviderConfig
 		*out = new(OIDCIdentityProviderConfig)
@@ -172,6 +191,21 @@ func (in *AWSManagedControlPlaneSpec) DeepCopyInto(out *AWSManagedControlPlaneSp
 	}
 	in.VpcCni.DeepCopyInto(&out.VpcCni)
 	out.KubeProxy = in.KubeProxy
+	if in.AutoMode != nil {
+		in, out := &in.AutoMode, &out.AutoMode
+		*out = new(AutoMode)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		**out = **in
+	}
+	if in.ZonalShiftConfig != nil {
+		in, out := &in.ZonalShiftConfig, &out.ZonalShiftConfig
+		*out = new(ZonalShiftConfig)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSManagedControlPlaneSpec.
@@ -226,6 +260,25 @@ func (in *AWSManagedControlPlaneStatus) DeepCopyInto(out *AWSManagedControlPlane
 		}
 	}
 	out.IdentityProviderStatus = in.IdentityProviderStatus
+	if in.AccessEntries != nil {
+		in, out := &in.AccessEntries, &out.AccessEntries
+		*out = make([]AccessEntryState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodIdentityAssociations != nil {
+		in, out := &in.PodIdentityAssociations, &out.PodIdentityAssociations
+		*out = make([]PodIdentityAssociationStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Version != nil {
 		in, out := &in.Version, &out.Version
 		*out = new(string)
@@ -333,9 +386,124 @@ func (in *AWSManagedControlPlaneTemplateSpec) DeepCopy() *AWSManagedControlPlane
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessConfig) DeepCopyInto(out *AccessConfig) {
+	*out = *in
+	if in.BootstrapClusterCreatorAdminPermissions != nil {
+		in, out := &in.BootstrapClusterCreatorAdminPermissions, &out.BootstrapClusterCreatorAdminPermissions
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessConfig.
+func (in *AccessConfig) DeepCopy() *AccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntry) DeepCopyInto(out *AccessEntry) {
+	*out = *in
+	if in.KubernetesGroups != nil {
+		in, out := &in.KubernetesGroups, &out.KubernetesGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessPolicies != nil {
+		in, out := &in.AccessPolicies, &out.AccessPolicies
+		*out = make([]AccessPolicyReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntry.
+func (in *AccessEntry) DeepCopy() *AccessEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessEntryState) DeepCopyInto(out *AccessEntryState) {
+	*out = *in
+	if in.KubernetesGroups != nil {
+		in, out := &in.KubernetesGroups, &out.KubernetesGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessPolicies != nil {
+		in, out := &in.AccessPolicies, &out.AccessPolicies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.ModifiedAt.DeepCopyInto(&out.ModifiedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessEntryState.
+func (in *AccessEntryState) DeepCopy() *AccessEntryState {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessEntryState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPolicyReference) DeepCopyInto(out *AccessPolicyReference) {
+	*out = *in
+	in.AccessScope.DeepCopyInto(&out.AccessScope)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPolicyReference.
+func (in *AccessPolicyReference) DeepCopy() *AccessPolicyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPolicyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessScope) DeepCopyInto(out *AccessScope) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessScope.
+func (in *AccessScope) DeepCopy() *AccessScope {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
+	if in.VersionPolicy != nil {
+		in, out := &in.VersionPolicy, &out.VersionPolicy
+		*out = new(AddonVersionPolicy)
+		**out = **in
+	}
 	if in.ConflictResolution != nil {
 		in, out := &in.ConflictResolution, &out.ConflictResolution
 		*out = new(AddonResolution)
@@ -422,6 +590,47 @@ func (in *AddonState) DeepCopy() *AddonState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoMode) DeepCopyInto(out *AutoMode) {
+	*out = *in
+	in.Compute.DeepCopyInto(&out.Compute)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMode.
+func (in *AutoMode) DeepCopy() *AutoMode {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoModeCompute) DeepCopyInto(out *AutoModeCompute) {
+	*out = *in
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]AutoModeNodePool, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeRoleName != nil {
+		in, out := &in.NodeRoleName, &out.NodeRoleName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoModeCompute.
+func (in *AutoModeCompute) DeepCopy() *AutoModeCompute {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoModeCompute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControlPlaneLoggingSpec) DeepCopyInto(out *ControlPlaneLoggingSpec) {
 	*out = *in
@@ -647,6 +856,66 @@ func (in *OIDCProviderStatus) DeepCopy() *OIDCProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociation) DeepCopyInto(out *PodIdentityAssociation) {
+	*out = *in
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(PodIdentityRole)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociation.
+func (in *PodIdentityAssociation) DeepCopy() *PodIdentityAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityAssociationStatus) DeepCopyInto(out *PodIdentityAssociationStatus) {
+	*out = *in
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityAssociationStatus.
+func (in *PodIdentityAssociationStatus) DeepCopy() *PodIdentityAssociationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityAssociationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityRole) DeepCopyInto(out *PodIdentityRole) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityRole.
+func (in *PodIdentityRole) DeepCopy() *PodIdentityRole {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleMapping) DeepCopyInto(out *RoleMapping) {
 	*out = *in
@@ -663,6 +932,56 @@ func (in *RoleMapping) DeepCopy() *RoleMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]UpgradeStep, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStep) DeepCopyInto(out *UpgradeStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStep.
+func (in *UpgradeStep) DeepCopy() *UpgradeStep {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserMapping) DeepCopyInto(out *UserMapping) {
 	*out = *in
@@ -700,3 +1019,18 @@ func (in *VpcCni) DeepCopy() *VpcCni {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZonalShiftConfig) DeepCopyInto(out *ZonalShiftConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZonalShiftConfig.
+func (in *ZonalShiftConfig) DeepCopy() *ZonalShiftConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZonalShiftConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -268,6 +268,14 @@ func (r *AWSManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ct
 			infrav1.ClusterSecurityGroupsReadyCondition,
 		}
 
+		if managedScope.AuthenticationMode().UsesAccessEntries() {
+			applicableConditions = append(applicableConditions, ekscontrolplanev1.EKSAccessEntriesConfiguredCondition)
+		}
+
+		if len(managedScope.PodIdentityAssociations()) > 0 {
+			applicableConditions = append(applicableConditions, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition)
+		}
+
 		if managedScope.VPC().IsManaged(managedScope.Name()) {
 			applicableConditions = append(applicableConditions,
 				infrav1.InternetGatewayReadyCondition,
@@ -330,7 +338,11 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 	networkSvc := r.getNetworkService(managedScope)
 	ekssvc := r.getEKSService(managedScope)
 	sgService := r.getSecurityGroupService(managedScope)
-	authService := r.getIAMAuthenticatorService(managedScope, iamauth.BackendTypeConfigMap, managedScope.Client)
+	authBackend := iamauth.BackendTypeConfigMap
+	if managedScope.AuthenticationMode() == ekscontrolplanev1.EKSAuthenticationModeAPI {
+		authBackend = iamauth.BackendTypeAccessEntry
+	}
+	authService := r.getIAMAuthenticatorService(managedScope, authBackend, managedScope.Client)
 	awsnodeService := r.getAWSNodeService(managedScope)
 	kubeproxyService := r.getKubeProxyService(managedScope)
 
@@ -352,13 +364,16 @@ func (r *AWSManagedControlPlaneReconciler) reconcileNormal(ctx context.Context,
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
 	}
 
-	if err := awsnodeService.ReconcileCNI(ctx); err != nil {
-		conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
-	}
+	// With EKS Auto Mode the networking components are managed by EKS.
+	if !managedScope.AutoModeEnabled() {
+		if err := awsnodeService.ReconcileCNI(ctx); err != nil {
+			conditions.MarkFalse(managedScope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, infrav1.SecondaryCidrReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
 
-	if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		if err := kubeproxyService.ReconcileKubeProxy(ctx); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile control plane for AWSManagedControlPlane %s/%s: %w", awsManagedControlPlane.Namespace, awsManagedControlPlane.Name, err)
+		}
 	}
 
 	if feature.Gates.Enabled(feature.EventBridgeInstanceState) {
@@ -74,8 +74,26 @@ func (src *AWSMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.AWSLaunchTemplate.CapacityReservationPreference = preference
 	}
 
+	dst.Spec.AWSLaunchTemplate.CPUOptions = restored.Spec.AWSLaunchTemplate.CPUOptions
+	dst.Spec.AWSLaunchTemplate.EnclaveOptions = restored.Spec.AWSLaunchTemplate.EnclaveOptions
+	dst.Spec.AWSLaunchTemplate.ENAExpress = restored.Spec.AWSLaunchTemplate.ENAExpress
+
 	dst.Spec.DefaultInstanceWarmup = restored.Spec.DefaultInstanceWarmup
 	dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+	if restored.Spec.AWSLaunchTemplate.RootVolume != nil && dst.Spec.AWSLaunchTemplate.RootVolume != nil {
+		dst.Spec.AWSLaunchTemplate.RootVolume.Snapshot = restored.Spec.AWSLaunchTemplate.RootVolume.Snapshot
+	}
+	dst.Spec.WarmPool = restored.Spec.WarmPool
+	dst.Spec.ScheduledActions = restored.Spec.ScheduledActions
+	dst.Spec.ScalingPolicies = restored.Spec.ScalingPolicies
+	if restored.Spec.MixedInstancesPolicy != nil && dst.Spec.MixedInstancesPolicy != nil &&
+		len(restored.Spec.MixedInstancesPolicy.Overrides) == len(dst.Spec.MixedInstancesPolicy.Overrides) {
+		for i, override := range restored.Spec.MixedInstancesPolicy.Overrides {
+			dst.Spec.MixedInstancesPolicy.Overrides[i].InstanceRequirements = override.InstanceRequirements
+			dst.Spec.MixedInstancesPolicy.Overrides[i].WeightedCapacity = override.WeightedCapacity
+			dst.Spec.MixedInstancesPolicy.Overrides[i].LaunchTemplate = override.LaunchTemplate
+		}
+	}
 	return nil
 }
 
@@ -120,6 +138,9 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		}
 		dst.Spec.AWSLaunchTemplate.InstanceMetadataOptions = restored.Spec.AWSLaunchTemplate.InstanceMetadataOptions
 		dst.Spec.AWSLaunchTemplate.NonRootVolumes = restored.Spec.AWSLaunchTemplate.NonRootVolumes
+		if restored.Spec.AWSLaunchTemplate.RootVolume != nil && dst.Spec.AWSLaunchTemplate.RootVolume != nil {
+			dst.Spec.AWSLaunchTemplate.RootVolume.Snapshot = restored.Spec.AWSLaunchTemplate.RootVolume.Snapshot
+		}
 
 		if restored.Spec.AWSLaunchTemplate.PrivateDNSName != nil {
 			dst.Spec.AWSLaunchTemplate.PrivateDNSName = restored.Spec.AWSLaunchTemplate.PrivateDNSName
@@ -136,6 +157,10 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 		if preference := restored.Spec.AWSLaunchTemplate.CapacityReservationPreference; preference != "" {
 			dst.Spec.AWSLaunchTemplate.CapacityReservationPreference = preference
 		}
+
+		dst.Spec.AWSLaunchTemplate.CPUOptions = restored.Spec.AWSLaunchTemplate.CPUOptions
+		dst.Spec.AWSLaunchTemplate.EnclaveOptions = restored.Spec.AWSLaunchTemplate.EnclaveOptions
+		dst.Spec.AWSLaunchTemplate.ENAExpress = restored.Spec.AWSLaunchTemplate.ENAExpress
 	}
 	if restored.Spec.AvailabilityZoneSubnetType != nil {
 		dst.Spec.AvailabilityZoneSubnetType = restored.Spec.AvailabilityZoneSubnetType
@@ -146,6 +171,8 @@ func (src *AWSManagedMachinePool) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.RolePath = restored.Spec.RolePath
 	dst.Spec.RolePermissionsBoundary = restored.Spec.RolePermissionsBoundary
+	dst.Spec.ExternalLaunchTemplate = restored.Spec.ExternalLaunchTemplate
+	dst.Spec.NodeRepairConfig = restored.Spec.NodeRepairConfig
 
 	return nil
 }
@@ -248,6 +275,12 @@ func Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in *expinf
 	return autoConvert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(in, out, s)
 }
 
+// Convert_v1beta2_Overrides_To_v1beta1_Overrides converts the v1beta2 Overrides receiver to a v1beta1 Overrides.
+func Convert_v1beta2_Overrides_To_v1beta1_Overrides(in *expinfrav1.Overrides, out *Overrides, s apiconversion.Scope) error {
+	// InstanceRequirements, WeightedCapacity and LaunchTemplate have been added to v1beta2.
+	return autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in, out, s)
+}
+
 func Convert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in *expinfrav1.FargateProfileSpec, out *FargateProfileSpec, s apiconversion.Scope) error {
 	return autoConvert_v1beta2_FargateProfileSpec_To_v1beta1_FargateProfileSpec(in, out, s)
 }
@@ -233,11 +233,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Overrides)(nil), (*Overrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Overrides_To_v1beta1_Overrides(a.(*v1beta2.Overrides), b.(*Overrides), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*RefreshPreferences)(nil), (*v1beta2.RefreshPreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_RefreshPreferences_To_v1beta2_RefreshPreferences(a.(*RefreshPreferences), b.(*v1beta2.RefreshPreferences), scope)
 	}); err != nil {
@@ -293,6 +288,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Overrides)(nil), (*Overrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Overrides_To_v1beta1_Overrides(a.(*v1beta2.Overrides), b.(*Overrides), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.RefreshPreferences)(nil), (*RefreshPreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_RefreshPreferences_To_v1beta1_RefreshPreferences(a.(*v1beta2.RefreshPreferences), b.(*RefreshPreferences), scope)
 	}); err != nil {
@@ -415,6 +415,9 @@ func autoConvert_v1beta2_AWSLaunchTemplate_To_v1beta1_AWSLaunchTemplate(in *v1be
 	// WARNING: in.CapacityReservationID requires manual conversion: does not exist in peer-type
 	// WARNING: in.MarketType requires manual conversion: does not exist in peer-type
 	// WARNING: in.CapacityReservationPreference requires manual conversion: does not exist in peer-type
+	// WARNING: in.CPUOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnclaveOptions requires manual conversion: does not exist in peer-type
+	// WARNING: in.ENAExpress requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -524,7 +527,15 @@ func autoConvert_v1beta1_AWSMachinePoolSpec_To_v1beta2_AWSMachinePoolSpec(in *AW
 	if err := Convert_v1beta1_AWSLaunchTemplate_To_v1beta2_AWSLaunchTemplate(&in.AWSLaunchTemplate, &out.AWSLaunchTemplate, s); err != nil {
 		return err
 	}
-	out.MixedInstancesPolicy = (*v1beta2.MixedInstancesPolicy)(unsafe.Pointer(in.MixedInstancesPolicy))
+	if in.MixedInstancesPolicy != nil {
+		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
+		*out = new(v1beta2.MixedInstancesPolicy)
+		if err := Convert_v1beta1_MixedInstancesPolicy_To_v1beta2_MixedInstancesPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MixedInstancesPolicy = nil
+	}
 	out.ProviderIDList = *(*[]string)(unsafe.Pointer(&in.ProviderIDList))
 	out.DefaultCoolDown = in.DefaultCoolDown
 	if in.RefreshPreferences != nil {
@@ -556,7 +567,15 @@ func autoConvert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in *v1
 	if err := Convert_v1beta2_AWSLaunchTemplate_To_v1beta1_AWSLaunchTemplate(&in.AWSLaunchTemplate, &out.AWSLaunchTemplate, s); err != nil {
 		return err
 	}
-	out.MixedInstancesPolicy = (*MixedInstancesPolicy)(unsafe.Pointer(in.MixedInstancesPolicy))
+	if in.MixedInstancesPolicy != nil {
+		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
+		*out = new(MixedInstancesPolicy)
+		if err := Convert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MixedInstancesPolicy = nil
+	}
 	out.ProviderIDList = *(*[]string)(unsafe.Pointer(&in.ProviderIDList))
 	out.DefaultCoolDown = in.DefaultCoolDown
 	// WARNING: in.DefaultInstanceWarmup requires manual conversion: does not exist in peer-type
@@ -573,6 +592,9 @@ func autoConvert_v1beta2_AWSMachinePoolSpec_To_v1beta1_AWSMachinePoolSpec(in *v1
 	// WARNING: in.SuspendProcesses requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
 	// WARNING: in.AWSLifecycleHooks requires manual conversion: does not exist in peer-type
+	// WARNING: in.WarmPool requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScheduledActions requires manual conversion: does not exist in peer-type
+	// WARNING: in.ScalingPolicies requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -747,6 +769,8 @@ func autoConvert_v1beta2_AWSManagedMachinePoolSpec_To_v1beta1_AWSManagedMachineP
 	} else {
 		out.AWSLaunchTemplate = nil
 	}
+	// WARNING: in.ExternalLaunchTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeRepairConfig requires manual conversion: does not exist in peer-type
 	// WARNING: in.AWSLifecycleHooks requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -794,7 +818,15 @@ func autoConvert_v1beta1_AutoScalingGroup_To_v1beta2_AutoScalingGroup(in *AutoSc
 	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
 	out.DefaultCoolDown = in.DefaultCoolDown
 	out.CapacityRebalance = in.CapacityRebalance
-	out.MixedInstancesPolicy = (*v1beta2.MixedInstancesPolicy)(unsafe.Pointer(in.MixedInstancesPolicy))
+	if in.MixedInstancesPolicy != nil {
+		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
+		*out = new(v1beta2.MixedInstancesPolicy)
+		if err := Convert_v1beta1_MixedInstancesPolicy_To_v1beta2_MixedInstancesPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MixedInstancesPolicy = nil
+	}
 	out.Status = v1beta2.ASGStatus(in.Status)
 	out.Instances = *(*[]apiv1beta2.Instance)(unsafe.Pointer(&in.Instances))
 	return nil
@@ -817,7 +849,15 @@ func autoConvert_v1beta2_AutoScalingGroup_To_v1beta1_AutoScalingGroup(in *v1beta
 	out.DefaultCoolDown = in.DefaultCoolDown
 	// WARNING: in.DefaultInstanceWarmup requires manual conversion: does not exist in peer-type
 	out.CapacityRebalance = in.CapacityRebalance
-	out.MixedInstancesPolicy = (*MixedInstancesPolicy)(unsafe.Pointer(in.MixedInstancesPolicy))
+	if in.MixedInstancesPolicy != nil {
+		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
+		*out = new(MixedInstancesPolicy)
+		if err := Convert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.MixedInstancesPolicy = nil
+	}
 	out.Status = ASGStatus(in.Status)
 	out.Instances = *(*[]apiv1beta2.Instance)(unsafe.Pointer(&in.Instances))
 	// WARNING: in.CurrentlySuspendProcesses requires manual conversion: does not exist in peer-type
@@ -1023,7 +1063,17 @@ func Convert_v1beta2_ManagedRemoteAccess_To_v1beta1_ManagedRemoteAccess(in *v1be
 
 func autoConvert_v1beta1_MixedInstancesPolicy_To_v1beta2_MixedInstancesPolicy(in *MixedInstancesPolicy, out *v1beta2.MixedInstancesPolicy, s conversion.Scope) error {
 	out.InstancesDistribution = (*v1beta2.InstancesDistribution)(unsafe.Pointer(in.InstancesDistribution))
-	out.Overrides = *(*[]v1beta2.Overrides)(unsafe.Pointer(&in.Overrides))
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]v1beta2.Overrides, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Overrides_To_v1beta2_Overrides(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Overrides = nil
+	}
 	return nil
 }
 
@@ -1034,7 +1084,17 @@ func Convert_v1beta1_MixedInstancesPolicy_To_v1beta2_MixedInstancesPolicy(in *Mi
 
 func autoConvert_v1beta2_MixedInstancesPolicy_To_v1beta1_MixedInstancesPolicy(in *v1beta2.MixedInstancesPolicy, out *MixedInstancesPolicy, s conversion.Scope) error {
 	out.InstancesDistribution = (*InstancesDistribution)(unsafe.Pointer(in.InstancesDistribution))
-	out.Overrides = *(*[]Overrides)(unsafe.Pointer(&in.Overrides))
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]Overrides, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_Overrides_To_v1beta1_Overrides(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Overrides = nil
+	}
 	return nil
 }
 
@@ -1055,14 +1115,12 @@ func Convert_v1beta1_Overrides_To_v1beta2_Overrides(in *Overrides, out *v1beta2.
 
 func autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in *v1beta2.Overrides, out *Overrides, s conversion.Scope) error {
 	out.InstanceType = in.InstanceType
+	// WARNING: in.InstanceRequirements requires manual conversion: does not exist in peer-type
+	// WARNING: in.WeightedCapacity requires manual conversion: does not exist in peer-type
+	// WARNING: in.LaunchTemplate requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Overrides_To_v1beta1_Overrides is an autogenerated conversion function.
-func Convert_v1beta2_Overrides_To_v1beta1_Overrides(in *v1beta2.Overrides, out *Overrides, s conversion.Scope) error {
-	return autoConvert_v1beta2_Overrides_To_v1beta1_Overrides(in, out, s)
-}
-
 func autoConvert_v1beta1_RefreshPreferences_To_v1beta2_RefreshPreferences(in *RefreshPreferences, out *v1beta2.RefreshPreferences, s conversion.Scope) error {
 	out.Strategy = (*string)(unsafe.Pointer(in.Strategy))
 	out.InstanceWarmup = (*int64)(unsafe.Pointer(in.InstanceWarmup))
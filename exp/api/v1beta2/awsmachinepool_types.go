@@ -112,6 +112,30 @@ type AWSMachinePoolSpec struct {
 	// AWSLifecycleHooks specifies lifecycle hooks for the autoscaling group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// WarmPool specifies a warm pool of pre-initialized instances for the autoscaling group.
+	// Instances in the warm pool are not counted as replicas of the machine pool until they
+	// are moved into the autoscaling group and enter the InService state.
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// ScheduledActions specifies scheduled actions for the autoscaling group. Scheduled actions
+	// not listed here are removed from the autoscaling group.
+	// When set, the desired capacity of the autoscaling group is managed by AWS and synced back
+	// to the MachinePool replicas, as with the cluster.x-k8s.io/replicas-managed-by annotation.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ScheduledActions []AWSScheduledAction `json:"scheduledActions,omitempty"`
+
+	// ScalingPolicies specifies dynamic scaling policies for the autoscaling group. Scaling
+	// policies not listed here are removed from the autoscaling group.
+	// When set, the desired capacity of the autoscaling group is managed by AWS and synced back
+	// to the MachinePool replicas, as with the cluster.x-k8s.io/replicas-managed-by annotation.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ScalingPolicies []AWSScalingPolicy `json:"scalingPolicies,omitempty"`
 }
 
 // SuspendProcessesTypes contains user friendly auto-completable values for suspended process names.
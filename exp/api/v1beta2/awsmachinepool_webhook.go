@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -88,6 +89,10 @@ func (r *AWSMachinePool) validateRootVolume() field.ErrorList {
 		log.Info("root volume shouldn't have a device name (this can be ignored if performing a `clusterctl move`)")
 	}
 
+	if r.Spec.AWSLaunchTemplate.RootVolume.Snapshot != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.awsLaunchTemplate.rootVolume.snapshot"), "root volume can't be restored from a snapshot"))
+	}
+
 	return allErrs
 }
 
@@ -111,6 +116,10 @@ func (r *AWSMachinePool) validateNonRootVolumes() field.ErrorList {
 		if volume.DeviceName == "" {
 			allErrs = append(allErrs, field.Required(field.NewPath("spec.template.spec.nonRootVolumes.deviceName"), "non root volume should have device name"))
 		}
+
+		if volume.Snapshot != nil && volume.Snapshot.ID != nil && len(volume.Snapshot.Filters) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.template.spec.nonRootVolumes.snapshot"), "only one of ID or Filters may be specified, specifying both is forbidden"))
+		}
 	}
 
 	return allErrs
@@ -143,6 +152,14 @@ func (r *AWSMachinePool) validateAdditionalSecurityGroups() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateENAExpress() field.ErrorList {
+	var allErrs field.ErrorList
+	if enaExpress := r.Spec.AWSLaunchTemplate.ENAExpress; enaExpress != nil && enaExpress.UDPEnabled && !enaExpress.Enabled {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.awsLaunchTemplate.enaExpress.udpEnabled"), "requires spec.awsLaunchTemplate.enaExpress.enabled to be true"))
+	}
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateSpotInstances() field.ErrorList {
 	var allErrs field.ErrorList
 	if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil && r.Spec.MixedInstancesPolicy != nil {
@@ -171,10 +188,205 @@ func (r *AWSMachinePool) validateRefreshPreferences() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSMachinePool) validateMixedInstancesPolicy() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Spec.MixedInstancesPolicy == nil {
+		return allErrs
+	}
+
+	overridesPath := field.NewPath("spec", "mixedInstancesPolicy", "overrides")
+	overrides := r.Spec.MixedInstancesPolicy.Overrides
+
+	var withInstanceType, withInstanceRequirements, withWeightedCapacity int
+	for i, override := range overrides {
+		overridePath := overridesPath.Index(i)
+
+		switch {
+		case override.InstanceType != "" && override.InstanceRequirements != nil:
+			allErrs = append(allErrs, field.Forbidden(overridePath, "only one of instanceType and instanceRequirements can be set"))
+		case override.InstanceType == "" && override.InstanceRequirements == nil:
+			allErrs = append(allErrs, field.Required(overridePath, "one of instanceType and instanceRequirements must be set"))
+		}
+
+		if override.InstanceType != "" {
+			withInstanceType++
+		}
+		if override.InstanceRequirements != nil {
+			withInstanceRequirements++
+			allErrs = append(allErrs, validateInstanceRequirements(override.InstanceRequirements, overridePath.Child("instanceRequirements"))...)
+		}
+		if override.WeightedCapacity != nil {
+			withWeightedCapacity++
+		}
+
+		if lt := override.LaunchTemplate; lt != nil && (lt.ID == nil) == (lt.Name == nil) {
+			allErrs = append(allErrs, field.Invalid(overridePath.Child("launchTemplate"), lt, "exactly one of id and name must be set"))
+		}
+	}
+
+	if withInstanceType > 0 && withInstanceRequirements > 0 {
+		allErrs = append(allErrs, field.Forbidden(overridesPath, "overrides must either all use instanceType or all use instanceRequirements"))
+	}
+	if withWeightedCapacity > 0 && withWeightedCapacity != len(overrides) {
+		allErrs = append(allErrs, field.Forbidden(overridesPath, "weightedCapacity must be set for all overrides or for none"))
+	}
+
+	return allErrs
+}
+
+func validateInstanceRequirements(requirements *InstanceRequirements, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateIntegerRange(requirements.VCPUCount, path.Child("vCPUCount"))...)
+	allErrs = append(allErrs, validateIntegerRange(requirements.MemoryMiB, path.Child("memoryMiB"))...)
+	if requirements.AcceleratorCount != nil {
+		allErrs = append(allErrs, validateIntegerRange(*requirements.AcceleratorCount, path.Child("acceleratorCount"))...)
+	}
+
+	if len(requirements.AllowedInstanceTypes) > 0 && len(requirements.ExcludedInstanceTypes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("excludedInstanceTypes"), "cannot be set together with allowedInstanceTypes"))
+	}
+	if requirements.SpotMaxPricePercentageOverLowestPrice != nil && requirements.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("maxSpotPriceAsPercentageOfOptimalOnDemandPrice"), "cannot be set together with spotMaxPricePercentageOverLowestPrice"))
+	}
+
+	return allErrs
+}
+
+func validateIntegerRange(r IntegerRange, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if r.Max != nil && *r.Max < r.Min {
+		allErrs = append(allErrs, field.Invalid(path.Child("max"), *r.Max, "must be greater than or equal to min"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachinePool) validateLifecycleHooks() field.ErrorList {
 	return validateLifecycleHooks(r.Spec.AWSLifecycleHooks)
 }
 
+func (r *AWSMachinePool) validateWarmPool() field.ErrorList {
+	var allErrs field.ErrorList
+
+	warmPool := r.Spec.WarmPool
+	if warmPool == nil {
+		return allErrs
+	}
+
+	warmPoolPath := field.NewPath("spec", "warmPool")
+	if r.Spec.MixedInstancesPolicy != nil {
+		allErrs = append(allErrs, field.Forbidden(warmPoolPath, "warm pools cannot be used together with spec.mixedInstancesPolicy"))
+	}
+	if r.Spec.AWSLaunchTemplate.SpotMarketOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(warmPoolPath, "warm pools cannot be used together with spec.awsLaunchTemplate.spotMarketOptions"))
+	}
+
+	if warmPool.MaxGroupPreparedCapacity != nil && *warmPool.MaxGroupPreparedCapacity != -1 {
+		if *warmPool.MaxGroupPreparedCapacity < ptr.Deref(warmPool.MinSize, 0) {
+			allErrs = append(allErrs, field.Invalid(warmPoolPath.Child("maxGroupPreparedCapacity"), *warmPool.MaxGroupPreparedCapacity, "must be -1 or greater than or equal to spec.warmPool.minSize"))
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScheduledActions() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, action := range r.Spec.ScheduledActions {
+		actionPath := field.NewPath("spec", "scheduledActions").Index(i)
+
+		if names[action.Name] {
+			allErrs = append(allErrs, field.Duplicate(actionPath.Child("name"), action.Name))
+		}
+		names[action.Name] = true
+
+		if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+			allErrs = append(allErrs, field.Required(actionPath, "at least one of minSize, maxSize or desiredCapacity must be set"))
+		}
+		if action.Recurrence == nil && action.StartTime == nil {
+			allErrs = append(allErrs, field.Required(actionPath, "at least one of recurrence or startTime must be set"))
+		}
+		if action.TimeZone != nil && action.Recurrence == nil {
+			allErrs = append(allErrs, field.Forbidden(actionPath.Child("timeZone"), "can be set only together with recurrence"))
+		}
+		if action.StartTime != nil && action.EndTime != nil && !action.EndTime.After(action.StartTime.Time) {
+			allErrs = append(allErrs, field.Invalid(actionPath.Child("endTime"), action.EndTime, "must be after startTime"))
+		}
+		if action.MinSize != nil && action.MaxSize != nil && *action.MinSize > *action.MaxSize {
+			allErrs = append(allErrs, field.Invalid(actionPath.Child("minSize"), *action.MinSize, "must be less than or equal to maxSize"))
+		}
+		if action.DesiredCapacity != nil {
+			if action.MinSize != nil && *action.DesiredCapacity < *action.MinSize {
+				allErrs = append(allErrs, field.Invalid(actionPath.Child("desiredCapacity"), *action.DesiredCapacity, "must be greater than or equal to minSize"))
+			}
+			if action.MaxSize != nil && *action.DesiredCapacity > *action.MaxSize {
+				allErrs = append(allErrs, field.Invalid(actionPath.Child("desiredCapacity"), *action.DesiredCapacity, "must be less than or equal to maxSize"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (r *AWSMachinePool) validateScalingPolicies() field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, policy := range r.Spec.ScalingPolicies {
+		policyPath := field.NewPath("spec", "scalingPolicies").Index(i)
+
+		if names[policy.Name] {
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("name"), policy.Name))
+		}
+		names[policy.Name] = true
+
+		switch policy.PolicyType {
+		case ScalingPolicyTypeTargetTracking:
+			if policy.TargetTracking == nil {
+				allErrs = append(allErrs, field.Required(policyPath.Child("targetTracking"), "is required for TargetTrackingScaling policies"))
+			}
+			if policy.StepScaling != nil {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("stepScaling"), "cannot be set for TargetTrackingScaling policies"))
+			}
+		case ScalingPolicyTypeStep:
+			if policy.StepScaling == nil {
+				allErrs = append(allErrs, field.Required(policyPath.Child("stepScaling"), "is required for StepScaling policies"))
+			}
+			if policy.TargetTracking != nil {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("targetTracking"), "cannot be set for StepScaling policies"))
+			}
+		}
+
+		if tt := policy.TargetTracking; tt != nil {
+			if tt.PredefinedMetricType == PredefinedMetricTypeALBRequestCountPerTarget && tt.ResourceLabel == nil {
+				allErrs = append(allErrs, field.Required(policyPath.Child("targetTracking", "resourceLabel"), "is required for the ALBRequestCountPerTarget metric"))
+			}
+			if tt.PredefinedMetricType != PredefinedMetricTypeALBRequestCountPerTarget && tt.ResourceLabel != nil {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("targetTracking", "resourceLabel"), "can be set only for the ALBRequestCountPerTarget metric"))
+			}
+		}
+
+		if step := policy.StepScaling; step != nil {
+			if step.MinAdjustmentMagnitude != nil && step.AdjustmentType != AdjustmentTypePercentChangeInCapacity {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("stepScaling", "minAdjustmentMagnitude"), "can be set only for the PercentChangeInCapacity adjustment type"))
+			}
+			for j, adjustment := range step.StepAdjustments {
+				if adjustment.MetricIntervalLowerBound != nil && adjustment.MetricIntervalUpperBound != nil &&
+					*adjustment.MetricIntervalLowerBound >= *adjustment.MetricIntervalUpperBound {
+					allErrs = append(allErrs, field.Invalid(policyPath.Child("stepScaling", "stepAdjustments").Index(j).Child("metricIntervalUpperBound"), *adjustment.MetricIntervalUpperBound, "must be greater than metricIntervalLowerBound"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func (r *AWSMachinePool) ignitionEnabled() bool {
 	return r.Spec.Ignition != nil
 }
@@ -209,10 +421,15 @@ func (*AWSMachinePoolWebhook) ValidateCreate(_ context.Context, obj runtime.Obje
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateENAExpress()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 	allErrs = append(allErrs, r.validateRefreshPreferences()...)
 	allErrs = append(allErrs, r.validateInstanceMarketType()...)
 	allErrs = append(allErrs, r.validateCapacityReservation()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
 	allErrs = append(allErrs, r.validateIgnition()...)
 
 	if len(allErrs) == 0 {
@@ -278,8 +495,13 @@ func (*AWSMachinePoolWebhook) ValidateUpdate(_ context.Context, oldObj, newObj r
 	allErrs = append(allErrs, r.validateSubnets()...)
 	allErrs = append(allErrs, r.validateAdditionalSecurityGroups()...)
 	allErrs = append(allErrs, r.validateSpotInstances()...)
+	allErrs = append(allErrs, r.validateENAExpress()...)
+	allErrs = append(allErrs, r.validateMixedInstancesPolicy()...)
 	allErrs = append(allErrs, r.validateRefreshPreferences()...)
 	allErrs = append(allErrs, r.validateLifecycleHooks()...)
+	allErrs = append(allErrs, r.validateWarmPool()...)
+	allErrs = append(allErrs, r.validateScheduledActions()...)
+	allErrs = append(allErrs, r.validateScalingPolicies()...)
 
 	if len(allErrs) == 0 {
 		return nil, nil
@@ -155,6 +155,141 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: ptr.To[string]("spotMarketOptions"),
 		},
+		{
+			name: "Should fail if ENA Express UDP is enabled without ENA Express",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						ENAExpress: &infrav1.ENAExpress{UDPEnabled: true},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("udpEnabled"),
+		},
+		{
+			name: "Should succeed on overrides with instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{
+								InstanceRequirements: &InstanceRequirements{
+									VCPUCount:                             IntegerRange{Min: 2, Max: ptr.To[int32](8)},
+									MemoryMiB:                             IntegerRange{Min: 4096},
+									CPUManufacturers:                      []CPUManufacturer{"intel", "amd"},
+									BurstablePerformance:                  InstanceRequirementExcluded,
+									AcceleratorCount:                      &IntegerRange{Min: 0, Max: ptr.To[int32](0)},
+									ExcludedInstanceTypes:                 []string{"t2.*"},
+									SpotMaxPricePercentageOverLowestPrice: ptr.To[int32](50),
+								},
+								WeightedCapacity: ptr.To[int32](1),
+								LaunchTemplate:   &LaunchTemplateReference{ID: ptr.To("lt-123"), Version: ptr.To("$Latest")},
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if an override sets both instance type and instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{
+								InstanceType:         "m5.large",
+								InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}},
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("only one of instanceType and instanceRequirements can be set"),
+		},
+		{
+			name: "Should fail if an override sets neither instance type nor instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{{WeightedCapacity: ptr.To[int32](2)}},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("one of instanceType and instanceRequirements must be set"),
+		},
+		{
+			name: "Should fail if overrides mix instance types and instance requirements",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "m5.large"},
+							{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 2}, MemoryMiB: IntegerRange{Min: 4096}}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("overrides must either all use instanceType or all use instanceRequirements"),
+		},
+		{
+			name: "Should fail if weighted capacity is only set for some overrides",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "m5.large", WeightedCapacity: ptr.To[int32](1)},
+							{InstanceType: "m5.xlarge"},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("weightedCapacity must be set for all overrides or for none"),
+		},
+		{
+			name: "Should fail if the vCPU range is inverted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceRequirements: &InstanceRequirements{VCPUCount: IntegerRange{Min: 8, Max: ptr.To[int32](4)}, MemoryMiB: IntegerRange{Min: 4096}}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.mixedInstancesPolicy.overrides[0].instanceRequirements.vCPUCount.max"),
+		},
+		{
+			name: "Should fail if allowed and excluded instance types are both set",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceRequirements: &InstanceRequirements{
+								VCPUCount:             IntegerRange{Min: 2},
+								MemoryMiB:             IntegerRange{Min: 4096},
+								AllowedInstanceTypes:  []string{"m5.*"},
+								ExcludedInstanceTypes: []string{"m5.metal"},
+							}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("cannot be set together with allowedInstanceTypes"),
+		},
+		{
+			name: "Should fail if an override launch template sets both id and name",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{
+						Overrides: []Overrides{
+							{InstanceType: "m7g.large", LaunchTemplate: &LaunchTemplateReference{ID: ptr.To("lt-123"), Name: ptr.To("arm64")}},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("exactly one of id and name must be set"),
+		},
 		{
 			name: "Should fail if MaxHealthyPercentage is set, but MinHealthyPercentage is not set",
 			pool: &AWSMachinePool{
@@ -257,6 +392,209 @@ func TestAWSMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErrToContain: nil,
 		},
+		{
+			name: "Should succeed on a valid warm pool",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					WarmPool: &WarmPool{
+						MinSize:                  ptr.To[int32](1),
+						MaxGroupPreparedCapacity: ptr.To[int32](5),
+						PoolState:                WarmPoolStateHibernated,
+						ReuseOnScaleIn:           true,
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should succeed on a warm pool sized by the ASG max size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					WarmPool: &WarmPool{
+						MinSize:                  ptr.To[int32](3),
+						MaxGroupPreparedCapacity: ptr.To[int32](-1),
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if the warm pool max prepared capacity is less than its min size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					WarmPool: &WarmPool{
+						MinSize:                  ptr.To[int32](3),
+						MaxGroupPreparedCapacity: ptr.To[int32](2),
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.warmPool.maxGroupPreparedCapacity"),
+		},
+		{
+			name: "Should fail if a warm pool is used with a mixed instances policy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					MixedInstancesPolicy: &MixedInstancesPolicy{},
+					WarmPool:             &WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("warm pools cannot be used together with spec.mixedInstancesPolicy"),
+		},
+		{
+			name: "Should fail if a warm pool is used with spot instances",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					AWSLaunchTemplate: AWSLaunchTemplate{
+						SpotMarketOptions: &infrav1.SpotMarketOptions{},
+					},
+					WarmPool: &WarmPool{},
+				},
+			},
+			wantErrToContain: ptr.To[string]("warm pools cannot be used together with spec.awsLaunchTemplate.spotMarketOptions"),
+		},
+		{
+			name: "Should succeed on a valid recurring scheduled action",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-up",
+							Recurrence:      ptr.To("0 8 * * 1-5"),
+							TimeZone:        ptr.To("Europe/Berlin"),
+							MinSize:         ptr.To[int32](2),
+							DesiredCapacity: ptr.To[int32](4),
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if a scheduled action does not change the size",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:       "noop",
+							Recurrence: ptr.To("0 8 * * *"),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("at least one of minSize, maxSize or desiredCapacity must be set"),
+		},
+		{
+			name: "Should fail if a scheduled action has no schedule",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:    "unscheduled",
+							MaxSize: ptr.To[int32](3),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("at least one of recurrence or startTime must be set"),
+		},
+		{
+			name: "Should fail if a scheduled action desired capacity is out of bounds",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{
+							Name:            "scale-down",
+							StartTime:       &metav1.Time{Time: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+							MaxSize:         ptr.To[int32](3),
+							DesiredCapacity: ptr.To[int32](5),
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scheduledActions[0].desiredCapacity"),
+		},
+		{
+			name: "Should fail on duplicate scheduled action names",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScheduledActions: []AWSScheduledAction{
+						{Name: "scale", Recurrence: ptr.To("0 8 * * *"), MinSize: ptr.To[int32](1)},
+						{Name: "scale", Recurrence: ptr.To("0 20 * * *"), MinSize: ptr.To[int32](0)},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("Duplicate value"),
+		},
+		{
+			name: "Should succeed on a valid target tracking scaling policy",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []AWSScalingPolicy{
+						{
+							Name:       "cpu",
+							PolicyType: ScalingPolicyTypeTargetTracking,
+							TargetTracking: &TargetTrackingConfiguration{
+								PredefinedMetricType: PredefinedMetricTypeASGAverageCPUUtilization,
+								TargetValue:          60,
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: nil,
+		},
+		{
+			name: "Should fail if a target tracking scaling policy has no configuration",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []AWSScalingPolicy{
+						{
+							Name:       "cpu",
+							PolicyType: ScalingPolicyTypeTargetTracking,
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].targetTracking"),
+		},
+		{
+			name: "Should fail if the ALB request count metric has no resource label",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []AWSScalingPolicy{
+						{
+							Name:       "requests",
+							PolicyType: ScalingPolicyTypeTargetTracking,
+							TargetTracking: &TargetTrackingConfiguration{
+								PredefinedMetricType: PredefinedMetricTypeALBRequestCountPerTarget,
+								TargetValue:          1000,
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].targetTracking.resourceLabel"),
+		},
+		{
+			name: "Should fail if step adjustment bounds are inverted",
+			pool: &AWSMachinePool{
+				Spec: AWSMachinePoolSpec{
+					ScalingPolicies: []AWSScalingPolicy{
+						{
+							Name:       "step",
+							PolicyType: ScalingPolicyTypeStep,
+							StepScaling: &StepScalingConfiguration{
+								AdjustmentType: AdjustmentTypeChangeInCapacity,
+								StepAdjustments: []StepAdjustment{
+									{MetricIntervalLowerBound: ptr.To[int64](10), MetricIntervalUpperBound: ptr.To[int64](0), ScalingAdjustment: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrToContain: ptr.To[string]("spec.scalingPolicies[0].stepScaling.stepAdjustments[0].metricIntervalUpperBound"),
+		},
 		{
 			name: "with invalid MarketType provided",
 			pool: &AWSMachinePool{
@@ -211,6 +211,16 @@ type AWSManagedMachinePoolSpec struct {
 	// +optional
 	AWSLaunchTemplate *AWSLaunchTemplate `json:"awsLaunchTemplate,omitempty"`
 
+	// ExternalLaunchTemplate references a launch template which is managed outside of CAPA to use
+	// to create the managed node group. The node group is updated whenever the version of the launch
+	// template selected by the version policy changes. Mutually exclusive with AWSLaunchTemplate.
+	// +optional
+	ExternalLaunchTemplate *ExternalLaunchTemplate `json:"externalLaunchTemplate,omitempty"`
+
+	// NodeRepairConfig specifies the node auto repair configuration of the managed node group.
+	// +optional
+	NodeRepairConfig *NodeRepairConfig `json:"nodeRepairConfig,omitempty"`
+
 	// AWSLifecycleHooks specifies lifecycle hooks for the managed node group.
 	// +optional
 	AWSLifecycleHooks []AWSLifecycleHook `json:"lifecycleHooks,omitempty"`
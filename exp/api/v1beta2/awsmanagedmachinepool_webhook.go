@@ -144,6 +144,26 @@ func (r *AWSManagedMachinePool) validateLaunchTemplate() field.ErrorList {
 	return allErrs
 }
 
+func (r *AWSManagedMachinePool) validateExternalLaunchTemplate() field.ErrorList {
+	var allErrs field.ErrorList
+	if r.Spec.ExternalLaunchTemplate == nil {
+		return allErrs
+	}
+
+	launchTemplatePath := field.NewPath("spec", "externalLaunchTemplate")
+	if r.Spec.AWSLaunchTemplate != nil {
+		allErrs = append(allErrs, field.Forbidden(launchTemplatePath, "cannot be specified when awsLaunchTemplate is specified"))
+	}
+	if (r.Spec.ExternalLaunchTemplate.ID == nil) == (r.Spec.ExternalLaunchTemplate.Name == nil) {
+		allErrs = append(allErrs, field.Invalid(launchTemplatePath, r.Spec.ExternalLaunchTemplate, "exactly one of id and name must be specified"))
+	}
+	if r.Spec.DiskSize != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "diskSize"), r.Spec.DiskSize, "diskSize cannot be specified when externalLaunchTemplate is specified"))
+	}
+
+	return allErrs
+}
+
 func (r *AWSManagedMachinePool) validateLifecycleHooks() field.ErrorList {
 	return validateLifecycleHooks(r.Spec.AWSLifecycleHooks)
 }
@@ -174,6 +194,9 @@ func (*awsManagedMachinePoolWebhook) ValidateCreate(_ context.Context, obj runti
 	if errs := r.validateLaunchTemplate(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateExternalLaunchTemplate(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 	if errs := r.validateLifecycleHooks(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -219,6 +242,9 @@ func (*awsManagedMachinePoolWebhook) ValidateUpdate(_ context.Context, oldObj, n
 	if errs := r.validateLaunchTemplate(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
+	if errs := r.validateExternalLaunchTemplate(); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
 	if errs := r.validateLifecycleHooks(); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -280,6 +306,16 @@ func (r *AWSManagedMachinePool) validateImmutable(old *AWSManagedMachinePool) fi
 	if old.Spec.AWSLaunchTemplate != nil && r.Spec.AWSLaunchTemplate != nil {
 		appendErrorIfMutated(old.Spec.AWSLaunchTemplate.Name, r.Spec.AWSLaunchTemplate.Name, "awsLaunchTemplate.name")
 	}
+	if (old.Spec.ExternalLaunchTemplate == nil) != (r.Spec.ExternalLaunchTemplate == nil) {
+		allErrs = append(
+			allErrs,
+			field.Invalid(field.NewPath("spec", "externalLaunchTemplate"), r.Spec.ExternalLaunchTemplate, "field is immutable"),
+		)
+	}
+	if old.Spec.ExternalLaunchTemplate != nil && r.Spec.ExternalLaunchTemplate != nil {
+		appendErrorIfMutated(old.Spec.ExternalLaunchTemplate.ID, r.Spec.ExternalLaunchTemplate.ID, "externalLaunchTemplate.id")
+		appendErrorIfMutated(old.Spec.ExternalLaunchTemplate.Name, r.Spec.ExternalLaunchTemplate.Name, "externalLaunchTemplate.name")
+	}
 
 	return allErrs
 }
@@ -143,6 +143,57 @@ func TestAWSManagedMachinePoolValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "external launch template by name is accepted",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						Name:          aws.String("node-template"),
+						VersionPolicy: LaunchTemplateVersionPolicyLatest,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "external launch template with both id and name",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID:   aws.String("lt-0123456789abcdef0"),
+						Name: aws.String("node-template"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "external launch template without id and name",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName:       "eks-node-group-3",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "external launch template together with awsLaunchTemplate",
+			pool: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-3",
+					AWSLaunchTemplate: &AWSLaunchTemplate{
+						Name: "test",
+					},
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID: aws.String("lt-0123456789abcdef0"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "minSize 0 is accepted",
 			pool: &AWSManagedMachinePool{
@@ -671,6 +722,65 @@ func TestAWSManagedMachinePoolValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "adding external launch template is rejected",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID: aws.String("lt-0123456789abcdef0"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "changing external launch template id is rejected",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID: aws.String("lt-0123456789abcdef0"),
+					},
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID: aws.String("lt-0fedcba9876543210"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "changing external launch template version policy is accepted",
+			old: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID:            aws.String("lt-0123456789abcdef0"),
+						VersionPolicy: LaunchTemplateVersionPolicyDefault,
+					},
+				},
+			},
+			new: &AWSManagedMachinePool{
+				Spec: AWSManagedMachinePoolSpec{
+					EKSNodegroupName: "eks-node-group-1",
+					ExternalLaunchTemplate: &ExternalLaunchTemplate{
+						ID:            aws.String("lt-0123456789abcdef0"),
+						VersionPolicy: LaunchTemplateVersionPolicyLatest,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "changing launch template fields other than name is accepted",
 			old: &AWSManagedMachinePool{
@@ -67,6 +67,24 @@ const (
 	LifecycleHookUpdateFailedReason = "LifecycleHookUpdateFailed"
 	// LifecycleHookDeletionFailedReason used for failures during lifecycle hook deletion.
 	LifecycleHookDeletionFailedReason = "LifecycleHookDeletionFailed"
+	// WarmPoolReadyCondition reports on the status of the warm pool.
+	WarmPoolReadyCondition clusterv1.ConditionType = "WarmPoolReady"
+	// WarmPoolUpdateFailedReason used for failures during warm pool creation or update.
+	WarmPoolUpdateFailedReason = "WarmPoolUpdateFailed"
+	// WarmPoolDeletionFailedReason used for failures during warm pool deletion.
+	WarmPoolDeletionFailedReason = "WarmPoolDeletionFailed"
+	// ScheduledActionsReadyCondition reports on the status of the scheduled actions.
+	ScheduledActionsReadyCondition clusterv1.ConditionType = "ScheduledActionsReady"
+	// ScheduledActionUpdateFailedReason used for failures during scheduled action creation or update.
+	ScheduledActionUpdateFailedReason = "ScheduledActionUpdateFailed"
+	// ScheduledActionDeletionFailedReason used for failures during scheduled action deletion.
+	ScheduledActionDeletionFailedReason = "ScheduledActionDeletionFailed"
+	// ScalingPoliciesReadyCondition reports on the status of the scaling policies.
+	ScalingPoliciesReadyCondition clusterv1.ConditionType = "ScalingPoliciesReady"
+	// ScalingPolicyUpdateFailedReason used for failures during scaling policy creation or update.
+	ScalingPolicyUpdateFailedReason = "ScalingPolicyUpdateFailed"
+	// ScalingPolicyDeletionFailedReason used for failures during scaling policy deletion.
+	ScalingPolicyDeletionFailedReason = "ScalingPolicyDeletionFailed"
 )
 
 const (
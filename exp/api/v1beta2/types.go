@@ -154,12 +154,186 @@ type AWSLaunchTemplate struct {
 	// +kubebuilder:validation:Enum="";None;CapacityReservationsOnly;Open
 	// +optional
 	CapacityReservationPreference infrav1.CapacityReservationPreference `json:"capacityReservationPreference,omitempty"`
+
+	// CPUOptions configures the CPU core count, threads per core and AMD SEV-SNP of the instances.
+	// +optional
+	CPUOptions *infrav1.CPUOptions `json:"cpuOptions,omitempty"`
+
+	// EnclaveOptions configures AWS Nitro Enclaves support of the instances.
+	// +optional
+	EnclaveOptions *infrav1.EnclaveOptions `json:"enclaveOptions,omitempty"`
+
+	// ENAExpress configures ENA Express on the primary network interface of the instances.
+	// +optional
+	ENAExpress *infrav1.ENAExpress `json:"enaExpress,omitempty"`
 }
 
 // Overrides are used to override the instance type specified by the launch template with multiple
 // instance types that can be used to launch On-Demand Instances and Spot Instances.
 type Overrides struct {
-	InstanceType string `json:"instanceType"`
+	// InstanceType is the instance type to launch.
+	// Exactly one of InstanceType and InstanceRequirements must be set.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// InstanceRequirements describes the attributes instance types must have, and lets
+	// AWS select any instance type with those attributes instead of a fixed instance type.
+	// Exactly one of InstanceType and InstanceRequirements must be set.
+	// +optional
+	InstanceRequirements *InstanceRequirements `json:"instanceRequirements,omitempty"`
+
+	// WeightedCapacity is the number of capacity units provided by the instance type in terms
+	// of the desired capacity of the autoscaling group. If set for one override, it must be
+	// set for all overrides.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	WeightedCapacity *int32 `json:"weightedCapacity,omitempty"`
+
+	// LaunchTemplate is an existing launch template to use for this override instead of the
+	// launch template managed for the AWSMachinePool, for example to use an AMI of a different
+	// architecture.
+	// +optional
+	LaunchTemplate *LaunchTemplateReference `json:"launchTemplate,omitempty"`
+}
+
+// LaunchTemplateReference references an existing launch template by ID or name.
+type LaunchTemplateReference struct {
+	// ID is the ID of the launch template.
+	// Exactly one of ID and Name must be set.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the launch template.
+	// Exactly one of ID and Name must be set.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Version is the version of the launch template, either a version number or one of
+	// $Latest and $Default. Defaults to the default version of the launch template.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// IntegerRange is a range of integer values with an inclusive minimum and an optional
+// inclusive maximum.
+type IntegerRange struct {
+	// Min is the minimum value.
+	// +kubebuilder:validation:Minimum=0
+	Min int32 `json:"min"`
+
+	// Max is the maximum value. If not set, there is no maximum.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Max *int32 `json:"max,omitempty"`
+}
+
+// InstanceRequirementInclusion specifies whether instance types with a given attribute are
+// included, excluded or required.
+type InstanceRequirementInclusion string
+
+const (
+	// InstanceRequirementIncluded includes instance types with the attribute.
+	InstanceRequirementIncluded InstanceRequirementInclusion = "included"
+	// InstanceRequirementExcluded excludes instance types with the attribute.
+	InstanceRequirementExcluded InstanceRequirementInclusion = "excluded"
+	// InstanceRequirementRequired only selects instance types with the attribute.
+	InstanceRequirementRequired InstanceRequirementInclusion = "required"
+)
+
+// CPUManufacturer is a CPU manufacturer.
+// +kubebuilder:validation:Enum=intel;amd;amazon-web-services;apple
+type CPUManufacturer string
+
+// AcceleratorType is a type of accelerator.
+// +kubebuilder:validation:Enum=gpu;fpga;inference
+type AcceleratorType string
+
+// AcceleratorManufacturer is a manufacturer of accelerators.
+// +kubebuilder:validation:Enum=amazon-web-services;amd;nvidia;xilinx;habana
+type AcceleratorManufacturer string
+
+// InstanceGeneration is a generation of instance types.
+// +kubebuilder:validation:Enum=current;previous
+type InstanceGeneration string
+
+// InstanceRequirements describes the attributes of the instance types to launch. Instance
+// types that have all of the specified attributes are selected.
+type InstanceRequirements struct {
+	// VCPUCount is the range of the number of vCPUs.
+	VCPUCount IntegerRange `json:"vCPUCount"`
+
+	// MemoryMiB is the range of the amount of memory, in MiB.
+	MemoryMiB IntegerRange `json:"memoryMiB"`
+
+	// CPUManufacturers restricts the selection to instance types with CPUs of the given
+	// manufacturers. If not set, any manufacturer is allowed.
+	// +optional
+	CPUManufacturers []CPUManufacturer `json:"cpuManufacturers,omitempty"`
+
+	// InstanceGenerations restricts the selection to instance types of the given generations.
+	// If not set, any generation is allowed.
+	// +optional
+	InstanceGenerations []InstanceGeneration `json:"instanceGenerations,omitempty"`
+
+	// BurstablePerformance specifies whether burstable performance instance types are
+	// included, excluded or required. Defaults to excluded.
+	// +kubebuilder:validation:Enum=included;excluded;required
+	// +optional
+	BurstablePerformance InstanceRequirementInclusion `json:"burstablePerformance,omitempty"`
+
+	// BareMetal specifies whether bare metal instance types are included, excluded or
+	// required. Defaults to excluded.
+	// +kubebuilder:validation:Enum=included;excluded;required
+	// +optional
+	BareMetal InstanceRequirementInclusion `json:"bareMetal,omitempty"`
+
+	// AcceleratorTypes restricts the selection to instance types with accelerators of the
+	// given types. If not set, any type is allowed.
+	// +optional
+	AcceleratorTypes []AcceleratorType `json:"acceleratorTypes,omitempty"`
+
+	// AcceleratorManufacturers restricts the selection to instance types with accelerators
+	// of the given manufacturers. If not set, any manufacturer is allowed.
+	// +optional
+	AcceleratorManufacturers []AcceleratorManufacturer `json:"acceleratorManufacturers,omitempty"`
+
+	// AcceleratorCount is the range of the number of accelerators. Set the maximum to 0
+	// to exclude instance types with accelerators, such as GPUs.
+	// +optional
+	AcceleratorCount *IntegerRange `json:"acceleratorCount,omitempty"`
+
+	// AllowedInstanceTypes restricts the selection to the given instance types. Wildcards
+	// are supported, for example "m5.*" or "c*". Cannot be set together with ExcludedInstanceTypes.
+	// +kubebuilder:validation:MaxItems=400
+	// +optional
+	AllowedInstanceTypes []string `json:"allowedInstanceTypes,omitempty"`
+
+	// ExcludedInstanceTypes excludes the given instance types from the selection. Wildcards
+	// are supported, for example "m5.*" or "c*". Cannot be set together with AllowedInstanceTypes.
+	// +kubebuilder:validation:MaxItems=400
+	// +optional
+	ExcludedInstanceTypes []string `json:"excludedInstanceTypes,omitempty"`
+
+	// SpotMaxPricePercentageOverLowestPrice is the price protection threshold for Spot Instances,
+	// as a percentage above the price of the cheapest matching instance type. Cannot be set
+	// together with MaxSpotPriceAsPercentageOfOptimalOnDemandPrice.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SpotMaxPricePercentageOverLowestPrice *int32 `json:"spotMaxPricePercentageOverLowestPrice,omitempty"`
+
+	// MaxSpotPriceAsPercentageOfOptimalOnDemandPrice is the price protection threshold for Spot
+	// Instances, as a percentage of the On-Demand price of the cheapest matching instance type.
+	// Cannot be set together with SpotMaxPricePercentageOverLowestPrice.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSpotPriceAsPercentageOfOptimalOnDemandPrice *int32 `json:"maxSpotPriceAsPercentageOfOptimalOnDemandPrice,omitempty"`
+
+	// OnDemandMaxPricePercentageOverLowestPrice is the price protection threshold for On-Demand
+	// Instances, as a percentage above the price of the cheapest matching instance type.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandMaxPricePercentageOverLowestPrice *int32 `json:"onDemandMaxPricePercentageOverLowestPrice,omitempty"`
 }
 
 // OnDemandAllocationStrategy indicates how to allocate instance types to fulfill On-Demand capacity.
@@ -284,6 +458,229 @@ type AWSLifecycleHook struct {
 	NotificationMetadata *string `json:"notificationMetadata,omitempty"`
 }
 
+// WarmPoolState is the state instances in the warm pool are kept in.
+type WarmPoolState string
+
+const (
+	// WarmPoolStateStopped keeps warm pool instances stopped.
+	WarmPoolStateStopped WarmPoolState = "Stopped"
+	// WarmPoolStateRunning keeps warm pool instances running.
+	WarmPoolStateRunning WarmPoolState = "Running"
+	// WarmPoolStateHibernated keeps warm pool instances hibernated.
+	WarmPoolStateHibernated WarmPoolState = "Hibernated"
+)
+
+// WarmPool describes a warm pool of pre-initialized instances attached to an autoscaling group.
+type WarmPool struct {
+	// MinSize is the minimum number of instances to maintain in the warm pool.
+	// Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxGroupPreparedCapacity is the maximum number of instances that are allowed to be in the
+	// warm pool or in any state except Terminated for the autoscaling group.
+	// If not set, or set to -1, the maximum size of the autoscaling group is used.
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	MaxGroupPreparedCapacity *int32 `json:"maxGroupPreparedCapacity,omitempty"`
+
+	// PoolState is the state instances in the warm pool are kept in.
+	// Defaults to Stopped.
+	// +kubebuilder:validation:Enum=Stopped;Running;Hibernated
+	// +kubebuilder:default=Stopped
+	// +optional
+	PoolState WarmPoolState `json:"poolState,omitempty"`
+
+	// ReuseOnScaleIn indicates whether instances of the autoscaling group are returned to the
+	// warm pool on scale in instead of being terminated.
+	// +optional
+	ReuseOnScaleIn bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// AWSScheduledAction describes a scheduled action that changes the size of an autoscaling group
+// at a given time or on a recurring schedule.
+type AWSScheduledAction struct {
+	// Name is the name of the scheduled action.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// Recurrence is the recurring schedule for the action, in Unix cron syntax format,
+	// for example "0 8 * * 1-5".
+	// +optional
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// TimeZone is the IANA time zone the recurrence is evaluated in, for example "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// StartTime is the time for the action to start. For a one-time action, this is the time
+	// the action runs. For a recurring action, the recurrence is only evaluated after this time.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time for the recurring action to end.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// MinSize is the minimum size of the autoscaling group while the action is in effect.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSize *int32 `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum size of the autoscaling group while the action is in effect.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// DesiredCapacity is the desired capacity of the autoscaling group when the action runs.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+}
+
+// ScalingPolicyType is the type of a scaling policy.
+type ScalingPolicyType string
+
+const (
+	// ScalingPolicyTypeTargetTracking scales the autoscaling group to keep a metric at a target value.
+	ScalingPolicyTypeTargetTracking ScalingPolicyType = "TargetTrackingScaling"
+	// ScalingPolicyTypeStep scales the autoscaling group in steps based on the size of a CloudWatch alarm breach.
+	ScalingPolicyTypeStep ScalingPolicyType = "StepScaling"
+)
+
+// PredefinedMetricType is a predefined metric a target tracking scaling policy can track.
+type PredefinedMetricType string
+
+const (
+	// PredefinedMetricTypeASGAverageCPUUtilization is the average CPU utilization of the autoscaling group.
+	PredefinedMetricTypeASGAverageCPUUtilization PredefinedMetricType = "ASGAverageCPUUtilization"
+	// PredefinedMetricTypeASGAverageNetworkIn is the average number of bytes received by a single instance.
+	PredefinedMetricTypeASGAverageNetworkIn PredefinedMetricType = "ASGAverageNetworkIn"
+	// PredefinedMetricTypeASGAverageNetworkOut is the average number of bytes sent out by a single instance.
+	PredefinedMetricTypeASGAverageNetworkOut PredefinedMetricType = "ASGAverageNetworkOut"
+	// PredefinedMetricTypeALBRequestCountPerTarget is the number of requests completed per target in an
+	// Application Load Balancer target group.
+	PredefinedMetricTypeALBRequestCountPerTarget PredefinedMetricType = "ALBRequestCountPerTarget"
+)
+
+// AdjustmentType specifies how a step scaling adjustment is interpreted.
+type AdjustmentType string
+
+const (
+	// AdjustmentTypeChangeInCapacity adds the adjustment to the current capacity.
+	AdjustmentTypeChangeInCapacity AdjustmentType = "ChangeInCapacity"
+	// AdjustmentTypeExactCapacity sets the capacity to the adjustment.
+	AdjustmentTypeExactCapacity AdjustmentType = "ExactCapacity"
+	// AdjustmentTypePercentChangeInCapacity changes the current capacity by the adjustment, as a percentage.
+	AdjustmentTypePercentChangeInCapacity AdjustmentType = "PercentChangeInCapacity"
+)
+
+// MetricAggregationType is the aggregation type for the CloudWatch metrics of a step scaling policy.
+type MetricAggregationType string
+
+const (
+	// MetricAggregationTypeMinimum aggregates metrics by minimum.
+	MetricAggregationTypeMinimum MetricAggregationType = "Minimum"
+	// MetricAggregationTypeMaximum aggregates metrics by maximum.
+	MetricAggregationTypeMaximum MetricAggregationType = "Maximum"
+	// MetricAggregationTypeAverage aggregates metrics by average.
+	MetricAggregationTypeAverage MetricAggregationType = "Average"
+)
+
+// AWSScalingPolicy describes a dynamic scaling policy of an autoscaling group.
+type AWSScalingPolicy struct {
+	// Name is the name of the scaling policy.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// PolicyType is the type of the scaling policy.
+	// +kubebuilder:validation:Enum=TargetTrackingScaling;StepScaling
+	PolicyType ScalingPolicyType `json:"policyType"`
+
+	// EstimatedInstanceWarmup is the time until a newly launched instance can contribute
+	// to the CloudWatch metrics. Defaults to the default instance warmup of the autoscaling group.
+	// +kubebuilder:validation:Format=duration
+	// +optional
+	EstimatedInstanceWarmup *metav1.Duration `json:"estimatedInstanceWarmup,omitempty"`
+
+	// TargetTracking configures a target tracking scaling policy.
+	// Required if PolicyType is TargetTrackingScaling.
+	// +optional
+	TargetTracking *TargetTrackingConfiguration `json:"targetTracking,omitempty"`
+
+	// StepScaling configures a step scaling policy.
+	// Required if PolicyType is StepScaling.
+	// +optional
+	StepScaling *StepScalingConfiguration `json:"stepScaling,omitempty"`
+}
+
+// TargetTrackingConfiguration describes a target tracking scaling policy.
+type TargetTrackingConfiguration struct {
+	// PredefinedMetricType is the metric to track.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	PredefinedMetricType PredefinedMetricType `json:"predefinedMetricType"`
+
+	// ResourceLabel identifies the target group for the ALBRequestCountPerTarget metric, in the
+	// format app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// Required if PredefinedMetricType is ALBRequestCountPerTarget.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+
+	// TargetValue is the target value for the metric.
+	// +kubebuilder:validation:Minimum=1
+	TargetValue int64 `json:"targetValue"`
+
+	// DisableScaleIn indicates whether scaling in by the policy is disabled.
+	// +optional
+	DisableScaleIn bool `json:"disableScaleIn,omitempty"`
+}
+
+// StepScalingConfiguration describes a step scaling policy. Step scaling policies are
+// invoked by CloudWatch alarms, which are not managed by this controller.
+type StepScalingConfiguration struct {
+	// AdjustmentType specifies how ScalingAdjustment of the step adjustments is interpreted.
+	// +kubebuilder:validation:Enum=ChangeInCapacity;ExactCapacity;PercentChangeInCapacity
+	AdjustmentType AdjustmentType `json:"adjustmentType"`
+
+	// MetricAggregationType is the aggregation type for the CloudWatch metrics.
+	// Defaults to Average.
+	// +kubebuilder:validation:Enum=Minimum;Maximum;Average
+	// +optional
+	MetricAggregationType *MetricAggregationType `json:"metricAggregationType,omitempty"`
+
+	// MinAdjustmentMagnitude is the minimum number of instances to scale, used with the
+	// PercentChangeInCapacity adjustment type.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinAdjustmentMagnitude *int32 `json:"minAdjustmentMagnitude,omitempty"`
+
+	// StepAdjustments is the set of adjustments to apply based on the size of the alarm breach.
+	// +kubebuilder:validation:MinItems=1
+	StepAdjustments []StepAdjustment `json:"stepAdjustments"`
+}
+
+// StepAdjustment describes a step of a step scaling policy. The bounds are relative to the
+// threshold of the CloudWatch alarm invoking the policy.
+type StepAdjustment struct {
+	// MetricIntervalLowerBound is the inclusive lower bound of the step. If not set,
+	// the step has no lower bound.
+	// +optional
+	MetricIntervalLowerBound *int64 `json:"metricIntervalLowerBound,omitempty"`
+
+	// MetricIntervalUpperBound is the exclusive upper bound of the step. If not set,
+	// the step has no upper bound.
+	// +optional
+	MetricIntervalUpperBound *int64 `json:"metricIntervalUpperBound,omitempty"`
+
+	// ScalingAdjustment is the amount by which to scale, interpreted according to the
+	// adjustment type of the policy.
+	ScalingAdjustment int32 `json:"scalingAdjustment"`
+}
+
 // LifecycleTransition is the state of the EC2 instance to which to attach the lifecycle hook.
 type LifecycleTransition string
 
@@ -391,6 +788,43 @@ type UpdateConfig struct {
 	MaxUnavailablePercentage *int `json:"maxUnavailablePercentage,omitempty"`
 }
 
+// NodeRepairConfig is the node auto repair configuration of a nodegroup.
+type NodeRepairConfig struct {
+	// Enabled specifies whether unhealthy nodes of the nodegroup are repaired automatically.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// LaunchTemplateVersionPolicy defines which version of an external launch template is used.
+type LaunchTemplateVersionPolicy string
+
+const (
+	// LaunchTemplateVersionPolicyDefault uses the default version of the launch template.
+	LaunchTemplateVersionPolicyDefault = LaunchTemplateVersionPolicy("Default")
+
+	// LaunchTemplateVersionPolicyLatest uses the latest version of the launch template.
+	LaunchTemplateVersionPolicyLatest = LaunchTemplateVersionPolicy("Latest")
+)
+
+// ExternalLaunchTemplate references a launch template which is not managed by CAPA. Only one of
+// ID and Name should be specified.
+type ExternalLaunchTemplate struct {
+	// ID is the ID of the launch template.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Name is the name of the launch template.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// VersionPolicy defines which version of the launch template the node group uses. Defaults to
+	// the default version of the launch template.
+	// +kubebuilder:validation:Enum=Default;Latest
+	// +kubebuilder:default=Default
+	// +optional
+	VersionPolicy LaunchTemplateVersionPolicy `json:"versionPolicy,omitempty"`
+}
+
 // AZSubnetType is the type of subnet to use when an availability zone is specified.
 type AZSubnetType string
 
@@ -142,6 +142,21 @@ func (in *AWSLaunchTemplate) DeepCopyInto(out *AWSLaunchTemplate) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(apiv1beta2.CPUOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnclaveOptions != nil {
+		in, out := &in.EnclaveOptions, &out.EnclaveOptions
+		*out = new(apiv1beta2.EnclaveOptions)
+		**out = **in
+	}
+	if in.ENAExpress != nil {
+		in, out := &in.ENAExpress, &out.ENAExpress
+		*out = new(apiv1beta2.ENAExpress)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLaunchTemplate.
@@ -335,6 +350,25 @@ func (in *AWSMachinePoolSpec) DeepCopyInto(out *AWSMachinePoolSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledActions != nil {
+		in, out := &in.ScheduledActions, &out.ScheduledActions
+		*out = make([]AWSScheduledAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScalingPolicies != nil {
+		in, out := &in.ScalingPolicies, &out.ScalingPolicies
+		*out = make([]AWSScalingPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSMachinePoolSpec.
@@ -562,6 +596,16 @@ func (in *AWSManagedMachinePoolSpec) DeepCopyInto(out *AWSManagedMachinePoolSpec
 		*out = new(AWSLaunchTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExternalLaunchTemplate != nil {
+		in, out := &in.ExternalLaunchTemplate, &out.ExternalLaunchTemplate
+		*out = new(ExternalLaunchTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeRepairConfig != nil {
+		in, out := &in.NodeRepairConfig, &out.NodeRepairConfig
+		*out = new(NodeRepairConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AWSLifecycleHooks != nil {
 		in, out := &in.AWSLifecycleHooks, &out.AWSLifecycleHooks
 		*out = make([]AWSLifecycleHook, len(*in))
@@ -623,6 +667,84 @@ func (in *AWSManagedMachinePoolStatus) DeepCopy() *AWSManagedMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSScalingPolicy) DeepCopyInto(out *AWSScalingPolicy) {
+	*out = *in
+	if in.EstimatedInstanceWarmup != nil {
+		in, out := &in.EstimatedInstanceWarmup, &out.EstimatedInstanceWarmup
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TargetTracking != nil {
+		in, out := &in.TargetTracking, &out.TargetTracking
+		*out = new(TargetTrackingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StepScaling != nil {
+		in, out := &in.StepScaling, &out.StepScaling
+		*out = new(StepScalingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSScalingPolicy.
+func (in *AWSScalingPolicy) DeepCopy() *AWSScalingPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSScalingPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSScheduledAction) DeepCopyInto(out *AWSScheduledAction) {
+	*out = *in
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSScheduledAction.
+func (in *AWSScheduledAction) DeepCopy() *AWSScheduledAction {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSScheduledAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountRoleConfig) DeepCopyInto(out *AccountRoleConfig) {
 	*out = *in
@@ -751,6 +873,31 @@ func (in *EBS) DeepCopy() *EBS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalLaunchTemplate) DeepCopyInto(out *ExternalLaunchTemplate) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalLaunchTemplate.
+func (in *ExternalLaunchTemplate) DeepCopy() *ExternalLaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalLaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfileSpec) DeepCopyInto(out *FargateProfileSpec) {
 	*out = *in
@@ -839,6 +986,73 @@ func (in *FargateSelector) DeepCopy() *FargateSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRequirements) DeepCopyInto(out *InstanceRequirements) {
+	*out = *in
+	in.VCPUCount.DeepCopyInto(&out.VCPUCount)
+	in.MemoryMiB.DeepCopyInto(&out.MemoryMiB)
+	if in.CPUManufacturers != nil {
+		in, out := &in.CPUManufacturers, &out.CPUManufacturers
+		*out = make([]CPUManufacturer, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceGenerations != nil {
+		in, out := &in.InstanceGenerations, &out.InstanceGenerations
+		*out = make([]InstanceGeneration, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorTypes != nil {
+		in, out := &in.AcceleratorTypes, &out.AcceleratorTypes
+		*out = make([]AcceleratorType, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorManufacturers != nil {
+		in, out := &in.AcceleratorManufacturers, &out.AcceleratorManufacturers
+		*out = make([]AcceleratorManufacturer, len(*in))
+		copy(*out, *in)
+	}
+	if in.AcceleratorCount != nil {
+		in, out := &in.AcceleratorCount, &out.AcceleratorCount
+		*out = new(IntegerRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedInstanceTypes != nil {
+		in, out := &in.AllowedInstanceTypes, &out.AllowedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedInstanceTypes != nil {
+		in, out := &in.ExcludedInstanceTypes, &out.ExcludedInstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpotMaxPricePercentageOverLowestPrice != nil {
+		in, out := &in.SpotMaxPricePercentageOverLowestPrice, &out.SpotMaxPricePercentageOverLowestPrice
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice != nil {
+		in, out := &in.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice, &out.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnDemandMaxPricePercentageOverLowestPrice != nil {
+		in, out := &in.OnDemandMaxPricePercentageOverLowestPrice, &out.OnDemandMaxPricePercentageOverLowestPrice
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRequirements.
+func (in *InstanceRequirements) DeepCopy() *InstanceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancesDistribution) DeepCopyInto(out *InstancesDistribution) {
 	*out = *in
@@ -864,6 +1078,56 @@ func (in *InstancesDistribution) DeepCopy() *InstancesDistribution {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegerRange) DeepCopyInto(out *IntegerRange) {
+	*out = *in
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegerRange.
+func (in *IntegerRange) DeepCopy() *IntegerRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegerRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateReference) DeepCopyInto(out *LaunchTemplateReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateReference.
+func (in *LaunchTemplateReference) DeepCopy() *LaunchTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedMachinePoolScaling) DeepCopyInto(out *ManagedMachinePoolScaling) {
 	*out = *in
@@ -925,7 +1189,9 @@ func (in *MixedInstancesPolicy) DeepCopyInto(out *MixedInstancesPolicy) {
 	if in.Overrides != nil {
 		in, out := &in.Overrides, &out.Overrides
 		*out = make([]Overrides, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -939,6 +1205,26 @@ func (in *MixedInstancesPolicy) DeepCopy() *MixedInstancesPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeRepairConfig) DeepCopyInto(out *NodeRepairConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairConfig.
+func (in *NodeRepairConfig) DeepCopy() *NodeRepairConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeRepairConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorRoleConfig) DeepCopyInto(out *OperatorRoleConfig) {
 	*out = *in
@@ -958,6 +1244,21 @@ func (in *OperatorRoleConfig) DeepCopy() *OperatorRoleConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Overrides) DeepCopyInto(out *Overrides) {
 	*out = *in
+	if in.InstanceRequirements != nil {
+		in, out := &in.InstanceRequirements, &out.InstanceRequirements
+		*out = new(InstanceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WeightedCapacity != nil {
+		in, out := &in.WeightedCapacity, &out.WeightedCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(LaunchTemplateReference)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Overrides.
@@ -1647,6 +1948,63 @@ func (in *SharedVPCConfig) DeepCopy() *SharedVPCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepAdjustment) DeepCopyInto(out *StepAdjustment) {
+	*out = *in
+	if in.MetricIntervalLowerBound != nil {
+		in, out := &in.MetricIntervalLowerBound, &out.MetricIntervalLowerBound
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MetricIntervalUpperBound != nil {
+		in, out := &in.MetricIntervalUpperBound, &out.MetricIntervalUpperBound
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepAdjustment.
+func (in *StepAdjustment) DeepCopy() *StepAdjustment {
+	if in == nil {
+		return nil
+	}
+	out := new(StepAdjustment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepScalingConfiguration) DeepCopyInto(out *StepScalingConfiguration) {
+	*out = *in
+	if in.MetricAggregationType != nil {
+		in, out := &in.MetricAggregationType, &out.MetricAggregationType
+		*out = new(MetricAggregationType)
+		**out = **in
+	}
+	if in.MinAdjustmentMagnitude != nil {
+		in, out := &in.MinAdjustmentMagnitude, &out.MinAdjustmentMagnitude
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StepAdjustments != nil {
+		in, out := &in.StepAdjustments, &out.StepAdjustments
+		*out = make([]StepAdjustment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepScalingConfiguration.
+func (in *StepScalingConfiguration) DeepCopy() *StepScalingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StepScalingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuspendProcessesTypes) DeepCopyInto(out *SuspendProcessesTypes) {
 	*out = *in
@@ -1722,6 +2080,26 @@ func (in Taints) DeepCopy() Taints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTrackingConfiguration) DeepCopyInto(out *TargetTrackingConfiguration) {
+	*out = *in
+	if in.ResourceLabel != nil {
+		in, out := &in.ResourceLabel, &out.ResourceLabel
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTrackingConfiguration.
+func (in *TargetTrackingConfiguration) DeepCopy() *TargetTrackingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTrackingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdateConfig) DeepCopyInto(out *UpdateConfig) {
 	*out = *in
@@ -1746,3 +2124,28 @@ func (in *UpdateConfig) DeepCopy() *UpdateConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPool) DeepCopyInto(out *WarmPool) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxGroupPreparedCapacity != nil {
+		in, out := &in.MaxGroupPreparedCapacity, &out.MaxGroupPreparedCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPool.
+func (in *WarmPool) DeepCopy() *WarmPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPool)
+	in.DeepCopyInto(out)
+	return out
+}
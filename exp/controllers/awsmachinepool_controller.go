@@ -59,7 +59,6 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
-	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
@@ -409,7 +408,22 @@ func (r *AWSMachinePoolReconciler) reconcileNormal(ctx context.Context, machineP
 		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile lifecycle hooks")
 	}
 
-	if annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if err := r.reconcileWarmPool(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedWarmPoolReconcile", "Failed to reconcile warm pool: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile warm pool")
+	}
+
+	if err := r.reconcileScheduledActions(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScheduledActionsReconcile", "Failed to reconcile scheduled actions: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scheduled actions")
+	}
+
+	if err := r.reconcileScalingPolicies(ctx, machinePoolScope, asgsvc); err != nil {
+		r.Recorder.Eventf(machinePoolScope.AWSMachinePool, corev1.EventTypeWarning, "FailedScalingPoliciesReconcile", "Failed to reconcile scaling policies: %v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile scaling policies")
+	}
+
+	if machinePoolScope.ReplicasExternallyManaged() {
 		// Set MachinePool replicas to the ASG DesiredCapacity
 		if *machinePoolScope.MachinePool.Spec.Replicas != *asg.DesiredCapacity {
 			machinePoolScope.Info("Setting MachinePool replicas to ASG DesiredCapacity",
@@ -653,7 +667,7 @@ func (r *AWSMachinePoolReconciler) findASG(machinePoolScope *scope.MachinePoolSc
 func diffASG(machinePoolScope *scope.MachinePoolScope, existingASG *expinfrav1.AutoScalingGroup) string {
 	detectedMachinePoolSpec := machinePoolScope.MachinePool.Spec.DeepCopy()
 
-	if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if !machinePoolScope.ReplicasExternallyManaged() {
 		detectedMachinePoolSpec.Replicas = existingASG.DesiredCapacity
 	}
 	if diff := cmp.Diff(machinePoolScope.MachinePool.Spec, *detectedMachinePoolSpec); diff != "" {
@@ -741,6 +755,29 @@ func (r *AWSMachinePoolReconciler) reconcileLifecycleHooks(ctx context.Context,
 	return asg.ReconcileLifecycleHooks(ctx, asgsvc, asgName, machinePoolScope.GetLifecycleHooks(), map[string]bool{}, machinePoolScope.GetMachinePool(), machinePoolScope)
 }
 
+// reconcileWarmPool reconciles the warm pool of the ASG.
+func (r *AWSMachinePoolReconciler) reconcileWarmPool(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	asgName := machinePoolScope.Name()
+
+	// The condition is stored on the AWSMachinePool, which is always patched, as it records whether there may be a
+	// warm pool to delete.
+	return asg.ReconcileWarmPool(ctx, asgsvc, asgName, machinePoolScope.AWSMachinePool.Spec.WarmPool, machinePoolScope.AWSMachinePool, machinePoolScope)
+}
+
+// reconcileScheduledActions reconciles the scheduled actions of the ASG.
+func (r *AWSMachinePoolReconciler) reconcileScheduledActions(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	asgName := machinePoolScope.Name()
+
+	return asg.ReconcileScheduledActions(ctx, asgsvc, asgName, machinePoolScope.AWSMachinePool.Spec.ScheduledActions, machinePoolScope.GetMachinePool(), machinePoolScope)
+}
+
+// reconcileScalingPolicies reconciles the scaling policies of the ASG.
+func (r *AWSMachinePoolReconciler) reconcileScalingPolicies(ctx context.Context, machinePoolScope *scope.MachinePoolScope, asgsvc services.ASGInterface) error {
+	asgName := machinePoolScope.Name()
+
+	return asg.ReconcileScalingPolicies(ctx, asgsvc, asgName, machinePoolScope.AWSMachinePool.Spec.ScalingPolicies, machinePoolScope.GetMachinePool(), machinePoolScope)
+}
+
 func (r *AWSMachinePoolReconciler) getInfraCluster(ctx context.Context, log *logger.Logger, cluster *clusterv1.Cluster, awsMachinePool *expinfrav1.AWSMachinePool) (scope.EC2Scope, scope.S3Scope, error) {
 	var clusterScope *scope.ClusterScope
 	var managedControlPlaneScope *scope.ManagedControlPlaneScope
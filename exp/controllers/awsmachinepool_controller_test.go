@@ -338,6 +338,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 
 				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -378,6 +380,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
 				ec2Svc.EXPECT().InstanceIfExists(aws.String("1")).Return(&infrav1.Instance{ID: "1", Type: "m6.2xlarge"}, nil)
 				ec2Svc.EXPECT().InstanceIfExists(aws.String("2")).Return(&infrav1.Instance{ID: "2", Type: "m6.2xlarge"}, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -477,6 +481,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 
 				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(asg, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
 				asgSvc.EXPECT().UpdateASG(gomock.Any()).Return(nil)
@@ -535,6 +541,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				setSuspendedProcesses(t, g)
 				ms.AWSMachinePool.Spec.SuspendProcesses.All = true
 				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{
@@ -576,6 +584,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				setSuspendedProcesses(t, g)
 
 				reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 				asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&expinfrav1.AutoScalingGroup{
@@ -602,6 +612,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				DesiredCapacity: ptr.To[int32](1),
 			}
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil)
 			asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{}, nil)
@@ -643,6 +655,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				Subnets: []string{"subnet1", "subnet2"},
 			}
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -663,6 +677,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				Subnets: []string{"subnet1", "subnet2"},
 			}
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -683,6 +699,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				Subnets: []string{},
 			}
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
 			asgSvc.EXPECT().GetASGByName(gomock.Any()).Return(&asg, nil).AnyTimes()
@@ -758,6 +776,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -813,6 +833,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -871,6 +893,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -963,6 +987,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 						MixedInstancesPolicy: awsMachinePool.Spec.MixedInstancesPolicy.DeepCopy(),
 					}, nil
 				})
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -1118,6 +1144,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 				// reference (`MachinePool.spec.template.spec.bootstrap`).
 				asgSvc.EXPECT().StartASGInstanceRefresh(gomock.Any())
 
+				asgSvc.EXPECT().DescribeScheduledActions(gomock.Any()).Return(nil, nil)
+				asgSvc.EXPECT().DescribeScalingPolicies(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Any()).Return(nil, nil)
 				asgSvc.EXPECT().SubnetIDs(gomock.Any()).Return([]string{"subnet-1"}, nil) // no change
 				// No changes, so there must not be an ASG update!
@@ -1229,6 +1257,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, newLifecycleHook)
 
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().CreateLifecycleHook(gomock.Any(), ms.Name(), &newLifecycleHook).Return(nil)
 			reconSvc.EXPECT().ReconcileTags(gomock.Any(), gomock.Any()).Return(nil)
@@ -1259,6 +1289,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			defer teardown(t, g)
 
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-remove",
@@ -1302,6 +1334,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, newLifecycleHook)
 
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-remove",
@@ -1346,6 +1380,8 @@ func TestAWSMachinePoolReconciler(t *testing.T) {
 			ms.AWSMachinePool.Spec.AWSLifecycleHooks = append(ms.AWSMachinePool.Spec.AWSLifecycleHooks, updateLifecycleHook)
 
 			reconSvc.EXPECT().ReconcileLaunchTemplate(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScheduledActions(gomock.Eq(ms.Name())).Return(nil, nil)
+			asgSvc.EXPECT().DescribeScalingPolicies(gomock.Eq(ms.Name())).Return(nil, nil)
 			asgSvc.EXPECT().DescribeLifecycleHooks(gomock.Eq(ms.Name())).Return([]*expinfrav1.AWSLifecycleHook{
 				{
 					Name:                "hook-to-update",
@@ -1397,6 +1433,56 @@ func expectConditions(g *WithT, m *expinfrav1.AWSMachinePool, expected []conditi
 	}
 }
 
+func TestAWSMachinePoolReconcileWarmPool(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+
+	cs, err := setupCluster("test-cluster")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	scheme := runtime.NewScheme()
+	g.Expect(expinfrav1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(expclusterv1.AddToScheme(scheme)).To(Succeed())
+	awsMachinePool := &expinfrav1.AWSMachinePool{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: expinfrav1.AWSMachinePoolSpec{
+			WarmPool: &expinfrav1.WarmPool{MinSize: ptr.To[int32](1)},
+		},
+	}
+	machinePool := &expclusterv1.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "mp", Namespace: "default"}}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(awsMachinePool, machinePool).WithStatusSubresource(awsMachinePool).Build()
+	newMachinePoolScope := func() *scope.MachinePoolScope {
+		gotAWSMachinePool := &expinfrav1.AWSMachinePool{}
+		g.Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(awsMachinePool), gotAWSMachinePool)).To(Succeed())
+		ms, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
+			Client:         fakeClient,
+			Cluster:        &clusterv1.Cluster{},
+			MachinePool:    machinePool.DeepCopy(),
+			InfraCluster:   cs,
+			AWSMachinePool: gotAWSMachinePool,
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+		return ms
+	}
+	reconciler := AWSMachinePoolReconciler{Client: fakeClient}
+
+	asgSvc.EXPECT().DescribeWarmPool("test").Return(nil, nil)
+	asgSvc.EXPECT().PutWarmPool(gomock.Any(), "test", awsMachinePool.Spec.WarmPool).Return(nil)
+	ms := newMachinePoolScope()
+	g.Expect(reconciler.reconcileWarmPool(context.TODO(), ms, asgSvc)).To(Succeed())
+	g.Expect(ms.PatchObject()).To(Succeed())
+
+	// The warm pool is deleted once it's removed from the spec, as its condition was persisted.
+	asgSvc.EXPECT().DescribeWarmPool("test").Return(awsMachinePool.Spec.WarmPool, nil)
+	asgSvc.EXPECT().DeleteWarmPool(gomock.Any(), "test").Return(nil)
+	ms = newMachinePoolScope()
+	g.Expect(conditions.IsTrue(ms.AWSMachinePool, expinfrav1.WarmPoolReadyCondition)).To(BeTrue())
+	ms.AWSMachinePool.Spec.WarmPool = nil
+	g.Expect(reconciler.reconcileWarmPool(context.TODO(), ms, asgSvc)).To(Succeed())
+	g.Expect(conditions.Has(ms.AWSMachinePool, expinfrav1.WarmPoolReadyCondition)).To(BeFalse())
+}
+
 func setupCluster(clusterName string) (*scope.ClusterScope, error) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
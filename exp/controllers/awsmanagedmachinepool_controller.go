@@ -51,6 +51,10 @@ import (
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
+// externalLaunchTemplateRequeueAfter is how often a node group using an external launch template is
+// reconciled to pick up new versions of the launch template, which don't trigger a reconciliation.
+const externalLaunchTemplateRequeueAfter = 5 * time.Minute
+
 // AWSManagedMachinePoolReconciler reconciles a AWSManagedMachinePool object.
 type AWSManagedMachinePoolReconciler struct {
 	client.Client
@@ -251,6 +255,10 @@ func (r *AWSManagedMachinePoolReconciler) reconcileNormal(
 		return ctrl.Result{}, errors.Wrapf(err, "failed to reconcile machine pool for AWSManagedMachinePool %s/%s", machinePoolScope.ManagedMachinePool.Namespace, machinePoolScope.ManagedMachinePool.Name)
 	}
 
+	if machinePoolScope.ManagedMachinePool.Spec.ExternalLaunchTemplate != nil {
+		return ctrl.Result{RequeueAfter: externalLaunchTemplateRequeueAfter}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
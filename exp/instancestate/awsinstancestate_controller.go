@@ -16,6 +16,7 @@ limitations under the License.
 
 // Package instancestate provides a controller that listens
 // for EC2 instance state change notifications and updates the corresponding AWSMachine's status.
+// It optionally handles instance interruption events by draining the affected nodes.
 package instancestate
 
 import (
@@ -29,18 +30,23 @@ import (
 	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
@@ -53,12 +59,23 @@ type AwsInstanceStateReconciler struct {
 	client.Client
 	Log               logr.Logger
 	sqsServiceFactory func() instancestate.SQSAPI
+	asgServiceFactory func() asg.AutoScalingAPI
 	queueURLs         sync.Map
 	WatchFilterValue  string
+
+	// drains holds the nodes waiting to be drained ahead of instance interruptions, and drainsInFlight the IDs of
+	// their instances until the drain completes.
+	drains         chan drainRequest
+	drainsInFlight sync.Map
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsclusters,verbs=get;list;watch
-// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachines/status,verbs=get;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=awsmachinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *AwsInstanceStateReconciler) getSQSService(region string) (instancestate.SQSAPI, error) {
 	if r.sqsServiceFactory != nil {
@@ -76,6 +93,22 @@ func (r *AwsInstanceStateReconciler) getSQSService(region string) (instancestate
 	return scope.NewGlobalSQSClient(globalScope, globalScope), nil
 }
 
+func (r *AwsInstanceStateReconciler) getASGService(region string, target runtime.Object) (asg.AutoScalingAPI, error) {
+	if r.asgServiceFactory != nil {
+		return r.asgServiceFactory(), nil
+	}
+
+	globalScope, err := scope.NewGlobalScope(scope.GlobalScopeParams{
+		ControllerName: "awsinstancestate",
+		Region:         region,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return scope.NewASGClient(globalScope, globalScope, logger.NewLogger(r.Log), target), nil
+}
+
 func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	// Fetch the AWSCluster instance
 	awsCluster := &infrav1.AWSCluster{}
@@ -95,25 +128,29 @@ func (r *AwsInstanceStateReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return reconcile.Result{}, nil
 	}
 
-	// retrieve queue URL if it isn't already tracked
-	if _, ok := r.queueURLs.Load(awsCluster.Name); !ok {
-		URL, err := r.getQueueURL(ctx, awsCluster)
-		if err != nil {
-			if queueNotFoundError(err) {
-				return reconcile.Result{}, nil
-			}
-			return reconcile.Result{}, err
+	// retrieve queue URL if it isn't already tracked, and the owning cluster once it's set
+	qp, err := r.getQueueParams(ctx, awsCluster)
+	if err != nil {
+		if queueNotFoundError(err) {
+			return reconcile.Result{}, nil
 		}
-		r.queueURLs.Store(awsCluster.Name, queueParams{region: awsCluster.Spec.Region, URL: URL})
+		return reconcile.Result{}, err
 	}
+	r.queueURLs.Store(awsCluster.Name, qp)
 
 	return ctrl.Result{}, nil
 }
 
 func (r *AwsInstanceStateReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
-	go func() {
-		r.watchQueuesForInstanceEvents()
-	}()
+	r.drains = make(chan drainRequest, drainQueueSize)
+	// The queues are watched, and nodes drained, for as long as the manager runs.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		r.runDrainWorkers(ctx)
+		r.watchQueuesForInstanceEvents(ctx)
+		return nil
+	})); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.AWSCluster{}).
 		Named("awsinstancestate").
@@ -122,17 +159,24 @@ func (r *AwsInstanceStateReconciler) SetupWithManager(ctx context.Context, mgr c
 		Complete(r)
 }
 
-func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
-	ctx := context.TODO()
+func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents(ctx context.Context) {
 	awsClusterList := &infrav1.AWSClusterList{}
 	if err := r.Client.List(ctx, awsClusterList); err == nil {
 		for i, cluster := range awsClusterList.Items {
-			if URL, err := r.getQueueURL(ctx, &awsClusterList.Items[i]); err == nil {
-				r.queueURLs.Store(cluster.Name, queueParams{region: cluster.Spec.Region, URL: URL})
+			if qp, err := r.getQueueParams(ctx, &awsClusterList.Items[i]); err == nil {
+				r.queueURLs.Store(cluster.Name, qp)
 			}
 		}
 	}
-	for range time.Tick(1 * time.Second) {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
 		// go through each cluster and check for messages on its queue
 		r.queueURLs.Range(func(key, val interface{}) bool {
 			go func() {
@@ -156,7 +200,7 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 						return
 					}
 					// TODO: handle errors during process message. We currently deletes the message regardless.
-					r.processMessage(ctx, m)
+					r.processMessage(ctx, qp, m)
 
 					_, err = sqsSvs.DeleteMessage(ctx, &sqs.DeleteMessageInput{
 						QueueUrl:      aws.String(qp.URL),
@@ -174,8 +218,15 @@ func (r *AwsInstanceStateReconciler) watchQueuesForInstanceEvents() {
 	}
 }
 
-// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed.
-func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg message) {
+// processMessage triggers a reconcile on an AWSMachine if its EC2 instance state changed, and handles
+// announcements of instance interruptions received from the queue of the given cluster.
+func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, qp queueParams, msg message) {
+	if feature.Gates.Enabled(feature.EventBridgeInterruptionHandling) {
+		for _, i := range interruptionsFromMessage(msg) {
+			r.processInterruption(ctx, qp, i)
+		}
+	}
+
 	if msg.Source != "aws.ec2" || msg.DetailType != instancestate.Ec2StateChangeNotification || msg.MessageDetail == nil {
 		return
 	}
@@ -213,6 +264,32 @@ func (r *AwsInstanceStateReconciler) processMessage(ctx context.Context, msg mes
 	}
 }
 
+// getQueueParams returns the parameters of the queue of a cluster. The queue URL is only retrieved if it isn't
+// tracked already. The name of the owning Cluster is empty until its controller sets the owner reference, and
+// interruptions aren't handled until then.
+func (r *AwsInstanceStateReconciler) getQueueParams(ctx context.Context, awsCluster *infrav1.AWSCluster) (queueParams, error) {
+	qp := queueParams{region: awsCluster.Spec.Region, namespace: awsCluster.Namespace}
+	if tracked, ok := r.queueURLs.Load(awsCluster.Name); ok {
+		qp.URL = tracked.(queueParams).URL
+	} else {
+		URL, err := r.getQueueURL(ctx, awsCluster)
+		if err != nil {
+			return qp, err
+		}
+		qp.URL = URL
+	}
+
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, awsCluster.ObjectMeta)
+	if err != nil {
+		return qp, err
+	}
+	if cluster != nil {
+		qp.clusterName = cluster.Name
+	}
+
+	return qp, nil
+}
+
 // getQueueURL retrieves the SQS queue URL for a given cluster.
 func (r *AwsInstanceStateReconciler) getQueueURL(ctx context.Context, cluster *infrav1.AWSCluster) (string, error) {
 	sqsSvs, err := r.getSQSService(cluster.Spec.Region)
@@ -240,6 +317,10 @@ func queueNotFoundError(err error) bool {
 type queueParams struct {
 	region string
 	URL    string
+
+	// namespace and clusterName identify the Cluster owning the AWSCluster of the queue.
+	namespace   string
+	clusterName string
 }
 
 type message struct {
@@ -251,4 +332,25 @@ type message struct {
 type messageDetail struct {
 	InstanceID string                `json:"instance-id,omitempty"`
 	State      infrav1.InstanceState `json:"state,omitempty"`
+
+	// InstanceAction is set for Spot instance interruption warnings.
+	InstanceAction string `json:"instance-action,omitempty"`
+
+	// EC2InstanceID, AutoScalingGroupName, LifecycleHookName and LifecycleActionToken are set for Auto Scaling
+	// lifecycle actions.
+	EC2InstanceID        string `json:"EC2InstanceId,omitempty"`
+	AutoScalingGroupName string `json:"AutoScalingGroupName,omitempty"`
+	LifecycleHookName    string `json:"LifecycleHookName,omitempty"`
+	LifecycleActionToken string `json:"LifecycleActionToken,omitempty"`
+
+	// Service, EventTypeCategory, EventTypeCode, StartTime and AffectedEntities are set for AWS Health events.
+	Service           string           `json:"service,omitempty"`
+	EventTypeCategory string           `json:"eventTypeCategory,omitempty"`
+	EventTypeCode     string           `json:"eventTypeCode,omitempty"`
+	StartTime         string           `json:"startTime,omitempty"`
+	AffectedEntities  []affectedEntity `json:"affectedEntities,omitempty"`
+}
+
+type affectedEntity struct {
+	EntityValue string `json:"entityValue"`
 }
@@ -0,0 +1,416 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+const (
+	// healthServiceEC2 is the AWS Health service name of events affecting EC2 instances.
+	healthServiceEC2 = "EC2"
+	// healthCategoryScheduledChange is the AWS Health event category of scheduled maintenance and retirements.
+	healthCategoryScheduledChange = "scheduledChange"
+
+	// lifecycleActionResultContinue lets the Auto Scaling group proceed with the termination of the instance.
+	lifecycleActionResultContinue = "CONTINUE"
+
+	// drainTimeout leaves some headroom before the two-minute deadline of Spot instance interruptions.
+	drainTimeout = 90 * time.Second
+	// drainInterval is the interval at which pods are evicted until the node is drained.
+	drainInterval = 5 * time.Second
+
+	// drainWorkers is the number of nodes drained at the same time.
+	drainWorkers = 10
+	// drainQueueSize is the number of nodes waiting for a drain worker.
+	drainQueueSize = 100
+)
+
+// interruption describes an announcement that an EC2 instance is about to be interrupted or terminated.
+type interruption struct {
+	instanceID string
+	reason     string
+	message    string
+
+	// remediate is false for announcements that don't make the interruption certain, which are only
+	// recorded on the AWSMachine.
+	remediate bool
+
+	// lifecycleAction is set when an Auto Scaling group waits for the instance to be drained before terminating it.
+	lifecycleAction *lifecycleAction
+}
+
+// lifecycleAction identifies a pending Auto Scaling lifecycle action.
+type lifecycleAction struct {
+	autoScalingGroupName string
+	hookName             string
+	token                string
+}
+
+// interruptionsFromMessage returns the interruptions announced by an EventBridge message.
+func interruptionsFromMessage(msg message) []interruption {
+	detail := msg.MessageDetail
+	if detail == nil {
+		return nil
+	}
+
+	switch {
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2SpotInterruptionWarning:
+		return []interruption{{
+			instanceID: detail.InstanceID,
+			reason:     infrav1.SpotInterruptionWarningReason,
+			message:    fmt.Sprintf("Spot instance interruption warning received, instance will be %s", detail.InstanceAction),
+			remediate:  true,
+		}}
+	case msg.Source == "aws.ec2" && msg.DetailType == instancestate.Ec2RebalanceRecommendation:
+		return []interruption{{
+			instanceID: detail.InstanceID,
+			reason:     infrav1.RebalanceRecommendationReason,
+			message:    "Spot instance is at an elevated risk of interruption",
+		}}
+	case msg.Source == "aws.health" && msg.DetailType == instancestate.HealthEvent:
+		if detail.Service != healthServiceEC2 || detail.EventTypeCategory != healthCategoryScheduledChange {
+			return nil
+		}
+		ret := make([]interruption, 0, len(detail.AffectedEntities))
+		for _, entity := range detail.AffectedEntities {
+			ret = append(ret, interruption{
+				instanceID: entity.EntityValue,
+				reason:     infrav1.ScheduledMaintenanceReason,
+				message:    fmt.Sprintf("AWS Health scheduled change %s starting at %s", detail.EventTypeCode, detail.StartTime),
+				remediate:  true,
+			})
+		}
+		return ret
+	case msg.Source == "aws.autoscaling" && msg.DetailType == instancestate.AutoScalingTerminateLifecycleAction:
+		return []interruption{{
+			instanceID: detail.EC2InstanceID,
+			reason:     infrav1.AutoScalingTerminationReason,
+			message:    fmt.Sprintf("Auto Scaling group %s is terminating the instance", detail.AutoScalingGroupName),
+			remediate:  true,
+			lifecycleAction: &lifecycleAction{
+				autoScalingGroupName: detail.AutoScalingGroupName,
+				hookName:             detail.LifecycleHookName,
+				token:                detail.LifecycleActionToken,
+			},
+		}}
+	}
+
+	return nil
+}
+
+// processInterruption records an interruption on the AWSMachine of the affected instance. Unless the interruption
+// is only a recommendation, it also marks the Machine for remediation and drains the node ahead of the interruption.
+// Lifecycle actions of the Auto Scaling groups of the cluster are completed right away for instances without an
+// AWSMachine, as there is no node to drain.
+func (r *AwsInstanceStateReconciler) processInterruption(ctx context.Context, qp queueParams, i interruption) {
+	log := r.Log.WithValues("instanceID", i.instanceID, "reason", i.reason)
+
+	awsMachines := &infrav1.AWSMachineList{}
+	if err := r.List(ctx, awsMachines, client.InNamespace(qp.namespace), client.MatchingFields{controllers.InstanceIDIndex: i.instanceID}); err != nil {
+		log.Error(err, "unable to list machines by instance ID")
+		return
+	}
+
+	owned := false
+	for idx := range awsMachines.Items {
+		awsMachine := &awsMachines.Items[idx]
+		// The interruption rule of every cluster in the account and region receives the event, so only the
+		// queue of the cluster owning the instance acts on it.
+		if qp.clusterName == "" || awsMachine.Labels[clusterv1.ClusterNameLabel] != qp.clusterName || !awsMachine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		owned = true
+
+		// Messages can be delivered more than once, don't drain again if the interruption was handled already.
+		// Recommendations don't replace an interruption that was recorded before.
+		if conditions.GetReason(awsMachine, infrav1.InstanceHealthyCondition) == i.reason ||
+			(!i.remediate && conditions.IsFalse(awsMachine, infrav1.InstanceHealthyCondition)) {
+			continue
+		}
+
+		log := log.WithValues("awsMachine", client.ObjectKeyFromObject(awsMachine))
+		log.Info("Received interruption event for instance", "message", i.message)
+
+		patchHelper, err := patch.NewHelper(awsMachine, r.Client)
+		if err != nil {
+			log.Error(err, "unable to create patch helper")
+			continue
+		}
+		conditions.MarkFalse(awsMachine, infrav1.InstanceHealthyCondition, i.reason, clusterv1.ConditionSeverityWarning, "%s", i.message)
+		if err := patchHelper.Patch(ctx, awsMachine); err != nil {
+			log.Error(err, "unable to patch AWS machine")
+			continue
+		}
+
+		if !i.remediate {
+			continue
+		}
+
+		machine, err := util.GetOwnerMachine(ctx, r.Client, awsMachine.ObjectMeta)
+		if err != nil {
+			log.Error(err, "unable to get owner machine")
+			continue
+		}
+		if machine != nil {
+			if err := r.markForRemediation(ctx, machine); err != nil {
+				log.Error(err, "unable to mark machine for remediation", "machine", client.ObjectKeyFromObject(machine))
+			}
+		}
+
+		r.enqueueDrain(ctx, drainRequest{log: log, region: qp.region, awsMachine: awsMachine, machine: machine, interruption: i})
+	}
+
+	if !owned && i.lifecycleAction != nil {
+		r.completeUnownedLifecycleAction(ctx, log, qp, i)
+	}
+}
+
+// completeUnownedLifecycleAction completes the lifecycle action of an instance without an AWSMachine, such as the
+// instances of AWSMachinePools without the MachinePoolMachines feature gate, if the Auto Scaling group belongs to
+// the cluster. Lifecycle actions of other Auto Scaling groups are left to their owners.
+func (r *AwsInstanceStateReconciler) completeUnownedLifecycleAction(ctx context.Context, log logr.Logger, qp queueParams, i interruption) {
+	if qp.clusterName == "" {
+		return
+	}
+
+	// The Auto Scaling group of an AWSMachinePool is named after it.
+	awsMachinePool := &expinfrav1.AWSMachinePool{}
+	key := client.ObjectKey{Namespace: qp.namespace, Name: i.lifecycleAction.autoScalingGroupName}
+	if err := r.Get(ctx, key, awsMachinePool); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "unable to get AWS machine pool", "awsMachinePool", key)
+		}
+		return
+	}
+	if awsMachinePool.Labels[clusterv1.ClusterNameLabel] != qp.clusterName {
+		return
+	}
+
+	if err := r.completeLifecycleAction(ctx, qp.region, awsMachinePool, i); err != nil {
+		log.Error(err, "unable to complete lifecycle action", "autoScalingGroup", i.lifecycleAction.autoScalingGroupName)
+		return
+	}
+	log.Info("Completed lifecycle action of instance without AWS machine", "autoScalingGroup", i.lifecycleAction.autoScalingGroupName)
+}
+
+// drainRequest is a node to drain ahead of the interruption of its instance.
+type drainRequest struct {
+	log          logr.Logger
+	region       string
+	awsMachine   *infrav1.AWSMachine
+	machine      *clusterv1.Machine
+	interruption interruption
+}
+
+// enqueueDrain queues the drain of the node of an interrupted instance for the drain workers, so the queue of the
+// cluster isn't blocked while it lasts. A node is only drained once at a time, even if the interruption is
+// delivered again.
+func (r *AwsInstanceStateReconciler) enqueueDrain(ctx context.Context, req drainRequest) {
+	instanceID := req.interruption.instanceID
+	if _, inFlight := r.drainsInFlight.LoadOrStore(instanceID, struct{}{}); inFlight {
+		req.log.V(4).Info("Node of the instance is already being drained")
+		return
+	}
+
+	select {
+	case r.drains <- req:
+	default:
+		r.drainsInFlight.Delete(instanceID)
+		req.log.Info("Too many nodes waiting to be drained, not draining the node of the instance")
+		// Don't let the Auto Scaling group wait for the lifecycle hook to time out.
+		r.completeLifecycleActionOf(ctx, req)
+	}
+}
+
+// runDrainWorkers drains the queued nodes with a bounded number of workers until the context is cancelled.
+func (r *AwsInstanceStateReconciler) runDrainWorkers(ctx context.Context) {
+	for range drainWorkers {
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case req := <-r.drains:
+					r.drainAndCompleteLifecycleAction(ctx, req)
+					r.drainsInFlight.Delete(req.interruption.instanceID)
+				}
+			}
+		}()
+	}
+}
+
+// drainAndCompleteLifecycleAction drains the node of the Machine, and lets the Auto Scaling group terminate the
+// instance afterwards. The lifecycle action is completed even if the drain fails, as the instance is terminated
+// anyway once the lifecycle hook times out.
+func (r *AwsInstanceStateReconciler) drainAndCompleteLifecycleAction(ctx context.Context, req drainRequest) {
+	if req.machine != nil && req.machine.Status.NodeRef != nil {
+		if err := r.drainNode(ctx, req.machine); err != nil {
+			req.log.Error(err, "unable to drain node", "node", req.machine.Status.NodeRef.Name)
+		} else {
+			req.log.Info("Drained node ahead of instance interruption", "node", req.machine.Status.NodeRef.Name)
+		}
+	}
+
+	r.completeLifecycleActionOf(ctx, req)
+}
+
+// completeLifecycleActionOf completes the lifecycle action of the interruption of a drain request, if any.
+func (r *AwsInstanceStateReconciler) completeLifecycleActionOf(ctx context.Context, req drainRequest) {
+	i := req.interruption
+	if i.lifecycleAction == nil {
+		return
+	}
+	if err := r.completeLifecycleAction(ctx, req.region, req.awsMachine, i); err != nil {
+		req.log.Error(err, "unable to complete lifecycle action", "autoScalingGroup", i.lifecycleAction.autoScalingGroupName)
+		return
+	}
+	req.log.Info("Completed lifecycle action", "autoScalingGroup", i.lifecycleAction.autoScalingGroupName)
+}
+
+// completeLifecycleAction lets the Auto Scaling group continue terminating the instance.
+func (r *AwsInstanceStateReconciler) completeLifecycleAction(ctx context.Context, region string, target runtime.Object, i interruption) error {
+	asgSvc, err := r.getASGService(region, target)
+	if err != nil {
+		return errors.Wrap(err, "failed to create Auto Scaling client")
+	}
+
+	_, err = asgSvc.CompleteLifecycleAction(ctx, &autoscaling.CompleteLifecycleActionInput{
+		AutoScalingGroupName:  aws.String(i.lifecycleAction.autoScalingGroupName),
+		LifecycleHookName:     aws.String(i.lifecycleAction.hookName),
+		LifecycleActionToken:  aws.String(i.lifecycleAction.token),
+		InstanceId:            aws.String(i.instanceID),
+		LifecycleActionResult: aws.String(lifecycleActionResultContinue),
+	})
+	return err
+}
+
+// markForRemediation requests the MachineHealthCheck of the Machine to replace it.
+func (r *AwsInstanceStateReconciler) markForRemediation(ctx context.Context, machine *clusterv1.Machine) error {
+	if _, ok := machine.Annotations[clusterv1.RemediateMachineAnnotation]; ok {
+		return nil
+	}
+
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return err
+	}
+	if machine.Annotations == nil {
+		machine.Annotations = map[string]string{}
+	}
+	machine.Annotations[clusterv1.RemediateMachineAnnotation] = ""
+
+	return patchHelper.Patch(ctx, machine)
+}
+
+// drainNode cordons the node of a Machine in the workload cluster and evicts its pods.
+func (r *AwsInstanceStateReconciler) drainNode(ctx context.Context, machine *clusterv1.Machine) error {
+	restConfig, err := remote.RESTConfig(ctx, "awsinstancestate", r.Client, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.ClusterName})
+	if err != nil {
+		return errors.Wrap(err, "failed to get workload cluster REST config")
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return errors.Wrap(err, "failed to create workload cluster client")
+	}
+
+	return r.drain(ctx, clientset, machine.Status.NodeRef.Name)
+}
+
+// drain cordons a node and evicts its pods until they are gone or the drain timeout expires. Pods of DaemonSets
+// and mirror pods are left on the node, as they would be recreated on it. Evictions blocked by a
+// PodDisruptionBudget are retried.
+func (r *AwsInstanceStateReconciler) drain(ctx context.Context, clientset kubernetes.Interface, nodeName string) error {
+	cordon := []byte(`{"spec":{"unschedulable":true}}`)
+	if _, err := clientset.CoreV1().Nodes().Patch(ctx, nodeName, types.StrategicMergePatchType, cordon, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to cordon node %s", nodeName)
+	}
+
+	listOptions := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String()}
+	err := wait.PollUntilContextTimeout(ctx, drainInterval, drainTimeout, true, func(ctx context.Context) (bool, error) {
+		pods, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(ctx, listOptions)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to list pods of node %s", nodeName)
+		}
+
+		drained := true
+		for idx := range pods.Items {
+			pod := &pods.Items[idx]
+			if !needsEviction(pod) {
+				continue
+			}
+			drained = false
+			if !pod.DeletionTimestamp.IsZero() {
+				continue
+			}
+
+			err := clientset.CoreV1().Pods(pod.Namespace).EvictV1(ctx, &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			})
+			if err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+				r.Log.Error(err, "failed to evict pod", "pod", client.ObjectKeyFromObject(pod))
+			}
+		}
+		return drained, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to drain node %s", nodeName)
+	}
+
+	return nil
+}
+
+// needsEviction returns whether a pod has to be evicted to drain its node.
+func needsEviction(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
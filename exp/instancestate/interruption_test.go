@@ -0,0 +1,444 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/controllers"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	asg "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestInterruptionsFromMessage(t *testing.T) {
+	testCases := []struct {
+		name                string
+		body                string
+		wantInstanceIDs     []string
+		wantReason          string
+		wantLifecycleAction *lifecycleAction
+	}{
+		{
+			name: "spot interruption warning",
+			body: `{
+				"source": "aws.ec2",
+				"detail-type": "EC2 Spot Instance Interruption Warning",
+				"detail": {"instance-id": "i-1", "instance-action": "terminate"}
+			}`,
+			wantInstanceIDs: []string{"i-1"},
+			wantReason:      infrav1.SpotInterruptionWarningReason,
+		},
+		{
+			name: "rebalance recommendation",
+			body: `{
+				"source": "aws.ec2",
+				"detail-type": "EC2 Instance Rebalance Recommendation",
+				"detail": {"instance-id": "i-1"}
+			}`,
+			wantInstanceIDs: []string{"i-1"},
+			wantReason:      infrav1.RebalanceRecommendationReason,
+		},
+		{
+			name: "scheduled maintenance affecting multiple instances",
+			body: `{
+				"source": "aws.health",
+				"detail-type": "AWS Health Event",
+				"detail": {
+					"service": "EC2",
+					"eventTypeCategory": "scheduledChange",
+					"eventTypeCode": "AWS_EC2_INSTANCE_RETIREMENT_SCHEDULED",
+					"startTime": "Sat, 05 Jun 2025 15:10:09 GMT",
+					"affectedEntities": [{"entityValue": "i-1"}, {"entityValue": "i-2"}]
+				}
+			}`,
+			wantInstanceIDs: []string{"i-1", "i-2"},
+			wantReason:      infrav1.ScheduledMaintenanceReason,
+		},
+		{
+			name: "health event of another category is ignored",
+			body: `{
+				"source": "aws.health",
+				"detail-type": "AWS Health Event",
+				"detail": {
+					"service": "EC2",
+					"eventTypeCategory": "accountNotification",
+					"affectedEntities": [{"entityValue": "i-1"}]
+				}
+			}`,
+		},
+		{
+			name: "auto scaling terminate lifecycle action",
+			body: `{
+				"source": "aws.autoscaling",
+				"detail-type": "EC2 Instance-terminate Lifecycle Action",
+				"detail": {
+					"AutoScalingGroupName": "my-asg",
+					"EC2InstanceId": "i-1",
+					"LifecycleHookName": "my-hook",
+					"LifecycleActionToken": "my-token",
+					"LifecycleTransition": "autoscaling:EC2_INSTANCE_TERMINATING"
+				}
+			}`,
+			wantInstanceIDs: []string{"i-1"},
+			wantReason:      infrav1.AutoScalingTerminationReason,
+			wantLifecycleAction: &lifecycleAction{
+				autoScalingGroupName: "my-asg",
+				hookName:             "my-hook",
+				token:                "my-token",
+			},
+		},
+		{
+			name: "state change notification is not an interruption",
+			body: `{
+				"source": "aws.ec2",
+				"detail-type": "EC2 Instance State-change Notification",
+				"detail": {"instance-id": "i-1", "state": "shutting-down"}
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := message{}
+			g.Expect(json.Unmarshal([]byte(tc.body), &m)).To(Succeed())
+
+			interruptions := interruptionsFromMessage(m)
+			g.Expect(interruptions).To(HaveLen(len(tc.wantInstanceIDs)))
+			for i, interruption := range interruptions {
+				g.Expect(interruption.instanceID).To(Equal(tc.wantInstanceIDs[i]))
+				g.Expect(interruption.reason).To(Equal(tc.wantReason))
+				g.Expect(interruption.message).NotTo(BeEmpty())
+				g.Expect(interruption.lifecycleAction).To(Equal(tc.wantLifecycleAction))
+			}
+		})
+	}
+}
+
+func TestProcessInterruption(t *testing.T) {
+	spotInterruption := interruption{
+		instanceID: "i-1",
+		reason:     infrav1.SpotInterruptionWarningReason,
+		message:    "Spot instance interruption warning received, instance will be terminate",
+		remediate:  true,
+	}
+	rebalanceRecommendation := interruption{
+		instanceID: "i-1",
+		reason:     infrav1.RebalanceRecommendationReason,
+		message:    "Spot instance is at an elevated risk of interruption",
+	}
+	lifecycleActionInterruption := interruption{
+		instanceID: "i-1",
+		reason:     infrav1.AutoScalingTerminationReason,
+		message:    "Auto Scaling group my-asg is terminating the instance",
+		remediate:  true,
+		lifecycleAction: &lifecycleAction{
+			autoScalingGroupName: "my-asg",
+			hookName:             "my-hook",
+			token:                "my-token",
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		interruption        interruption
+		clusterName         string
+		poolClusterName     string
+		deleted             bool
+		healthyReason       string
+		wantReason          string
+		wantRemediation     bool
+		wantLifecycleAction bool
+	}{
+		{
+			name:            "spot interruption of an instance of the cluster marks the machine for remediation",
+			interruption:    spotInterruption,
+			clusterName:     "test-cluster",
+			wantReason:      infrav1.SpotInterruptionWarningReason,
+			wantRemediation: true,
+		},
+		{
+			name:         "instance of another cluster is ignored",
+			interruption: spotInterruption,
+			clusterName:  "other-cluster",
+		},
+		{
+			name:         "deleted machine is ignored",
+			interruption: spotInterruption,
+			clusterName:  "test-cluster",
+			deleted:      true,
+		},
+		{
+			name:          "redelivered interruption is only handled once",
+			interruption:  spotInterruption,
+			clusterName:   "test-cluster",
+			healthyReason: infrav1.SpotInterruptionWarningReason,
+			wantReason:    infrav1.SpotInterruptionWarningReason,
+		},
+		{
+			name:         "rebalance recommendation only sets the condition",
+			interruption: rebalanceRecommendation,
+			clusterName:  "test-cluster",
+			wantReason:   infrav1.RebalanceRecommendationReason,
+		},
+		{
+			name:          "rebalance recommendation doesn't replace a recorded interruption",
+			interruption:  rebalanceRecommendation,
+			clusterName:   "test-cluster",
+			healthyReason: infrav1.SpotInterruptionWarningReason,
+			wantReason:    infrav1.SpotInterruptionWarningReason,
+		},
+		{
+			name:                "auto scaling termination completes the lifecycle action",
+			interruption:        lifecycleActionInterruption,
+			clusterName:         "test-cluster",
+			wantReason:          infrav1.AutoScalingTerminationReason,
+			wantRemediation:     true,
+			wantLifecycleAction: true,
+		},
+		{
+			name:                "auto scaling termination of an instance of the cluster without AWS machine completes the lifecycle action",
+			interruption:        lifecycleActionInterruption,
+			clusterName:         "other-cluster",
+			poolClusterName:     "test-cluster",
+			wantLifecycleAction: true,
+		},
+		{
+			name:            "auto scaling termination in an auto scaling group of another cluster is ignored",
+			interruption:    lifecycleActionInterruption,
+			clusterName:     "other-cluster",
+			poolClusterName: "other-cluster",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			asgSvc := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+
+			completed := make(chan struct{})
+			if tc.wantLifecycleAction {
+				asgSvc.EXPECT().CompleteLifecycleAction(gomock.Any(), gomock.Eq(&autoscaling.CompleteLifecycleActionInput{
+					AutoScalingGroupName:  aws.String("my-asg"),
+					LifecycleHookName:     aws.String("my-hook"),
+					LifecycleActionToken:  aws.String("my-token"),
+					InstanceId:            aws.String("i-1"),
+					LifecycleActionResult: aws.String("CONTINUE"),
+				})).DoAndReturn(func(_ context.Context, _ *autoscaling.CompleteLifecycleActionInput, _ ...func(*autoscaling.Options)) (*autoscaling.CompleteLifecycleActionOutput, error) {
+					close(completed)
+					return &autoscaling.CompleteLifecycleActionOutput{}, nil
+				})
+			}
+
+			machine := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "machine-1",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: tc.clusterName},
+				},
+				Spec: clusterv1.MachineSpec{ClusterName: tc.clusterName},
+			}
+			awsMachine := &infrav1.AWSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "aws-machine-1",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: tc.clusterName},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       "machine-1",
+					}},
+				},
+				Spec: infrav1.AWSMachineSpec{InstanceID: aws.String("i-1")},
+			}
+			if tc.deleted {
+				awsMachine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+				awsMachine.Finalizers = []string{infrav1.MachineFinalizer}
+			}
+			if tc.healthyReason != "" {
+				conditions.MarkFalse(awsMachine, infrav1.InstanceHealthyCondition, tc.healthyReason, clusterv1.ConditionSeverityWarning, "")
+			}
+
+			objects := []client.Object{machine, awsMachine}
+			if tc.poolClusterName != "" {
+				objects = append(objects, &expinfrav1.AWSMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-asg",
+						Namespace: "default",
+						Labels:    map[string]string{clusterv1.ClusterNameLabel: tc.poolClusterName},
+					},
+				})
+			}
+
+			scheme := runtime.NewScheme()
+			g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(expinfrav1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(awsMachine).
+				WithIndex(&infrav1.AWSMachine{}, controllers.InstanceIDIndex, func(o client.Object) []string {
+					return []string{aws.ToString(o.(*infrav1.AWSMachine).Spec.InstanceID)}
+				}).
+				Build()
+
+			ctx, cancel := context.WithCancel(context.TODO())
+			defer cancel()
+			r := &AwsInstanceStateReconciler{
+				Client: fakeClient,
+				Log:    ctrl.Log,
+				asgServiceFactory: func() asg.AutoScalingAPI {
+					return asgSvc
+				},
+				drains: make(chan drainRequest, drainQueueSize),
+			}
+			r.runDrainWorkers(ctx)
+			r.processInterruption(ctx, queueParams{namespace: "default", clusterName: "test-cluster"}, tc.interruption)
+
+			if tc.wantLifecycleAction {
+				g.Eventually(completed, 5*time.Second).Should(BeClosed())
+			}
+
+			gotAWSMachine := &infrav1.AWSMachine{}
+			g.Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(awsMachine), gotAWSMachine)).To(Succeed())
+			if tc.wantReason == "" {
+				g.Expect(conditions.Has(gotAWSMachine, infrav1.InstanceHealthyCondition)).To(BeFalse())
+			} else {
+				g.Expect(conditions.IsFalse(gotAWSMachine, infrav1.InstanceHealthyCondition)).To(BeTrue())
+				g.Expect(conditions.GetReason(gotAWSMachine, infrav1.InstanceHealthyCondition)).To(Equal(tc.wantReason))
+			}
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(context.TODO(), client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			if tc.wantRemediation {
+				g.Expect(gotMachine.Annotations).To(HaveKey(clusterv1.RemediateMachineAnnotation))
+			} else {
+				g.Expect(gotMachine.Annotations).NotTo(HaveKey(clusterv1.RemediateMachineAnnotation))
+			}
+		})
+	}
+}
+
+func TestEnqueueDrain(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	asgSvc := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	// The lifecycle action of an instance that can't be queued for draining is completed right away.
+	asgSvc.EXPECT().CompleteLifecycleAction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *autoscaling.CompleteLifecycleActionInput, _ ...func(*autoscaling.Options)) (*autoscaling.CompleteLifecycleActionOutput, error) {
+			g.Expect(aws.ToString(input.InstanceId)).To(Equal("i-2"))
+			return &autoscaling.CompleteLifecycleActionOutput{}, nil
+		})
+
+	r := &AwsInstanceStateReconciler{
+		Log: ctrl.Log,
+		asgServiceFactory: func() asg.AutoScalingAPI {
+			return asgSvc
+		},
+		drains: make(chan drainRequest, 1),
+	}
+	request := func(instanceID string) drainRequest {
+		return drainRequest{
+			log: ctrl.Log,
+			interruption: interruption{
+				instanceID:      instanceID,
+				lifecycleAction: &lifecycleAction{autoScalingGroupName: "my-asg", hookName: "my-hook", token: "my-token"},
+			},
+		}
+	}
+
+	// Redelivered interruptions don't queue the same instance again.
+	r.enqueueDrain(context.TODO(), request("i-1"))
+	r.enqueueDrain(context.TODO(), request("i-1"))
+	g.Expect(r.drains).To(HaveLen(1))
+
+	r.enqueueDrain(context.TODO(), request("i-2"))
+	g.Expect(r.drains).To(HaveLen(1))
+	_, inFlight := r.drainsInFlight.Load("i-2")
+	g.Expect(inFlight).To(BeFalse())
+}
+
+func TestDrain(t *testing.T) {
+	g := NewWithT(t)
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	pod := func(name string, mutate func(*corev1.Pod)) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node-1"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if mutate != nil {
+			mutate(p)
+		}
+		return p
+	}
+	clientset := kubefake.NewSimpleClientset(
+		node,
+		pod("app", nil),
+		pod("daemon", func(p *corev1.Pod) {
+			p.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "daemon", Controller: ptr.To(true)}}
+		}),
+		pod("mirror", func(p *corev1.Pod) {
+			p.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: ""}
+		}),
+		pod("completed", func(p *corev1.Pod) {
+			p.Status.Phase = corev1.PodSucceeded
+		}),
+	)
+	evicted := []string{}
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		evicted = append(evicted, eviction.Name)
+		return true, nil, clientset.Tracker().Delete(corev1.SchemeGroupVersion.WithResource("pods"), eviction.Namespace, eviction.Name)
+	})
+
+	r := &AwsInstanceStateReconciler{Log: ctrl.Log}
+	g.Expect(r.drain(context.TODO(), clientset, "node-1")).To(Succeed())
+
+	gotNode, err := clientset.CoreV1().Nodes().Get(context.TODO(), "node-1", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gotNode.Spec.Unschedulable).To(BeTrue())
+	g.Expect(evicted).To(ConsistOf("app"))
+}
@@ -70,6 +70,12 @@ const (
 	// alpha: v0.7?
 	EventBridgeInstanceState featuregate.Feature = "EventBridgeInstanceState"
 
+	// EventBridgeInterruptionHandling will additionally route Spot interruption warnings, rebalance recommendations,
+	// scheduled maintenance events and Auto Scaling termination lifecycle actions to the EventBridge queue,
+	// and cordon and drain the affected nodes. Requires EventBridgeInstanceState.
+	// alpha: v2.9
+	EventBridgeInterruptionHandling featuregate.Feature = "EventBridgeInterruptionHandling"
+
 	// AutoControllerIdentityCreator will create AWSClusterControllerIdentity instance that allows all namespaces to use it.
 	// owner: @sedefsavas
 	// alpha: v0.6
@@ -107,19 +113,20 @@ func init() {
 // To add a new feature, define a key for it above and add it here.
 var defaultCAPAFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	// Every feature should be initiated here:
-	EKS:                           {Default: true, PreRelease: featuregate.Beta},
-	EKSEnableIAM:                  {Default: false, PreRelease: featuregate.Beta},
-	EKSAllowAddRoles:              {Default: false, PreRelease: featuregate.Beta},
-	EKSFargate:                    {Default: false, PreRelease: featuregate.Alpha},
-	EventBridgeInstanceState:      {Default: false, PreRelease: featuregate.Alpha},
-	MachinePool:                   {Default: true, PreRelease: featuregate.Beta},
-	MachinePoolMachines:           {Default: false, PreRelease: featuregate.Alpha},
-	AutoControllerIdentityCreator: {Default: true, PreRelease: featuregate.Alpha},
-	BootstrapFormatIgnition:       {Default: false, PreRelease: featuregate.Alpha},
-	ExternalResourceGC:            {Default: true, PreRelease: featuregate.Beta},
-	AlternativeGCStrategy:         {Default: false, PreRelease: featuregate.Beta},
-	TagUnmanagedNetworkResources:  {Default: true, PreRelease: featuregate.Alpha},
-	ROSA:                          {Default: false, PreRelease: featuregate.Alpha},
+	EKS:                             {Default: true, PreRelease: featuregate.Beta},
+	EKSEnableIAM:                    {Default: false, PreRelease: featuregate.Beta},
+	EKSAllowAddRoles:                {Default: false, PreRelease: featuregate.Beta},
+	EKSFargate:                      {Default: false, PreRelease: featuregate.Alpha},
+	EventBridgeInstanceState:        {Default: false, PreRelease: featuregate.Alpha},
+	EventBridgeInterruptionHandling: {Default: false, PreRelease: featuregate.Alpha},
+	MachinePool:                     {Default: true, PreRelease: featuregate.Beta},
+	MachinePoolMachines:             {Default: false, PreRelease: featuregate.Alpha},
+	AutoControllerIdentityCreator:   {Default: true, PreRelease: featuregate.Alpha},
+	BootstrapFormatIgnition:         {Default: false, PreRelease: featuregate.Alpha},
+	ExternalResourceGC:              {Default: true, PreRelease: featuregate.Beta},
+	AlternativeGCStrategy:           {Default: false, PreRelease: featuregate.Beta},
+	TagUnmanagedNetworkResources:    {Default: true, PreRelease: featuregate.Alpha},
+	ROSA:                            {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GiantSwarmMachinePoolMachinesFeatureGateCheck is used while testing
@@ -49,6 +49,7 @@ const (
 	NoCredentialProviders                   = "NoCredentialProviders"
 	NoSuchKey                               = "NoSuchKey"
 	PermissionNotFound                      = "InvalidPermission.NotFound"
+	PrefixListNotFound                      = "InvalidPrefixListID.NotFound"
 	ResourceExists                          = "ResourceExistsException"
 	ResourceNotFound                        = "InvalidResourceID.NotFound"
 	RouteTableNotFound                      = "InvalidRouteTableID.NotFound"
@@ -29,6 +29,7 @@ import (
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -57,6 +58,7 @@ var (
 		"ssm":                  ssm.ServiceID,
 		"sts":                  sts.ServiceID,
 		"secretsmanager":       secretsmanager.ServiceID,
+		"route53":              route53.ServiceID,
 	}
 )
 
@@ -400,3 +402,25 @@ func (s *SecretsManagerEndpointResolver) ResolveEndpoint(ctx context.Context, pa
 	params.Region = &endpoint.SigningRegion
 	return secretsmanager.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
 }
+
+// Route53EndpointResolver implements EndpointResolverV2 interface for Route53.
+type Route53EndpointResolver struct {
+	*MultiServiceEndpointResolver
+}
+
+// ResolveEndpoint for Route53.
+func (s *Route53EndpointResolver) ResolveEndpoint(ctx context.Context, params route53.EndpointParameters) (smithyendpoints.Endpoint, error) {
+	// If custom endpoint not found, return default endpoint for the service
+	log := logger.FromContext(ctx)
+	endpoint, ok := s.endpoints[route53.ServiceID]
+
+	if !ok {
+		log.Debug("Custom endpoint not found, using default endpoint")
+		return route53.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+	}
+
+	log.Debug("Custom endpoint found, using custom endpoint", "endpoint", endpoint.URL)
+	params.Endpoint = &endpoint.URL
+	params.Region = &endpoint.SigningRegion
+	return route53.NewDefaultEndpointResolverV2().ResolveEndpoint(ctx, params)
+}
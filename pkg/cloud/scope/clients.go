@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	rgapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -335,6 +336,28 @@ func NewS3Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logge
 	return s3.NewFromConfig(cfg, s3Opts...)
 }
 
+// NewRoute53Client creates a new Route53 API client for a given session.
+func NewRoute53Client(scopeUser cloud.ScopeUsage, session cloud.Session, logger logger.Wrapper, target runtime.Object) *route53.Client {
+	cfg := session.Session()
+	multiSvcEndpointResolver := endpoints.NewMultiServiceEndpointResolver()
+	route53EndpointResolver := &endpoints.Route53EndpointResolver{
+		MultiServiceEndpointResolver: multiSvcEndpointResolver,
+	}
+	route53Opts := []func(*route53.Options){
+		func(o *route53.Options) {
+			o.Logger = logger.GetAWSLogger()
+			o.ClientLogMode = awslogs.GetAWSLogLevel(logger.GetLogger())
+			o.EndpointResolverV2 = route53EndpointResolver
+		},
+		route53.WithAPIOptions(
+			awsmetrics.WithMiddlewares(scopeUser.ControllerName(), target),
+			awsmetrics.WithCAPAUserAgentMiddleware(),
+		),
+	}
+
+	return route53.NewFromConfig(cfg, route53Opts...)
+}
+
 // AWSClients contains all the aws clients used by the scopes.
 type AWSClients struct {
 	ELB             *elb.Client
@@ -436,3 +436,38 @@ func (s *ClusterScope) UnstructuredControlPlane() (*unstructured.Unstructured, e
 func (s *ClusterScope) NodePortIngressRuleCidrBlocks() []string {
 	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().NodePortIngressRuleCidrBlocks
 }
+
+// NodePortIngressRulePrefixListIDs returns the managed prefix list IDs for the node NodePort ingress rules.
+func (s *ClusterScope) NodePortIngressRulePrefixListIDs() []string {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().NodePortIngressRulePrefixListIDs
+}
+
+// PrefixList returns the managed prefix list owned by the cluster.
+func (s *ClusterScope) PrefixList() *infrav1.PrefixListSpec {
+	return s.AWSCluster.Spec.NetworkSpec.PrefixList
+}
+
+// VPCEndpoints returns the VPC endpoints to create in the VPC.
+func (s *ClusterScope) VPCEndpoints() []infrav1.VPCEndpointSpec {
+	return s.AWSCluster.Spec.NetworkSpec.VPCEndpoints
+}
+
+// NetworkACLs returns the default network ACLs of the subnets.
+func (s *ClusterScope) NetworkACLs() *infrav1.NetworkACLsSpec {
+	return s.AWSCluster.Spec.NetworkSpec.NetworkACLs
+}
+
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+func (s *ClusterScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneEgressRules
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+func (s *ClusterScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().AdditionalNodeEgressRules
+}
+
+// SecurityGroupEgressRules returns the egress rules replacing the ones of the security groups per role.
+func (s *ClusterScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.AWSCluster.Spec.NetworkSpec.DeepCopy().SecurityGroupEgressRules
+}
@@ -173,6 +173,43 @@ func (m *MachineScope) SetInstanceState(v infrav1.InstanceState) {
 	m.AWSMachine.Status.InstanceState = &v
 }
 
+// DesiredPowerState returns the power state requested for the instance. The power state of the AWSMachine
+// takes precedence over the power state annotation of the Cluster.
+func (m *MachineScope) DesiredPowerState() infrav1.PowerState {
+	if m.AWSMachine.Spec.PowerState != "" {
+		return m.AWSMachine.Spec.PowerState
+	}
+
+	// Parking a cluster only stops its workers, as stopping control plane instances would lose quorum
+	// and trigger remediation by the control plane provider.
+	if m.IsControlPlane() {
+		return infrav1.PowerStateRunning
+	}
+
+	switch infrav1.PowerState(m.Cluster.GetAnnotations()[infrav1.PowerStateAnnotation]) {
+	case infrav1.PowerStateStopped:
+		return infrav1.PowerStateStopped
+	case infrav1.PowerStateHibernated:
+		// Instances launched without hibernation support are stopped instead.
+		if m.AWSMachine.Spec.HibernationOptions != nil && m.AWSMachine.Spec.HibernationOptions.Configured {
+			return infrav1.PowerStateHibernated
+		}
+		return infrav1.PowerStateStopped
+	}
+
+	return infrav1.PowerStateRunning
+}
+
+// GetPowerState returns the power state the instance was last brought into.
+func (m *MachineScope) GetPowerState() infrav1.PowerState {
+	return m.AWSMachine.Status.PowerState
+}
+
+// SetPowerState sets the AWSMachine status power state.
+func (m *MachineScope) SetPowerState(v infrav1.PowerState) {
+	m.AWSMachine.Status.PowerState = v
+}
+
 // SetReady sets the AWSMachine Ready Status.
 func (m *MachineScope) SetReady() {
 	m.AWSMachine.Status.Ready = true
@@ -325,6 +362,7 @@ func (m *MachineScope) PatchObject() error {
 			infrav1.InstanceReadyCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.ELBAttachedCondition,
+			infrav1.VolumesReadyCondition,
 		}})
 }
 
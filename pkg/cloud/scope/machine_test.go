@@ -341,3 +341,85 @@ func TestSetProviderID(t *testing.T) {
 		t.Fatalf("Expected providerID %s, got %s", expectedProviderID, providerID)
 	}
 }
+
+func TestDesiredPowerState(t *testing.T) {
+	tests := []struct {
+		name               string
+		specPowerState     infrav1.PowerState
+		clusterAnnotation  string
+		hibernationEnabled bool
+		controlPlane       bool
+		expected           infrav1.PowerState
+	}{
+		{
+			name:     "defaults to running",
+			expected: infrav1.PowerStateRunning,
+		},
+		{
+			name:              "cluster annotation stops the instance",
+			clusterAnnotation: "Stopped",
+			expected:          infrav1.PowerStateStopped,
+		},
+		{
+			name:               "cluster annotation hibernates the instance when hibernation is configured",
+			clusterAnnotation:  "Hibernated",
+			hibernationEnabled: true,
+			expected:           infrav1.PowerStateHibernated,
+		},
+		{
+			name:              "cluster annotation stops the instance when hibernation is not configured",
+			clusterAnnotation: "Hibernated",
+			expected:          infrav1.PowerStateStopped,
+		},
+		{
+			name:              "unknown cluster annotation value is ignored",
+			clusterAnnotation: "Sleeping",
+			expected:          infrav1.PowerStateRunning,
+		},
+		{
+			name:              "cluster annotation doesn't stop control plane instances",
+			clusterAnnotation: "Stopped",
+			controlPlane:      true,
+			expected:          infrav1.PowerStateRunning,
+		},
+		{
+			name:           "spec power state stops control plane instances",
+			specPowerState: infrav1.PowerStateStopped,
+			controlPlane:   true,
+			expected:       infrav1.PowerStateStopped,
+		},
+		{
+			name:              "spec power state takes precedence over the cluster annotation",
+			specPowerState:    infrav1.PowerStateRunning,
+			clusterAnnotation: "Stopped",
+			expected:          infrav1.PowerStateRunning,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scope, err := setupMachineScope()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scope.AWSMachine.Spec.PowerState = tc.specPowerState
+			if tc.hibernationEnabled {
+				scope.AWSMachine.Spec.HibernationOptions = &infrav1.HibernationOptions{Configured: true}
+			}
+			if tc.clusterAnnotation != "" {
+				scope.Cluster.Annotations = map[string]string{infrav1.PowerStateAnnotation: tc.clusterAnnotation}
+			}
+			if tc.controlPlane {
+				if scope.Machine.Labels == nil {
+					scope.Machine.Labels = map[string]string{}
+				}
+				scope.Machine.Labels[clusterv1.MachineControlPlaneLabel] = ""
+			}
+
+			if got := scope.DesiredPowerState(); got != tc.expected {
+				t.Fatalf("Expected power state %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
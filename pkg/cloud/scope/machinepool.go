@@ -38,6 +38,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
@@ -178,6 +179,7 @@ func (m *MachinePoolScope) PatchObject() error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			expinfrav1.ASGReadyCondition,
 			expinfrav1.LaunchTemplateReadyCondition,
+			expinfrav1.WarmPoolReadyCondition,
 		}})
 }
 
@@ -398,3 +400,12 @@ func (m *MachinePoolScope) GetRuntimeObject() runtime.Object {
 func (m *MachinePoolScope) GetLifecycleHooks() []expinfrav1.AWSLifecycleHook {
 	return m.AWSMachinePool.Spec.AWSLifecycleHooks
 }
+
+// ReplicasExternallyManaged returns true if the desired capacity of the ASG is managed outside of
+// the MachinePool replicas, either by an external autoscaler or by the scheduled actions and
+// scaling policies of the ASG. The ASG desired capacity is then synced back to the MachinePool.
+func (m *MachinePoolScope) ReplicasExternallyManaged() bool {
+	return annotations.ReplicasManagedByExternalAutoscaler(m.MachinePool) ||
+		len(m.AWSMachinePool.Spec.ScheduledActions) > 0 ||
+		len(m.AWSMachinePool.Spec.ScalingPolicies) > 0
+}
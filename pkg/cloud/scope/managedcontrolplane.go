@@ -281,6 +281,8 @@ func (s *ManagedControlPlaneScope) PatchObject() error {
 			ekscontrolplanev1.EKSControlPlaneCreatingCondition,
 			ekscontrolplanev1.EKSControlPlaneReadyCondition,
 			ekscontrolplanev1.EKSControlPlaneUpdatingCondition,
+			ekscontrolplanev1.EKSUpgradeInsightsPassedCondition,
+			ekscontrolplanev1.EKSAddonsConfigurationValidCondition,
 			ekscontrolplanev1.IAMControlPlaneRolesReadyCondition,
 		}})
 }
@@ -407,6 +409,25 @@ func (s *ManagedControlPlaneScope) IAMAuthConfig() *ekscontrolplanev1.IAMAuthent
 	return s.ControlPlane.Spec.IAMAuthenticatorConfig
 }
 
+// AuthenticationMode returns the desired authentication mode of the EKS cluster.
+// Defaults to CONFIG_MAP when no access config is specified.
+func (s *ManagedControlPlaneScope) AuthenticationMode() ekscontrolplanev1.EKSAuthenticationMode {
+	if s.ControlPlane.Spec.AccessConfig == nil || s.ControlPlane.Spec.AccessConfig.AuthenticationMode == "" {
+		return ekscontrolplanev1.EKSAuthenticationModeConfigMap
+	}
+	return s.ControlPlane.Spec.AccessConfig.AuthenticationMode
+}
+
+// AccessEntries returns the list of access entries for a EKS cluster.
+func (s *ManagedControlPlaneScope) AccessEntries() []ekscontrolplanev1.AccessEntry {
+	return s.ControlPlane.Spec.AccessEntries
+}
+
+// PodIdentityAssociations returns the list of pod identity associations for a EKS cluster.
+func (s *ManagedControlPlaneScope) PodIdentityAssociations() []ekscontrolplanev1.PodIdentityAssociation {
+	return s.ControlPlane.Spec.PodIdentityAssociations
+}
+
 // Addons returns the list of addons for a EKS cluster.
 func (s *ManagedControlPlaneScope) Addons() []ekscontrolplanev1.Addon {
 	if s.ControlPlane.Spec.Addons == nil {
@@ -425,6 +446,11 @@ func (s *ManagedControlPlaneScope) DisableVPCCNI() bool {
 	return s.ControlPlane.Spec.VpcCni.Disable
 }
 
+// AutoModeEnabled returns whether EKS Auto Mode is enabled for the cluster.
+func (s *ManagedControlPlaneScope) AutoModeEnabled() bool {
+	return s.ControlPlane.Spec.AutoMode != nil && s.ControlPlane.Spec.AutoMode.Enabled
+}
+
 // BootstrapSelfManagedAddons returns whether the AWS EKS networking addons should be disabled.
 func (s *ManagedControlPlaneScope) BootstrapSelfManagedAddons() *bool {
 	return &s.ControlPlane.Spec.BootstrapSelfManagedAddons
@@ -497,6 +523,41 @@ func (s *ManagedControlPlaneScope) NodePortIngressRuleCidrBlocks() []string {
 	return nil
 }
 
+// NodePortIngressRulePrefixListIDs returns the managed prefix list IDs for the node NodePort ingress rules.
+func (s *ManagedControlPlaneScope) NodePortIngressRulePrefixListIDs() []string {
+	return nil
+}
+
+// PrefixList returns the managed prefix list owned by the cluster.
+func (s *ManagedControlPlaneScope) PrefixList() *infrav1.PrefixListSpec {
+	return s.ControlPlane.Spec.NetworkSpec.PrefixList
+}
+
+// VPCEndpoints returns the VPC endpoints to create in the VPC.
+func (s *ManagedControlPlaneScope) VPCEndpoints() []infrav1.VPCEndpointSpec {
+	return s.ControlPlane.Spec.NetworkSpec.VPCEndpoints
+}
+
+// NetworkACLs returns the default network ACLs of the subnets.
+func (s *ManagedControlPlaneScope) NetworkACLs() *infrav1.NetworkACLsSpec {
+	return s.ControlPlane.Spec.NetworkSpec.NetworkACLs
+}
+
+// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+func (s *ManagedControlPlaneScope) AdditionalControlPlaneEgressRules() []infrav1.EgressRule {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().AdditionalControlPlaneEgressRules
+}
+
+// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+func (s *ManagedControlPlaneScope) AdditionalNodeEgressRules() []infrav1.EgressRule {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().AdditionalNodeEgressRules
+}
+
+// SecurityGroupEgressRules returns the egress rules replacing the ones of the security groups per role.
+func (s *ManagedControlPlaneScope) SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules {
+	return s.ControlPlane.Spec.NetworkSpec.DeepCopy().SecurityGroupEgressRules
+}
+
 // MaxWaitDuration returns time waiting for operation.
 func (s *ManagedControlPlaneScope) MaxWaitDuration() time.Duration {
 	return s.MaxWaitActiveUpdateDelete
@@ -59,4 +59,13 @@ type NetworkScope interface {
 	SetNatGatewaysIPs(ips []string)
 	// GetNatGatewaysIPs gets the Nat Gateways Public IPs.
 	GetNatGatewaysIPs() []string
+
+	// PrefixList returns the managed prefix list owned by the cluster.
+	PrefixList() *infrav1.PrefixListSpec
+
+	// VPCEndpoints returns the VPC endpoints to create in the VPC.
+	VPCEndpoints() []infrav1.VPCEndpointSpec
+
+	// NetworkACLs returns the default network ACLs of the subnets.
+	NetworkACLs() *infrav1.NetworkACLsSpec
 }
@@ -65,4 +65,16 @@ type SGScope interface {
 
 	// NodePortIngressRuleCidrBlocks returns the CIDR blocks for the node NodePort ingress rules.
 	NodePortIngressRuleCidrBlocks() []string
+
+	// NodePortIngressRulePrefixListIDs returns the managed prefix list IDs for the node NodePort ingress rules.
+	NodePortIngressRulePrefixListIDs() []string
+
+	// AdditionalControlPlaneEgressRules returns the additional egress rules for the control plane security group.
+	AdditionalControlPlaneEgressRules() []infrav1.EgressRule
+
+	// AdditionalNodeEgressRules returns the additional egress rules for the node security group.
+	AdditionalNodeEgressRules() []infrav1.EgressRule
+
+	// SecurityGroupEgressRules returns the egress rules replacing the ones of the security groups per role.
+	SecurityGroupEgressRules() map[infrav1.SecurityGroupRole]infrav1.EgressRules
 }
@@ -39,7 +39,6 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
-	"sigs.k8s.io/cluster-api/util/annotations"
 )
 
 // SDKToAutoScalingGroup converts an AWS EC2 SDK AutoScalingGroup to the CAPA AutoScalingGroup type.
@@ -68,7 +67,7 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 		}
 
 		for _, override := range v.MixedInstancesPolicy.LaunchTemplate.Overrides {
-			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, expinfrav1.Overrides{InstanceType: aws.ToString(override.InstanceType)})
+			i.MixedInstancesPolicy.Overrides = append(i.MixedInstancesPolicy.Overrides, sdkToOverrides(override))
 		}
 
 		onDemandAllocationStrategy := aws.ToString(v.MixedInstancesPolicy.InstancesDistribution.OnDemandAllocationStrategy)
@@ -106,6 +105,11 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 
 	if len(v.Instances) > 0 {
 		for _, autoscalingInstance := range v.Instances {
+			// Instances of the warm pool are not part of the machine pool until they
+			// leave the warm pool, so they must neither get AWSMachines nor count as replicas.
+			if isWarmPoolInstance(autoscalingInstance) {
+				continue
+			}
 			tmp := &infrav1.Instance{
 				ID:               aws.ToString(autoscalingInstance.InstanceId),
 				State:            infrav1.InstanceState(autoscalingInstance.LifecycleState),
@@ -126,6 +130,11 @@ func (s *Service) SDKToAutoScalingGroup(v *autoscalingtypes.AutoScalingGroup) (*
 	return i, nil
 }
 
+// isWarmPoolInstance returns whether the instance is currently in the warm pool of the ASG.
+func isWarmPoolInstance(instance autoscalingtypes.Instance) bool {
+	return strings.HasPrefix(string(instance.LifecycleState), "Warmed:")
+}
+
 // ASGIfExists returns the existing autoscaling group or nothing if it doesn't exist.
 func (s *Service) ASGIfExists(name *string) (*expinfrav1.AutoScalingGroup, error) {
 	if name == nil {
@@ -177,7 +186,7 @@ func (s *Service) CreateASG(machinePoolScope *scope.MachinePoolScope) (*expinfra
 	// Ignore the problem for externally managed clusters because MachinePool replicas will be updated to the right value automatically.
 	if mpReplicas >= machinePoolScope.AWSMachinePool.Spec.MinSize && mpReplicas <= machinePoolScope.AWSMachinePool.Spec.MaxSize {
 		desiredCapacity = &mpReplicas
-	} else if !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	} else if !machinePoolScope.ReplicasExternallyManaged() {
 		return nil, fmt.Errorf("incorrect number of replicas %d in MachinePool %v", mpReplicas, machinePoolScope.MachinePool.Name)
 	}
 
@@ -285,7 +294,7 @@ func (s *Service) UpdateASG(machinePoolScope *scope.MachinePoolScope) error {
 		CapacityRebalance:    aws.Bool(machinePoolScope.AWSMachinePool.Spec.CapacityRebalance),
 	}
 
-	if machinePoolScope.MachinePool.Spec.Replicas != nil && !annotations.ReplicasManagedByExternalAutoscaler(machinePoolScope.MachinePool) {
+	if machinePoolScope.MachinePool.Spec.Replicas != nil && !machinePoolScope.ReplicasExternallyManaged() {
 		input.DesiredCapacity = aws.Int32(*machinePoolScope.MachinePool.Spec.Replicas)
 	}
 
@@ -404,9 +413,7 @@ func createSDKMixedInstancesPolicy(name string, i *expinfrav1.MixedInstancesPoli
 	}
 
 	for _, override := range i.Overrides {
-		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, autoscalingtypes.LaunchTemplateOverrides{
-			InstanceType: aws.String(override.InstanceType),
-		})
+		mixedInstancesPolicy.LaunchTemplate.Overrides = append(mixedInstancesPolicy.LaunchTemplate.Overrides, createSDKLaunchTemplateOverrides(override))
 	}
 
 	return mixedInstancesPolicy
@@ -307,6 +307,48 @@ func TestServiceSDKToAutoScalingGroup(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid input - warm pool instances are excluded",
+			input: &autoscalingtypes.AutoScalingGroup{
+				AutoScalingGroupARN:  aws.String("test-id"),
+				AutoScalingGroupName: aws.String("test-name"),
+				DesiredCapacity:      aws.Int32(1),
+				MaxSize:              aws.Int32(3),
+				MinSize:              aws.Int32(1),
+				Instances: []autoscalingtypes.Instance{
+					{
+						InstanceId:       aws.String("i-inservice"),
+						LifecycleState:   autoscalingtypes.LifecycleStateInService,
+						AvailabilityZone: aws.String("us-east-1a"),
+					},
+					{
+						InstanceId:       aws.String("i-warm"),
+						LifecycleState:   autoscalingtypes.LifecycleStateWarmedStopped,
+						AvailabilityZone: aws.String("us-east-1a"),
+					},
+					{
+						InstanceId:       aws.String("i-warm-pending"),
+						LifecycleState:   autoscalingtypes.LifecycleStateWarmedPending,
+						AvailabilityZone: aws.String("us-east-1b"),
+					},
+				},
+			},
+			want: &expinfrav1.AutoScalingGroup{
+				ID:              "test-id",
+				Name:            "test-name",
+				DesiredCapacity: aws.Int32(1),
+				MaxSize:         int32(3),
+				MinSize:         int32(1),
+				Instances: []infrav1.Instance{
+					{
+						ID:               "i-inservice",
+						State:            "InService",
+						AvailabilityZone: "us-east-1a",
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalid input - incorrect on-demand allocation strategy",
 			input: &autoscalingtypes.AutoScalingGroup{
@@ -71,6 +71,26 @@ func (mr *MockAutoScalingAPIMockRecorder) CancelInstanceRefresh(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInstanceRefresh", reflect.TypeOf((*MockAutoScalingAPI)(nil).CancelInstanceRefresh), varargs...)
 }
 
+// CompleteLifecycleAction mocks base method.
+func (m *MockAutoScalingAPI) CompleteLifecycleAction(arg0 context.Context, arg1 *autoscaling.CompleteLifecycleActionInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.CompleteLifecycleActionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CompleteLifecycleAction", varargs...)
+	ret0, _ := ret[0].(*autoscaling.CompleteLifecycleActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteLifecycleAction indicates an expected call of CompleteLifecycleAction.
+func (mr *MockAutoScalingAPIMockRecorder) CompleteLifecycleAction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteLifecycleAction", reflect.TypeOf((*MockAutoScalingAPI)(nil).CompleteLifecycleAction), varargs...)
+}
+
 // CreateAutoScalingGroup mocks base method.
 func (m *MockAutoScalingAPI) CreateAutoScalingGroup(arg0 context.Context, arg1 *autoscaling.CreateAutoScalingGroupInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.CreateAutoScalingGroupOutput, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +171,46 @@ func (mr *MockAutoScalingAPIMockRecorder) DeleteLifecycleHook(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecycleHook", reflect.TypeOf((*MockAutoScalingAPI)(nil).DeleteLifecycleHook), varargs...)
 }
 
+// DeletePolicy mocks base method.
+func (m *MockAutoScalingAPI) DeletePolicy(arg0 context.Context, arg1 *autoscaling.DeletePolicyInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DeletePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeletePolicy", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DeletePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockAutoScalingAPIMockRecorder) DeletePolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockAutoScalingAPI)(nil).DeletePolicy), varargs...)
+}
+
+// DeleteScheduledAction mocks base method.
+func (m *MockAutoScalingAPI) DeleteScheduledAction(arg0 context.Context, arg1 *autoscaling.DeleteScheduledActionInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DeleteScheduledActionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteScheduledAction", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DeleteScheduledActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteScheduledAction indicates an expected call of DeleteScheduledAction.
+func (mr *MockAutoScalingAPIMockRecorder) DeleteScheduledAction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledAction", reflect.TypeOf((*MockAutoScalingAPI)(nil).DeleteScheduledAction), varargs...)
+}
+
 // DeleteTags mocks base method.
 func (m *MockAutoScalingAPI) DeleteTags(arg0 context.Context, arg1 *autoscaling.DeleteTagsInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DeleteTagsOutput, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +231,26 @@ func (mr *MockAutoScalingAPIMockRecorder) DeleteTags(arg0, arg1 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockAutoScalingAPI)(nil).DeleteTags), varargs...)
 }
 
+// DeleteWarmPool mocks base method.
+func (m *MockAutoScalingAPI) DeleteWarmPool(arg0 context.Context, arg1 *autoscaling.DeleteWarmPoolInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DeleteWarmPoolOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWarmPool", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DeleteWarmPoolOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWarmPool indicates an expected call of DeleteWarmPool.
+func (mr *MockAutoScalingAPIMockRecorder) DeleteWarmPool(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWarmPool", reflect.TypeOf((*MockAutoScalingAPI)(nil).DeleteWarmPool), varargs...)
+}
+
 // DescribeAutoScalingGroups mocks base method.
 func (m *MockAutoScalingAPI) DescribeAutoScalingGroups(arg0 context.Context, arg1 *autoscaling.DescribeAutoScalingGroupsInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
 	m.ctrl.T.Helper()
@@ -231,6 +311,66 @@ func (mr *MockAutoScalingAPIMockRecorder) DescribeLifecycleHooks(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLifecycleHooks", reflect.TypeOf((*MockAutoScalingAPI)(nil).DescribeLifecycleHooks), varargs...)
 }
 
+// DescribePolicies mocks base method.
+func (m *MockAutoScalingAPI) DescribePolicies(arg0 context.Context, arg1 *autoscaling.DescribePoliciesInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DescribePoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePolicies", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DescribePoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePolicies indicates an expected call of DescribePolicies.
+func (mr *MockAutoScalingAPIMockRecorder) DescribePolicies(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePolicies", reflect.TypeOf((*MockAutoScalingAPI)(nil).DescribePolicies), varargs...)
+}
+
+// DescribeScheduledActions mocks base method.
+func (m *MockAutoScalingAPI) DescribeScheduledActions(arg0 context.Context, arg1 *autoscaling.DescribeScheduledActionsInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeScheduledActions", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DescribeScheduledActionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduledActions indicates an expected call of DescribeScheduledActions.
+func (mr *MockAutoScalingAPIMockRecorder) DescribeScheduledActions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduledActions", reflect.TypeOf((*MockAutoScalingAPI)(nil).DescribeScheduledActions), varargs...)
+}
+
+// DescribeWarmPool mocks base method.
+func (m *MockAutoScalingAPI) DescribeWarmPool(arg0 context.Context, arg1 *autoscaling.DescribeWarmPoolInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.DescribeWarmPoolOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeWarmPool", varargs...)
+	ret0, _ := ret[0].(*autoscaling.DescribeWarmPoolOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWarmPool indicates an expected call of DescribeWarmPool.
+func (mr *MockAutoScalingAPIMockRecorder) DescribeWarmPool(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWarmPool", reflect.TypeOf((*MockAutoScalingAPI)(nil).DescribeWarmPool), varargs...)
+}
+
 // PutLifecycleHook mocks base method.
 func (m *MockAutoScalingAPI) PutLifecycleHook(arg0 context.Context, arg1 *autoscaling.PutLifecycleHookInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.PutLifecycleHookOutput, error) {
 	m.ctrl.T.Helper()
@@ -251,6 +391,66 @@ func (mr *MockAutoScalingAPIMockRecorder) PutLifecycleHook(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutLifecycleHook", reflect.TypeOf((*MockAutoScalingAPI)(nil).PutLifecycleHook), varargs...)
 }
 
+// PutScalingPolicy mocks base method.
+func (m *MockAutoScalingAPI) PutScalingPolicy(arg0 context.Context, arg1 *autoscaling.PutScalingPolicyInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.PutScalingPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutScalingPolicy", varargs...)
+	ret0, _ := ret[0].(*autoscaling.PutScalingPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutScalingPolicy indicates an expected call of PutScalingPolicy.
+func (mr *MockAutoScalingAPIMockRecorder) PutScalingPolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScalingPolicy", reflect.TypeOf((*MockAutoScalingAPI)(nil).PutScalingPolicy), varargs...)
+}
+
+// PutScheduledUpdateGroupAction mocks base method.
+func (m *MockAutoScalingAPI) PutScheduledUpdateGroupAction(arg0 context.Context, arg1 *autoscaling.PutScheduledUpdateGroupActionInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutScheduledUpdateGroupAction", varargs...)
+	ret0, _ := ret[0].(*autoscaling.PutScheduledUpdateGroupActionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutScheduledUpdateGroupAction indicates an expected call of PutScheduledUpdateGroupAction.
+func (mr *MockAutoScalingAPIMockRecorder) PutScheduledUpdateGroupAction(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScheduledUpdateGroupAction", reflect.TypeOf((*MockAutoScalingAPI)(nil).PutScheduledUpdateGroupAction), varargs...)
+}
+
+// PutWarmPool mocks base method.
+func (m *MockAutoScalingAPI) PutWarmPool(arg0 context.Context, arg1 *autoscaling.PutWarmPoolInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.PutWarmPoolOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PutWarmPool", varargs...)
+	ret0, _ := ret[0].(*autoscaling.PutWarmPoolOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutWarmPool indicates an expected call of PutWarmPool.
+func (mr *MockAutoScalingAPIMockRecorder) PutWarmPool(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWarmPool", reflect.TypeOf((*MockAutoScalingAPI)(nil).PutWarmPool), varargs...)
+}
+
 // ResumeProcesses mocks base method.
 func (m *MockAutoScalingAPI) ResumeProcesses(arg0 context.Context, arg1 *autoscaling.ResumeProcessesInput, arg2 ...func(*autoscaling.Options)) (*autoscaling.ResumeProcessesOutput, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,161 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+// createSDKLaunchTemplateOverrides converts a CAPA override to the AWS SDK type.
+func createSDKLaunchTemplateOverrides(override expinfrav1.Overrides) autoscalingtypes.LaunchTemplateOverrides {
+	ret := autoscalingtypes.LaunchTemplateOverrides{}
+
+	if override.InstanceType != "" {
+		ret.InstanceType = aws.String(override.InstanceType)
+	}
+
+	if override.InstanceRequirements != nil {
+		ret.InstanceRequirements = createSDKInstanceRequirements(override.InstanceRequirements)
+	}
+
+	if override.WeightedCapacity != nil {
+		ret.WeightedCapacity = aws.String(strconv.Itoa(int(*override.WeightedCapacity)))
+	}
+
+	if override.LaunchTemplate != nil {
+		ret.LaunchTemplateSpecification = &autoscalingtypes.LaunchTemplateSpecification{
+			LaunchTemplateId:   override.LaunchTemplate.ID,
+			LaunchTemplateName: override.LaunchTemplate.Name,
+			Version:            override.LaunchTemplate.Version,
+		}
+	}
+
+	return ret
+}
+
+func createSDKInstanceRequirements(r *expinfrav1.InstanceRequirements) *autoscalingtypes.InstanceRequirements {
+	ret := &autoscalingtypes.InstanceRequirements{
+		VCpuCount: &autoscalingtypes.VCpuCountRequest{
+			Min: aws.Int32(r.VCPUCount.Min),
+			Max: r.VCPUCount.Max,
+		},
+		MemoryMiB: &autoscalingtypes.MemoryMiBRequest{
+			Min: aws.Int32(r.MemoryMiB.Min),
+			Max: r.MemoryMiB.Max,
+		},
+		BurstablePerformance: autoscalingtypes.BurstablePerformance(r.BurstablePerformance),
+		BareMetal:            autoscalingtypes.BareMetal(r.BareMetal),
+		AllowedInstanceTypes: r.AllowedInstanceTypes,
+
+		ExcludedInstanceTypes:                          r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:          r.SpotMaxPricePercentageOverLowestPrice,
+		MaxSpotPriceAsPercentageOfOptimalOnDemandPrice: r.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice,
+		OnDemandMaxPricePercentageOverLowestPrice:      r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	for _, m := range r.CPUManufacturers {
+		ret.CpuManufacturers = append(ret.CpuManufacturers, autoscalingtypes.CpuManufacturer(m))
+	}
+	for _, g := range r.InstanceGenerations {
+		ret.InstanceGenerations = append(ret.InstanceGenerations, autoscalingtypes.InstanceGeneration(g))
+	}
+	for _, t := range r.AcceleratorTypes {
+		ret.AcceleratorTypes = append(ret.AcceleratorTypes, autoscalingtypes.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		ret.AcceleratorManufacturers = append(ret.AcceleratorManufacturers, autoscalingtypes.AcceleratorManufacturer(m))
+	}
+
+	if r.AcceleratorCount != nil {
+		ret.AcceleratorCount = &autoscalingtypes.AcceleratorCountRequest{
+			Min: aws.Int32(r.AcceleratorCount.Min),
+			Max: r.AcceleratorCount.Max,
+		}
+	}
+
+	return ret
+}
+
+// sdkToOverrides converts AWS SDK launch template overrides to the CAPA type.
+func sdkToOverrides(override autoscalingtypes.LaunchTemplateOverrides) expinfrav1.Overrides {
+	ret := expinfrav1.Overrides{
+		InstanceType: aws.ToString(override.InstanceType),
+	}
+
+	if override.InstanceRequirements != nil {
+		ret.InstanceRequirements = sdkToInstanceRequirements(override.InstanceRequirements)
+	}
+
+	if override.WeightedCapacity != nil {
+		if weight, err := strconv.ParseInt(*override.WeightedCapacity, 10, 32); err == nil {
+			ret.WeightedCapacity = aws.Int32(int32(weight))
+		}
+	}
+
+	if spec := override.LaunchTemplateSpecification; spec != nil {
+		ret.LaunchTemplate = &expinfrav1.LaunchTemplateReference{
+			ID:      spec.LaunchTemplateId,
+			Name:    spec.LaunchTemplateName,
+			Version: spec.Version,
+		}
+	}
+
+	return ret
+}
+
+func sdkToInstanceRequirements(r *autoscalingtypes.InstanceRequirements) *expinfrav1.InstanceRequirements {
+	ret := &expinfrav1.InstanceRequirements{
+		BurstablePerformance: expinfrav1.InstanceRequirementInclusion(r.BurstablePerformance),
+		BareMetal:            expinfrav1.InstanceRequirementInclusion(r.BareMetal),
+		AllowedInstanceTypes: r.AllowedInstanceTypes,
+
+		ExcludedInstanceTypes:                          r.ExcludedInstanceTypes,
+		SpotMaxPricePercentageOverLowestPrice:          r.SpotMaxPricePercentageOverLowestPrice,
+		MaxSpotPriceAsPercentageOfOptimalOnDemandPrice: r.MaxSpotPriceAsPercentageOfOptimalOnDemandPrice,
+		OnDemandMaxPricePercentageOverLowestPrice:      r.OnDemandMaxPricePercentageOverLowestPrice,
+	}
+
+	if r.VCpuCount != nil {
+		ret.VCPUCount = expinfrav1.IntegerRange{Min: aws.ToInt32(r.VCpuCount.Min), Max: r.VCpuCount.Max}
+	}
+	if r.MemoryMiB != nil {
+		ret.MemoryMiB = expinfrav1.IntegerRange{Min: aws.ToInt32(r.MemoryMiB.Min), Max: r.MemoryMiB.Max}
+	}
+	if r.AcceleratorCount != nil {
+		ret.AcceleratorCount = &expinfrav1.IntegerRange{Min: aws.ToInt32(r.AcceleratorCount.Min), Max: r.AcceleratorCount.Max}
+	}
+
+	for _, m := range r.CpuManufacturers {
+		ret.CPUManufacturers = append(ret.CPUManufacturers, expinfrav1.CPUManufacturer(m))
+	}
+	for _, g := range r.InstanceGenerations {
+		ret.InstanceGenerations = append(ret.InstanceGenerations, expinfrav1.InstanceGeneration(g))
+	}
+	for _, t := range r.AcceleratorTypes {
+		ret.AcceleratorTypes = append(ret.AcceleratorTypes, expinfrav1.AcceleratorType(t))
+	}
+	for _, m := range r.AcceleratorManufacturers {
+		ret.AcceleratorManufacturers = append(ret.AcceleratorManufacturers, expinfrav1.AcceleratorManufacturer(m))
+	}
+
+	return ret
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+)
+
+func TestLaunchTemplateOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		override expinfrav1.Overrides
+		want     autoscalingtypes.LaunchTemplateOverrides
+	}{
+		{
+			name:     "instance type",
+			override: expinfrav1.Overrides{InstanceType: "m5.large"},
+			want:     autoscalingtypes.LaunchTemplateOverrides{InstanceType: aws.String("m5.large")},
+		},
+		{
+			name: "instance type with weight and launch template",
+			override: expinfrav1.Overrides{
+				InstanceType:     "m7g.xlarge",
+				WeightedCapacity: ptr.To[int32](2),
+				LaunchTemplate: &expinfrav1.LaunchTemplateReference{
+					Name:    aws.String("arm64"),
+					Version: aws.String("$Latest"),
+				},
+			},
+			want: autoscalingtypes.LaunchTemplateOverrides{
+				InstanceType:     aws.String("m7g.xlarge"),
+				WeightedCapacity: aws.String("2"),
+				LaunchTemplateSpecification: &autoscalingtypes.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("arm64"),
+					Version:            aws.String("$Latest"),
+				},
+			},
+		},
+		{
+			name: "instance requirements",
+			override: expinfrav1.Overrides{
+				InstanceRequirements: &expinfrav1.InstanceRequirements{
+					VCPUCount:                             expinfrav1.IntegerRange{Min: 2, Max: ptr.To[int32](8)},
+					MemoryMiB:                             expinfrav1.IntegerRange{Min: 4096},
+					CPUManufacturers:                      []expinfrav1.CPUManufacturer{"intel", "amd"},
+					InstanceGenerations:                   []expinfrav1.InstanceGeneration{"current"},
+					BurstablePerformance:                  expinfrav1.InstanceRequirementExcluded,
+					BareMetal:                             expinfrav1.InstanceRequirementIncluded,
+					AcceleratorTypes:                      []expinfrav1.AcceleratorType{"gpu"},
+					AcceleratorManufacturers:              []expinfrav1.AcceleratorManufacturer{"nvidia"},
+					AcceleratorCount:                      &expinfrav1.IntegerRange{Min: 1},
+					ExcludedInstanceTypes:                 []string{"g4dn.*"},
+					SpotMaxPricePercentageOverLowestPrice: ptr.To[int32](50),
+				},
+			},
+			want: autoscalingtypes.LaunchTemplateOverrides{
+				InstanceRequirements: &autoscalingtypes.InstanceRequirements{
+					VCpuCount:                             &autoscalingtypes.VCpuCountRequest{Min: aws.Int32(2), Max: aws.Int32(8)},
+					MemoryMiB:                             &autoscalingtypes.MemoryMiBRequest{Min: aws.Int32(4096)},
+					CpuManufacturers:                      []autoscalingtypes.CpuManufacturer{autoscalingtypes.CpuManufacturerIntel, autoscalingtypes.CpuManufacturerAmd},
+					InstanceGenerations:                   []autoscalingtypes.InstanceGeneration{autoscalingtypes.InstanceGenerationCurrent},
+					BurstablePerformance:                  autoscalingtypes.BurstablePerformanceExcluded,
+					BareMetal:                             autoscalingtypes.BareMetalIncluded,
+					AcceleratorTypes:                      []autoscalingtypes.AcceleratorType{autoscalingtypes.AcceleratorTypeGpu},
+					AcceleratorManufacturers:              []autoscalingtypes.AcceleratorManufacturer{autoscalingtypes.AcceleratorManufacturerNvidia},
+					AcceleratorCount:                      &autoscalingtypes.AcceleratorCountRequest{Min: aws.Int32(1)},
+					ExcludedInstanceTypes:                 []string{"g4dn.*"},
+					SpotMaxPricePercentageOverLowestPrice: aws.Int32(50),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := createSDKLaunchTemplateOverrides(tt.override)
+			g.Expect(got).To(Equal(tt.want))
+
+			// Converting back must yield the original override, otherwise the ASG would be
+			// detected as drifted on every reconciliation.
+			g.Expect(sdkToOverrides(got)).To(Equal(tt.override))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// DescribeScalingPolicies returns the scaling policies for the given AutoScalingGroup after retrieving them from the AWS API.
+func (s *Service) DescribeScalingPolicies(asgName string) ([]*expinfrav1.AWSScalingPolicy, error) {
+	input := &autoscaling.DescribePoliciesInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	policies := []*expinfrav1.AWSScalingPolicy{}
+	paginator := autoscaling.NewDescribePoliciesPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scaling policies for AutoScalingGroup: %q", asgName)
+		}
+		for _, policy := range out.ScalingPolicies {
+			policies = append(policies, s.SDKToScalingPolicy(policy))
+		}
+	}
+
+	return policies, nil
+}
+
+// PutScalingPolicy creates or updates a scaling policy for the given AutoScalingGroup.
+func (s *Service) PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.AWSScalingPolicy) error {
+	input := getPutScalingPolicyInput(asgName, policy)
+
+	if _, err := s.ASGClient.PutScalingPolicy(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scaling policy %q for AutoScalingGroup: %q", policy.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScalingPolicy deletes a scaling policy of the given AutoScalingGroup.
+func (s *Service) DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error {
+	input := &autoscaling.DeletePolicyInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		PolicyName:           ptr.To(policyName),
+	}
+
+	if _, err := s.ASGClient.DeletePolicy(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scaling policy %q for AutoScalingGroup: %q", policyName, asgName)
+	}
+
+	return nil
+}
+
+// SDKToScalingPolicy converts an AWS SDK ScalingPolicy to the CAPA scaling policy type.
+// Settings of policy types that are not supported by CAPA are not converted.
+func (s *Service) SDKToScalingPolicy(policy autoscalingtypes.ScalingPolicy) *expinfrav1.AWSScalingPolicy {
+	ret := &expinfrav1.AWSScalingPolicy{
+		Name:       ptr.Deref(policy.PolicyName, ""),
+		PolicyType: expinfrav1.ScalingPolicyType(ptr.Deref(policy.PolicyType, "")),
+	}
+
+	if policy.EstimatedInstanceWarmup != nil {
+		ret.EstimatedInstanceWarmup = &metav1.Duration{Duration: time.Duration(*policy.EstimatedInstanceWarmup) * time.Second}
+	}
+
+	if tt := policy.TargetTrackingConfiguration; tt != nil {
+		ret.TargetTracking = &expinfrav1.TargetTrackingConfiguration{
+			TargetValue:    int64(ptr.Deref(tt.TargetValue, 0)),
+			DisableScaleIn: ptr.Deref(tt.DisableScaleIn, false),
+		}
+		if tt.PredefinedMetricSpecification != nil {
+			ret.TargetTracking.PredefinedMetricType = expinfrav1.PredefinedMetricType(tt.PredefinedMetricSpecification.PredefinedMetricType)
+			ret.TargetTracking.ResourceLabel = tt.PredefinedMetricSpecification.ResourceLabel
+		}
+	}
+
+	if ret.PolicyType == expinfrav1.ScalingPolicyTypeStep {
+		ret.StepScaling = &expinfrav1.StepScalingConfiguration{
+			AdjustmentType:         expinfrav1.AdjustmentType(ptr.Deref(policy.AdjustmentType, "")),
+			MinAdjustmentMagnitude: policy.MinAdjustmentMagnitude,
+		}
+		if policy.MetricAggregationType != nil {
+			ret.StepScaling.MetricAggregationType = ptr.To(expinfrav1.MetricAggregationType(*policy.MetricAggregationType))
+		}
+		for _, step := range policy.StepAdjustments {
+			adjustment := expinfrav1.StepAdjustment{
+				ScalingAdjustment: ptr.Deref(step.ScalingAdjustment, 0),
+			}
+			if step.MetricIntervalLowerBound != nil {
+				adjustment.MetricIntervalLowerBound = ptr.To(int64(*step.MetricIntervalLowerBound))
+			}
+			if step.MetricIntervalUpperBound != nil {
+				adjustment.MetricIntervalUpperBound = ptr.To(int64(*step.MetricIntervalUpperBound))
+			}
+			ret.StepScaling.StepAdjustments = append(ret.StepScaling.StepAdjustments, adjustment)
+		}
+	}
+
+	return ret
+}
+
+func getPutScalingPolicyInput(asgName string, policy *expinfrav1.AWSScalingPolicy) *autoscaling.PutScalingPolicyInput {
+	input := &autoscaling.PutScalingPolicyInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		PolicyName:           ptr.To(policy.Name),
+		PolicyType:           ptr.To(string(policy.PolicyType)),
+	}
+
+	if policy.EstimatedInstanceWarmup != nil {
+		input.EstimatedInstanceWarmup = ptr.To(int32(policy.EstimatedInstanceWarmup.Duration.Seconds()))
+	}
+
+	if tt := policy.TargetTracking; tt != nil {
+		input.TargetTrackingConfiguration = &autoscalingtypes.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+				PredefinedMetricType: autoscalingtypes.MetricType(tt.PredefinedMetricType),
+				ResourceLabel:        tt.ResourceLabel,
+			},
+			TargetValue:    ptr.To(float64(tt.TargetValue)),
+			DisableScaleIn: ptr.To(tt.DisableScaleIn),
+		}
+	}
+
+	if step := policy.StepScaling; step != nil {
+		input.AdjustmentType = ptr.To(string(step.AdjustmentType))
+		input.MinAdjustmentMagnitude = step.MinAdjustmentMagnitude
+		input.MetricAggregationType = ptr.To(string(metricAggregationTypeOrDefault(step.MetricAggregationType)))
+		for _, adjustment := range step.StepAdjustments {
+			sdkAdjustment := autoscalingtypes.StepAdjustment{
+				ScalingAdjustment: ptr.To(adjustment.ScalingAdjustment),
+			}
+			if adjustment.MetricIntervalLowerBound != nil {
+				sdkAdjustment.MetricIntervalLowerBound = ptr.To(float64(*adjustment.MetricIntervalLowerBound))
+			}
+			if adjustment.MetricIntervalUpperBound != nil {
+				sdkAdjustment.MetricIntervalUpperBound = ptr.To(float64(*adjustment.MetricIntervalUpperBound))
+			}
+			input.StepAdjustments = append(input.StepAdjustments, sdkAdjustment)
+		}
+	}
+
+	return input
+}
+
+func metricAggregationTypeOrDefault(aggregationType *expinfrav1.MetricAggregationType) expinfrav1.MetricAggregationType {
+	return ptr.Deref(aggregationType, expinfrav1.MetricAggregationTypeAverage)
+}
+
+func stepAdjustmentsEqual(a, b []expinfrav1.StepAdjustment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !ptr.Equal(a[i].MetricIntervalLowerBound, b[i].MetricIntervalLowerBound) ||
+			!ptr.Equal(a[i].MetricIntervalUpperBound, b[i].MetricIntervalUpperBound) ||
+			a[i].ScalingAdjustment != b[i].ScalingAdjustment {
+			return false
+		}
+	}
+	return true
+}
+
+func scalingPolicyNeedsUpdate(existing *expinfrav1.AWSScalingPolicy, expected *expinfrav1.AWSScalingPolicy) bool {
+	if existing.PolicyType != expected.PolicyType {
+		return true
+	}
+
+	// The estimated instance warmup is only compared if it is set explicitly, as AWS
+	// falls back to the default instance warmup of the ASG otherwise.
+	if expected.EstimatedInstanceWarmup != nil &&
+		(existing.EstimatedInstanceWarmup == nil || existing.EstimatedInstanceWarmup.Duration != expected.EstimatedInstanceWarmup.Duration) {
+		return true
+	}
+
+	switch {
+	case expected.TargetTracking != nil:
+		if existing.TargetTracking == nil {
+			return true
+		}
+		return existing.TargetTracking.PredefinedMetricType != expected.TargetTracking.PredefinedMetricType ||
+			ptr.Deref(existing.TargetTracking.ResourceLabel, "") != ptr.Deref(expected.TargetTracking.ResourceLabel, "") ||
+			existing.TargetTracking.TargetValue != expected.TargetTracking.TargetValue ||
+			existing.TargetTracking.DisableScaleIn != expected.TargetTracking.DisableScaleIn
+	case expected.StepScaling != nil:
+		if existing.StepScaling == nil {
+			return true
+		}
+		return existing.StepScaling.AdjustmentType != expected.StepScaling.AdjustmentType ||
+			metricAggregationTypeOrDefault(existing.StepScaling.MetricAggregationType) != metricAggregationTypeOrDefault(expected.StepScaling.MetricAggregationType) ||
+			!ptr.Equal(existing.StepScaling.MinAdjustmentMagnitude, expected.StepScaling.MinAdjustmentMagnitude) ||
+			!stepAdjustmentsEqual(existing.StepScaling.StepAdjustments, expected.StepScaling.StepAdjustments)
+	}
+
+	return false
+}
+
+// ReconcileScalingPolicies reconciles scaling policies for an ASG
+// by creating missing policies, updating mismatching policies and
+// deleting extraneous policies.
+func ReconcileScalingPolicies(ctx context.Context, asgService services.ASGInterface, asgName string, wantedPolicies []expinfrav1.AWSScalingPolicy, storeConditionsOnObject conditions.Setter, log logger.Wrapper) error {
+	existingPolicies, err := asgService.DescribeScalingPolicies(asgName)
+	if err != nil {
+		return err
+	}
+
+	existingByName := make(map[string]*expinfrav1.AWSScalingPolicy, len(existingPolicies))
+	for _, policy := range existingPolicies {
+		existingByName[policy.Name] = policy
+	}
+
+	wantedNames := make(map[string]bool, len(wantedPolicies))
+	for i := range wantedPolicies {
+		wantedPolicy := &wantedPolicies[i]
+		wantedNames[wantedPolicy.Name] = true
+
+		existingPolicy := existingByName[wantedPolicy.Name]
+		if existingPolicy != nil && !scalingPolicyNeedsUpdate(existingPolicy, wantedPolicy) {
+			continue
+		}
+
+		log.Info("Creating or updating scaling policy", "policy", wantedPolicy.Name)
+		if err := asgService.PutScalingPolicy(ctx, asgName, wantedPolicy); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScalingPolicyUpdateFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	for _, existingPolicy := range existingPolicies {
+		if wantedNames[existingPolicy.Name] {
+			continue
+		}
+
+		log.Info("Deleting extraneous scaling policy", "policy", existingPolicy.Name)
+		if err := asgService.DeleteScalingPolicy(ctx, asgName, existingPolicy.Name); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition, expinfrav1.ScalingPolicyDeletionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	if len(wantedPolicies) == 0 {
+		conditions.Delete(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition)
+		return nil
+	}
+
+	conditions.MarkTrue(storeConditionsOnObject, expinfrav1.ScalingPoliciesReadyCondition)
+	return nil
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestScalingPolicyNeedsUpdate(t *testing.T) {
+	cpuPolicy := expinfrav1.AWSScalingPolicy{
+		Name:       "cpu",
+		PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+		TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+			PredefinedMetricType: expinfrav1.PredefinedMetricTypeASGAverageCPUUtilization,
+			TargetValue:          60,
+		},
+	}
+	stepPolicy := expinfrav1.AWSScalingPolicy{
+		Name:       "step",
+		PolicyType: expinfrav1.ScalingPolicyTypeStep,
+		StepScaling: &expinfrav1.StepScalingConfiguration{
+			AdjustmentType: expinfrav1.AdjustmentTypeChangeInCapacity,
+			StepAdjustments: []expinfrav1.StepAdjustment{
+				{MetricIntervalLowerBound: ptr.To[int64](0), ScalingAdjustment: 1},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		existing   *expinfrav1.AWSScalingPolicy
+		expected   *expinfrav1.AWSScalingPolicy
+		wantUpdate bool
+	}{
+		{
+			name:       "target tracking policy exactly equal",
+			existing:   cpuPolicy.DeepCopy(),
+			expected:   cpuPolicy.DeepCopy(),
+			wantUpdate: false,
+		},
+		{
+			name: "estimated instance warmup reported by AWS but not set in manifest",
+			existing: func() *expinfrav1.AWSScalingPolicy {
+				p := cpuPolicy.DeepCopy()
+				p.EstimatedInstanceWarmup = &metav1.Duration{Duration: 5 * time.Minute}
+				return p
+			}(),
+			expected:   cpuPolicy.DeepCopy(),
+			wantUpdate: false,
+		},
+		{
+			name:     "target value differs",
+			existing: cpuPolicy.DeepCopy(),
+			expected: func() *expinfrav1.AWSScalingPolicy {
+				p := cpuPolicy.DeepCopy()
+				p.TargetTracking.TargetValue = 70
+				return p
+			}(),
+			wantUpdate: true,
+		},
+		{
+			name: "step policy with default metric aggregation type set by AWS",
+			existing: func() *expinfrav1.AWSScalingPolicy {
+				p := stepPolicy.DeepCopy()
+				p.StepScaling.MetricAggregationType = ptr.To(expinfrav1.MetricAggregationTypeAverage)
+				return p
+			}(),
+			expected:   stepPolicy.DeepCopy(),
+			wantUpdate: false,
+		},
+		{
+			name:     "step adjustments differ",
+			existing: stepPolicy.DeepCopy(),
+			expected: func() *expinfrav1.AWSScalingPolicy {
+				p := stepPolicy.DeepCopy()
+				p.StepScaling.StepAdjustments[0].ScalingAdjustment = 2
+				return p
+			}(),
+			wantUpdate: true,
+		},
+		{
+			name:       "policy type differs",
+			existing:   &expinfrav1.AWSScalingPolicy{Name: "cpu", PolicyType: "SimpleScaling"},
+			expected:   cpuPolicy.DeepCopy(),
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scalingPolicyNeedsUpdate(tt.existing, tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestServicePutScalingPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *expinfrav1.AWSScalingPolicy
+		want   *autoscaling.PutScalingPolicyInput
+	}{
+		{
+			name: "target tracking policy",
+			policy: &expinfrav1.AWSScalingPolicy{
+				Name:                    "requests",
+				PolicyType:              expinfrav1.ScalingPolicyTypeTargetTracking,
+				EstimatedInstanceWarmup: &metav1.Duration{Duration: 3 * time.Minute},
+				TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+					PredefinedMetricType: expinfrav1.PredefinedMetricTypeALBRequestCountPerTarget,
+					ResourceLabel:        aws.String("app/lb/123/targetgroup/tg/456"),
+					TargetValue:          1000,
+				},
+			},
+			want: &autoscaling.PutScalingPolicyInput{
+				AutoScalingGroupName:    aws.String("asg"),
+				PolicyName:              aws.String("requests"),
+				PolicyType:              aws.String("TargetTrackingScaling"),
+				EstimatedInstanceWarmup: aws.Int32(180),
+				TargetTrackingConfiguration: &autoscalingtypes.TargetTrackingConfiguration{
+					PredefinedMetricSpecification: &autoscalingtypes.PredefinedMetricSpecification{
+						PredefinedMetricType: autoscalingtypes.MetricTypeALBRequestCountPerTarget,
+						ResourceLabel:        aws.String("app/lb/123/targetgroup/tg/456"),
+					},
+					TargetValue:    aws.Float64(1000),
+					DisableScaleIn: aws.Bool(false),
+				},
+			},
+		},
+		{
+			name: "step scaling policy",
+			policy: &expinfrav1.AWSScalingPolicy{
+				Name:       "step",
+				PolicyType: expinfrav1.ScalingPolicyTypeStep,
+				StepScaling: &expinfrav1.StepScalingConfiguration{
+					AdjustmentType: expinfrav1.AdjustmentTypeChangeInCapacity,
+					StepAdjustments: []expinfrav1.StepAdjustment{
+						{MetricIntervalUpperBound: ptr.To[int64](0), ScalingAdjustment: -1},
+						{MetricIntervalLowerBound: ptr.To[int64](0), ScalingAdjustment: 2},
+					},
+				},
+			},
+			want: &autoscaling.PutScalingPolicyInput{
+				AutoScalingGroupName:  aws.String("asg"),
+				PolicyName:            aws.String("step"),
+				PolicyType:            aws.String("StepScaling"),
+				AdjustmentType:        aws.String("ChangeInCapacity"),
+				MetricAggregationType: aws.String("Average"),
+				StepAdjustments: []autoscalingtypes.StepAdjustment{
+					{MetricIntervalUpperBound: aws.Float64(0), ScalingAdjustment: aws.Int32(-1)},
+					{MetricIntervalLowerBound: aws.Float64(0), ScalingAdjustment: aws.Int32(2)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			clusterScope, err := getClusterScope(getFakeClient())
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			asgMock.EXPECT().PutScalingPolicy(gomock.Any(), tt.want).Return(&autoscaling.PutScalingPolicyOutput{}, nil)
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			g.Expect(s.PutScalingPolicy(context.TODO(), "asg", tt.policy)).To(Succeed())
+		})
+	}
+}
+
+func TestReconcileScalingPolicies(t *testing.T) {
+	cpuPolicy := expinfrav1.AWSScalingPolicy{
+		Name:       "cpu",
+		PolicyType: expinfrav1.ScalingPolicyTypeTargetTracking,
+		TargetTracking: &expinfrav1.TargetTrackingConfiguration{
+			PredefinedMetricType: expinfrav1.PredefinedMetricTypeASGAverageCPUUtilization,
+			TargetValue:          60,
+		},
+	}
+
+	tests := []struct {
+		name          string
+		wanted        []expinfrav1.AWSScalingPolicy
+		expect        func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantErr       bool
+		wantCondition *corev1.ConditionStatus
+	}{
+		{
+			name: "should do nothing if no scaling policies are wanted or present",
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies("asg").Return(nil, nil)
+			},
+		},
+		{
+			name:   "should create a missing scaling policy",
+			wanted: []expinfrav1.AWSScalingPolicy{cpuPolicy},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies("asg").Return(nil, nil)
+				m.PutScalingPolicy(gomock.Any(), "asg", &cpuPolicy).Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should not update an up to date scaling policy and delete a stale one",
+			wanted: []expinfrav1.AWSScalingPolicy{cpuPolicy},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies("asg").Return([]*expinfrav1.AWSScalingPolicy{
+					cpuPolicy.DeepCopy(),
+					{Name: "stale", PolicyType: "SimpleScaling"},
+				}, nil)
+				m.DeleteScalingPolicy(gomock.Any(), "asg", "stale").Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should mark the condition false if a stale scaling policy cannot be deleted",
+			wanted: []expinfrav1.AWSScalingPolicy{cpuPolicy},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScalingPolicies("asg").Return([]*expinfrav1.AWSScalingPolicy{
+					cpuPolicy.DeepCopy(),
+					{Name: "stale", PolicyType: "SimpleScaling"},
+				}, nil)
+				m.DeleteScalingPolicy(gomock.Any(), "asg", "stale").Return(errors.New("some error"))
+			},
+			wantErr:       true,
+			wantCondition: ptr.To(corev1.ConditionFalse),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			tt.expect(asgSvc.EXPECT())
+
+			awsMachinePool := &expinfrav1.AWSMachinePool{}
+			err := ReconcileScalingPolicies(context.TODO(), asgSvc, "asg", tt.wanted, awsMachinePool, logger.FromContext(context.TODO()))
+			checkErr(tt.wantErr, err, g)
+
+			condition := conditions.Get(awsMachinePool, expinfrav1.ScalingPoliciesReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(*tt.wantCondition))
+		})
+	}
+}
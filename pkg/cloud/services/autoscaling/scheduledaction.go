@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// DescribeScheduledActions returns the scheduled actions for the given AutoScalingGroup after retrieving them from the AWS API.
+func (s *Service) DescribeScheduledActions(asgName string) ([]*expinfrav1.AWSScheduledAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	actions := []*expinfrav1.AWSScheduledAction{}
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(s.ASGClient, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe scheduled actions for AutoScalingGroup: %q", asgName)
+		}
+		for _, action := range out.ScheduledUpdateGroupActions {
+			actions = append(actions, s.SDKToScheduledAction(action))
+		}
+	}
+
+	return actions, nil
+}
+
+// PutScheduledAction creates or updates a scheduled action for the given AutoScalingGroup.
+func (s *Service) PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error {
+	input := getPutScheduledUpdateGroupActionInput(asgName, action)
+
+	if _, err := s.ASGClient.PutScheduledUpdateGroupAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put scheduled action %q for AutoScalingGroup: %q", action.Name, asgName)
+	}
+
+	return nil
+}
+
+// DeleteScheduledAction deletes a scheduled action of the given AutoScalingGroup.
+func (s *Service) DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error {
+	input := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(actionName),
+	}
+
+	if _, err := s.ASGClient.DeleteScheduledAction(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete scheduled action %q for AutoScalingGroup: %q", actionName, asgName)
+	}
+
+	return nil
+}
+
+// SDKToScheduledAction converts an AWS SDK ScheduledUpdateGroupAction to the CAPA scheduled action type.
+func (s *Service) SDKToScheduledAction(action autoscalingtypes.ScheduledUpdateGroupAction) *expinfrav1.AWSScheduledAction {
+	return &expinfrav1.AWSScheduledAction{
+		Name:            ptr.Deref(action.ScheduledActionName, ""),
+		Recurrence:      action.Recurrence,
+		TimeZone:        action.TimeZone,
+		StartTime:       toMetav1Time(action.StartTime),
+		EndTime:         toMetav1Time(action.EndTime),
+		MinSize:         action.MinSize,
+		MaxSize:         action.MaxSize,
+		DesiredCapacity: action.DesiredCapacity,
+	}
+}
+
+func getPutScheduledUpdateGroupActionInput(asgName string, action *expinfrav1.AWSScheduledAction) *autoscaling.PutScheduledUpdateGroupActionInput {
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: ptr.To(asgName),
+		ScheduledActionName:  ptr.To(action.Name),
+		Recurrence:           action.Recurrence,
+		TimeZone:             action.TimeZone,
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}
+
+	if action.StartTime != nil {
+		input.StartTime = ptr.To(action.StartTime.UTC())
+	}
+	if action.EndTime != nil {
+		input.EndTime = ptr.To(action.EndTime.UTC())
+	}
+
+	return input
+}
+
+func toMetav1Time(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	return &metav1.Time{Time: *t}
+}
+
+func timesEqual(a, b *metav1.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Unix() == b.Unix()
+}
+
+func scheduledActionNeedsUpdate(existing *expinfrav1.AWSScheduledAction, expected *expinfrav1.AWSScheduledAction) bool {
+	// For recurring actions, AWS reports the next occurrence as the start time, so
+	// the start time is only compared if it is set explicitly.
+	if expected.StartTime != nil && !timesEqual(existing.StartTime, expected.StartTime) {
+		return true
+	}
+
+	return ptr.Deref(existing.Recurrence, "") != ptr.Deref(expected.Recurrence, "") ||
+		ptr.Deref(existing.TimeZone, "") != ptr.Deref(expected.TimeZone, "") ||
+		!timesEqual(existing.EndTime, expected.EndTime) ||
+		!ptr.Equal(existing.MinSize, expected.MinSize) ||
+		!ptr.Equal(existing.MaxSize, expected.MaxSize) ||
+		!ptr.Equal(existing.DesiredCapacity, expected.DesiredCapacity)
+}
+
+// ReconcileScheduledActions reconciles scheduled actions for an ASG
+// by creating missing actions, updating mismatching actions and
+// deleting extraneous actions.
+func ReconcileScheduledActions(ctx context.Context, asgService services.ASGInterface, asgName string, wantedActions []expinfrav1.AWSScheduledAction, storeConditionsOnObject conditions.Setter, log logger.Wrapper) error {
+	existingActions, err := asgService.DescribeScheduledActions(asgName)
+	if err != nil {
+		return err
+	}
+
+	existingByName := make(map[string]*expinfrav1.AWSScheduledAction, len(existingActions))
+	for _, action := range existingActions {
+		existingByName[action.Name] = action
+	}
+
+	wantedNames := make(map[string]bool, len(wantedActions))
+	for i := range wantedActions {
+		wantedAction := &wantedActions[i]
+		wantedNames[wantedAction.Name] = true
+
+		existingAction := existingByName[wantedAction.Name]
+		if existingAction != nil && !scheduledActionNeedsUpdate(existingAction, wantedAction) {
+			continue
+		}
+
+		log.Info("Creating or updating scheduled action", "action", wantedAction.Name)
+		if err := asgService.PutScheduledAction(ctx, asgName, wantedAction); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition, expinfrav1.ScheduledActionUpdateFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	for _, existingAction := range existingActions {
+		if wantedNames[existingAction.Name] {
+			continue
+		}
+
+		log.Info("Deleting extraneous scheduled action", "action", existingAction.Name)
+		if err := asgService.DeleteScheduledAction(ctx, asgName, existingAction.Name); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition, expinfrav1.ScheduledActionDeletionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	if len(wantedActions) == 0 {
+		conditions.Delete(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition)
+		return nil
+	}
+
+	conditions.MarkTrue(storeConditionsOnObject, expinfrav1.ScheduledActionsReadyCondition)
+	return nil
+}
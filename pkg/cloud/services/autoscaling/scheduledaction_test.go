@@ -0,0 +1,309 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestScheduledActionNeedsUpdate(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name       string
+		existing   expinfrav1.AWSScheduledAction
+		expected   expinfrav1.AWSScheduledAction
+		wantUpdate bool
+	}{
+		{
+			name: "exactly equal",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-up",
+				Recurrence:      ptr.To("0 8 * * *"),
+				TimeZone:        ptr.To("Europe/Berlin"),
+				MinSize:         ptr.To[int32](2),
+				DesiredCapacity: ptr.To[int32](3),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:            "scale-up",
+				Recurrence:      ptr.To("0 8 * * *"),
+				TimeZone:        ptr.To("Europe/Berlin"),
+				MinSize:         ptr.To[int32](2),
+				DesiredCapacity: ptr.To[int32](3),
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "start time of a recurring action reported by AWS but not set in manifest",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:       "scale-up",
+				Recurrence: ptr.To("0 8 * * *"),
+				StartTime:  &startTime,
+				MinSize:    ptr.To[int32](2),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:       "scale-up",
+				Recurrence: ptr.To("0 8 * * *"),
+				MinSize:    ptr.To[int32](2),
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "start time differs",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:      "once",
+				StartTime: &startTime,
+				MaxSize:   ptr.To[int32](2),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:      "once",
+				StartTime: ptr.To(metav1.NewTime(startTime.Add(time.Hour))),
+				MaxSize:   ptr.To[int32](2),
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "recurrence differs",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:       "scale-up",
+				Recurrence: ptr.To("0 8 * * *"),
+				MinSize:    ptr.To[int32](2),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:       "scale-up",
+				Recurrence: ptr.To("0 9 * * *"),
+				MinSize:    ptr.To[int32](2),
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "size removed from manifest",
+			existing: expinfrav1.AWSScheduledAction{
+				Name:            "scale-up",
+				Recurrence:      ptr.To("0 8 * * *"),
+				MinSize:         ptr.To[int32](2),
+				DesiredCapacity: ptr.To[int32](3),
+			},
+			expected: expinfrav1.AWSScheduledAction{
+				Name:       "scale-up",
+				Recurrence: ptr.To("0 8 * * *"),
+				MinSize:    ptr.To[int32](2),
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(scheduledActionNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestServiceDescribeScheduledActions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	startTime := time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		want    []*expinfrav1.AWSScheduledAction
+		wantErr bool
+	}{
+		{
+			name: "should return the scheduled actions of all pages",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), &autoscaling.DescribeScheduledActionsInput{
+					AutoScalingGroupName: aws.String("asg"),
+				}, gomock.Any()).Return(&autoscaling.DescribeScheduledActionsOutput{
+					ScheduledUpdateGroupActions: []autoscalingtypes.ScheduledUpdateGroupAction{
+						{
+							ScheduledActionName: aws.String("scale-up"),
+							Recurrence:          aws.String("0 8 * * *"),
+							StartTime:           &startTime,
+							MinSize:             aws.Int32(2),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.DescribeScheduledActions(gomock.Any(), &autoscaling.DescribeScheduledActionsInput{
+					AutoScalingGroupName: aws.String("asg"),
+					NextToken:            aws.String("next"),
+				}, gomock.Any()).Return(&autoscaling.DescribeScheduledActionsOutput{
+					ScheduledUpdateGroupActions: []autoscalingtypes.ScheduledUpdateGroupAction{
+						{
+							ScheduledActionName: aws.String("scale-down"),
+							Recurrence:          aws.String("0 20 * * *"),
+							MinSize:             aws.Int32(0),
+						},
+					},
+				}, nil)
+			},
+			want: []*expinfrav1.AWSScheduledAction{
+				{
+					Name:       "scale-up",
+					Recurrence: ptr.To("0 8 * * *"),
+					StartTime:  &metav1.Time{Time: startTime},
+					MinSize:    ptr.To[int32](2),
+				},
+				{
+					Name:       "scale-down",
+					Recurrence: ptr.To("0 20 * * *"),
+					MinSize:    ptr.To[int32](0),
+				},
+			},
+		},
+		{
+			name: "should return an error if describing the scheduled actions fails",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeScheduledActions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			got, err := s.DescribeScheduledActions("asg")
+			checkErr(tt.wantErr, err, g)
+			if !tt.wantErr {
+				g.Expect(got).To(Equal(tt.want))
+			}
+		})
+	}
+}
+
+func TestReconcileScheduledActions(t *testing.T) {
+	scaleUp := expinfrav1.AWSScheduledAction{
+		Name:       "scale-up",
+		Recurrence: ptr.To("0 8 * * *"),
+		MinSize:    ptr.To[int32](2),
+	}
+
+	tests := []struct {
+		name          string
+		wanted        []expinfrav1.AWSScheduledAction
+		expect        func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantErr       bool
+		wantCondition *corev1.ConditionStatus
+	}{
+		{
+			name: "should do nothing if no scheduled actions are wanted or present",
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return(nil, nil)
+			},
+		},
+		{
+			name:   "should create a missing scheduled action",
+			wanted: []expinfrav1.AWSScheduledAction{scaleUp},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return(nil, nil)
+				m.PutScheduledAction(gomock.Any(), "asg", &scaleUp).Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should not update an up to date scheduled action",
+			wanted: []expinfrav1.AWSScheduledAction{scaleUp},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return([]*expinfrav1.AWSScheduledAction{scaleUp.DeepCopy()}, nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should update a drifted scheduled action and delete a stale one",
+			wanted: []expinfrav1.AWSScheduledAction{scaleUp},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return([]*expinfrav1.AWSScheduledAction{
+					{Name: "scale-up", Recurrence: ptr.To("0 8 * * *"), MinSize: ptr.To[int32](1)},
+					{Name: "stale", Recurrence: ptr.To("0 20 * * *"), MinSize: ptr.To[int32](0)},
+				}, nil)
+				m.PutScheduledAction(gomock.Any(), "asg", &scaleUp).Return(nil)
+				m.DeleteScheduledAction(gomock.Any(), "asg", "stale").Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name: "should delete all scheduled actions when none are wanted",
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return([]*expinfrav1.AWSScheduledAction{scaleUp.DeepCopy()}, nil)
+				m.DeleteScheduledAction(gomock.Any(), "asg", "scale-up").Return(nil)
+			},
+		},
+		{
+			name:   "should mark the condition false if a scheduled action cannot be created",
+			wanted: []expinfrav1.AWSScheduledAction{scaleUp},
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeScheduledActions("asg").Return(nil, nil)
+				m.PutScheduledAction(gomock.Any(), "asg", &scaleUp).Return(errors.New("some error"))
+			},
+			wantErr:       true,
+			wantCondition: ptr.To(corev1.ConditionFalse),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			tt.expect(asgSvc.EXPECT())
+
+			awsMachinePool := &expinfrav1.AWSMachinePool{}
+			err := ReconcileScheduledActions(context.TODO(), asgSvc, "asg", tt.wanted, awsMachinePool, logger.FromContext(context.TODO()))
+			checkErr(tt.wantErr, err, g)
+
+			condition := conditions.Get(awsMachinePool, expinfrav1.ScheduledActionsReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(*tt.wantCondition))
+		})
+	}
+}
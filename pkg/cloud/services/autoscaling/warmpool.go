@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// DescribeWarmPool returns the warm pool of the given AutoScalingGroup after retrieving it from the AWS API.
+// It returns nil if the AutoScalingGroup has no warm pool, or if its warm pool is being deleted.
+func (s *Service) DescribeWarmPool(asgName string) (*expinfrav1.WarmPool, error) {
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	out, err := s.ASGClient.DescribeWarmPool(context.TODO(), input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	if out.WarmPoolConfiguration == nil || out.WarmPoolConfiguration.Status == autoscalingtypes.WarmPoolStatusPendingDelete {
+		return nil, nil
+	}
+
+	return s.SDKToWarmPool(out.WarmPoolConfiguration), nil
+}
+
+// PutWarmPool creates or updates the warm pool of the given AutoScalingGroup.
+func (s *Service) PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error {
+	input := getPutWarmPoolInput(asgName, warmPool)
+
+	if _, err := s.ASGClient.PutWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to put warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// DeleteWarmPool deletes the warm pool of the given AutoScalingGroup.
+func (s *Service) DeleteWarmPool(ctx context.Context, asgName string) error {
+	input := &autoscaling.DeleteWarmPoolInput{
+		AutoScalingGroupName: ptr.To(asgName),
+	}
+
+	if _, err := s.ASGClient.DeleteWarmPool(ctx, input); err != nil {
+		return errors.Wrapf(err, "failed to delete warm pool for AutoScalingGroup: %q", asgName)
+	}
+
+	return nil
+}
+
+// SDKToWarmPool converts an AWS SDK WarmPoolConfiguration to the CAPA warm pool type.
+func (s *Service) SDKToWarmPool(config *autoscalingtypes.WarmPoolConfiguration) *expinfrav1.WarmPool {
+	warmPool := &expinfrav1.WarmPool{
+		MinSize:                  ptr.To(ptr.Deref(config.MinSize, 0)),
+		MaxGroupPreparedCapacity: ptr.To(ptr.Deref(config.MaxGroupPreparedCapacity, -1)),
+		PoolState:                expinfrav1.WarmPoolState(config.PoolState),
+	}
+
+	if config.InstanceReusePolicy != nil {
+		warmPool.ReuseOnScaleIn = ptr.Deref(config.InstanceReusePolicy.ReuseOnScaleIn, false)
+	}
+
+	return warmPool
+}
+
+func getPutWarmPoolInput(asgName string, warmPool *expinfrav1.WarmPool) *autoscaling.PutWarmPoolInput {
+	// Always fill in the optional parameters with the AWS default values, so that
+	// drifted settings are reconciled back to the desired state.
+	return &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName:     ptr.To(asgName),
+		MinSize:                  ptr.To(ptr.Deref(warmPool.MinSize, 0)),
+		MaxGroupPreparedCapacity: ptr.To(ptr.Deref(warmPool.MaxGroupPreparedCapacity, -1)),
+		PoolState:                autoscalingtypes.WarmPoolState(warmPoolStateOrDefault(warmPool.PoolState)),
+		InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{
+			ReuseOnScaleIn: ptr.To(warmPool.ReuseOnScaleIn),
+		},
+	}
+}
+
+func warmPoolStateOrDefault(state expinfrav1.WarmPoolState) expinfrav1.WarmPoolState {
+	if state == "" {
+		return expinfrav1.WarmPoolStateStopped
+	}
+	return state
+}
+
+func warmPoolNeedsUpdate(existing *expinfrav1.WarmPool, expected *expinfrav1.WarmPool) bool {
+	return ptr.Deref(existing.MinSize, 0) != ptr.Deref(expected.MinSize, 0) ||
+		ptr.Deref(existing.MaxGroupPreparedCapacity, -1) != ptr.Deref(expected.MaxGroupPreparedCapacity, -1) ||
+		warmPoolStateOrDefault(existing.PoolState) != warmPoolStateOrDefault(expected.PoolState) ||
+		existing.ReuseOnScaleIn != expected.ReuseOnScaleIn
+}
+
+// ReconcileWarmPool reconciles the warm pool of an ASG by creating or updating
+// it to match the wanted warm pool, or deleting it if no warm pool is wanted.
+func ReconcileWarmPool(ctx context.Context, asgService services.ASGInterface, asgName string, wantedWarmPool *expinfrav1.WarmPool, storeConditionsOnObject conditions.Setter, log logger.Wrapper) error {
+	// The condition is only set once a warm pool was wanted, so there's nothing to delete without it.
+	if wantedWarmPool == nil && !conditions.Has(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition) {
+		return nil
+	}
+
+	existingWarmPool, err := asgService.DescribeWarmPool(asgName)
+	if err != nil {
+		return err
+	}
+
+	if wantedWarmPool == nil {
+		if existingWarmPool != nil {
+			log.Info("Deleting warm pool")
+			if err := asgService.DeleteWarmPool(ctx, asgName); err != nil {
+				conditions.MarkFalse(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition, expinfrav1.WarmPoolDeletionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+				return err
+			}
+		}
+		conditions.Delete(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition)
+		return nil
+	}
+
+	if existingWarmPool == nil || warmPoolNeedsUpdate(existingWarmPool, wantedWarmPool) {
+		log.Info("Creating or updating warm pool")
+		if err := asgService.PutWarmPool(ctx, asgName, wantedWarmPool); err != nil {
+			conditions.MarkFalse(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition, expinfrav1.WarmPoolUpdateFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return err
+		}
+	}
+
+	conditions.MarkTrue(storeConditionsOnObject, expinfrav1.WarmPoolReadyCondition)
+	return nil
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/autoscaling/mock_autoscalingiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/mock_services"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/logger"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestWarmPoolNeedsUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		existing   expinfrav1.WarmPool
+		expected   expinfrav1.WarmPool
+		wantUpdate bool
+	}{
+		{
+			name: "exactly equal",
+			existing: expinfrav1.WarmPool{
+				MinSize:                  ptr.To[int32](1),
+				MaxGroupPreparedCapacity: ptr.To[int32](3),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+			expected: expinfrav1.WarmPool{
+				MinSize:                  ptr.To[int32](1),
+				MaxGroupPreparedCapacity: ptr.To[int32](3),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+			wantUpdate: false,
+		},
+		{
+			name: "optional fields not set in manifest, but set to defaults by AWS",
+			existing: expinfrav1.WarmPool{
+				MinSize:                  ptr.To[int32](0),
+				MaxGroupPreparedCapacity: ptr.To[int32](-1),
+				PoolState:                expinfrav1.WarmPoolStateStopped,
+			},
+			expected:   expinfrav1.WarmPool{},
+			wantUpdate: false,
+		},
+		{
+			name: "min size differs",
+			existing: expinfrav1.WarmPool{
+				MinSize: ptr.To[int32](1),
+			},
+			expected: expinfrav1.WarmPool{
+				MinSize: ptr.To[int32](2),
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "max group prepared capacity differs",
+			existing: expinfrav1.WarmPool{
+				MaxGroupPreparedCapacity: ptr.To[int32](-1),
+			},
+			expected: expinfrav1.WarmPool{
+				MaxGroupPreparedCapacity: ptr.To[int32](5),
+			},
+			wantUpdate: true,
+		},
+		{
+			name: "pool state differs",
+			existing: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateStopped,
+			},
+			expected: expinfrav1.WarmPool{
+				PoolState: expinfrav1.WarmPoolStateRunning,
+			},
+			wantUpdate: true,
+		},
+		{
+			name:     "reuse on scale in differs",
+			existing: expinfrav1.WarmPool{},
+			expected: expinfrav1.WarmPool{
+				ReuseOnScaleIn: true,
+			},
+			wantUpdate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(warmPoolNeedsUpdate(&tt.existing, &tt.expected)).To(Equal(tt.wantUpdate))
+		})
+	}
+}
+
+func TestServiceDescribeWarmPool(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	tests := []struct {
+		name    string
+		expect  func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder)
+		want    *expinfrav1.WarmPool
+		wantErr bool
+	}{
+		{
+			name: "should return nil if the ASG has no warm pool",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), &autoscaling.DescribeWarmPoolInput{
+					AutoScalingGroupName: aws.String("asg"),
+				}).Return(&autoscaling.DescribeWarmPoolOutput{}, nil)
+			},
+			want: nil,
+		},
+		{
+			name: "should return nil if the warm pool is being deleted",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeWarmPoolOutput{
+					WarmPoolConfiguration: &autoscalingtypes.WarmPoolConfiguration{
+						MinSize:   aws.Int32(1),
+						PoolState: autoscalingtypes.WarmPoolStateStopped,
+						Status:    autoscalingtypes.WarmPoolStatusPendingDelete,
+					},
+				}, nil)
+			},
+			want: nil,
+		},
+		{
+			name: "should return the warm pool",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Any()).Return(&autoscaling.DescribeWarmPoolOutput{
+					WarmPoolConfiguration: &autoscalingtypes.WarmPoolConfiguration{
+						MinSize:             aws.Int32(1),
+						PoolState:           autoscalingtypes.WarmPoolStateHibernated,
+						InstanceReusePolicy: &autoscalingtypes.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+					},
+				}, nil)
+			},
+			want: &expinfrav1.WarmPool{
+				MinSize:                  ptr.To[int32](1),
+				MaxGroupPreparedCapacity: ptr.To[int32](-1),
+				PoolState:                expinfrav1.WarmPoolStateHibernated,
+				ReuseOnScaleIn:           true,
+			},
+		},
+		{
+			name: "should return an error if describing the warm pool fails",
+			expect: func(m *mock_autoscalingiface.MockAutoScalingAPIMockRecorder) {
+				m.DescribeWarmPool(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			fakeClient := getFakeClient()
+
+			clusterScope, err := getClusterScope(fakeClient)
+			g.Expect(err).ToNot(HaveOccurred())
+			asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+			tt.expect(asgMock.EXPECT())
+			s := NewService(clusterScope)
+			s.ASGClient = asgMock
+
+			got, err := s.DescribeWarmPool("asg")
+			checkErr(tt.wantErr, err, g)
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestServicePutWarmPool(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterScope, err := getClusterScope(getFakeClient())
+	g.Expect(err).ToNot(HaveOccurred())
+	asgMock := mock_autoscalingiface.NewMockAutoScalingAPI(mockCtrl)
+	asgMock.EXPECT().PutWarmPool(gomock.Any(), &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName:     aws.String("asg"),
+		MinSize:                  aws.Int32(2),
+		MaxGroupPreparedCapacity: aws.Int32(-1),
+		PoolState:                autoscalingtypes.WarmPoolStateStopped,
+		InstanceReusePolicy:      &autoscalingtypes.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(false)},
+	}).Return(&autoscaling.PutWarmPoolOutput{}, nil)
+	s := NewService(clusterScope)
+	s.ASGClient = asgMock
+
+	g.Expect(s.PutWarmPool(context.TODO(), "asg", &expinfrav1.WarmPool{MinSize: ptr.To[int32](2)})).To(Succeed())
+}
+
+func TestReconcileWarmPool(t *testing.T) {
+	warmPool := &expinfrav1.WarmPool{
+		MinSize:   ptr.To[int32](1),
+		PoolState: expinfrav1.WarmPoolStateStopped,
+	}
+
+	tests := []struct {
+		name          string
+		wanted        *expinfrav1.WarmPool
+		hadWarmPool   bool
+		expect        func(m *mock_services.MockASGInterfaceMockRecorder)
+		wantErr       bool
+		wantCondition *corev1.ConditionStatus
+	}{
+		{
+			name:   "should not describe the warm pool if none is wanted or recorded",
+			wanted: nil,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {},
+		},
+		{
+			name:        "should remove the condition if the recorded warm pool is already gone",
+			wanted:      nil,
+			hadWarmPool: true,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(nil, nil)
+			},
+		},
+		{
+			name:   "should create a missing warm pool",
+			wanted: warmPool,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(nil, nil)
+				m.PutWarmPool(gomock.Any(), "asg", warmPool).Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should not update an up to date warm pool",
+			wanted: warmPool,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(&expinfrav1.WarmPool{
+					MinSize:                  ptr.To[int32](1),
+					MaxGroupPreparedCapacity: ptr.To[int32](-1),
+					PoolState:                expinfrav1.WarmPoolStateStopped,
+				}, nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:   "should update a drifted warm pool",
+			wanted: warmPool,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(&expinfrav1.WarmPool{
+					MinSize:   ptr.To[int32](1),
+					PoolState: expinfrav1.WarmPoolStateRunning,
+				}, nil)
+				m.PutWarmPool(gomock.Any(), "asg", warmPool).Return(nil)
+			},
+			wantCondition: ptr.To(corev1.ConditionTrue),
+		},
+		{
+			name:        "should delete a warm pool that is no longer wanted",
+			wanted:      nil,
+			hadWarmPool: true,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(warmPool, nil)
+				m.DeleteWarmPool(gomock.Any(), "asg").Return(nil)
+			},
+		},
+		{
+			name:   "should mark the condition false if the warm pool cannot be updated",
+			wanted: warmPool,
+			expect: func(m *mock_services.MockASGInterfaceMockRecorder) {
+				m.DescribeWarmPool("asg").Return(nil, nil)
+				m.PutWarmPool(gomock.Any(), "asg", warmPool).Return(errors.New("some error"))
+			},
+			wantErr:       true,
+			wantCondition: ptr.To(corev1.ConditionFalse),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			asgSvc := mock_services.NewMockASGInterface(mockCtrl)
+			tt.expect(asgSvc.EXPECT())
+
+			awsMachinePool := &expinfrav1.AWSMachinePool{}
+			if tt.hadWarmPool {
+				conditions.MarkTrue(awsMachinePool, expinfrav1.WarmPoolReadyCondition)
+			}
+			err := ReconcileWarmPool(context.TODO(), asgSvc, "asg", tt.wanted, awsMachinePool, logger.FromContext(context.TODO()))
+			checkErr(tt.wantErr, err, g)
+
+			condition := conditions.Get(awsMachinePool, expinfrav1.WarmPoolReadyCondition)
+			if tt.wantCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(*tt.wantCondition))
+		})
+	}
+}
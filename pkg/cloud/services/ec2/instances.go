@@ -265,6 +265,14 @@ func (s *Service) CreateInstance(ctx context.Context, scope *scope.MachineScope,
 
 	input.CapacityReservationPreference = scope.AWSMachine.Spec.CapacityReservationPreference
 
+	input.HibernationOptions = scope.AWSMachine.Spec.HibernationOptions
+
+	input.CPUOptions = scope.AWSMachine.Spec.CPUOptions
+
+	input.EnclaveOptions = scope.AWSMachine.Spec.EnclaveOptions
+
+	input.ENAExpress = scope.AWSMachine.Spec.ENAExpress
+
 	s.scope.Debug("Running instance", "machine-role", scope.Role())
 	s.scope.Debug("Running instance with instance metadata options", "metadata options", input.InstanceMetadataOptions)
 	out, err := s.runInstance(scope.Role(), input)
@@ -558,6 +566,42 @@ func (s *Service) TerminateInstanceAndWait(instanceID string) error {
 	return nil
 }
 
+// StopInstance stops an EC2 instance. If hibernate is true, the contents of the instance memory
+// are saved to the root volume before the instance is stopped.
+func (s *Service) StopInstance(instanceID string, hibernate bool) error {
+	s.scope.Debug("Attempting to stop instance", "instance-id", instanceID, "hibernate", hibernate)
+
+	input := &ec2.StopInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+	if hibernate {
+		input.Hibernate = aws.Bool(true)
+	}
+
+	if _, err := s.EC2Client.StopInstances(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to stop instance with id %q", instanceID)
+	}
+
+	s.scope.Debug("Stopped instance", "instance-id", instanceID)
+	return nil
+}
+
+// StartInstance starts a stopped EC2 instance.
+func (s *Service) StartInstance(instanceID string) error {
+	s.scope.Debug("Attempting to start instance", "instance-id", instanceID)
+
+	input := &ec2.StartInstancesInput{
+		InstanceIds: []string{instanceID},
+	}
+
+	if _, err := s.EC2Client.StartInstances(context.TODO(), input); err != nil {
+		return errors.Wrapf(err, "failed to start instance with id %q", instanceID)
+	}
+
+	s.scope.Debug("Started instance", "instance-id", instanceID)
+	return nil
+}
+
 func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instance, error) {
 	input := &ec2.RunInstancesInput{
 		InstanceType: types.InstanceType(i.Type),
@@ -590,6 +634,7 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 				SubnetId:                 aws.String(i.SubnetID),
 				Groups:                   i.SecurityGroupIDs,
 				AssociatePublicIpAddress: i.PublicIPOnLaunch,
+				EnaSrdSpecification:      getENASrdSpecificationRequest(i.ENAExpress),
 			},
 		}
 	}
@@ -625,6 +670,11 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 		}
 
 		blockDeviceMapping := volumeToBlockDeviceMapping(&nonRootVolume)
+		snapshotID, err := s.getVolumeSnapshotID(nonRootVolume.Snapshot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to find snapshot for volume %q", nonRootVolume.DeviceName)
+		}
+		blockDeviceMapping.Ebs.SnapshotId = snapshotID
 		blockdeviceMappings = append(blockdeviceMappings, blockDeviceMapping)
 	}
 
@@ -669,6 +719,20 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	input.PrivateDnsNameOptions = getPrivateDNSNameOptionsRequest(i.PrivateDNSName)
 	input.CapacityReservationSpecification = getCapacityReservationSpecification(i.CapacityReservationID, i.CapacityReservationPreference)
 
+	if i.HibernationOptions != nil && i.HibernationOptions.Configured {
+		input.HibernationOptions = &types.HibernationOptionsRequest{
+			Configured: aws.Bool(true),
+		}
+	}
+
+	input.CpuOptions = getCPUOptionsRequest(i.CPUOptions)
+
+	if i.EnclaveOptions != nil && i.EnclaveOptions.Enabled {
+		input.EnclaveOptions = &types.EnclaveOptionsRequest{
+			Enabled: aws.Bool(true),
+		}
+	}
+
 	if i.Tenancy != "" {
 		input.Placement = &types.Placement{
 			Tenancy: types.Tenancy(i.Tenancy),
@@ -725,6 +789,35 @@ func (s *Service) runInstance(role string, i *infrav1.Instance) (*infrav1.Instan
 	return s.SDKToInstance(out.Instances[0])
 }
 
+func getCPUOptionsRequest(options *infrav1.CPUOptions) *types.CpuOptionsRequest {
+	if options == nil || (options.CoreCount == nil && options.ThreadsPerCore == nil && !options.AMDSEVSNP) {
+		return nil
+	}
+
+	request := &types.CpuOptionsRequest{
+		CoreCount:      options.CoreCount,
+		ThreadsPerCore: options.ThreadsPerCore,
+	}
+	if options.AMDSEVSNP {
+		request.AmdSevSnp = types.AmdSevSnpSpecificationEnabled
+	}
+
+	return request
+}
+
+func getENASrdSpecificationRequest(enaExpress *infrav1.ENAExpress) *types.EnaSrdSpecificationRequest {
+	if enaExpress == nil || !enaExpress.Enabled {
+		return nil
+	}
+
+	return &types.EnaSrdSpecificationRequest{
+		EnaSrdEnabled: aws.Bool(true),
+		EnaSrdUdpSpecification: &types.EnaSrdUdpSpecificationRequest{
+			EnaSrdUdpEnabled: aws.Bool(enaExpress.UDPEnabled),
+		},
+	}
+}
+
 func volumeToBlockDeviceMapping(v *infrav1.Volume) types.BlockDeviceMapping {
 	ebsDevice := &types.EbsBlockDevice{
 		DeleteOnTermination: aws.Bool(true),
@@ -970,6 +1063,32 @@ func (s *Service) SDKToInstance(v types.Instance) (*infrav1.Instance, error) {
 		i.InstanceMetadataOptions = metadataOptions
 	}
 
+	if v.HibernationOptions != nil && aws.ToBool(v.HibernationOptions.Configured) {
+		i.HibernationOptions = &infrav1.HibernationOptions{Configured: true}
+	}
+
+	if v.CpuOptions != nil {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      v.CpuOptions.CoreCount,
+			ThreadsPerCore: v.CpuOptions.ThreadsPerCore,
+			AMDSEVSNP:      v.CpuOptions.AmdSevSnp == types.AmdSevSnpSpecificationEnabled,
+		}
+	}
+
+	if v.EnclaveOptions != nil && aws.ToBool(v.EnclaveOptions.Enabled) {
+		i.EnclaveOptions = &infrav1.EnclaveOptions{Enabled: true}
+	}
+
+	for _, eni := range v.NetworkInterfaces {
+		if eni.Attachment != nil && aws.ToInt32(eni.Attachment.DeviceIndex) == 0 && eni.Attachment.EnaSrdSpecification != nil &&
+			aws.ToBool(eni.Attachment.EnaSrdSpecification.EnaSrdEnabled) {
+			i.ENAExpress = &infrav1.ENAExpress{Enabled: true}
+			if udp := eni.Attachment.EnaSrdSpecification.EnaSrdUdpSpecification; udp != nil {
+				i.ENAExpress.UDPEnabled = aws.ToBool(udp.EnaSrdUdpEnabled)
+			}
+		}
+	}
+
 	if v.PrivateDnsNameOptions != nil {
 		i.PrivateDNSName = &infrav1.PrivateDNSName{
 			EnableResourceNameDNSAAAARecord: v.PrivateDnsNameOptions.EnableResourceNameDnsAAAARecord,
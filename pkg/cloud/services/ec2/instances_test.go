@@ -278,6 +278,138 @@ func TestTerminateInstance(t *testing.T) {
 	}
 }
 
+func TestStopInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name      string
+		hibernate bool
+		expect    func(m *mocks.MockEC2APIMockRecorder)
+		wantErr   bool
+	}{
+		{
+			name: "stops the instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.StopInstances(context.TODO(), gomock.Eq(&ec2.StopInstancesInput{
+					InstanceIds: []string{"i-1"},
+				})).
+					Return(&ec2.StopInstancesOutput{}, nil)
+			},
+		},
+		{
+			name:      "hibernates the instance",
+			hibernate: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.StopInstances(context.TODO(), gomock.Eq(&ec2.StopInstancesInput{
+					InstanceIds: []string{"i-1"},
+					Hibernate:   aws.Bool(true),
+				})).
+					Return(&ec2.StopInstancesOutput{}, nil)
+			},
+		},
+		{
+			name: "fails to stop the instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.StopInstances(context.TODO(), gomock.Eq(&ec2.StopInstancesInput{
+					InstanceIds: []string{"i-1"},
+				})).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.StopInstance("i-1", tc.hibernate)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestStartInstance(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	testCases := []struct {
+		name    string
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "starts the instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.StartInstances(context.TODO(), gomock.Eq(&ec2.StartInstancesInput{
+					InstanceIds: []string{"i-1"},
+				})).
+					Return(&ec2.StartInstancesOutput{}, nil)
+			},
+		},
+		{
+			name: "fails to start the instance",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.StartInstances(context.TODO(), gomock.Eq(&ec2.StartInstancesInput{
+					InstanceIds: []string{"i-1"},
+				})).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			err = s.StartInstance("i-1")
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
 func TestCreateInstance(t *testing.T) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -6390,3 +6522,82 @@ func TestGetCapacityReservationSpecification(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCPUOptionsRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		options         *infrav1.CPUOptions
+		expectedRequest *types.CpuOptionsRequest
+	}{
+		{
+			name:            "with no CPU options specified",
+			options:         nil,
+			expectedRequest: nil,
+		},
+		{
+			name:            "with empty CPU options",
+			options:         &infrav1.CPUOptions{},
+			expectedRequest: nil,
+		},
+		{
+			name:    "with core count and threads per core",
+			options: &infrav1.CPUOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(1)},
+			expectedRequest: &types.CpuOptionsRequest{
+				CoreCount:      aws.Int32(4),
+				ThreadsPerCore: aws.Int32(1),
+			},
+		},
+		{
+			name:    "with AMD SEV-SNP",
+			options: &infrav1.CPUOptions{AMDSEVSNP: true},
+			expectedRequest: &types.CpuOptionsRequest{
+				AmdSevSnp: types.AmdSevSnpSpecificationEnabled,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getCPUOptionsRequest(tc.options)
+			if !cmp.Equal(request, tc.expectedRequest, cmpopts.IgnoreUnexported(types.CpuOptionsRequest{})) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
+
+func TestGetENASrdSpecificationRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		enaExpress      *infrav1.ENAExpress
+		expectedRequest *types.EnaSrdSpecificationRequest
+	}{
+		{
+			name:            "with no ENA Express specified",
+			enaExpress:      nil,
+			expectedRequest: nil,
+		},
+		{
+			name:            "with ENA Express disabled",
+			enaExpress:      &infrav1.ENAExpress{},
+			expectedRequest: nil,
+		},
+		{
+			name:       "with ENA Express enabled for TCP and UDP",
+			enaExpress: &infrav1.ENAExpress{Enabled: true, UDPEnabled: true},
+			expectedRequest: &types.EnaSrdSpecificationRequest{
+				EnaSrdEnabled: aws.Bool(true),
+				EnaSrdUdpSpecification: &types.EnaSrdUdpSpecificationRequest{
+					EnaSrdUdpEnabled: aws.Bool(true),
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := getENASrdSpecificationRequest(tc.enaExpress)
+			if !cmp.Equal(request, tc.expectedRequest, cmpopts.IgnoreUnexported(types.EnaSrdSpecificationRequest{}, types.EnaSrdUdpSpecificationRequest{})) {
+				t.Errorf("Case: %s. Got: %v, expected: %v", tc.name, request, tc.expectedRequest)
+			}
+		})
+	}
+}
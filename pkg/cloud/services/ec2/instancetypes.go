@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+)
+
+// GetInstanceTypeInfo returns the EC2 description of the given instance type.
+// Results are cached since instance type properties are not expected to change.
+func (s *Service) GetInstanceTypeInfo(instanceType string) (*ec2types.InstanceTypeInfo, error) {
+	if instanceType == "" {
+		return nil, errors.New("instance type must not be empty")
+	}
+
+	if s.InstanceTypeInfoCache != nil {
+		if entry, ok := s.InstanceTypeInfoCache.Has(instanceType); ok {
+			info := entry.Info
+			return &info, nil
+		}
+	}
+
+	out, err := s.EC2Client.DescribeInstanceTypes(context.TODO(), &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: []ec2types.InstanceType{ec2types.InstanceType(instanceType)},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance type %q", instanceType)
+	}
+
+	if len(out.InstanceTypes) == 0 {
+		return nil, fmt.Errorf("instance type result empty for type %q", instanceType)
+	}
+
+	info := out.InstanceTypes[0]
+	if s.InstanceTypeInfoCache != nil {
+		s.InstanceTypeInfoCache.Add(cache.InstanceTypeInfoCacheEntry{
+			InstanceType: ec2types.InstanceType(instanceType),
+			Info:         info,
+		})
+	}
+
+	return &info, nil
+}
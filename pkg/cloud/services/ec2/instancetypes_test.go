@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+)
+
+func TestGetInstanceTypeInfo(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	m5Large := ec2types.InstanceTypeInfo{
+		InstanceType: ec2types.InstanceTypeM5Large,
+		VCpuInfo:     &ec2types.VCpuInfo{DefaultVCpus: aws.Int32(2)},
+		MemoryInfo:   &ec2types.MemoryInfo{SizeInMiB: aws.Int64(8192)},
+	}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+		want         *ec2types.InstanceTypeInfo
+		wantErr      bool
+	}{
+		{
+			name:         "Should return the description of the instance type",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(context.TODO(), gomock.Eq(&ec2.DescribeInstanceTypesInput{
+					InstanceTypes: []ec2types.InstanceType{ec2types.InstanceTypeM5Large},
+				})).Return(&ec2.DescribeInstanceTypesOutput{
+					InstanceTypes: []ec2types.InstanceTypeInfo{m5Large},
+				}, nil)
+			},
+			want: &m5Large,
+		},
+		{
+			name:         "Should return an error if the instance type is empty",
+			instanceType: "",
+			wantErr:      true,
+		},
+		{
+			name:         "Should return an error if DescribeInstanceTypes fails",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(context.TODO(), gomock.Any()).Return(nil, awserrors.NewFailedDependency("dependency failure"))
+			},
+			wantErr: true,
+		},
+		{
+			name:         "Should return an error if the instance type is unknown",
+			instanceType: "m5.large",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeInstanceTypes(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			scheme, err := setupScheme()
+			g.Expect(err).NotTo(HaveOccurred())
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tt.expect != nil {
+				tt.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := setupClusterScope(client)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope).WithInstanceTypeInfoCache(nil)
+			s.EC2Client = ec2Mock
+
+			got, err := s.GetInstanceTypeInfo(tt.instanceType)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetInstanceTypeInfoCached(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scheme, err := setupScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := setupClusterScope(client)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeInstanceTypes(context.TODO(), gomock.Any()).Return(&ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []ec2types.InstanceTypeInfo{{InstanceType: ec2types.InstanceTypeM5Large}},
+	}, nil).Times(1)
+
+	s := NewService(clusterScope).WithInstanceTypeInfoCache(capicache.New[cache.InstanceTypeInfoCacheEntry](time.Hour))
+	s.EC2Client = ec2Mock
+
+	for range 2 {
+		got, err := s.GetInstanceTypeInfo("m5.large")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(got.InstanceType).To(Equal(ec2types.InstanceTypeM5Large))
+	}
+}
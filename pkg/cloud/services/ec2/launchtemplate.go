@@ -676,6 +676,30 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 	data.InstanceMarketOptions = instanceMarketOptions
 	data.PrivateDnsNameOptions = getLaunchTemplatePrivateDNSNameOptionsRequest(scope.GetLaunchTemplate().PrivateDNSName)
 	data.CapacityReservationSpecification = getLaunchTemplateCapacityReservationSpecification(scope.GetLaunchTemplate())
+	data.CpuOptions = getLaunchTemplateCPUOptionsRequest(lt.CPUOptions)
+
+	if lt.EnclaveOptions != nil && lt.EnclaveOptions.Enabled {
+		data.EnclaveOptions = &types.LaunchTemplateEnclaveOptionsRequest{
+			Enabled: aws.Bool(true),
+		}
+	}
+
+	// ENA Express can only be configured on network interfaces, which then carry the security groups.
+	if lt.ENAExpress != nil && lt.ENAExpress.Enabled {
+		data.NetworkInterfaces = []types.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			{
+				DeviceIndex: aws.Int32(0),
+				Groups:      data.SecurityGroupIds,
+				EnaSrdSpecification: &types.EnaSrdSpecificationRequest{
+					EnaSrdEnabled: aws.Bool(true),
+					EnaSrdUdpSpecification: &types.EnaSrdUdpSpecificationRequest{
+						EnaSrdUdpEnabled: aws.Bool(lt.ENAExpress.UDPEnabled),
+					},
+				},
+			},
+		}
+		data.SecurityGroupIds = nil
+	}
 
 	blockDeviceMappings := []types.LaunchTemplateBlockDeviceMappingRequest{}
 
@@ -696,6 +720,11 @@ func (s *Service) createLaunchTemplateData(scope scope.LaunchTemplateScope, imag
 		nonRootVolume := lt.NonRootVolumes[vi]
 
 		blockDeviceMapping := volumeToLaunchTemplateBlockDeviceMappingRequest(&nonRootVolume)
+		snapshotID, err := s.getVolumeSnapshotID(nonRootVolume.Snapshot)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to find snapshot for volume %q", nonRootVolume.DeviceName)
+		}
+		blockDeviceMapping.Ebs.SnapshotId = snapshotID
 		blockDeviceMappings = append(blockDeviceMappings, *blockDeviceMapping)
 	}
 
@@ -726,6 +755,22 @@ func getLaunchTemplateCapacityReservationSpecification(awsLaunchTemplate *expinf
 	return spec
 }
 
+func getLaunchTemplateCPUOptionsRequest(options *infrav1.CPUOptions) *types.LaunchTemplateCpuOptionsRequest {
+	if options == nil || (options.CoreCount == nil && options.ThreadsPerCore == nil && !options.AMDSEVSNP) {
+		return nil
+	}
+
+	request := &types.LaunchTemplateCpuOptionsRequest{
+		CoreCount:      options.CoreCount,
+		ThreadsPerCore: options.ThreadsPerCore,
+	}
+	if options.AMDSEVSNP {
+		request.AmdSevSnp = types.AmdSevSnpSpecificationEnabled
+	}
+
+	return request
+}
+
 func volumeToLaunchTemplateBlockDeviceMappingRequest(v *infrav1.Volume) *types.LaunchTemplateBlockDeviceMappingRequest {
 	ltEbsDevice := &types.LaunchTemplateEbsBlockDeviceRequest{
 		DeleteOnTermination: aws.Bool(true),
@@ -958,7 +1003,34 @@ func (s *Service) SDKToLaunchTemplate(d types.LaunchTemplateVersion) (*expinfrav
 		}
 	}
 
-	for _, id := range v.SecurityGroupIds {
+	if v.CpuOptions != nil && (v.CpuOptions.CoreCount != nil || v.CpuOptions.ThreadsPerCore != nil || v.CpuOptions.AmdSevSnp == types.AmdSevSnpSpecificationEnabled) {
+		i.CPUOptions = &infrav1.CPUOptions{
+			CoreCount:      v.CpuOptions.CoreCount,
+			ThreadsPerCore: v.CpuOptions.ThreadsPerCore,
+			AMDSEVSNP:      v.CpuOptions.AmdSevSnp == types.AmdSevSnpSpecificationEnabled,
+		}
+	}
+
+	if v.EnclaveOptions != nil && aws.ToBool(v.EnclaveOptions.Enabled) {
+		i.EnclaveOptions = &infrav1.EnclaveOptions{Enabled: true}
+	}
+
+	securityGroupIDs := v.SecurityGroupIds
+	for _, eni := range v.NetworkInterfaces {
+		if aws.ToInt32(eni.DeviceIndex) != 0 {
+			continue
+		}
+		// Security groups are set on the primary network interface when it is configured, see `createLaunchTemplateData`.
+		securityGroupIDs = append(securityGroupIDs, eni.Groups...)
+		if eni.EnaSrdSpecification != nil && aws.ToBool(eni.EnaSrdSpecification.EnaSrdEnabled) {
+			i.ENAExpress = &infrav1.ENAExpress{Enabled: true}
+			if udp := eni.EnaSrdSpecification.EnaSrdUdpSpecification; udp != nil {
+				i.ENAExpress.UDPEnabled = aws.ToBool(udp.EnaSrdUdpEnabled)
+			}
+		}
+	}
+
+	for _, id := range securityGroupIDs {
 		// FIXME(dlipovetsky): This will include the core security groups as well, making the
 		// "Additional" a bit dishonest. However, including the core groups drastically simplifies
 		// comparison with the incoming security groups.
@@ -1031,6 +1103,19 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 		return true, "SSHKeyName", nil
 	}
 
+	// Options that are all unset aren't sent to EC2, so nil and empty options are treated the same.
+	if !cmp.Equal(ptr.Deref(incoming.CPUOptions, infrav1.CPUOptions{}), ptr.Deref(existing.CPUOptions, infrav1.CPUOptions{})) {
+		return true, "CPUOptions", nil
+	}
+
+	if !cmp.Equal(ptr.Deref(incoming.EnclaveOptions, infrav1.EnclaveOptions{}), ptr.Deref(existing.EnclaveOptions, infrav1.EnclaveOptions{})) {
+		return true, "EnclaveOptions", nil
+	}
+
+	if !cmp.Equal(enaExpressOrEmpty(incoming.ENAExpress), enaExpressOrEmpty(existing.ENAExpress)) {
+		return true, "ENAExpress", nil
+	}
+
 	incomingIDs, err := s.GetAdditionalSecurityGroupsIDs(incoming.AdditionalSecurityGroups)
 	if err != nil {
 		return false, "", err
@@ -1056,6 +1141,15 @@ func (s *Service) LaunchTemplateNeedsUpdate(scope scope.LaunchTemplateScope, inc
 	return false, "", nil
 }
 
+// enaExpressOrEmpty returns the given ENA Express configuration, or an empty one if ENA Express is disabled,
+// as UDP can't be enabled without it.
+func enaExpressOrEmpty(enaExpress *infrav1.ENAExpress) infrav1.ENAExpress {
+	if enaExpress == nil || !enaExpress.Enabled {
+		return infrav1.ENAExpress{}
+	}
+	return *enaExpress
+}
+
 // DiscoverLaunchTemplateAMI will discover the AMI launch template.
 func (s *Service) DiscoverLaunchTemplateAMI(ctx context.Context, scope scope.LaunchTemplateScope) (*string, error) {
 	lt := scope.GetLaunchTemplate()
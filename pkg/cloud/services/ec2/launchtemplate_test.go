@@ -558,6 +558,55 @@ func TestServiceSDKToLaunchTemplate(t *testing.T) {
 			wantDataSecretKey:     &types.NamespacedName{Namespace: "bootstrap-secret-ns", Name: "bootstrap-secret"},
 			wantBootstrapDataHash: &testBootstrapDataHash,
 		},
+		{
+			name: "cpu, enclave and ENA Express options",
+			input: ec2types.LaunchTemplateVersion{
+				LaunchTemplateId:   aws.String("lt-12345"),
+				LaunchTemplateName: aws.String("foo"),
+				LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{
+					ImageId: aws.String("foo-image"),
+					CpuOptions: &ec2types.LaunchTemplateCpuOptions{
+						CoreCount:      aws.Int32(4),
+						ThreadsPerCore: aws.Int32(1),
+						AmdSevSnp:      ec2types.AmdSevSnpSpecificationEnabled,
+					},
+					EnclaveOptions: &ec2types.LaunchTemplateEnclaveOptions{
+						Enabled: aws.Bool(true),
+					},
+					NetworkInterfaces: []ec2types.LaunchTemplateInstanceNetworkInterfaceSpecification{
+						{
+							DeviceIndex: aws.Int32(0),
+							Groups:      []string{"sg-111"},
+							EnaSrdSpecification: &ec2types.LaunchTemplateEnaSrdSpecification{
+								EnaSrdEnabled: aws.Bool(true),
+								EnaSrdUdpSpecification: &ec2types.LaunchTemplateEnaSrdUdpSpecification{
+									EnaSrdUdpEnabled: aws.Bool(true),
+								},
+							},
+						},
+					},
+				},
+				VersionNumber: aws.Int64(1),
+			},
+			wantLT: &expinfrav1.AWSLaunchTemplate{
+				Name: "foo",
+				AMI: infrav1.AMIReference{
+					ID: aws.String("foo-image"),
+				},
+				VersionNumber: aws.Int64(1),
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+				},
+				CPUOptions: &infrav1.CPUOptions{
+					CoreCount:      aws.Int32(4),
+					ThreadsPerCore: aws.Int32(1),
+					AMDSEVSNP:      true,
+				},
+				EnclaveOptions: &infrav1.EnclaveOptions{Enabled: true},
+				ENAExpress:     &infrav1.ENAExpress{Enabled: true, UDPEnabled: true},
+			},
+			wantUserDataHash: userdata.ComputeHash(nil),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -944,6 +993,54 @@ func TestServiceLaunchTemplateNeedsUpdate(t *testing.T) {
 			wantNeedsUpdateReason: "CapacityReservationID",
 			wantErr:               false,
 		},
+		{
+			name: "empty CPU options are the same as no CPU options",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CPUOptions: &infrav1.CPUOptions{},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				AdditionalSecurityGroups: []infrav1.AWSResourceReference{
+					{ID: aws.String("sg-111")},
+					{ID: aws.String("sg-222")},
+				},
+			},
+			want:    false,
+			wantErr: false,
+		},
+		{
+			name: "CPU options changed",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				CPUOptions: &infrav1.CPUOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(1)},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				CPUOptions: &infrav1.CPUOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(2)},
+			},
+			want:                  true,
+			wantNeedsUpdateReason: "CPUOptions",
+			wantErr:               false,
+		},
+		{
+			name: "Nitro Enclaves enabled",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				EnclaveOptions: &infrav1.EnclaveOptions{Enabled: true},
+			},
+			existing:              &expinfrav1.AWSLaunchTemplate{},
+			want:                  true,
+			wantNeedsUpdateReason: "EnclaveOptions",
+			wantErr:               false,
+		},
+		{
+			name: "ENA Express UDP enabled",
+			incoming: &expinfrav1.AWSLaunchTemplate{
+				ENAExpress: &infrav1.ENAExpress{Enabled: true, UDPEnabled: true},
+			},
+			existing: &expinfrav1.AWSLaunchTemplate{
+				ENAExpress: &infrav1.ENAExpress{Enabled: true},
+			},
+			want:                  true,
+			wantNeedsUpdateReason: "ENAExpress",
+			wantErr:               false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1469,6 +1566,39 @@ func TestLaunchTemplateDataCreation(t *testing.T) {
 		g.Expect(err).To(HaveOccurred())
 		g.Expect(launchTemplate).Should(BeEmpty())
 	})
+
+	t.Run("Should configure CPU, Nitro Enclaves and ENA Express options", func(t *testing.T) {
+		g := NewWithT(t)
+		scheme, err := setupScheme()
+		g.Expect(err).NotTo(HaveOccurred())
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		cs, err := setupClusterScope(client)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		ms, err := setupMachinePoolScope(client, cs)
+		g.Expect(err).NotTo(HaveOccurred())
+		ms.AWSMachinePool.Spec.AWSLaunchTemplate.CPUOptions = &infrav1.CPUOptions{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(1)}
+		ms.AWSMachinePool.Spec.AWSLaunchTemplate.EnclaveOptions = &infrav1.EnclaveOptions{Enabled: true}
+		ms.AWSMachinePool.Spec.AWSLaunchTemplate.ENAExpress = &infrav1.ENAExpress{Enabled: true}
+
+		s := NewService(cs)
+
+		data, err := s.createLaunchTemplateData(ms, aws.String("imageID"), types.NamespacedName{}, nil, "")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(data.CpuOptions).To(Equal(&ec2types.LaunchTemplateCpuOptionsRequest{CoreCount: aws.Int32(4), ThreadsPerCore: aws.Int32(1)}))
+		g.Expect(data.EnclaveOptions).To(Equal(&ec2types.LaunchTemplateEnclaveOptionsRequest{Enabled: aws.Bool(true)}))
+		// Security groups move to the primary network interface, which ENA Express is configured on.
+		g.Expect(data.SecurityGroupIds).To(BeEmpty())
+		g.Expect(data.NetworkInterfaces).To(HaveLen(1))
+		g.Expect(data.NetworkInterfaces[0].Groups).To(ConsistOf("nodeSG", "lbSG"))
+		g.Expect(data.NetworkInterfaces[0].EnaSrdSpecification).To(Equal(&ec2types.EnaSrdSpecificationRequest{
+			EnaSrdEnabled: aws.Bool(true),
+			EnaSrdUdpSpecification: &ec2types.EnaSrdUdpSpecificationRequest{
+				EnaSrdUdpEnabled: aws.Bool(false),
+			},
+		}))
+	})
 }
 
 var LaunchTemplateVersionIgnoreUnexported = cmpopts.IgnoreUnexported(
@@ -37,6 +37,7 @@ type Service struct {
 	SSMClient ssm.SSMAPI
 
 	InstanceTypeArchitectureCache cache.InstanceTypeArchitectureCache
+	InstanceTypeInfoCache         cache.InstanceTypeInfoCache
 }
 
 // NewService returns a new service given the ec2 api client.
@@ -47,6 +48,7 @@ func NewService(clusterScope scope.EC2Scope) *Service {
 		SSMClient:                     scope.NewSSMClient(clusterScope, clusterScope, clusterScope, clusterScope.InfraCluster()),
 		netService:                    network.NewService(clusterScope.(scope.NetworkScope)),
 		InstanceTypeArchitectureCache: cache.InstanceTypeArchitectureCacheSingleton,
+		InstanceTypeInfoCache:         cache.InstanceTypeInfoCacheSingleton,
 	}
 }
 
@@ -55,3 +57,9 @@ func (s *Service) WithInstanceTypeArchitectureCache(instanceTypeArchitectureCach
 	s.InstanceTypeArchitectureCache = instanceTypeArchitectureCache
 	return s
 }
+
+// WithInstanceTypeInfoCache overrides the cache for InstanceTypeInfoCacheEntry items (nil disables caching).
+func (s *Service) WithInstanceTypeInfoCache(instanceTypeInfoCache cache.InstanceTypeInfoCache) *Service {
+	s.InstanceTypeInfoCache = instanceTypeInfoCache
+	return s
+}
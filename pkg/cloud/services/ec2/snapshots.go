@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+)
+
+// SnapshotVolumes creates snapshots of the EBS volumes attached to an instance under the given device names,
+// tagged with the given tags and the device name of the volume. Device names that aren't attached to the
+// instance are skipped. Snapshots are point-in-time, so the instance can be terminated right after.
+func (s *Service) SnapshotVolumes(instanceID string, deviceNames []string, tags infrav1.Tags) ([]infrav1.VolumeSnapshot, error) {
+	out, err := s.EC2Client.DescribeInstances(context.TODO(), &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance %q", instanceID)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return nil, errors.Errorf("failed to find instance %q", instanceID)
+	}
+	instance := out.Reservations[0].Instances[0]
+
+	snapshots := []infrav1.VolumeSnapshot{}
+	for _, mapping := range instance.BlockDeviceMappings {
+		deviceName := aws.ToString(mapping.DeviceName)
+		if mapping.Ebs == nil || !slices.Contains(deviceNames, deviceName) {
+			continue
+		}
+		volumeID := aws.ToString(mapping.Ebs.VolumeId)
+
+		snapshotTags := tags.DeepCopy()
+		if snapshotTags == nil {
+			snapshotTags = infrav1.Tags{}
+		}
+		snapshotTags[infrav1.SnapshotDeviceNameTagKey] = deviceName
+
+		s.scope.Debug("Creating snapshot of volume", "instance-id", instanceID, "volume-id", volumeID, "device-name", deviceName)
+		output, err := s.EC2Client.CreateSnapshot(context.TODO(), &ec2.CreateSnapshotInput{
+			VolumeId:    aws.String(volumeID),
+			Description: aws.String(fmt.Sprintf("Snapshot of %s of instance %s taken before termination", deviceName, instanceID)),
+			TagSpecifications: []types.TagSpecification{
+				{
+					ResourceType: types.ResourceTypeSnapshot,
+					Tags:         converters.MapToTags(snapshotTags),
+				},
+			},
+		})
+		if err != nil {
+			return snapshots, errors.Wrapf(err, "failed to create snapshot of volume %q", volumeID)
+		}
+
+		snapshots = append(snapshots, infrav1.VolumeSnapshot{
+			DeviceName: deviceName,
+			VolumeID:   volumeID,
+			SnapshotID: aws.ToString(output.SnapshotId),
+		})
+	}
+
+	return snapshots, nil
+}
+
+// getVolumeSnapshotID returns the ID of the snapshot a volume is restored from. Snapshots referenced by filters are
+// looked up among the completed snapshots owned by the account, the most recent one is used.
+func (s *Service) getVolumeSnapshotID(ref *infrav1.AWSResourceReference) (*string, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.ID != nil {
+		return ref.ID, nil
+	}
+	if len(ref.Filters) == 0 {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("status"),
+				Values: []string{string(types.SnapshotStateCompleted)},
+			},
+		},
+	}
+	for _, f := range ref.Filters {
+		input.Filters = append(input.Filters, types.Filter{Name: aws.String(f.Name), Values: f.Values})
+	}
+
+	var latest *types.Snapshot
+	paginator := ec2.NewDescribeSnapshotsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe snapshots")
+		}
+		for i := range out.Snapshots {
+			snapshot := &out.Snapshots[i]
+			if latest == nil || aws.ToTime(snapshot.StartTime).After(aws.ToTime(latest.StartTime)) {
+				latest = snapshot
+			}
+		}
+	}
+
+	if latest == nil {
+		return nil, errors.Errorf("no completed snapshot found matching filters %v", ref.Filters)
+	}
+
+	return latest.SnapshotId, nil
+}
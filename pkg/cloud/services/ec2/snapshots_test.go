@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestSnapshotVolumes(t *testing.T) {
+	describeInstance := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeInstances(context.TODO(), gomock.Eq(&ec2.DescribeInstancesInput{
+			InstanceIds: []string{"i-1"},
+		})).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []types.Reservation{
+				{
+					Instances: []types.Instance{
+						{
+							InstanceId:     aws.String("i-1"),
+							RootDeviceName: aws.String("/dev/xvda"),
+							BlockDeviceMappings: []types.InstanceBlockDeviceMapping{
+								{
+									DeviceName: aws.String("/dev/xvda"),
+									Ebs:        &types.EbsInstanceBlockDevice{VolumeId: aws.String("vol-root")},
+								},
+								{
+									DeviceName: aws.String("/dev/sdb"),
+									Ebs:        &types.EbsInstanceBlockDevice{VolumeId: aws.String("vol-data")},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+	}
+
+	testCases := []struct {
+		name        string
+		deviceNames []string
+		expect      []func(m *mocks.MockEC2APIMockRecorder)
+		want        []infrav1.VolumeSnapshot
+		wantErr     bool
+	}{
+		{
+			name:        "selected volumes are snapshotted with tags",
+			deviceNames: []string{"/dev/sdb", "/dev/sdc"},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.CreateSnapshot(context.TODO(), gomock.Eq(&ec2.CreateSnapshotInput{
+						VolumeId:    aws.String("vol-data"),
+						Description: aws.String("Snapshot of /dev/sdb of instance i-1 taken before termination"),
+						TagSpecifications: []types.TagSpecification{
+							{
+								ResourceType: types.ResourceTypeSnapshot,
+								Tags: []types.Tag{
+									{Key: aws.String("Name"), Value: aws.String("test")},
+									{Key: aws.String("sigs.k8s.io/cluster-api-provider-aws/device-name"), Value: aws.String("/dev/sdb")},
+								},
+							},
+						},
+					})).Return(&ec2.CreateSnapshotOutput{SnapshotId: aws.String("snap-1")}, nil)
+				},
+			},
+			want: []infrav1.VolumeSnapshot{
+				{DeviceName: "/dev/sdb", VolumeID: "vol-data", SnapshotID: "snap-1"},
+			},
+		},
+		{
+			name:        "create snapshot error is returned",
+			deviceNames: []string{"/dev/xvda"},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.CreateSnapshot(context.TODO(), gomock.Any()).Return(nil, errors.New("snapshot limit exceeded"))
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			for _, expect := range tc.expect {
+				expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(newSnapshotTestScope(g))
+			s.EC2Client = ec2Mock
+
+			got, err := s.SnapshotVolumes("i-1", tc.deviceNames, infrav1.Tags{"Name": "test"})
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tc.want))
+		})
+	}
+}
+
+func TestGetVolumeSnapshotID(t *testing.T) {
+	filters := []infrav1.Filter{{Name: "tag:Name", Values: []string{"etcd"}}}
+	describeSnapshots := func(snapshots ...types.Snapshot) func(m *mocks.MockEC2APIMockRecorder) {
+		return func(m *mocks.MockEC2APIMockRecorder) {
+			m.DescribeSnapshots(gomock.Any(), gomock.Eq(&ec2.DescribeSnapshotsInput{
+				OwnerIds: []string{"self"},
+				Filters: []types.Filter{
+					{Name: aws.String("status"), Values: []string{"completed"}},
+					{Name: aws.String("tag:Name"), Values: []string{"etcd"}},
+				},
+			}), gomock.Any()).Return(&ec2.DescribeSnapshotsOutput{Snapshots: snapshots}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		ref     *infrav1.AWSResourceReference
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+		want    *string
+		wantErr bool
+	}{
+		{
+			name:   "no snapshot",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:   "snapshot by ID",
+			ref:    &infrav1.AWSResourceReference{ID: aws.String("snap-1")},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {},
+			want:   aws.String("snap-1"),
+		},
+		{
+			name: "most recent snapshot matching filters",
+			ref:  &infrav1.AWSResourceReference{Filters: filters},
+			expect: describeSnapshots(
+				types.Snapshot{SnapshotId: aws.String("snap-old"), StartTime: aws.Time(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))},
+				types.Snapshot{SnapshotId: aws.String("snap-new"), StartTime: aws.Time(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))},
+				types.Snapshot{SnapshotId: aws.String("snap-mid"), StartTime: aws.Time(time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC))},
+			),
+			want: aws.String("snap-new"),
+		},
+		{
+			name:    "no snapshot matching filters",
+			ref:     &infrav1.AWSResourceReference{Filters: filters},
+			expect:  describeSnapshots(),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(newSnapshotTestScope(g))
+			s.EC2Client = ec2Mock
+
+			got, err := s.getVolumeSnapshotID(tc.ref)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tc.want))
+		})
+	}
+}
+
+func newSnapshotTestScope(g *WithT) *scope.ClusterScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:     client,
+		Cluster:    &clusterv1.Cluster{},
+		AWSCluster: &infrav1.AWSCluster{},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return scope
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+)
+
+// ModifyVolumes modifies the size, type, IOPS and throughput of the EBS volumes attached to an instance to match
+// the given root and non-root volumes, and returns the latest modification of each of these volumes.
+// Volumes are not modified while a previous modification is in progress, nor modified again to the same
+// target after a failed modification.
+func (s *Service) ModifyVolumes(instanceID string, rootVolume *infrav1.Volume, nonRootVolumes []infrav1.Volume) ([]infrav1.VolumeModification, error) {
+	out, err := s.EC2Client.DescribeInstances(context.TODO(), &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe instance %q", instanceID)
+	}
+	if len(out.Reservations) == 0 || len(out.Reservations[0].Instances) == 0 {
+		return nil, errors.Errorf("failed to find instance %q", instanceID)
+	}
+	instance := out.Reservations[0].Instances[0]
+
+	desired := make(map[string]*infrav1.Volume, len(nonRootVolumes)+1)
+	if rootVolume != nil {
+		desired[aws.ToString(instance.RootDeviceName)] = rootVolume
+	}
+	for i := range nonRootVolumes {
+		desired[nonRootVolumes[i].DeviceName] = &nonRootVolumes[i]
+	}
+
+	deviceNames := map[string]string{}
+	volumeIDs := []string{}
+	for _, mapping := range instance.BlockDeviceMappings {
+		if mapping.Ebs == nil {
+			continue
+		}
+		if _, ok := desired[aws.ToString(mapping.DeviceName)]; !ok {
+			continue
+		}
+		volumeID := aws.ToString(mapping.Ebs.VolumeId)
+		volumeIDs = append(volumeIDs, volumeID)
+		deviceNames[volumeID] = aws.ToString(mapping.DeviceName)
+	}
+	if len(volumeIDs) == 0 {
+		return nil, nil
+	}
+
+	volumes, err := s.EC2Client.DescribeVolumes(context.TODO(), &ec2.DescribeVolumesInput{
+		VolumeIds: volumeIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe volumes of instance %q", instanceID)
+	}
+
+	latest, err := s.latestVolumeModifications(volumeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	modifications := make([]infrav1.VolumeModification, 0, len(volumes.Volumes))
+	for _, volume := range volumes.Volumes {
+		volumeID := aws.ToString(volume.VolumeId)
+		deviceName := deviceNames[volumeID]
+		modification := latest[volumeID]
+		input := volumeModificationInput(desired[deviceName], volume)
+
+		switch {
+		case modification != nil && isVolumeModificationInProgress(modification):
+			// EBS doesn't accept modifications of volumes being modified, wait for the current one.
+		case input == nil:
+			// A failed modification doesn't matter anymore once the volume matches the spec.
+			if modification != nil && modification.ModificationState == types.VolumeModificationStateFailed {
+				modification = nil
+			}
+		case modification != nil && modification.ModificationState == types.VolumeModificationStateFailed && hasVolumeModificationTarget(modification, input):
+			// Don't retry a failed modification until the spec changes.
+		default:
+			s.scope.Debug("Modifying volume", "instance-id", instanceID, "volume-id", volumeID, "device-name", deviceName)
+			output, err := s.EC2Client.ModifyVolume(context.TODO(), input)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to modify volume %q", volumeID)
+			}
+			modification = output.VolumeModification
+		}
+
+		if modification != nil {
+			modifications = append(modifications, sdkToVolumeModification(modification, deviceName))
+		}
+	}
+
+	sort.Slice(modifications, func(i, j int) bool {
+		return modifications[i].DeviceName < modifications[j].DeviceName
+	})
+
+	return modifications, nil
+}
+
+// latestVolumeModifications returns the most recent modification of each of the given volumes that has been modified before.
+func (s *Service) latestVolumeModifications(volumeIDs []string) (map[string]*types.VolumeModification, error) {
+	// Filtering by volume ID rather than passing volume IDs, as the latter fails for volumes that were never modified.
+	input := &ec2.DescribeVolumesModificationsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("volume-id"),
+				Values: volumeIDs,
+			},
+		},
+	}
+
+	latest := map[string]*types.VolumeModification{}
+	paginator := ec2.NewDescribeVolumesModificationsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to describe volume modifications")
+		}
+		for i := range out.VolumesModifications {
+			modification := &out.VolumesModifications[i]
+			volumeID := aws.ToString(modification.VolumeId)
+			if previous, ok := latest[volumeID]; ok && !aws.ToTime(modification.StartTime).After(aws.ToTime(previous.StartTime)) {
+				continue
+			}
+			latest[volumeID] = modification
+		}
+	}
+
+	return latest, nil
+}
+
+// volumeModificationInput returns the modification that makes a volume match the desired volume, or nil if it matches already.
+func volumeModificationInput(desired *infrav1.Volume, volume types.Volume) *ec2.ModifyVolumeInput {
+	if desired == nil {
+		return nil
+	}
+
+	input := &ec2.ModifyVolumeInput{
+		VolumeId: volume.VolumeId,
+	}
+	modified := false
+
+	// Volumes can't shrink, a smaller size is left for the webhook to reject.
+	if desired.Size > int64(aws.ToInt32(volume.Size)) {
+		input.Size = aws.Int32(int32(desired.Size))
+		modified = true
+	}
+
+	volumeType := volume.VolumeType
+	if desired.Type != "" && string(desired.Type) != string(volume.VolumeType) {
+		volumeType = types.VolumeType(desired.Type)
+		input.VolumeType = volumeType
+		modified = true
+	}
+
+	// IOPS are only provisioned for io1, io2 and gp3 volumes, other types report a baseline.
+	if desired.IOPS != 0 && (infrav1.VolumeTypesProvisioned.Has(string(volumeType)) || volumeType == types.VolumeTypeGp3) &&
+		int32(desired.IOPS) != aws.ToInt32(volume.Iops) {
+		input.Iops = aws.Int32(int32(desired.IOPS))
+		modified = true
+	}
+
+	if desired.Throughput != nil && int32(*desired.Throughput) != aws.ToInt32(volume.Throughput) {
+		input.Throughput = aws.Int32(int32(*desired.Throughput))
+		modified = true
+	}
+
+	if !modified {
+		return nil
+	}
+	return input
+}
+
+// hasVolumeModificationTarget returns whether a modification targets the values of a modification input.
+func hasVolumeModificationTarget(modification *types.VolumeModification, input *ec2.ModifyVolumeInput) bool {
+	return (input.Size == nil || aws.ToInt32(input.Size) == aws.ToInt32(modification.TargetSize)) &&
+		(input.VolumeType == "" || input.VolumeType == modification.TargetVolumeType) &&
+		(input.Iops == nil || aws.ToInt32(input.Iops) == aws.ToInt32(modification.TargetIops)) &&
+		(input.Throughput == nil || aws.ToInt32(input.Throughput) == aws.ToInt32(modification.TargetThroughput))
+}
+
+func isVolumeModificationInProgress(modification *types.VolumeModification) bool {
+	return modification.ModificationState == types.VolumeModificationStateModifying ||
+		modification.ModificationState == types.VolumeModificationStateOptimizing
+}
+
+// sdkToVolumeModification converts an AWS EC2 SDK VolumeModification to the CAPA VolumeModification type.
+func sdkToVolumeModification(v *types.VolumeModification, deviceName string) infrav1.VolumeModification {
+	modification := infrav1.VolumeModification{
+		VolumeID:     aws.ToString(v.VolumeId),
+		DeviceName:   deviceName,
+		State:        infrav1.VolumeModificationState(v.ModificationState),
+		Progress:     aws.ToInt64(v.Progress),
+		OriginalSize: int64(aws.ToInt32(v.OriginalSize)),
+		TargetSize:   int64(aws.ToInt32(v.TargetSize)),
+		Message:      aws.ToString(v.StatusMessage),
+	}
+	if v.StartTime != nil {
+		startTime := metav1.NewTime(*v.StartTime)
+		modification.StartTime = &startTime
+	}
+
+	return modification
+}
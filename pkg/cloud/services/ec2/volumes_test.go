@@ -0,0 +1,299 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestModifyVolumes(t *testing.T) {
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	startMetaTime := metav1.NewTime(startTime)
+
+	describeInstance := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeInstances(context.TODO(), gomock.Eq(&ec2.DescribeInstancesInput{
+			InstanceIds: []string{"i-1"},
+		})).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []types.Reservation{
+				{
+					Instances: []types.Instance{
+						{
+							InstanceId:     aws.String("i-1"),
+							RootDeviceName: aws.String("/dev/xvda"),
+							BlockDeviceMappings: []types.InstanceBlockDeviceMapping{
+								{
+									DeviceName: aws.String("/dev/xvda"),
+									Ebs:        &types.EbsInstanceBlockDevice{VolumeId: aws.String("vol-root")},
+								},
+								{
+									DeviceName: aws.String("/dev/sdb"),
+									Ebs:        &types.EbsInstanceBlockDevice{VolumeId: aws.String("vol-data")},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, nil)
+	}
+	describeVolumes := func(m *mocks.MockEC2APIMockRecorder) {
+		m.DescribeVolumes(context.TODO(), gomock.Eq(&ec2.DescribeVolumesInput{
+			VolumeIds: []string{"vol-root", "vol-data"},
+		})).Return(&ec2.DescribeVolumesOutput{
+			Volumes: []types.Volume{
+				{
+					VolumeId:   aws.String("vol-root"),
+					Size:       aws.Int32(20),
+					VolumeType: types.VolumeTypeGp2,
+					Iops:       aws.Int32(100),
+				},
+				{
+					VolumeId:   aws.String("vol-data"),
+					Size:       aws.Int32(50),
+					VolumeType: types.VolumeTypeGp3,
+					Iops:       aws.Int32(3000),
+					Throughput: aws.Int32(125),
+				},
+			},
+		}, nil)
+	}
+	describeModifications := func(modifications ...types.VolumeModification) func(m *mocks.MockEC2APIMockRecorder) {
+		return func(m *mocks.MockEC2APIMockRecorder) {
+			m.DescribeVolumesModifications(gomock.Any(), gomock.Any(), gomock.Any()).Return(&ec2.DescribeVolumesModificationsOutput{
+				VolumesModifications: modifications,
+			}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		rootVolume     *infrav1.Volume
+		nonRootVolumes []infrav1.Volume
+		expect         []func(m *mocks.MockEC2APIMockRecorder)
+		want           []infrav1.VolumeModification
+		wantErr        bool
+	}{
+		{
+			name:       "volumes matching the spec are left alone",
+			rootVolume: &infrav1.Volume{Size: 20, Type: infrav1.VolumeTypeGP2},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50, Type: infrav1.VolumeTypeGP3, Throughput: aws.Int64(125)},
+			},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){describeInstance, describeVolumes, describeModifications()},
+			want:   []infrav1.VolumeModification{},
+		},
+		{
+			name:       "grown root volume is modified",
+			rootVolume: &infrav1.Volume{Size: 40},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.DescribeVolumes(context.TODO(), gomock.Eq(&ec2.DescribeVolumesInput{
+						VolumeIds: []string{"vol-root"},
+					})).Return(&ec2.DescribeVolumesOutput{
+						Volumes: []types.Volume{
+							{VolumeId: aws.String("vol-root"), Size: aws.Int32(20), VolumeType: types.VolumeTypeGp2},
+						},
+					}, nil)
+				},
+				describeModifications(),
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.ModifyVolume(context.TODO(), gomock.Eq(&ec2.ModifyVolumeInput{
+						VolumeId: aws.String("vol-root"),
+						Size:     aws.Int32(40),
+					})).Return(&ec2.ModifyVolumeOutput{
+						VolumeModification: &types.VolumeModification{
+							VolumeId:          aws.String("vol-root"),
+							ModificationState: types.VolumeModificationStateModifying,
+							OriginalSize:      aws.Int32(20),
+							TargetSize:        aws.Int32(40),
+						},
+					}, nil)
+				},
+			},
+			want: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateModifying, OriginalSize: 20, TargetSize: 40},
+			},
+		},
+		{
+			name:       "retyped volume with provisioned IOPS is modified",
+			rootVolume: &infrav1.Volume{Size: 20, Type: infrav1.VolumeTypeGP2},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50, Type: infrav1.VolumeTypeIO2, IOPS: 4000},
+			},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				describeVolumes,
+				describeModifications(),
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.ModifyVolume(context.TODO(), gomock.Eq(&ec2.ModifyVolumeInput{
+						VolumeId:   aws.String("vol-data"),
+						VolumeType: types.VolumeTypeIo2,
+						Iops:       aws.Int32(4000),
+					})).Return(&ec2.ModifyVolumeOutput{
+						VolumeModification: &types.VolumeModification{
+							VolumeId:          aws.String("vol-data"),
+							ModificationState: types.VolumeModificationStateModifying,
+						},
+					}, nil)
+				},
+			},
+			want: []infrav1.VolumeModification{
+				{VolumeID: "vol-data", DeviceName: "/dev/sdb", State: infrav1.VolumeModificationStateModifying},
+			},
+		},
+		{
+			name:       "volume is not modified while a modification is in progress",
+			rootVolume: &infrav1.Volume{Size: 80},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				describeVolumes,
+				describeModifications(
+					types.VolumeModification{
+						VolumeId:          aws.String("vol-root"),
+						ModificationState: types.VolumeModificationStateCompleted,
+						StartTime:         aws.Time(startTime.Add(-time.Hour)),
+					},
+					types.VolumeModification{
+						VolumeId:          aws.String("vol-root"),
+						ModificationState: types.VolumeModificationStateOptimizing,
+						Progress:          aws.Int64(40),
+						OriginalSize:      aws.Int32(10),
+						TargetSize:        aws.Int32(20),
+						StartTime:         aws.Time(startTime),
+					},
+				),
+			},
+			want: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateOptimizing, Progress: 40, OriginalSize: 10, TargetSize: 20, StartTime: &startMetaTime},
+			},
+		},
+		{
+			name:       "failed modification is not retried",
+			rootVolume: &infrav1.Volume{Size: 40},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				describeVolumes,
+				describeModifications(
+					types.VolumeModification{
+						VolumeId:          aws.String("vol-root"),
+						ModificationState: types.VolumeModificationStateFailed,
+						StatusMessage:     aws.String("not supported"),
+						TargetSize:        aws.Int32(40),
+					},
+				),
+			},
+			want: []infrav1.VolumeModification{
+				{VolumeID: "vol-root", DeviceName: "/dev/xvda", State: infrav1.VolumeModificationStateFailed, TargetSize: 40, Message: "not supported"},
+			},
+		},
+		{
+			name:       "failed modification is forgotten once the volume matches the spec",
+			rootVolume: &infrav1.Volume{Size: 20},
+			nonRootVolumes: []infrav1.Volume{
+				{DeviceName: "/dev/sdb", Size: 50},
+			},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				describeVolumes,
+				describeModifications(
+					types.VolumeModification{
+						VolumeId:          aws.String("vol-root"),
+						ModificationState: types.VolumeModificationStateFailed,
+						TargetSize:        aws.Int32(40),
+					},
+				),
+			},
+			want: []infrav1.VolumeModification{},
+		},
+		{
+			name:       "modify volume error is returned",
+			rootVolume: &infrav1.Volume{Size: 40},
+			expect: []func(m *mocks.MockEC2APIMockRecorder){
+				describeInstance,
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.DescribeVolumes(context.TODO(), gomock.Any()).Return(&ec2.DescribeVolumesOutput{
+						Volumes: []types.Volume{
+							{VolumeId: aws.String("vol-root"), Size: aws.Int32(20)},
+						},
+					}, nil)
+				},
+				describeModifications(),
+				func(m *mocks.MockEC2APIMockRecorder) {
+					m.ModifyVolume(context.TODO(), gomock.Any()).Return(nil, errors.New("volume modification rate exceeded"))
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client:     client,
+				Cluster:    &clusterv1.Cluster{},
+				AWSCluster: &infrav1.AWSCluster{},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			for _, expect := range tc.expect {
+				expect(ec2Mock.EXPECT())
+			}
+
+			s := NewService(scope)
+			s.EC2Client = ec2Mock
+
+			got, err := s.ModifyVolumes("i-1", tc.rootVolume, tc.nonRootVolumes)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tc.want))
+		})
+	}
+}
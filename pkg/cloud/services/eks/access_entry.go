@@ -0,0 +1,358 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
+)
+
+const (
+	// accessEntryRoleTagValue is the role tag value used to mark access entries
+	// that are managed from AWSManagedControlPlane.Spec.AccessEntries.
+	accessEntryRoleTagValue = "access-entry"
+)
+
+func (s *Service) reconcileAccessEntries(ctx context.Context) error {
+	if !s.scope.AuthenticationMode().UsesAccessEntries() {
+		s.scope.Debug("authentication mode does not use access entries, skipping reconcile", "mode", s.scope.AuthenticationMode())
+		return nil
+	}
+
+	s.scope.Info("Reconciling EKS access entries")
+
+	clusterName := s.scope.KubernetesClusterName()
+
+	existing, err := s.getAccessEntries(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("getting existing access entries: %w", err)
+	}
+
+	desired := s.scope.AccessEntries()
+	desiredPrincipals := make(map[string]struct{}, len(desired))
+
+	states := make([]ekscontrolplanev1.AccessEntryState, 0, len(desired))
+	for i := range desired {
+		entry := desired[i]
+		desiredPrincipals[entry.PrincipalARN] = struct{}{}
+
+		current, err := s.reconcileAccessEntry(ctx, clusterName, entry, existing[entry.PrincipalARN])
+		if err != nil {
+			return fmt.Errorf("reconciling access entry %s: %w", entry.PrincipalARN, err)
+		}
+
+		policyARNs, err := s.reconcileAccessPolicies(ctx, clusterName, entry)
+		if err != nil {
+			return fmt.Errorf("reconciling access policies for access entry %s: %w", entry.PrincipalARN, err)
+		}
+
+		states = append(states, accessEntryToState(current, policyARNs))
+	}
+
+	for principalARN, entry := range existing {
+		if _, ok := desiredPrincipals[principalARN]; ok {
+			continue
+		}
+		if !s.isOwnedAccessEntry(entry) {
+			continue
+		}
+		if err := s.deleteAccessEntry(ctx, clusterName, principalARN); err != nil {
+			return fmt.Errorf("deleting access entry %s: %w", principalARN, err)
+		}
+	}
+
+	s.scope.ControlPlane.Status.AccessEntries = states
+	if err := s.scope.PatchObject(); err != nil {
+		return fmt.Errorf("failed to update control plane: %w", err)
+	}
+
+	s.scope.Debug("Reconcile EKS access entries completed successfully")
+	return nil
+}
+
+// reconcileAccessEntry ensures the access entry for the principal exists and matches the spec.
+// Existing entries that are not owned by this cluster are adopted.
+func (s *Service) reconcileAccessEntry(ctx context.Context, clusterName string, entry ekscontrolplanev1.AccessEntry, current *ekstypes.AccessEntry) (*ekstypes.AccessEntry, error) {
+	if current != nil && aws.ToString(current.Type) != string(accessEntryType(entry)) {
+		// The type of an access entry is immutable, it has to be recreated.
+		s.scope.Info("Access entry type changed, recreating", "principal", entry.PrincipalARN, "from", aws.ToString(current.Type), "to", accessEntryType(entry))
+		if err := s.deleteAccessEntry(ctx, clusterName, entry.PrincipalARN); err != nil {
+			return nil, err
+		}
+		current = nil
+	}
+
+	if current == nil {
+		return s.createAccessEntry(ctx, clusterName, entry)
+	}
+
+	if !s.isOwnedAccessEntry(current) {
+		s.scope.Debug("Adopting existing access entry", "principal", entry.PrincipalARN)
+		if _, err := s.EKSClient.TagResource(ctx, &eks.TagResourceInput{
+			ResourceArn: current.AccessEntryArn,
+			Tags:        s.accessEntryTags(),
+		}); err != nil {
+			return nil, fmt.Errorf("tagging access entry: %w", err)
+		}
+	}
+
+	if !accessEntryNeedsUpdate(entry, current) {
+		return current, nil
+	}
+
+	input := &eks.UpdateAccessEntryInput{
+		ClusterName:      aws.String(clusterName),
+		PrincipalArn:     aws.String(entry.PrincipalARN),
+		KubernetesGroups: entry.KubernetesGroups,
+	}
+	if entry.Username != "" {
+		input.Username = aws.String(entry.Username)
+	}
+
+	out, err := s.EKSClient.UpdateAccessEntry(ctx, input)
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSAccessEntry", "Failed to update access entry %s: %v", entry.PrincipalARN, err)
+		return nil, fmt.Errorf("updating access entry: %w", err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateEKSAccessEntry", "Updated access entry %s", entry.PrincipalARN)
+
+	return out.AccessEntry, nil
+}
+
+func (s *Service) createAccessEntry(ctx context.Context, clusterName string, entry ekscontrolplanev1.AccessEntry) (*ekstypes.AccessEntry, error) {
+	input := &eks.CreateAccessEntryInput{
+		ClusterName:      aws.String(clusterName),
+		PrincipalArn:     aws.String(entry.PrincipalARN),
+		Type:             aws.String(string(accessEntryType(entry))),
+		KubernetesGroups: entry.KubernetesGroups,
+		Tags:             s.accessEntryTags(),
+	}
+	if entry.Username != "" {
+		input.Username = aws.String(entry.Username)
+	}
+
+	out, err := s.EKSClient.CreateAccessEntry(ctx, input)
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedCreateEKSAccessEntry", "Failed to create access entry %s: %v", entry.PrincipalARN, err)
+		return nil, fmt.Errorf("creating access entry: %w", err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulCreateEKSAccessEntry", "Created access entry %s", entry.PrincipalARN)
+
+	return out.AccessEntry, nil
+}
+
+func (s *Service) deleteAccessEntry(ctx context.Context, clusterName, principalARN string) error {
+	s.scope.Info("Deleting access entry", "principal", principalARN)
+
+	if _, err := s.EKSClient.DeleteAccessEntry(ctx, &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	}); err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteEKSAccessEntry", "Failed to delete access entry %s: %v", principalARN, err)
+		return err
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulDeleteEKSAccessEntry", "Deleted access entry %s", principalARN)
+
+	return nil
+}
+
+// reconcileAccessPolicies associates and disassociates access policies so that they
+// match the spec and returns the ARNs of the associated policies.
+func (s *Service) reconcileAccessPolicies(ctx context.Context, clusterName string, entry ekscontrolplanev1.AccessEntry) ([]string, error) {
+	if accessEntryType(entry) != ekscontrolplanev1.AccessEntryTypeStandard {
+		return nil, nil
+	}
+
+	current := map[string]ekstypes.AccessScope{}
+	input := &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(entry.PrincipalARN),
+	}
+	for {
+		out, err := s.EKSClient.ListAssociatedAccessPolicies(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing associated access policies: %w", err)
+		}
+		for _, policy := range out.AssociatedAccessPolicies {
+			if policy.AccessScope != nil {
+				current[aws.ToString(policy.PolicyArn)] = *policy.AccessScope
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	desired := map[string]struct{}{}
+	policyARNs := make([]string, 0, len(entry.AccessPolicies))
+	for _, policy := range entry.AccessPolicies {
+		desired[policy.PolicyARN] = struct{}{}
+		policyARNs = append(policyARNs, policy.PolicyARN)
+
+		scope := accessScopeToSDK(policy.AccessScope)
+		if currentScope, ok := current[policy.PolicyARN]; ok && accessScopesEqual(currentScope, scope) {
+			continue
+		}
+
+		// Associating an already associated policy replaces its access scope.
+		if _, err := s.EKSClient.AssociateAccessPolicy(ctx, &eks.AssociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(entry.PrincipalARN),
+			PolicyArn:    aws.String(policy.PolicyARN),
+			AccessScope:  &scope,
+		}); err != nil {
+			return nil, fmt.Errorf("associating access policy %s: %w", policy.PolicyARN, err)
+		}
+		s.scope.Debug("Associated access policy", "principal", entry.PrincipalARN, "policy", policy.PolicyARN)
+	}
+
+	for policyARN := range current {
+		if _, ok := desired[policyARN]; ok {
+			continue
+		}
+		if _, err := s.EKSClient.DisassociateAccessPolicy(ctx, &eks.DisassociateAccessPolicyInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(entry.PrincipalARN),
+			PolicyArn:    aws.String(policyARN),
+		}); err != nil {
+			return nil, fmt.Errorf("disassociating access policy %s: %w", policyARN, err)
+		}
+		s.scope.Debug("Disassociated access policy", "principal", entry.PrincipalARN, "policy", policyARN)
+	}
+
+	return policyARNs, nil
+}
+
+// getAccessEntries returns all the access entries of the cluster indexed by principal ARN.
+func (s *Service) getAccessEntries(ctx context.Context, clusterName string) (map[string]*ekstypes.AccessEntry, error) {
+	principals := []string{}
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}
+	for {
+		out, err := s.EKSClient.ListAccessEntries(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing access entries: %w", err)
+		}
+		principals = append(principals, out.AccessEntries...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	entries := make(map[string]*ekstypes.AccessEntry, len(principals))
+	for _, principal := range principals {
+		out, err := s.EKSClient.DescribeAccessEntry(ctx, &eks.DescribeAccessEntryInput{
+			ClusterName:  aws.String(clusterName),
+			PrincipalArn: aws.String(principal),
+		})
+		if err != nil {
+			var notFoundErr *ekstypes.ResourceNotFoundException
+			if errors.As(err, &notFoundErr) {
+				continue
+			}
+			return nil, fmt.Errorf("describing access entry %s: %w", principal, err)
+		}
+		if out.AccessEntry == nil {
+			continue
+		}
+		entries[principal] = out.AccessEntry
+	}
+
+	return entries, nil
+}
+
+func (s *Service) accessEntryTags() map[string]string {
+	params := s.getEKSTagParams("")
+	params.Role = aws.String(accessEntryRoleTagValue)
+	return infrav1.Build(*params)
+}
+
+func (s *Service) isOwnedAccessEntry(entry *ekstypes.AccessEntry) bool {
+	tags := infrav1.Tags(entry.Tags)
+	return tags.HasOwned(s.scope.KubernetesClusterName()) && tags.GetRole() == accessEntryRoleTagValue
+}
+
+func accessEntryType(entry ekscontrolplanev1.AccessEntry) ekscontrolplanev1.AccessEntryType {
+	if entry.Type == "" {
+		return ekscontrolplanev1.AccessEntryTypeStandard
+	}
+	return entry.Type
+}
+
+func accessEntryNeedsUpdate(entry ekscontrolplanev1.AccessEntry, current *ekstypes.AccessEntry) bool {
+	if accessEntryType(entry) != ekscontrolplanev1.AccessEntryTypeStandard {
+		// Only standard access entries have a configurable username and groups.
+		return false
+	}
+
+	if entry.Username != "" && entry.Username != aws.ToString(current.Username) {
+		return true
+	}
+
+	return !utils.StringSetsEqual(entry.KubernetesGroups, current.KubernetesGroups)
+}
+
+func accessScopeToSDK(scope ekscontrolplanev1.AccessScope) ekstypes.AccessScope {
+	scopeType := ekstypes.AccessScopeTypeCluster
+	if scope.Type == ekscontrolplanev1.AccessScopeTypeNamespace {
+		scopeType = ekstypes.AccessScopeTypeNamespace
+	}
+	return ekstypes.AccessScope{
+		Type:       scopeType,
+		Namespaces: scope.Namespaces,
+	}
+}
+
+func accessScopesEqual(a, b ekstypes.AccessScope) bool {
+	return a.Type == b.Type && utils.StringSetsEqual(a.Namespaces, b.Namespaces)
+}
+
+func accessEntryToState(entry *ekstypes.AccessEntry, policyARNs []string) ekscontrolplanev1.AccessEntryState {
+	state := ekscontrolplanev1.AccessEntryState{
+		PrincipalARN:     aws.ToString(entry.PrincipalArn),
+		ARN:              aws.ToString(entry.AccessEntryArn),
+		Type:             aws.ToString(entry.Type),
+		Username:         aws.ToString(entry.Username),
+		KubernetesGroups: entry.KubernetesGroups,
+		AccessPolicies:   policyARNs,
+	}
+	if entry.CreatedAt != nil {
+		state.CreatedAt = metav1.NewTime(*entry.CreatedAt)
+	}
+	if entry.ModifiedAt != nil {
+		state.ModifiedAt = metav1.NewTime(*entry.ModifiedAt)
+	}
+	return state
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileAccessEntries(t *testing.T) {
+	clusterName := "cluster-test"
+	adminARN := "arn:aws:iam::123456789012:role/admin"
+	devARN := "arn:aws:iam::123456789012:role/dev"
+	oldARN := "arn:aws:iam::123456789012:role/old"
+	creatorARN := "arn:aws:iam::123456789012:role/creator"
+	viewPolicyARN := "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+
+	ownedTags := map[string]string{
+		"Name":                             clusterName,
+		infrav1.ClusterTagKey(clusterName): string(infrav1.ResourceLifecycleOwned),
+		infrav1.NameAWSClusterAPIRole:      accessEntryRoleTagValue,
+	}
+
+	tests := []struct {
+		name          string
+		mode          ekscontrolplanev1.EKSAuthenticationMode
+		accessEntries []ekscontrolplanev1.AccessEntry
+		expect        func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectStatus  []string
+		expectError   bool
+	}{
+		{
+			name: "config map mode does not reconcile access entries",
+			mode: ekscontrolplanev1.EKSAuthenticationModeConfigMap,
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{PrincipalARN: adminARN},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name: "creates missing access entry and associates its policies",
+			mode: ekscontrolplanev1.EKSAuthenticationModeAPI,
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN:     devARN,
+					Type:             ekscontrolplanev1.AccessEntryTypeStandard,
+					Username:         "dev",
+					KubernetesGroups: []string{"developers"},
+					AccessPolicies: []ekscontrolplanev1.AccessPolicyReference{
+						{
+							PolicyARN: viewPolicyARN,
+							AccessScope: ekscontrolplanev1.AccessScope{
+								Type:       ekscontrolplanev1.AccessScopeTypeNamespace,
+								Namespaces: []string{"dev"},
+							},
+						},
+					},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListAccessEntries(gomock.Any(), &eks.ListAccessEntriesInput{ClusterName: aws.String(clusterName)}).
+					Return(&eks.ListAccessEntriesOutput{}, nil)
+				m.CreateAccessEntry(gomock.Any(), &eks.CreateAccessEntryInput{
+					ClusterName:      aws.String(clusterName),
+					PrincipalArn:     aws.String(devARN),
+					Type:             aws.String("STANDARD"),
+					Username:         aws.String("dev"),
+					KubernetesGroups: []string{"developers"},
+					Tags:             ownedTags,
+				}).Return(&eks.CreateAccessEntryOutput{
+					AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn:     aws.String(devARN),
+						Type:             aws.String("STANDARD"),
+						Username:         aws.String("dev"),
+						KubernetesGroups: []string{"developers"},
+					},
+				}, nil)
+				m.ListAssociatedAccessPolicies(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListAssociatedAccessPoliciesInput{})).
+					Return(&eks.ListAssociatedAccessPoliciesOutput{}, nil)
+				m.AssociateAccessPolicy(gomock.Any(), &eks.AssociateAccessPolicyInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(devARN),
+					PolicyArn:    aws.String(viewPolicyARN),
+					AccessScope: &ekstypes.AccessScope{
+						Type:       ekstypes.AccessScopeTypeNamespace,
+						Namespaces: []string{"dev"},
+					},
+				}).Return(&eks.AssociateAccessPolicyOutput{}, nil)
+			},
+			expectStatus: []string{devARN},
+		},
+		{
+			name: "updates changed access entry and deletes only owned entries that are no longer desired",
+			mode: ekscontrolplanev1.EKSAuthenticationModeAPIAndConfigMap,
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN:     devARN,
+					Type:             ekscontrolplanev1.AccessEntryTypeStandard,
+					KubernetesGroups: []string{"developers", "viewers"},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListAccessEntries(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListAccessEntriesInput{})).
+					Return(&eks.ListAccessEntriesOutput{AccessEntries: []string{devARN, oldARN, creatorARN}}, nil)
+				m.DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{ClusterName: aws.String(clusterName), PrincipalArn: aws.String(devARN)}).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn:     aws.String(devARN),
+						Type:             aws.String("STANDARD"),
+						KubernetesGroups: []string{"developers"},
+						Tags:             ownedTags,
+					}}, nil)
+				m.DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{ClusterName: aws.String(clusterName), PrincipalArn: aws.String(oldARN)}).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn: aws.String(oldARN),
+						Type:         aws.String("STANDARD"),
+						Tags:         ownedTags,
+					}}, nil)
+				m.DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{ClusterName: aws.String(clusterName), PrincipalArn: aws.String(creatorARN)}).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn: aws.String(creatorARN),
+						Type:         aws.String("STANDARD"),
+					}}, nil)
+				m.UpdateAccessEntry(gomock.Any(), &eks.UpdateAccessEntryInput{
+					ClusterName:      aws.String(clusterName),
+					PrincipalArn:     aws.String(devARN),
+					KubernetesGroups: []string{"developers", "viewers"},
+				}).Return(&eks.UpdateAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+					PrincipalArn:     aws.String(devARN),
+					Type:             aws.String("STANDARD"),
+					KubernetesGroups: []string{"developers", "viewers"},
+				}}, nil)
+				m.ListAssociatedAccessPolicies(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListAssociatedAccessPoliciesInput{})).
+					Return(&eks.ListAssociatedAccessPoliciesOutput{
+						AssociatedAccessPolicies: []ekstypes.AssociatedAccessPolicy{
+							{
+								PolicyArn:   aws.String(viewPolicyARN),
+								AccessScope: &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster},
+							},
+						},
+					}, nil)
+				m.DisassociateAccessPolicy(gomock.Any(), &eks.DisassociateAccessPolicyInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(devARN),
+					PolicyArn:    aws.String(viewPolicyARN),
+				}).Return(&eks.DisassociateAccessPolicyOutput{}, nil)
+				m.DeleteAccessEntry(gomock.Any(), &eks.DeleteAccessEntryInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(oldARN),
+				}).Return(&eks.DeleteAccessEntryOutput{}, nil)
+			},
+			expectStatus: []string{devARN},
+		},
+		{
+			name: "recreates access entry when the type changes",
+			mode: ekscontrolplanev1.EKSAuthenticationModeAPI,
+			accessEntries: []ekscontrolplanev1.AccessEntry{
+				{
+					PrincipalARN: adminARN,
+					Type:         ekscontrolplanev1.AccessEntryTypeEC2Linux,
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListAccessEntries(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListAccessEntriesInput{})).
+					Return(&eks.ListAccessEntriesOutput{AccessEntries: []string{adminARN}}, nil)
+				m.DescribeAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeAccessEntryInput{})).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn: aws.String(adminARN),
+						Type:         aws.String("STANDARD"),
+						Tags:         ownedTags,
+					}}, nil)
+				m.DeleteAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DeleteAccessEntryInput{})).
+					Return(&eks.DeleteAccessEntryOutput{}, nil)
+				m.CreateAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.CreateAccessEntryInput{})).
+					Return(&eks.CreateAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn: aws.String(adminARN),
+						Type:         aws.String("EC2_LINUX"),
+					}}, nil)
+			},
+			expectStatus: []string{adminARN},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "cp",
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName: clusterName,
+					AccessConfig: &ekscontrolplanev1.AccessConfig{
+						AuthenticationMode: tc.mode,
+					},
+					AccessEntries: tc.accessEntries,
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: controlPlane,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expect(eksMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			err = s.reconcileAccessEntries(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(BeNil())
+
+			principals := []string{}
+			for _, state := range controlPlane.Status.AccessEntries {
+				principals = append(principals, state.PrincipalARN)
+			}
+			if tc.expectStatus == nil {
+				g.Expect(controlPlane.Status.AccessEntries).To(BeEmpty())
+			} else {
+				g.Expect(principals).To(Equal(tc.expectStatus))
+			}
+		})
+	}
+}
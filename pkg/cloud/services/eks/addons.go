@@ -19,40 +19,83 @@ package eks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/apimachinery/pkg/util/version"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
 	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	podIdentityAgentAddonName = "eks-pod-identity-agent"
 )
 
 func (s *Service) reconcileAddons(ctx context.Context) error {
 	s.scope.Info("Reconciling EKS addons")
 
+	state, err := s.getAddonsState(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.applyAddons(ctx, state)
+}
+
+// addonsState holds the installed and desired addons of the cluster, which are computed once per
+// reconciliation.
+type addonsState struct {
+	addonNames    []string
+	installed     []*eksaddons.EKSAddon
+	desiredAddons []*eksaddons.EKSAddon
+}
+
+// getAddonsState gets the installed addons of the cluster and the addons desired from the spec.
+func (s *Service) getAddonsState(ctx context.Context) (*addonsState, error) {
 	eksClusterName := s.scope.KubernetesClusterName()
 
 	// Get available addon names for the cluster
 	addonNames, err := s.listAddons(ctx, eksClusterName)
 	if err != nil {
 		s.Error(err, "failed listing addons")
-		return fmt.Errorf("listing eks addons: %w", err)
+		return nil, fmt.Errorf("listing eks addons: %w", err)
 	}
 
 	// Get installed addons for the cluster
 	s.scope.Debug("getting installed eks addons", "cluster", eksClusterName)
 	installed, err := s.getClusterAddonsInstalled(ctx, eksClusterName, addonNames)
 	if err != nil {
-		return fmt.Errorf("getting installed eks addons: %w", err)
+		return nil, fmt.Errorf("getting installed eks addons: %w", err)
 	}
 
 	// Get the addons from the spec we want for the cluster
-	desiredAddons := s.translateAPIToAddon(s.scope.Addons())
+	desiredAddons, err := s.desiredAddons(ctx, eksClusterName, installed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &addonsState{
+		addonNames:    addonNames,
+		installed:     installed,
+		desiredAddons: desiredAddons,
+	}, nil
+}
+
+// applyAddons moves the installed addons to the desired addons, and updates the status of the addons.
+func (s *Service) applyAddons(ctx context.Context, state *addonsState) error {
+	eksClusterName := s.scope.KubernetesClusterName()
+	addonNames, installed, desiredAddons := state.addonNames, state.installed, state.desiredAddons
 
 	// If there are no addons desired or installed then do nothing
 	if len(installed) == 0 && len(desiredAddons) == 0 {
@@ -60,6 +103,13 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 		return nil
 	}
 
+	// Validate the addon configurations before updating the addons. Addons with an invalid
+	// configuration are left as they are, so the other addons are still reconciled.
+	if invalidAddons := s.validateAddonConfigurations(ctx, desiredAddons); len(invalidAddons) > 0 {
+		desiredAddons = withoutAddons(desiredAddons, invalidAddons)
+		installed = withoutAddons(installed, invalidAddons)
+	}
+
 	//  Compute operations to move installed to desired
 	s.scope.Debug("creating eks addons plan", "cluster", eksClusterName, "numdesired", len(desiredAddons), "numinstalled", len(installed))
 	addonsPlan := eksaddons.NewPlan(eksClusterName, desiredAddons, installed, s.EKSClient, s.scope.MaxWaitActiveUpdateDelete)
@@ -99,6 +149,106 @@ func (s *Service) reconcileAddons(ctx context.Context) error {
 	return nil
 }
 
+// validateAddonConfigurations validates the configuration of the addons against the configuration schema
+// of their version, and reports the result in the EKSAddonsConfigurationValid condition. It returns the
+// names of the addons with an invalid configuration.
+func (s *Service) validateAddonConfigurations(ctx context.Context, addons []*eksaddons.EKSAddon) []string {
+	configured := false
+	invalidAddons := []string{}
+	invalid := []string{}
+	schemaErrs := []string{}
+	for _, addon := range addons {
+		addonName := aws.ToString(addon.Name)
+		addonVersion := aws.ToString(addon.Version)
+		configuration := aws.ToString(addon.Configuration)
+		if configuration == "" || addonVersion == "" {
+			continue
+		}
+		configured = true
+
+		schema, err := s.addonConfigurationSchema(ctx, addonName, addonVersion)
+		if err != nil {
+			s.scope.Error(err, "failed getting eks addon configuration schema", "addon", addonName, "version", addonVersion)
+			schemaErrs = append(schemaErrs, fmt.Sprintf("addon %s: %s", addonName, err.Error()))
+			continue
+		}
+		if schema == "" {
+			continue
+		}
+
+		parsed, err := eksaddons.ParseConfigurationSchema(schema)
+		if err != nil {
+			// The configuration is then only validated by EKS when the addon is updated.
+			s.scope.Info("Not validating eks addon configuration", "addon", addonName, "version", addonVersion, "reason", err.Error())
+			continue
+		}
+
+		messages := eksaddons.ValidateConfiguration(parsed, configuration)
+		if len(messages) > 0 {
+			invalidAddons = append(invalidAddons, addonName)
+		}
+		for _, message := range messages {
+			invalid = append(invalid, fmt.Sprintf("addon %s: %s", addonName, message))
+		}
+	}
+
+	switch {
+	case !configured:
+		conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)
+	case len(invalid) > 0:
+		message := strings.Join(invalid, "; ")
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition, ekscontrolplanev1.EKSAddonsConfigurationInvalidReason, clusterv1.ConditionSeverityError, "%s", message)
+		record.Warnf(s.scope.ControlPlane, "InvalidEKSAddonConfiguration", "Invalid configuration of EKS addons: %s", message)
+	case len(schemaErrs) > 0:
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition, ekscontrolplanev1.EKSAddonsConfigurationSchemaFailedReason, clusterv1.ConditionSeverityWarning, "%s", strings.Join(schemaErrs, "; "))
+	default:
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)
+	}
+
+	return invalidAddons
+}
+
+// withoutAddons returns the addons whose name isn't in names.
+func withoutAddons(addons []*eksaddons.EKSAddon, names []string) []*eksaddons.EKSAddon {
+	filtered := []*eksaddons.EKSAddon{}
+	for _, addon := range addons {
+		if !slices.Contains(names, aws.ToString(addon.Name)) {
+			filtered = append(filtered, addon)
+		}
+	}
+	return filtered
+}
+
+// addonConfigurationSchema returns the JSON schema of the configuration of the addon version.
+// Results are cached since the schema of an addon version is not expected to change.
+func (s *Service) addonConfigurationSchema(ctx context.Context, addonName, addonVersion string) (string, error) {
+	key := cache.AddonConfigurationSchemaCacheEntry{AddonName: addonName, AddonVersion: addonVersion}.Key()
+	if s.AddonConfigurationSchemaCache != nil {
+		if entry, ok := s.AddonConfigurationSchemaCache.Has(key); ok {
+			return entry.Schema, nil
+		}
+	}
+
+	out, err := s.EKSClient.DescribeAddonConfiguration(ctx, &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describing eks addon configuration: %w", err)
+	}
+
+	schema := aws.ToString(out.ConfigurationSchema)
+	if s.AddonConfigurationSchemaCache != nil {
+		s.AddonConfigurationSchemaCache.Add(cache.AddonConfigurationSchemaCacheEntry{
+			AddonName:    addonName,
+			AddonVersion: addonVersion,
+			Schema:       schema,
+		})
+	}
+
+	return schema, nil
+}
+
 func (s *Service) getClusterAddonsInstalled(ctx context.Context, eksClusterName string, addonNames []string) ([]*eksaddons.EKSAddon, error) {
 	s.Debug("getting eks addons installed")
 
@@ -191,6 +341,56 @@ func (s *Service) listAddons(ctx context.Context, eksClusterName string) ([]stri
 	return addons, nil
 }
 
+// desiredAddons returns the addons the cluster should have. The versions of addons using the
+// latest-compatible version policy are resolved for the Kubernetes version of the cluster.
+func (s *Service) desiredAddons(ctx context.Context, eksClusterName string, installed []*eksaddons.EKSAddon) ([]*eksaddons.EKSAddon, error) {
+	addons := s.scope.Addons()
+	desired := s.translateAPIToAddon(addons)
+
+	for i := range addons {
+		if !addons[i].LatestCompatible() {
+			continue
+		}
+		addonVersion, err := s.latestCompatibleAddonVersion(ctx, addons[i].Name)
+		if err != nil {
+			return nil, err
+		}
+		desired[i].Version = aws.String(addonVersion)
+	}
+
+	// Pod identity associations require the agent addon to be installed
+	desired, err := s.ensurePodIdentityAgentAddon(ctx, eksClusterName, desired, installed)
+	if err != nil {
+		return nil, fmt.Errorf("ensuring eks pod identity agent addon: %w", err)
+	}
+
+	return desired, nil
+}
+
+// latestCompatibleAddonVersion returns the latest version of the addon that is compatible with the
+// Kubernetes version of the cluster.
+func (s *Service) latestCompatibleAddonVersion(ctx context.Context, addonName string) (string, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName: aws.String(addonName),
+	}
+	kubernetesVersion := s.clusterKubernetesVersion()
+	if kubernetesVersion != "" {
+		input.KubernetesVersion = aws.String(kubernetesVersion)
+	}
+	out, err := s.EKSClient.DescribeAddonVersions(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("describing eks addon versions: %w", err)
+	}
+
+	addonVersion := latestAddonVersion(out.Addons)
+	if addonVersion == "" {
+		return "", fmt.Errorf("no version of eks addon %s found for Kubernetes %s", addonName, kubernetesVersion)
+	}
+
+	s.scope.Debug("resolved latest compatible eks addon version", "addon", addonName, "version", addonVersion, "kubernetesVersion", kubernetesVersion)
+	return addonVersion, nil
+}
+
 func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksaddons.EKSAddon {
 	converted := []*eksaddons.EKSAddon{}
 
@@ -215,6 +415,105 @@ func (s *Service) translateAPIToAddon(addons []ekscontrolplanev1.Addon) []*eksad
 	return converted
 }
 
+// ensurePodIdentityAgentAddon adds the eks-pod-identity-agent addon to the desired addons when
+// pod identity associations are specified and the addon isn't part of the spec. An installed
+// agent is kept as is, otherwise the default version for the cluster version is installed.
+func (s *Service) ensurePodIdentityAgentAddon(ctx context.Context, eksClusterName string, desired, installed []*eksaddons.EKSAddon) ([]*eksaddons.EKSAddon, error) {
+	if len(s.scope.PodIdentityAssociations()) == 0 {
+		return desired, nil
+	}
+
+	for _, addon := range desired {
+		if aws.ToString(addon.Name) == podIdentityAgentAddonName {
+			return desired, nil
+		}
+	}
+
+	for _, addon := range installed {
+		if aws.ToString(addon.Name) == podIdentityAgentAddonName {
+			s.scope.Debug("keeping installed eks pod identity agent addon", "version", aws.ToString(addon.Version))
+			return append(desired, &eksaddons.EKSAddon{
+				Name:                  addon.Name,
+				Version:               addon.Version,
+				Configuration:         addon.Configuration,
+				ServiceAccountRoleARN: addon.ServiceAccountRoleARN,
+				Tags:                  ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
+			}), nil
+		}
+	}
+
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName: aws.String(podIdentityAgentAddonName),
+	}
+	if kubernetesVersion := s.clusterKubernetesVersion(); kubernetesVersion != "" {
+		input.KubernetesVersion = aws.String(kubernetesVersion)
+	}
+	out, err := s.EKSClient.DescribeAddonVersions(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("describing eks addon versions: %w", err)
+	}
+
+	addonVersion := defaultAddonVersion(out.Addons)
+	if addonVersion == "" {
+		return nil, fmt.Errorf("no default version found for eks addon %s", podIdentityAgentAddonName)
+	}
+
+	s.scope.Info("installing eks pod identity agent addon required by pod identity associations", "cluster", eksClusterName, "version", addonVersion)
+	return append(desired, &eksaddons.EKSAddon{
+		Name:    aws.String(podIdentityAgentAddonName),
+		Version: aws.String(addonVersion),
+		Tags:    ngTags(s.scope.Cluster.Name, s.scope.AdditionalTags()),
+	}), nil
+}
+
+// clusterKubernetesVersion returns the EKS version of the cluster in the major.minor format.
+func (s *Service) clusterKubernetesVersion() string {
+	raw := s.scope.ControlPlane.Status.Version
+	if raw == nil {
+		raw = s.scope.ControlPlane.Spec.Version
+	}
+	if raw == nil {
+		return ""
+	}
+	v, err := parseEKSVersion(*raw)
+	if err != nil {
+		return ""
+	}
+	return versionToEKS(v)
+}
+
+func defaultAddonVersion(addons []ekstypes.AddonInfo) string {
+	for _, addon := range addons {
+		for _, addonVersion := range addon.AddonVersions {
+			for _, compatibility := range addonVersion.Compatibilities {
+				if compatibility.DefaultVersion {
+					return aws.ToString(addonVersion.AddonVersion)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// latestAddonVersion returns the newest of the addon versions, which are formatted like v1.19.0-eksbuild.1.
+func latestAddonVersion(addons []ekstypes.AddonInfo) string {
+	var latest *version.Version
+	latestVersion := ""
+	for _, addon := range addons {
+		for _, addonVersion := range addon.AddonVersions {
+			v, err := version.ParseSemantic(aws.ToString(addonVersion.AddonVersion))
+			if err != nil {
+				continue
+			}
+			if latest == nil || latest.LessThan(v) {
+				latest = v
+				latestVersion = aws.ToString(addonVersion.AddonVersion)
+			}
+		}
+	}
+	return latestVersion
+}
+
 // WaitUntilAddonDeleted is blocking function to wait until EKS Addon is Deleted.
 func (k *EKSClient) WaitUntilAddonDeleted(ctx context.Context, input *eks.DescribeAddonInput, maxWait time.Duration) error {
 	waiter := eks.NewAddonDeletedWaiter(k, func(o *eks.AddonDeletedWaiterOptions) {
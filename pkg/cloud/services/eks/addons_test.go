@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	eksaddons "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/addons"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/util/cache"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capicache "sigs.k8s.io/cluster-api/util/cache"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestValidateAddonConfigurations(t *testing.T) {
+	corednsSchema := `{"type": "object", "additionalProperties": false, "properties": {"replicaCount": {"type": "integer"}}}`
+	describeConfiguration := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String("coredns"),
+		AddonVersion: aws.String("v1.11.1-eksbuild.4"),
+	}
+	coredns := func(configuration string) *eksaddons.EKSAddon {
+		return &eksaddons.EKSAddon{
+			Name:          aws.String("coredns"),
+			Version:       aws.String("v1.11.1-eksbuild.4"),
+			Configuration: convertConfiguration(configuration),
+		}
+	}
+
+	tests := []struct {
+		name            string
+		addons          []*eksaddons.EKSAddon
+		expect          func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectInvalid   []string
+		expectCondition *bool
+		expectReason    string
+		expectMessage   string
+	}{
+		{
+			name:   "no addon configuration",
+			addons: []*eksaddons.EKSAddon{coredns("")},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:   "valid YAML configuration",
+			addons: []*eksaddons.EKSAddon{coredns("replicaCount: 3")},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Any(), describeConfiguration).
+					Return(&eks.DescribeAddonConfigurationOutput{ConfigurationSchema: aws.String(corednsSchema)}, nil)
+			},
+			expectCondition: aws.Bool(true),
+		},
+		{
+			name:   "invalid JSON configuration",
+			addons: []*eksaddons.EKSAddon{coredns(`{"replicaCount": "3", "replicas": 3}`)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Any(), describeConfiguration).
+					Return(&eks.DescribeAddonConfigurationOutput{ConfigurationSchema: aws.String(corednsSchema)}, nil)
+			},
+			expectInvalid:   []string{"coredns"},
+			expectCondition: aws.Bool(false),
+			expectReason:    ekscontrolplanev1.EKSAddonsConfigurationInvalidReason,
+			expectMessage:   `addon coredns: configuration.replicaCount in body must be of type integer: "string"; addon coredns: configuration.replicas in body is a forbidden property`,
+		},
+		{
+			name:   "addon version without configuration schema",
+			addons: []*eksaddons.EKSAddon{coredns("replicaCount: 3")},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Any(), describeConfiguration).
+					Return(&eks.DescribeAddonConfigurationOutput{}, nil)
+			},
+			expectCondition: aws.Bool(true),
+		},
+		{
+			name:   "describing the configuration schema fails",
+			addons: []*eksaddons.EKSAddon{coredns("replicaCount: 3")},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.DescribeAddonConfiguration(gomock.Any(), describeConfiguration).
+					Return(nil, errors.New("access denied"))
+			},
+			expectCondition: aws.Bool(false),
+			expectReason:    ekscontrolplanev1.EKSAddonsConfigurationSchemaFailedReason,
+			expectMessage:   "addon coredns: describing eks addon configuration: access denied",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			scope := newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "default.cluster"})
+			s := NewService(scope, WithAddonConfigurationSchemaCache(nil))
+			s.EKSClient = eksMock
+
+			invalidAddons := s.validateAddonConfigurations(context.TODO(), tc.addons)
+			if tc.expectInvalid == nil {
+				g.Expect(invalidAddons).To(BeEmpty())
+			} else {
+				g.Expect(invalidAddons).To(Equal(tc.expectInvalid))
+			}
+
+			if tc.expectCondition == nil {
+				g.Expect(conditions.Has(scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(BeFalse())
+				return
+			}
+			g.Expect(conditions.IsTrue(scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(Equal(*tc.expectCondition))
+			g.Expect(conditions.GetReason(scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(Equal(tc.expectReason))
+			g.Expect(conditions.GetMessage(scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(Equal(tc.expectMessage))
+		})
+	}
+}
+
+func TestAddonConfigurationSchemaCached(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().DescribeAddonConfiguration(gomock.Any(), gomock.Any()).
+		Return(&eks.DescribeAddonConfigurationOutput{ConfigurationSchema: aws.String(`{"type": "object"}`)}, nil).
+		Times(1)
+
+	scope := newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "default.cluster"})
+	s := NewService(scope, WithAddonConfigurationSchemaCache(capicache.New[cache.AddonConfigurationSchemaCacheEntry](time.Hour)))
+	s.EKSClient = eksMock
+
+	for range 2 {
+		schema, err := s.addonConfigurationSchema(context.TODO(), "coredns", "v1.11.1-eksbuild.4")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(schema).To(Equal(`{"type": "object"}`))
+	}
+}
+
+func TestApplyAddonsWithInvalidConfiguration(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().DescribeAddonConfiguration(gomock.Any(), gomock.Any()).
+		Return(&eks.DescribeAddonConfigurationOutput{ConfigurationSchema: aws.String(`{"type": "object", "properties": {"replicaCount": {"type": "integer"}}}`)}, nil)
+	// Only the addon with a valid configuration is created, the installed addon with an invalid
+	// configuration is neither updated nor deleted.
+	eksMock.EXPECT().CreateAddon(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *eks.CreateAddonInput, _ ...func(*eks.Options)) (*eks.CreateAddonOutput, error) {
+			g.Expect(aws.ToString(input.AddonName)).To(Equal("vpc-cni"))
+			return &eks.CreateAddonOutput{Addon: &ekstypes.Addon{AddonName: input.AddonName}}, nil
+		})
+	eksMock.EXPECT().DescribeAddon(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, input *eks.DescribeAddonInput, _ ...func(*eks.Options)) (*eks.DescribeAddonOutput, error) {
+			return &eks.DescribeAddonOutput{Addon: &ekstypes.Addon{
+				AddonName:  input.AddonName,
+				Status:     ekstypes.AddonStatusActive,
+				CreatedAt:  aws.Time(time.Now()),
+				ModifiedAt: aws.Time(time.Now()),
+			}}, nil
+		}).AnyTimes()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "cp",
+		},
+		Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "default.cluster"},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-name",
+			},
+		},
+		ControlPlane: controlPlane,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	s := NewService(scope, WithAddonConfigurationSchemaCache(nil))
+	s.EKSClient = eksMock
+
+	state := &addonsState{
+		addonNames: []string{"coredns"},
+		installed: []*eksaddons.EKSAddon{
+			{
+				Name:          aws.String("coredns"),
+				Version:       aws.String("v1.11.1-eksbuild.4"),
+				Configuration: aws.String("replicaCount: 2"),
+				Tags:          infrav1.Tags{},
+				Status:        aws.String(string(ekstypes.AddonStatusActive)),
+			},
+		},
+		desiredAddons: []*eksaddons.EKSAddon{
+			{
+				Name:          aws.String("coredns"),
+				Version:       aws.String("v1.11.1-eksbuild.4"),
+				Configuration: aws.String(`{"replicaCount": "3"}`),
+				Tags:          infrav1.Tags{},
+			},
+			{
+				Name:            aws.String("vpc-cni"),
+				Version:         aws.String("v1.19.0-eksbuild.1"),
+				ResolveConflict: aws.String(string(ekstypes.ResolveConflictsOverwrite)),
+				Tags:            infrav1.Tags{},
+			},
+		},
+	}
+
+	g.Expect(s.applyAddons(context.TODO(), state)).To(Succeed())
+	g.Expect(conditions.IsFalse(controlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(controlPlane, ekscontrolplanev1.EKSAddonsConfigurationValidCondition)).To(Equal(ekscontrolplanev1.EKSAddonsConfigurationInvalidReason))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,6 +35,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/annotations"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/wait"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/internal/cidr"
@@ -113,16 +115,12 @@ func (s *Service) reconcileCluster(ctx context.Context) error {
 		return errors.Wrap(err, "failed reconciling additional kubeconfigs")
 	}
 
-	if err := s.reconcileClusterVersion(ctx, cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster version")
+	if err := s.reconcileUpgrade(ctx, cluster); err != nil {
+		return errors.Wrap(err, "failed reconciling cluster upgrade")
 	}
 
-	if err := s.reconcileClusterConfig(ctx, cluster); err != nil {
-		return errors.Wrap(err, "failed reconciling cluster config")
-	}
-
-	if err := s.reconcileLogging(ctx, cluster.Logging); err != nil {
-		return errors.Wrap(err, "failed reconciling logging")
+	if err := s.reconcileClusterConfigUpdates(ctx, cluster); err != nil {
+		return err
 	}
 
 	if err := s.reconcileEKSEncryptionConfig(ctx, cluster.EncryptionConfig); err != nil {
@@ -471,6 +469,22 @@ func (s *Service) createCluster(ctx context.Context, eksClusterName string) (*ek
 		Tags:                       tags,
 		KubernetesNetworkConfig:    netConfig,
 		BootstrapSelfManagedAddons: bootstrapAddon,
+		AccessConfig:               makeAccessConfig(s.scope.ControlPlane.Spec.AccessConfig),
+		UpgradePolicy:              makeUpgradePolicy(s.scope.ControlPlane.Spec.UpgradePolicy),
+		ZonalShiftConfig:           makeZonalShiftConfig(s.scope.ControlPlane.Spec.ZonalShiftConfig),
+	}
+
+	if s.scope.AutoModeEnabled() {
+		nodeRoleARN, err := s.autoModeNodeRoleARN(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var elb *ekstypes.ElasticLoadBalancing
+		input.ComputeConfig, input.StorageConfig, elb = makeAutoModeConfig(s.scope.ControlPlane.Spec.AutoMode, nodeRoleARN)
+		if input.KubernetesNetworkConfig == nil {
+			input.KubernetesNetworkConfig = &ekstypes.KubernetesNetworkConfigRequest{}
+		}
+		input.KubernetesNetworkConfig.ElasticLoadBalancing = elb
 	}
 
 	var out *eks.CreateClusterOutput
@@ -513,13 +527,43 @@ func (s *Service) waitForClusterActive(ctx context.Context) (*ekstypes.Cluster,
 	return cluster, nil
 }
 
-func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.Cluster) error {
+// reconcileClusterConfigUpdates updates the configuration of the cluster. EKS only accepts one
+// configuration update at a time and each update is compared with the cluster as it was before
+// the first one, so the remaining updates are made in the next reconciles once an update is started.
+func (s *Service) reconcileClusterConfigUpdates(ctx context.Context, cluster *ekstypes.Cluster) error {
+	updates := []struct {
+		name      string
+		reconcile func() (bool, error)
+	}{
+		{"cluster config", func() (bool, error) { return s.reconcileClusterConfig(ctx, cluster) }},
+		{"access config", func() (bool, error) { return s.reconcileAccessConfig(ctx, cluster.AccessConfig) }},
+		{"auto mode", func() (bool, error) { return s.reconcileAutoMode(ctx, cluster) }},
+		{"upgrade policy", func() (bool, error) { return s.reconcileUpgradePolicy(ctx, cluster.UpgradePolicy) }},
+		{"zonal shift config", func() (bool, error) { return s.reconcileZonalShiftConfig(ctx, cluster.ZonalShiftConfig) }},
+		{"logging", func() (bool, error) { return s.reconcileLogging(ctx, cluster.Logging) }},
+	}
+
+	for _, update := range updates {
+		updated, err := update.reconcile()
+		if err != nil {
+			return errors.Wrapf(err, "failed reconciling %s", update.name)
+		}
+		if updated {
+			s.scope.Debug("Deferring the remaining EKS cluster config updates until the update is done", "update", update.name)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.Cluster) (bool, error) {
 	var needsUpdate bool
 	input := &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
 
 	updateVpcConfig, err := s.reconcileVpcConfig(cluster.ResourcesVpcConfig)
 	if err != nil {
-		return errors.Wrap(err, "couldn't create vpc config for cluster")
+		return false, errors.Wrap(err, "couldn't create vpc config for cluster")
 	}
 	if updateVpcConfig != nil {
 		needsUpdate = true
@@ -536,13 +580,285 @@ func (s *Service) reconcileClusterConfig(ctx context.Context, cluster *ekstypes.
 			return true, nil
 		}); err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update the EKS control plane: %v", err)
-			return errors.Wrapf(err, "failed to update EKS cluster")
+			return false, errors.Wrapf(err, "failed to update EKS cluster")
 		}
 	}
-	return nil
+	return needsUpdate, nil
+}
+
+// makeAutoModeConfig returns the compute, block storage and load balancing configuration of EKS Auto Mode.
+// EKS requires the three capabilities to be enabled or disabled together.
+func makeAutoModeConfig(autoMode *ekscontrolplanev1.AutoMode, nodeRoleARN *string) (*ekstypes.ComputeConfigRequest, *ekstypes.StorageConfigRequest, *ekstypes.ElasticLoadBalancing) {
+	enabled := autoMode != nil && autoMode.Enabled
+
+	compute := &ekstypes.ComputeConfigRequest{
+		Enabled: aws.Bool(enabled),
+	}
+	if enabled && len(autoMode.Compute.NodePools) > 0 {
+		for _, nodePool := range autoMode.Compute.NodePools {
+			compute.NodePools = append(compute.NodePools, string(nodePool))
+		}
+		compute.NodeRoleArn = nodeRoleARN
+	}
+
+	storage := &ekstypes.StorageConfigRequest{
+		BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(enabled)},
+	}
+
+	return compute, storage, &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(enabled)}
+}
+
+// autoModeNeedsUpdate returns whether the EKS Auto Mode configuration of the cluster differs from the spec.
+// The node role can't be changed once auto mode is enabled, so it isn't compared.
+func autoModeNeedsUpdate(cluster *ekstypes.Cluster, autoMode *ekscontrolplanev1.AutoMode) bool {
+	var computeEnabled, storageEnabled, elbEnabled bool
+	var nodePools []string
+	if cluster.ComputeConfig != nil {
+		computeEnabled = aws.ToBool(cluster.ComputeConfig.Enabled)
+		nodePools = cluster.ComputeConfig.NodePools
+	}
+	if cluster.StorageConfig != nil && cluster.StorageConfig.BlockStorage != nil {
+		storageEnabled = aws.ToBool(cluster.StorageConfig.BlockStorage.Enabled)
+	}
+	if cluster.KubernetesNetworkConfig != nil && cluster.KubernetesNetworkConfig.ElasticLoadBalancing != nil {
+		elbEnabled = aws.ToBool(cluster.KubernetesNetworkConfig.ElasticLoadBalancing.Enabled)
+	}
+
+	if computeEnabled != autoMode.Enabled || storageEnabled != autoMode.Enabled || elbEnabled != autoMode.Enabled {
+		return true
+	}
+	if !autoMode.Enabled {
+		return false
+	}
+
+	desiredNodePools := sets.New[string]()
+	for _, nodePool := range autoMode.Compute.NodePools {
+		desiredNodePools.Insert(string(nodePool))
+	}
+	return !desiredNodePools.Equal(sets.New(nodePools...))
+}
+
+// autoModeNodeRoleARN returns the ARN of the node role of the built-in EKS Auto Mode node pools,
+// or nil when no built-in node pools are enabled.
+func (s *Service) autoModeNodeRoleARN(ctx context.Context) (*string, error) {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	if len(autoMode.Compute.NodePools) == 0 || autoMode.Compute.NodeRoleName == nil {
+		return nil, nil
+	}
+
+	role, err := s.GetIAMRole(ctx, *autoMode.Compute.NodeRoleName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting auto mode node iam role: %s", *autoMode.Compute.NodeRoleName)
+	}
+	return role.Arn, nil
+}
+
+// reconcileAutoMode enables, disables or updates the node pools of EKS Auto Mode. Clusters without
+// auto mode in the spec are left alone.
+func (s *Service) reconcileAutoMode(ctx context.Context, cluster *ekstypes.Cluster) (bool, error) {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	if autoMode == nil || !autoModeNeedsUpdate(cluster, autoMode) {
+		return false, nil
+	}
+
+	var nodeRoleARN *string
+	if autoMode.Enabled {
+		var err error
+		if nodeRoleARN, err = s.autoModeNodeRoleARN(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	compute, storage, elb := makeAutoModeConfig(autoMode, nodeRoleARN)
+	input := &eks.UpdateClusterConfigInput{
+		Name:          aws.String(s.scope.KubernetesClusterName()),
+		ComputeConfig: compute,
+		StorageConfig: storage,
+		KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+			ElasticLoadBalancing: elb,
+		},
+	}
+
+	s.scope.Debug("Updating EKS auto mode", "cluster", s.scope.KubernetesClusterName(), "enabled", autoMode.Enabled)
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated auto mode update for EKS control plane %s", s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane auto mode: %v", err)
+		return false, errors.Wrapf(err, "failed to update EKS cluster")
+	}
+
+	return true, nil
+}
+
+func makeUpgradePolicy(upgradePolicy *ekscontrolplanev1.UpgradePolicy) *ekstypes.UpgradePolicyRequest {
+	if upgradePolicy == nil {
+		return nil
+	}
+
+	return &ekstypes.UpgradePolicyRequest{
+		SupportType: ekstypes.SupportType(upgradePolicy.SupportType),
+	}
+}
+
+func makeZonalShiftConfig(zonalShiftConfig *ekscontrolplanev1.ZonalShiftConfig) *ekstypes.ZonalShiftConfigRequest {
+	if zonalShiftConfig == nil {
+		return nil
+	}
+
+	return &ekstypes.ZonalShiftConfigRequest{
+		Enabled: aws.Bool(zonalShiftConfig.Enabled),
+	}
+}
+
+// reconcileUpgradePolicy updates the support type of the cluster. Clusters without an upgrade
+// policy in the spec are left alone.
+func (s *Service) reconcileUpgradePolicy(ctx context.Context, upgradePolicy *ekstypes.UpgradePolicyResponse) (bool, error) {
+	desired := s.scope.ControlPlane.Spec.UpgradePolicy
+	if desired == nil {
+		return false, nil
+	}
+	if upgradePolicy != nil && string(upgradePolicy.SupportType) == string(desired.SupportType) {
+		return false, nil
+	}
+
+	s.scope.Debug("Updating EKS upgrade policy", "cluster", s.scope.KubernetesClusterName(), "supportType", desired.SupportType)
+	input := &eks.UpdateClusterConfigInput{
+		Name:          aws.String(s.scope.KubernetesClusterName()),
+		UpgradePolicy: makeUpgradePolicy(desired),
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated support type update to %s for EKS control plane %s", desired.SupportType, s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane upgrade policy: %v", err)
+		return false, errors.Wrapf(err, "failed to update EKS cluster")
+	}
+
+	return true, nil
+}
+
+// reconcileZonalShiftConfig enables or disables zonal shift for the cluster. Clusters without
+// a zonal shift config in the spec are left alone.
+func (s *Service) reconcileZonalShiftConfig(ctx context.Context, zonalShiftConfig *ekstypes.ZonalShiftConfigResponse) (bool, error) {
+	desired := s.scope.ControlPlane.Spec.ZonalShiftConfig
+	if desired == nil {
+		return false, nil
+	}
+	if zonalShiftConfig != nil && aws.ToBool(zonalShiftConfig.Enabled) == desired.Enabled {
+		return false, nil
+	}
+
+	s.scope.Debug("Updating EKS zonal shift config", "cluster", s.scope.KubernetesClusterName(), "enabled", desired.Enabled)
+	input := &eks.UpdateClusterConfigInput{
+		Name:             aws.String(s.scope.KubernetesClusterName()),
+		ZonalShiftConfig: makeZonalShiftConfig(desired),
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated zonal shift config update for EKS control plane %s", s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane zonal shift config: %v", err)
+		return false, errors.Wrapf(err, "failed to update EKS cluster")
+	}
+
+	return true, nil
+}
+
+func makeAccessConfig(accessConfig *ekscontrolplanev1.AccessConfig) *ekstypes.CreateAccessConfigRequest {
+	if accessConfig == nil {
+		return nil
+	}
+
+	req := &ekstypes.CreateAccessConfigRequest{
+		BootstrapClusterCreatorAdminPermissions: accessConfig.BootstrapClusterCreatorAdminPermissions,
+	}
+	if accessConfig.AuthenticationMode != "" {
+		req.AuthenticationMode = ekstypes.AuthenticationMode(accessConfig.AuthenticationMode)
+	}
+	return req
+}
+
+// nextAuthenticationMode returns the authentication mode the cluster should be moved to next
+// in order to converge from current to desired. Moving from CONFIG_MAP to API goes through
+// API_AND_CONFIG_MAP so that access entries can be created while the aws-auth ConfigMap is
+// still honoured. An empty result means no update is required or possible.
+func nextAuthenticationMode(current, desired ekstypes.AuthenticationMode) ekstypes.AuthenticationMode {
+	if current == desired {
+		return ""
+	}
+
+	switch current {
+	case ekstypes.AuthenticationModeConfigMap:
+		return ekstypes.AuthenticationModeApiAndConfigMap
+	case ekstypes.AuthenticationModeApiAndConfigMap:
+		if desired == ekstypes.AuthenticationModeApi {
+			return desired
+		}
+	}
+
+	// Moving back to a previous mode is not supported by EKS.
+	return ""
 }
 
-func (s *Service) reconcileLogging(ctx context.Context, logging *ekstypes.Logging) error {
+func (s *Service) reconcileAccessConfig(ctx context.Context, accessConfig *ekstypes.AccessConfigResponse) (bool, error) {
+	if s.scope.ControlPlane.Spec.AccessConfig == nil || s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode == "" {
+		return false, nil
+	}
+
+	current := ekstypes.AuthenticationModeConfigMap
+	if accessConfig != nil && accessConfig.AuthenticationMode != "" {
+		current = accessConfig.AuthenticationMode
+	}
+	desired := ekstypes.AuthenticationMode(s.scope.ControlPlane.Spec.AccessConfig.AuthenticationMode)
+
+	next := nextAuthenticationMode(current, desired)
+	if next == "" {
+		if current != desired {
+			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Cannot change EKS authentication mode from %s to %s", current, desired)
+			return false, errors.Errorf("changing the authentication mode from %s to %s is not supported", current, desired)
+		}
+		return false, nil
+	}
+
+	s.scope.Debug("Updating EKS authentication mode", "cluster", s.scope.KubernetesClusterName(), "from", current, "to", next)
+	input := &eks.UpdateClusterConfigInput{
+		Name: aws.String(s.scope.KubernetesClusterName()),
+		AccessConfig: &ekstypes.UpdateAccessConfigRequest{
+			AuthenticationMode: next,
+		},
+	}
+
+	if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+		if _, err := s.EKSClient.UpdateClusterConfig(ctx, input); err != nil {
+			return false, err
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneUpdatingCondition)
+		record.Eventf(s.scope.ControlPlane, "InitiatedUpdateEKSControlPlane", "Initiated authentication mode update to %s for EKS control plane %s", next, s.scope.KubernetesClusterName())
+		return true, nil
+	}); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane authentication mode: %v", err)
+		return false, errors.Wrapf(err, "failed to update EKS cluster")
+	}
+
+	return true, nil
+}
+
+func (s *Service) reconcileLogging(ctx context.Context, logging *ekstypes.Logging) (bool, error) {
 	input := &eks.UpdateClusterConfigInput{Name: aws.String(s.scope.KubernetesClusterName())}
 
 	for _, logSetup := range logging.ClusterLogging {
@@ -564,11 +880,11 @@ func (s *Service) reconcileLogging(ctx context.Context, logging *ekstypes.Loggin
 			return true, nil
 		}); err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "Failed to update EKS control plane logging: %v", err)
-			return errors.Wrapf(err, "failed to update EKS cluster")
+			return false, errors.Wrapf(err, "failed to update EKS cluster")
 		}
 	}
 
-	return nil
+	return input.Logging != nil, nil
 }
 
 func publicAccessCIDRsEqual(as []string, bs []string) bool {
@@ -686,6 +1002,10 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 		// need to go 1.14-> 1.15 and then 1.15 -> 1.16.
 		nextVersionString := versionToEKS(clusterVersion.WithMinor(clusterVersion.Minor() + 1))
 
+		if blocked, err := s.upgradeBlockedByInsights(ctx, nextVersionString); err != nil || blocked {
+			return err
+		}
+
 		input := &eks.UpdateClusterVersionInput{
 			Name:    aws.String(s.scope.KubernetesClusterName()),
 			Version: &nextVersionString,
@@ -715,10 +1035,76 @@ func (s *Service) reconcileClusterVersion(ctx context.Context, cluster *ekstypes
 			record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSControlPlane", "failed to update the EKS control plane: %v", err)
 			return errors.Wrapf(err, "failed to update EKS cluster")
 		}
+	} else {
+		conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)
 	}
 	return nil
 }
 
+// upgradeBlockedByInsights returns whether EKS upgrade insights with errors block the upgrade of
+// the control plane to the given version, and reports the result in the EKSUpgradeInsightsPassed
+// condition. The insights aren't checked when the control plane has the SkipUpgradeInsightsAnnotation.
+func (s *Service) upgradeBlockedByInsights(ctx context.Context, nextVersion string) (bool, error) {
+	if value, found := annotations.Get(s.scope.ControlPlane, ekscontrolplanev1.SkipUpgradeInsightsAnnotation); found && value != "false" {
+		conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)
+		record.Warnf(s.scope.ControlPlane, "SkippedUpgradeInsights", "Skipped checking the upgrade insights of EKS control plane %s for version %s", s.scope.KubernetesClusterName(), nextVersion)
+		return false, nil
+	}
+
+	insights, err := s.listUpgradeInsightErrors(ctx, nextVersion)
+	if err != nil {
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition, ekscontrolplanev1.EKSUpgradeInsightsCheckFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return true, errors.Wrapf(err, "failed to list upgrade insights for version %s", nextVersion)
+	}
+
+	if len(insights) == 0 {
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)
+		return false, nil
+	}
+
+	findings := make([]string, 0, len(insights))
+	for _, insight := range insights {
+		finding := aws.ToString(insight.Name)
+		if insight.InsightStatus != nil && insight.InsightStatus.Reason != nil {
+			finding = fmt.Sprintf("%s: %s", finding, aws.ToString(insight.InsightStatus.Reason))
+		}
+		findings = append(findings, finding)
+	}
+	message := fmt.Sprintf("Upgrade to version %s is blocked by upgrade insights with errors: %s", nextVersion, strings.Join(findings, "; "))
+
+	conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition, ekscontrolplanev1.EKSUpgradeBlockedByInsightsReason, clusterv1.ConditionSeverityError, "%s", message)
+	record.Warnf(s.scope.ControlPlane, "BlockedUpdateEKSControlPlane", "%s", message)
+	return true, nil
+}
+
+// listUpgradeInsightErrors returns the upgrade readiness insights of the cluster with errors for the
+// given Kubernetes version.
+func (s *Service) listUpgradeInsightErrors(ctx context.Context, version string) ([]ekstypes.InsightSummary, error) {
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(s.scope.KubernetesClusterName()),
+		Filter: &ekstypes.InsightsFilter{
+			Categories:         []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+			Statuses:           []ekstypes.InsightStatusValue{ekstypes.InsightStatusValueError},
+		},
+	}
+
+	insights := []ekstypes.InsightSummary{}
+	for {
+		out, err := s.EKSClient.ListInsights(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		insights = append(insights, out.Insights...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	return insights, nil
+}
+
 func (s *Service) describeEKSCluster(ctx context.Context, eksClusterName string) (*ekstypes.Cluster, error) {
 	input := &eks.DescribeClusterInput{
 		Name: aws.String(eksClusterName),
@@ -40,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestMakeEKSEncryptionConfigs(t *testing.T) {
@@ -368,10 +369,20 @@ func TestMakeEKSLogging(t *testing.T) {
 
 func TestReconcileClusterVersion(t *testing.T) {
 	clusterName := "default.cluster"
+	listInsightsInput := &eks.ListInsightsInput{
+		ClusterName: aws.String(clusterName),
+		Filter: &ekstypes.InsightsFilter{
+			Categories:         []ekstypes.Category{ekstypes.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{"1.15"},
+			Statuses:           []ekstypes.InsightStatusValue{ekstypes.InsightStatusValueError},
+		},
+	}
 	tests := []struct {
-		name        string
-		expect      func(m *mock_eksiface.MockEKSAPIMockRecorder)
-		expectError bool
+		name                 string
+		annotations          map[string]string
+		expect               func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectError          bool
+		expectInsightsPassed *bool
 	}{
 		{
 			name: "no upgrade necessary",
@@ -398,6 +409,9 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.Eq(listInsightsInput)).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.WaitUntilClusterUpdating(
 					gomock.Eq(context.TODO()),
 					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}),
@@ -407,7 +421,8 @@ func TestReconcileClusterVersion(t *testing.T) {
 					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
 					Return(&eks.UpdateClusterVersionOutput{}, nil)
 			},
-			expectError: false,
+			expectError:          false,
+			expectInsightsPassed: ptr.To(true),
 		},
 		{
 			name: "api error",
@@ -420,11 +435,86 @@ func TestReconcileClusterVersion(t *testing.T) {
 							Version: aws.String("1.14"),
 						},
 					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.Eq(listInsightsInput)).
+					Return(&eks.ListInsightsOutput{}, nil)
 				m.
 					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
 					Return(&eks.UpdateClusterVersionOutput{}, errors.New(""))
 			},
-			expectError: true,
+			expectError:          true,
+			expectInsightsPassed: ptr.To(true),
+		},
+		{
+			name: "upgrade blocked by insights with errors",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &ekstypes.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.14"),
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.Eq(listInsightsInput)).
+					Return(&eks.ListInsightsOutput{
+						Insights: []ekstypes.InsightSummary{
+							{
+								Name: aws.String("Deprecated APIs removed in Kubernetes v1.15"),
+								InsightStatus: &ekstypes.InsightStatus{
+									Status: ekstypes.InsightStatusValueError,
+									Reason: aws.String("Deprecated API usage detected within last 30 days."),
+								},
+							},
+						},
+					}, nil)
+			},
+			expectError:          false,
+			expectInsightsPassed: ptr.To(false),
+		},
+		{
+			name: "insights are skipped with annotation",
+			annotations: map[string]string{
+				ekscontrolplanev1.SkipUpgradeInsightsAnnotation: "true",
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &ekstypes.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.14"),
+						},
+					}, nil)
+				m.WaitUntilClusterUpdating(
+					gomock.Eq(context.TODO()),
+					gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}),
+					gomock.Any(),
+				).Return(nil)
+				m.
+					UpdateClusterVersion(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.UpdateClusterVersionInput{})).
+					Return(&eks.UpdateClusterVersionOutput{}, nil)
+			},
+			expectError: false,
+		},
+		{
+			name: "list insights error",
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.
+					DescribeCluster(gomock.Eq(context.TODO()), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{})).
+					Return(&eks.DescribeClusterOutput{
+						Cluster: &ekstypes.Cluster{
+							Name:    aws.String("default.cluster"),
+							Version: aws.String("1.14"),
+						},
+					}, nil)
+				m.
+					ListInsights(gomock.Eq(context.TODO()), gomock.Eq(listInsightsInput)).
+					Return(nil, errors.New("access denied"))
+			},
+			expectError:          true,
+			expectInsightsPassed: ptr.To(false),
 		},
 	}
 
@@ -450,8 +540,12 @@ func TestReconcileClusterVersion(t *testing.T) {
 					},
 				},
 				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tc.annotations,
+					},
 					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
-						Version: aws.String("1.16"),
+						EKSClusterName: clusterName,
+						Version:        aws.String("1.16"),
 					},
 				},
 			})
@@ -465,6 +559,11 @@ func TestReconcileClusterVersion(t *testing.T) {
 			g.Expect(err).To(BeNil())
 
 			err = s.reconcileClusterVersion(context.TODO(), cluster)
+			if tc.expectInsightsPassed == nil {
+				g.Expect(conditions.Has(scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)).To(BeFalse())
+			} else {
+				g.Expect(conditions.IsTrue(scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)).To(Equal(*tc.expectInsightsPassed))
+			}
 			if tc.expectError {
 				g.Expect(err).To(HaveOccurred())
 				return
@@ -787,3 +886,463 @@ func TestCreateIPv6Cluster(t *testing.T) {
 	_, err = s.createCluster(context.TODO(), "cluster-name")
 	g.Expect(err).To(BeNil())
 }
+
+func TestNextAuthenticationMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		current ekstypes.AuthenticationMode
+		desired ekstypes.AuthenticationMode
+		expect  ekstypes.AuthenticationMode
+	}{
+		{
+			name:    "no change",
+			current: ekstypes.AuthenticationModeApi,
+			desired: ekstypes.AuthenticationModeApi,
+			expect:  "",
+		},
+		{
+			name:    "config map to api and config map",
+			current: ekstypes.AuthenticationModeConfigMap,
+			desired: ekstypes.AuthenticationModeApiAndConfigMap,
+			expect:  ekstypes.AuthenticationModeApiAndConfigMap,
+		},
+		{
+			name:    "config map to api goes through api and config map",
+			current: ekstypes.AuthenticationModeConfigMap,
+			desired: ekstypes.AuthenticationModeApi,
+			expect:  ekstypes.AuthenticationModeApiAndConfigMap,
+		},
+		{
+			name:    "api and config map to api",
+			current: ekstypes.AuthenticationModeApiAndConfigMap,
+			desired: ekstypes.AuthenticationModeApi,
+			expect:  ekstypes.AuthenticationModeApi,
+		},
+		{
+			name:    "api to config map is not supported",
+			current: ekstypes.AuthenticationModeApi,
+			desired: ekstypes.AuthenticationModeConfigMap,
+			expect:  "",
+		},
+		{
+			name:    "api and config map to config map is not supported",
+			current: ekstypes.AuthenticationModeApiAndConfigMap,
+			desired: ekstypes.AuthenticationModeConfigMap,
+			expect:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(nextAuthenticationMode(tc.current, tc.desired)).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestMakeAutoModeConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		autoMode      *ekscontrolplanev1.AutoMode
+		nodeRoleARN   *string
+		expectCompute *ekstypes.ComputeConfigRequest
+		expectEnabled bool
+	}{
+		{
+			name:          "no auto mode disables all capabilities",
+			expectCompute: &ekstypes.ComputeConfigRequest{Enabled: aws.Bool(false)},
+			expectEnabled: false,
+		},
+		{
+			name:          "auto mode without built-in node pools",
+			autoMode:      &ekscontrolplanev1.AutoMode{Enabled: true},
+			nodeRoleARN:   aws.String("arn:node-role"),
+			expectCompute: &ekstypes.ComputeConfigRequest{Enabled: aws.Bool(true)},
+			expectEnabled: true,
+		},
+		{
+			name: "auto mode with built-in node pools",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: ekscontrolplanev1.AutoModeCompute{
+					NodePools: []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem},
+				},
+			},
+			nodeRoleARN: aws.String("arn:node-role"),
+			expectCompute: &ekstypes.ComputeConfigRequest{
+				Enabled:     aws.Bool(true),
+				NodePools:   []string{"general-purpose", "system"},
+				NodeRoleArn: aws.String("arn:node-role"),
+			},
+			expectEnabled: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			compute, storage, elb := makeAutoModeConfig(tc.autoMode, tc.nodeRoleARN)
+			g.Expect(compute).To(Equal(tc.expectCompute))
+			g.Expect(storage).To(Equal(&ekstypes.StorageConfigRequest{BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(tc.expectEnabled)}}))
+			g.Expect(elb).To(Equal(&ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(tc.expectEnabled)}))
+		})
+	}
+}
+
+func TestAutoModeNeedsUpdate(t *testing.T) {
+	enabledCluster := func(nodePools ...string) *ekstypes.Cluster {
+		return &ekstypes.Cluster{
+			ComputeConfig: &ekstypes.ComputeConfigResponse{Enabled: aws.Bool(true), NodePools: nodePools},
+			StorageConfig: &ekstypes.StorageConfigResponse{BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)}},
+			KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigResponse{
+				ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+			},
+		}
+	}
+	nodePools := func(pools ...ekscontrolplanev1.AutoModeNodePool) ekscontrolplanev1.AutoModeCompute {
+		return ekscontrolplanev1.AutoModeCompute{NodePools: pools}
+	}
+
+	tests := []struct {
+		name     string
+		cluster  *ekstypes.Cluster
+		autoMode *ekscontrolplanev1.AutoMode
+		expect   bool
+	}{
+		{
+			name:     "classic cluster stays classic",
+			cluster:  &ekstypes.Cluster{},
+			autoMode: &ekscontrolplanev1.AutoMode{},
+			expect:   false,
+		},
+		{
+			name:     "classic cluster is enabled",
+			cluster:  &ekstypes.Cluster{},
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: true},
+			expect:   true,
+		},
+		{
+			name:     "enabled cluster is disabled",
+			cluster:  enabledCluster(),
+			autoMode: &ekscontrolplanev1.AutoMode{},
+			expect:   true,
+		},
+		{
+			name:     "partially enabled cluster is enabled",
+			cluster:  &ekstypes.Cluster{ComputeConfig: &ekstypes.ComputeConfigResponse{Enabled: aws.Bool(true)}},
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: true},
+			expect:   true,
+		},
+		{
+			name:     "same node pools in a different order",
+			cluster:  enabledCluster("system", "general-purpose"),
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: true, Compute: nodePools(ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem)},
+			expect:   false,
+		},
+		{
+			name:     "node pool added",
+			cluster:  enabledCluster("general-purpose"),
+			autoMode: &ekscontrolplanev1.AutoMode{Enabled: true, Compute: nodePools(ekscontrolplanev1.AutoModeNodePoolGeneralPurpose, ekscontrolplanev1.AutoModeNodePoolSystem)},
+			expect:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(autoModeNeedsUpdate(tc.cluster, tc.autoMode)).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestReconcileAutoMode(t *testing.T) {
+	clusterName := "default.cluster"
+
+	tests := []struct {
+		name      string
+		autoMode  *ekscontrolplanev1.AutoMode
+		cluster   *ekstypes.Cluster
+		expectIAM func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectEKS func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:      "no auto mode in the spec",
+			cluster:   &ekstypes.Cluster{},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name: "auto mode is enabled with built-in node pools",
+			autoMode: &ekscontrolplanev1.AutoMode{
+				Enabled: true,
+				Compute: ekscontrolplanev1.AutoModeCompute{
+					NodePools:    []ekscontrolplanev1.AutoModeNodePool{ekscontrolplanev1.AutoModeNodePoolSystem},
+					NodeRoleName: aws.String("node-role"),
+				},
+			},
+			cluster: &ekstypes.Cluster{},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("node-role")}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String("arn:node-role")}}, nil)
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+					Name: aws.String(clusterName),
+					ComputeConfig: &ekstypes.ComputeConfigRequest{
+						Enabled:     aws.Bool(true),
+						NodePools:   []string{"system"},
+						NodeRoleArn: aws.String("arn:node-role"),
+					},
+					StorageConfig: &ekstypes.StorageConfigRequest{BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)}},
+					KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+						ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+					},
+				}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+		},
+		{
+			name:     "auto mode is disabled",
+			autoMode: &ekscontrolplanev1.AutoMode{},
+			cluster: &ekstypes.Cluster{
+				ComputeConfig: &ekstypes.ComputeConfigResponse{Enabled: aws.Bool(true)},
+				StorageConfig: &ekstypes.StorageConfigResponse{BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(true)}},
+				KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigResponse{
+					ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(true)},
+				},
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+					Name:          aws.String(clusterName),
+					ComputeConfig: &ekstypes.ComputeConfigRequest{Enabled: aws.Bool(false)},
+					StorageConfig: &ekstypes.StorageConfigRequest{BlockStorage: &ekstypes.BlockStorage{Enabled: aws.Bool(false)}},
+					KubernetesNetworkConfig: &ekstypes.KubernetesNetworkConfigRequest{
+						ElasticLoadBalancing: &ekstypes.ElasticLoadBalancing{Enabled: aws.Bool(false)},
+					},
+				}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expectIAM(iamMock.EXPECT())
+			tc.expectEKS(eksMock.EXPECT())
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+					Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+						EKSClusterName: clusterName,
+						AutoMode:       tc.autoMode,
+					},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			s := NewService(scope)
+			s.IAMClient = iamMock
+			s.EKSClient = eksMock
+
+			_, err = s.reconcileAutoMode(context.TODO(), tc.cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestReconcileUpgradePolicy(t *testing.T) {
+	clusterName := "default.cluster"
+
+	tests := []struct {
+		name          string
+		upgradePolicy *ekscontrolplanev1.UpgradePolicy
+		current       *ekstypes.UpgradePolicyResponse
+		expect        func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:    "no upgrade policy in the spec",
+			current: &ekstypes.UpgradePolicyResponse{SupportType: ekstypes.SupportTypeExtended},
+			expect:  func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:          "support type is up to date",
+			upgradePolicy: &ekscontrolplanev1.UpgradePolicy{SupportType: ekscontrolplanev1.SupportTypeExtended},
+			current:       &ekstypes.UpgradePolicyResponse{SupportType: ekstypes.SupportTypeExtended},
+			expect:        func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:          "support type is changed",
+			upgradePolicy: &ekscontrolplanev1.UpgradePolicy{SupportType: ekscontrolplanev1.SupportTypeStandard},
+			current:       &ekstypes.UpgradePolicyResponse{SupportType: ekstypes.SupportTypeExtended},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+					Name:          aws.String(clusterName),
+					UpgradePolicy: &ekstypes.UpgradePolicyRequest{SupportType: ekstypes.SupportTypeStandard},
+				}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			s := NewService(newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: clusterName,
+				UpgradePolicy:  tc.upgradePolicy,
+			}))
+			s.EKSClient = eksMock
+
+			_, err := s.reconcileUpgradePolicy(context.TODO(), tc.current)
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestReconcileZonalShiftConfig(t *testing.T) {
+	clusterName := "default.cluster"
+
+	tests := []struct {
+		name             string
+		zonalShiftConfig *ekscontrolplanev1.ZonalShiftConfig
+		current          *ekstypes.ZonalShiftConfigResponse
+		expect           func(m *mock_eksiface.MockEKSAPIMockRecorder)
+	}{
+		{
+			name:    "no zonal shift config in the spec",
+			current: &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+			expect:  func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:             "zonal shift is already enabled",
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+			current:          &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+			expect:           func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+		},
+		{
+			name:             "zonal shift is enabled",
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+					Name:             aws.String(clusterName),
+					ZonalShiftConfig: &ekstypes.ZonalShiftConfigRequest{Enabled: aws.Bool(true)},
+				}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+		},
+		{
+			name:             "zonal shift is disabled",
+			zonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{},
+			current:          &ekstypes.ZonalShiftConfigResponse{Enabled: aws.Bool(true)},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+					Name:             aws.String(clusterName),
+					ZonalShiftConfig: &ekstypes.ZonalShiftConfigRequest{Enabled: aws.Bool(false)},
+				}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			s := NewService(newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName:   clusterName,
+				ZonalShiftConfig: tc.zonalShiftConfig,
+			}))
+			s.EKSClient = eksMock
+
+			_, err := s.reconcileZonalShiftConfig(context.TODO(), tc.current)
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func newClusterConfigTestScope(g *WithT, spec ekscontrolplanev1.AWSManagedControlPlaneSpec) *scope.ManagedControlPlaneScope {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-name",
+			},
+		},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: spec,
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return scope
+}
+
+func TestReconcileClusterConfigUpdates(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterName := "default.cluster"
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().UpdateClusterConfig(gomock.Any(), &eks.UpdateClusterConfigInput{
+		Name:          aws.String(clusterName),
+		UpgradePolicy: &ekstypes.UpgradePolicyRequest{SupportType: ekstypes.SupportTypeStandard},
+	}).Return(&eks.UpdateClusterConfigOutput{}, nil)
+
+	s := NewService(newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: clusterName,
+		NetworkSpec: infrav1.NetworkSpec{
+			Subnets: infrav1.Subnets{
+				{ID: "one", CidrBlock: "10.0.10.0/24", AvailabilityZone: "us-west-2a"},
+				{ID: "two", CidrBlock: "10.0.11.0/24", AvailabilityZone: "us-west-2b"},
+			},
+		},
+		UpgradePolicy:    &ekscontrolplanev1.UpgradePolicy{SupportType: ekscontrolplanev1.SupportTypeStandard},
+		ZonalShiftConfig: &ekscontrolplanev1.ZonalShiftConfig{Enabled: true},
+	}))
+	s.EKSClient = eksMock
+
+	// The zonal shift config is only updated in the next reconcile.
+	g.Expect(s.reconcileClusterConfigUpdates(context.TODO(), &ekstypes.Cluster{
+		ResourcesVpcConfig: &ekstypes.VpcConfigResponse{
+			EndpointPublicAccess: true,
+			PublicAccessCidrs:    []string{"0.0.0.0/0"},
+		},
+		UpgradePolicy: &ekstypes.UpgradePolicyResponse{SupportType: ekstypes.SupportTypeExtended},
+		Logging:       &ekstypes.Logging{},
+	})).To(Succeed())
+}
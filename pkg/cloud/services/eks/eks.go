@@ -40,6 +40,12 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRolesReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
 		return err
 	}
+
+	// EKS Auto Mode node IAM Role
+	if err := s.reconcileAutoModeNodeIAMRole(ctx); err != nil {
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition, ekscontrolplanev1.IAMControlPlaneRolesReconciliationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return err
+	}
 	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.IAMControlPlaneRolesReadyCondition)
 
 	// EKS Cluster
@@ -49,12 +55,14 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSControlPlaneReadyCondition)
 
-	// EKS Addons
-	if err := s.reconcileAddons(ctx); err != nil {
-		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
-		return errors.Wrap(err, "failed reconciling eks addons")
+	// EKS Addons, which are reconciled by the upgrade plan while the cluster is upgraded
+	if !s.scope.ControlPlane.Status.Upgrade.InProgress() {
+		if err := s.reconcileAddons(ctx); err != nil {
+			conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+			return errors.Wrap(err, "failed reconciling eks addons")
+		}
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
 	}
-	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
 
 	// EKS Identity Provider
 	if err := s.reconcileIdentityProvider(ctx); err != nil {
@@ -63,6 +71,24 @@ func (s *Service) ReconcileControlPlane(ctx context.Context) error {
 	}
 	conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSIdentityProviderConfiguredCondition)
 
+	// EKS Access Entries
+	if err := s.reconcileAccessEntries(ctx); err != nil {
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSAccessEntriesConfiguredCondition, ekscontrolplanev1.EKSAccessEntriesConfiguredFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return errors.Wrap(err, "failed reconciling eks access entries")
+	}
+	if s.scope.AuthenticationMode().UsesAccessEntries() {
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSAccessEntriesConfiguredCondition)
+	}
+
+	// EKS Pod Identity Associations
+	if err := s.reconcilePodIdentityAssociations(ctx); err != nil {
+		conditions.MarkFalse(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return errors.Wrap(err, "failed reconciling eks pod identity associations")
+	}
+	if len(s.scope.PodIdentityAssociations()) > 0 {
+		conditions.MarkTrue(s.scope.ControlPlane, ekscontrolplanev1.EKSPodIdentityAssociationsConfiguredCondition)
+	}
+
 	s.scope.Debug("Reconcile EKS control plane completed successfully")
 	return nil
 }
@@ -76,11 +102,21 @@ func (s *Service) DeleteControlPlane(ctx context.Context) (err error) {
 		return err
 	}
 
+	// Pod Identity IAM roles
+	if err := s.deletePodIdentityRoles(ctx); err != nil {
+		return err
+	}
+
 	// Control Plane IAM role
 	if err := s.deleteControlPlaneIAMRole(ctx); err != nil {
 		return err
 	}
 
+	// EKS Auto Mode node IAM role
+	if err := s.deleteAutoModeNodeIAMRole(ctx); err != nil {
+		return err
+	}
+
 	// OIDC Provider
 	if err := s.deleteOIDCProvider(ctx); err != nil {
 		return err
@@ -32,9 +32,14 @@ var (
 	ErrNodegroupRoleNotFound = errors.New("the specified nodegroup role couldn't be found")
 	// ErrFargateRoleNotFound is an error if the specified role couldn't be founbd in AWS.
 	ErrFargateRoleNotFound = errors.New("the specified fargate role couldn't be found")
+	// ErrAutoModeNodeRoleNotFound is an error if the specified auto mode node role couldn't be found in AWS.
+	ErrAutoModeNodeRoleNotFound = errors.New("the specified auto mode node role couldn't be found")
 	// ErrCannotUseAdditionalRoles is an error if the spec contains additional role and the
 	// EKSAllowAddRoles feature flag isn't enabled.
 	ErrCannotUseAdditionalRoles = errors.New("additional rules cannot be added as this has been disabled")
 	// ErrNoSecurityGroup is an error when no security group is found for an EKS cluster.
 	ErrNoSecurityGroup = errors.New("no security group for EKS cluster")
+	// ErrPodIdentityRoleRequiresIAM is an error if a pod identity association specifies a role
+	// to create and the EKSEnableIAM feature flag isn't enabled.
+	ErrPodIdentityRoleRequiresIAM = errors.New("creating pod identity roles requires the EKSEnableIAM feature flag")
 )
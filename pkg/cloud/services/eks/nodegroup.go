@@ -19,11 +19,13 @@ package eks
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
@@ -119,6 +121,17 @@ func (s *NodegroupService) updateConfig() (*ekstypes.NodegroupUpdateConfig, erro
 	return converters.NodegroupUpdateconfigToSDK(updateConfig)
 }
 
+func (s *NodegroupService) nodeRepairConfig() *ekstypes.NodeRepairConfig {
+	nodeRepairConfig := s.scope.ManagedMachinePool.Spec.NodeRepairConfig
+	if nodeRepairConfig == nil {
+		return nil
+	}
+
+	return &ekstypes.NodeRepairConfig{
+		Enabled: nodeRepairConfig.Enabled,
+	}
+}
+
 func (s *NodegroupService) roleArn(ctx context.Context) (*string, error) {
 	var role *iamtypes.Role
 	if s.scope.RoleName() != "" {
@@ -209,17 +222,18 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 		return nil, fmt.Errorf("failed creating nodegroup, invalid update config: %w", err)
 	}
 	input := &eks.CreateNodegroupInput{
-		ScalingConfig: s.scalingConfig(),
-		ClusterName:   aws.String(eksClusterName),
-		NodegroupName: aws.String(nodegroupName),
-		Subnets:       subnets,
-		NodeRole:      roleArn,
-		Labels:        managedPool.Labels,
-		Tags:          tags,
-		RemoteAccess:  remoteAccess,
-		UpdateConfig:  updatedConfig,
-	}
-	if managedPool.AMIType != nil && (managedPool.AWSLaunchTemplate == nil || managedPool.AWSLaunchTemplate.AMI.ID == nil) {
+		ScalingConfig:    s.scalingConfig(),
+		ClusterName:      aws.String(eksClusterName),
+		NodegroupName:    aws.String(nodegroupName),
+		Subnets:          subnets,
+		NodeRole:         roleArn,
+		Labels:           managedPool.Labels,
+		Tags:             tags,
+		RemoteAccess:     remoteAccess,
+		UpdateConfig:     updatedConfig,
+		NodeRepairConfig: s.nodeRepairConfig(),
+	}
+	if managedPool.AMIType != nil && (managedPool.AWSLaunchTemplate == nil || managedPool.AWSLaunchTemplate.AMI.ID == nil) && !s.externalLaunchTemplateAMI {
 		input.AmiType = converters.AMITypeToSDK(*managedPool.AMIType)
 	}
 	if managedPool.DiskSize != nil {
@@ -243,7 +257,7 @@ func (s *NodegroupService) createNodegroup(ctx context.Context) (*ekstypes.Nodeg
 		}
 		input.CapacityType = capacityType
 	}
-	if managedPool.AWSLaunchTemplate != nil {
+	if managedPool.AWSLaunchTemplate != nil || managedPool.ExternalLaunchTemplate != nil {
 		input.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
 			Id:      s.scope.ManagedMachinePool.Status.LaunchTemplateID,
 			Version: s.scope.ManagedMachinePool.Status.LaunchTemplateVersion,
@@ -323,6 +337,21 @@ func (s *NodegroupService) reconcileNodegroupVersion(ctx context.Context, ng *ek
 		return fmt.Errorf("nodegroup version is nil")
 	}
 	ngVersion := version.MustParseGeneric(*ng.Version)
+	if upgrade := s.scope.ControlPlane.Status.Upgrade; upgrade.UpgradesNodegroups() {
+		// Node groups whose MachinePool doesn't have a newer version are upgraded along with the control plane.
+		upgradeVersion, err := parseEKSVersion(upgrade.Version)
+		if err != nil {
+			return fmt.Errorf("parsing EKS version from upgrade status: %w", err)
+		}
+		if specVersion == nil || specVersion.LessThan(upgradeVersion) {
+			specVersion = upgradeVersion
+		}
+	}
+	if specVersion != nil && ngVersion.LessThan(specVersion) && s.scope.ControlPlane.Status.Upgrade.HoldsNodegroupUpgrades() {
+		// Node groups are upgraded once the control plane and addons are upgraded.
+		s.Debug("Holding nodegroup version until the control plane upgrade completes", "nodegroup", s.scope.NodegroupName(), "version", *ng.Version)
+		specVersion = nil
+	}
 	specAMI := s.scope.ManagedMachinePool.Spec.AMIVersion
 	ngAMI := *ng.ReleaseVersion
 	statusLaunchTemplateVersion := s.scope.ManagedMachinePool.Status.LaunchTemplateVersion
@@ -480,6 +509,11 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 		input.UpdateConfig = updatedConfig
 		needsUpdate = true
 	}
+	if nodeRepairConfig := s.nodeRepairConfig(); nodeRepairConfig != nil && aws.ToBool(nodeRepairConfig.Enabled) != nodeRepairEnabled(ng) {
+		s.Debug("Nodegroup node repair configuration differs from spec, updating the nodegroup node repair config", "nodegroup", ng.NodegroupName)
+		input.NodeRepairConfig = nodeRepairConfig
+		needsUpdate = true
+	}
 	if !needsUpdate {
 		s.Debug("node group config update not needed", "cluster", eksClusterName, "name", *ng.NodegroupName)
 		return nil
@@ -493,7 +527,60 @@ func (s *NodegroupService) reconcileNodegroupConfig(ctx context.Context, ng *eks
 	return nil
 }
 
+func nodeRepairEnabled(ng *ekstypes.Nodegroup) bool {
+	if ng.NodeRepairConfig == nil {
+		return false
+	}
+	return aws.ToBool(ng.NodeRepairConfig.Enabled)
+}
+
+// reconcileExternalLaunchTemplate records the ID of the external launch template and the version
+// selected by the version policy in the status. The node group is created with this version, and
+// updated to it when the version changes outside of CAPA. It also records whether the version sets
+// the AMI of the nodes.
+func (s *NodegroupService) reconcileExternalLaunchTemplate(ctx context.Context) error {
+	launchTemplate := s.scope.ManagedMachinePool.Spec.ExternalLaunchTemplate
+	if launchTemplate == nil {
+		return nil
+	}
+
+	version := "$Default"
+	if launchTemplate.VersionPolicy == expinfrav1.LaunchTemplateVersionPolicyLatest {
+		version = "$Latest"
+	}
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId:   launchTemplate.ID,
+		LaunchTemplateName: launchTemplate.Name,
+		Versions:           []string{version},
+	}
+
+	out, err := s.EC2Client.DescribeLaunchTemplateVersions(ctx, input)
+	if err != nil {
+		return errors.Wrap(err, "failed to describe external launch template")
+	}
+	if len(out.LaunchTemplateVersions) == 0 || out.LaunchTemplateVersions[0].VersionNumber == nil {
+		return errors.Errorf("no %s version found for external launch template", version)
+	}
+	launchTemplateVersion := out.LaunchTemplateVersions[0]
+
+	status := &s.scope.ManagedMachinePool.Status
+	versionNumber := strconv.FormatInt(*launchTemplateVersion.VersionNumber, 10)
+	if status.LaunchTemplateVersion != nil && *status.LaunchTemplateVersion != versionNumber {
+		s.scope.Info("External launch template version changed", "launch-template-id", aws.ToString(launchTemplateVersion.LaunchTemplateId),
+			"previous-version", *status.LaunchTemplateVersion, "version", versionNumber)
+	}
+	status.LaunchTemplateID = launchTemplateVersion.LaunchTemplateId
+	status.LaunchTemplateVersion = aws.String(versionNumber)
+	s.externalLaunchTemplateAMI = launchTemplateVersion.LaunchTemplateData != nil && aws.ToString(launchTemplateVersion.LaunchTemplateData.ImageId) != ""
+
+	return nil
+}
+
 func (s *NodegroupService) reconcileNodegroup(ctx context.Context) error {
+	if err := s.reconcileExternalLaunchTemplate(ctx); err != nil {
+		return errors.Wrap(err, "failed to reconcile external launch template")
+	}
+
 	ng, err := s.describeNodegroup(ctx)
 	if err != nil {
 		return errors.Wrap(err, "failed to describe nodegroup")
@@ -0,0 +1,397 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	expclusterv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+)
+
+func TestReconcileExternalLaunchTemplate(t *testing.T) {
+	launchTemplateVersion := func(version int64) *ec2.DescribeLaunchTemplateVersionsOutput {
+		return &ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+				{LaunchTemplateId: aws.String("lt-0123456789abcdef0"), VersionNumber: aws.Int64(version)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		launchTemplate *expinfrav1.ExternalLaunchTemplate
+		statusVersion  *string
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectError    bool
+		expectVersion  *string
+	}{
+		{
+			name:          "no external launch template",
+			expect:        func(m *mocks.MockEC2APIMockRecorder) {},
+			expectVersion: nil,
+		},
+		{
+			name:           "default version by id",
+			launchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: aws.String("lt-0123456789abcdef0"), VersionPolicy: expinfrav1.LaunchTemplateVersionPolicyDefault},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateId: aws.String("lt-0123456789abcdef0"),
+					Versions:         []string{"$Default"},
+				}).Return(launchTemplateVersion(3), nil)
+			},
+			expectVersion: aws.String("3"),
+		},
+		{
+			name:           "latest version by name moves",
+			launchTemplate: &expinfrav1.ExternalLaunchTemplate{Name: aws.String("node-template"), VersionPolicy: expinfrav1.LaunchTemplateVersionPolicyLatest},
+			statusVersion:  aws.String("4"),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), &ec2.DescribeLaunchTemplateVersionsInput{
+					LaunchTemplateName: aws.String("node-template"),
+					Versions:           []string{"$Latest"},
+				}).Return(launchTemplateVersion(5), nil)
+			},
+			expectVersion: aws.String("5"),
+		},
+		{
+			name:           "describing the launch template fails",
+			launchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: aws.String("lt-0123456789abcdef0")},
+			statusVersion:  aws.String("4"),
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("launch template not found"))
+			},
+			expectError:   true,
+			expectVersion: aws.String("4"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			tc.expect(ec2Mock.EXPECT())
+
+			scope := newNodegroupTestScope(g, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:       "default.pool",
+				ExternalLaunchTemplate: tc.launchTemplate,
+			})
+			scope.ManagedMachinePool.Status.LaunchTemplateVersion = tc.statusVersion
+			s := NewNodegroupService(scope)
+			s.EC2Client = ec2Mock
+
+			err := s.reconcileExternalLaunchTemplate(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(scope.ManagedMachinePool.Status.LaunchTemplateVersion).To(Equal(tc.expectVersion))
+		})
+	}
+}
+
+func TestReconcileNodegroupVersionExternalLaunchTemplate(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+		ClusterName:   aws.String("default.cluster"),
+		NodegroupName: aws.String("default.pool"),
+		LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+			Id:      aws.String("lt-0123456789abcdef0"),
+			Version: aws.String("5"),
+		},
+	}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+
+	scope := newNodegroupTestScope(g, expinfrav1.AWSManagedMachinePoolSpec{
+		EKSNodegroupName:       "default.pool",
+		ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: aws.String("lt-0123456789abcdef0")},
+	})
+	scope.ManagedMachinePool.Status.LaunchTemplateID = aws.String("lt-0123456789abcdef0")
+	scope.ManagedMachinePool.Status.LaunchTemplateVersion = aws.String("5")
+	s := NewNodegroupService(scope)
+	s.EKSClient = eksMock
+
+	g.Expect(s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+		NodegroupName:  aws.String("default.pool"),
+		Version:        aws.String("1.30"),
+		ReleaseVersion: aws.String("1.30.0-20240703"),
+		LaunchTemplate: &ekstypes.LaunchTemplateSpecification{
+			Id:      aws.String("lt-0123456789abcdef0"),
+			Version: aws.String("4"),
+		},
+	})).To(Succeed())
+}
+
+func TestReconcileNodegroupVersionUpgrade(t *testing.T) {
+	tests := []struct {
+		name               string
+		machinePoolVersion *string
+		addonsPhase        ekscontrolplanev1.UpgradeStepPhase
+		nodegroupsPhase    ekscontrolplanev1.UpgradeStepPhase
+		expectVersion      *string
+	}{
+		{
+			name:            "node group without a version is upgraded to the version of the control plane",
+			addonsPhase:     ekscontrolplanev1.UpgradeStepPhaseCompleted,
+			nodegroupsPhase: ekscontrolplanev1.UpgradeStepPhaseInProgress,
+			expectVersion:   aws.String("1.31"),
+		},
+		{
+			name:               "node group with an older version is upgraded to the version of the control plane",
+			machinePoolVersion: aws.String("v1.29.0"),
+			addonsPhase:        ekscontrolplanev1.UpgradeStepPhaseCompleted,
+			nodegroupsPhase:    ekscontrolplanev1.UpgradeStepPhaseInProgress,
+			expectVersion:      aws.String("1.31"),
+		},
+		{
+			name:               "node group is held until the addons are upgraded",
+			machinePoolVersion: aws.String("v1.31.0"),
+			addonsPhase:        ekscontrolplanev1.UpgradeStepPhaseInProgress,
+			nodegroupsPhase:    ekscontrolplanev1.UpgradeStepPhasePending,
+		},
+		{
+			name:            "node group without a version is kept once the upgrade is complete",
+			addonsPhase:     ekscontrolplanev1.UpgradeStepPhaseCompleted,
+			nodegroupsPhase: ekscontrolplanev1.UpgradeStepPhaseCompleted,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			if tc.expectVersion != nil {
+				eksMock.EXPECT().UpdateNodegroupVersion(gomock.Any(), &eks.UpdateNodegroupVersionInput{
+					ClusterName:   aws.String("default.cluster"),
+					NodegroupName: aws.String("default.pool"),
+					Version:       tc.expectVersion,
+				}).Return(&eks.UpdateNodegroupVersionOutput{}, nil)
+			}
+
+			scope := newNodegroupTestScope(g, expinfrav1.AWSManagedMachinePoolSpec{EKSNodegroupName: "default.pool"})
+			scope.MachinePool.Spec.Template.Spec.Version = tc.machinePoolVersion
+			scope.ControlPlane.Status.Upgrade = &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.31",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: tc.addonsPhase},
+					{Name: ekscontrolplanev1.NodegroupsUpgradeStepName, Phase: tc.nodegroupsPhase},
+				},
+			}
+			s := NewNodegroupService(scope)
+			s.EKSClient = eksMock
+
+			g.Expect(s.reconcileNodegroupVersion(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:  aws.String("default.pool"),
+				Version:        aws.String("1.30"),
+				ReleaseVersion: aws.String("1.30.0-20240703"),
+			})).To(Succeed())
+		})
+	}
+}
+
+func TestCreateNodegroupExternalLaunchTemplateAMI(t *testing.T) {
+	tests := []struct {
+		name          string
+		imageID       *string
+		expectAMIType ekstypes.AMITypes
+	}{
+		{
+			name:          "launch template without AMI",
+			expectAMIType: ekstypes.AMITypesAl2X8664,
+		},
+		{
+			name:    "launch template with AMI",
+			imageID: aws.String("ami-0123456789abcdef0"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			ec2Mock := mocks.NewMockEC2API(mockControl)
+			ec2Mock.EXPECT().DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any()).
+				Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+					LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+						{
+							LaunchTemplateId:   aws.String("lt-0123456789abcdef0"),
+							VersionNumber:      aws.Int64(3),
+							LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{ImageId: tc.imageID},
+						},
+					},
+				}, nil)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+			iamMock.EXPECT().GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String("node-role")}).
+				Return(&iam.GetRoleOutput{Role: &iamtypes.Role{Arn: aws.String("arn:node-role")}}, nil)
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			eksMock.EXPECT().CreateNodegroup(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, input *eks.CreateNodegroupInput, _ ...func(*eks.Options)) (*eks.CreateNodegroupOutput, error) {
+					g.Expect(input.AmiType).To(Equal(tc.expectAMIType))
+					g.Expect(input.LaunchTemplate).To(Equal(&ekstypes.LaunchTemplateSpecification{
+						Id:      aws.String("lt-0123456789abcdef0"),
+						Version: aws.String("3"),
+					}))
+					return &eks.CreateNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{NodegroupName: input.NodegroupName}}, nil
+				})
+
+			amiType := expinfrav1.Al2x86_64
+			scope := newNodegroupTestScope(g, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName:       "default.pool",
+				RoleName:               "node-role",
+				SubnetIDs:              []string{"subnet-1"},
+				AMIType:                &amiType,
+				ExternalLaunchTemplate: &expinfrav1.ExternalLaunchTemplate{ID: aws.String("lt-0123456789abcdef0")},
+			})
+			scope.EC2Scope = newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "default.cluster"})
+			s := NewNodegroupService(scope)
+			s.EC2Client = ec2Mock
+			s.IAMClient = iamMock
+			s.EKSClient = eksMock
+
+			g.Expect(s.reconcileExternalLaunchTemplate(context.TODO())).To(Succeed())
+			_, err := s.createNodegroup(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestReconcileNodegroupConfigNodeRepair(t *testing.T) {
+	tests := []struct {
+		name             string
+		nodeRepairConfig *expinfrav1.NodeRepairConfig
+		ngNodeRepair     *ekstypes.NodeRepairConfig
+		expectUpdate     *ekstypes.NodeRepairConfig
+	}{
+		{
+			name:             "node repair not configured",
+			nodeRepairConfig: nil,
+			ngNodeRepair:     &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+		},
+		{
+			name:             "node repair is enabled",
+			nodeRepairConfig: &expinfrav1.NodeRepairConfig{Enabled: aws.Bool(true)},
+			expectUpdate:     &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+		},
+		{
+			name:             "node repair is disabled",
+			nodeRepairConfig: &expinfrav1.NodeRepairConfig{Enabled: aws.Bool(false)},
+			ngNodeRepair:     &ekstypes.NodeRepairConfig{Enabled: aws.Bool(true)},
+			expectUpdate:     &ekstypes.NodeRepairConfig{Enabled: aws.Bool(false)},
+		},
+		{
+			name:             "node repair is up to date",
+			nodeRepairConfig: &expinfrav1.NodeRepairConfig{Enabled: aws.Bool(false)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			if tc.expectUpdate != nil {
+				eksMock.EXPECT().UpdateNodegroupConfig(gomock.Any(), &eks.UpdateNodegroupConfigInput{
+					ClusterName:      aws.String("default.cluster"),
+					NodegroupName:    aws.String("default.pool"),
+					NodeRepairConfig: tc.expectUpdate,
+				}).Return(&eks.UpdateNodegroupConfigOutput{}, nil)
+			}
+
+			scope := newNodegroupTestScope(g, expinfrav1.AWSManagedMachinePoolSpec{
+				EKSNodegroupName: "default.pool",
+				NodeRepairConfig: tc.nodeRepairConfig,
+			})
+			s := NewNodegroupService(scope)
+			s.EKSClient = eksMock
+
+			g.Expect(s.reconcileNodegroupConfig(context.TODO(), &ekstypes.Nodegroup{
+				NodegroupName:    aws.String("default.pool"),
+				ScalingConfig:    &ekstypes.NodegroupScalingConfig{DesiredSize: aws.Int32(1)},
+				NodeRepairConfig: tc.ngNodeRepair,
+			})).To(Succeed())
+		})
+	}
+}
+
+func newNodegroupTestScope(g *WithT, spec expinfrav1.AWSManagedMachinePoolSpec) *scope.ManagedMachinePoolScope {
+	scheme := runtime.NewScheme()
+	_ = ekscontrolplanev1.AddToScheme(scheme)
+	_ = expinfrav1.AddToScheme(scheme)
+	_ = expclusterv1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	scope, err := scope.NewManagedMachinePoolScope(scope.ManagedMachinePoolScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns",
+				Name:      "capi-name",
+			},
+		},
+		ControlPlane: &ekscontrolplanev1.AWSManagedControlPlane{
+			Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{EKSClusterName: "default.cluster"},
+		},
+		ManagedMachinePool: &expinfrav1.AWSManagedMachinePool{
+			Spec: spec,
+		},
+		MachinePool: &expclusterv1.MachinePool{},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return scope
+}
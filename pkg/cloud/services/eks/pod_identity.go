@@ -0,0 +1,440 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
+	ekshelpers "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// podIdentityRoleTagValue is the role tag value used to mark pod identity associations
+	// and IAM roles that are managed from AWSManagedControlPlane.Spec.PodIdentityAssociations.
+	podIdentityRoleTagValue = "pod-identity"
+)
+
+func (s *Service) reconcilePodIdentityAssociations(ctx context.Context) error {
+	desired := s.scope.PodIdentityAssociations()
+	previous := s.scope.ControlPlane.Status.PodIdentityAssociations
+	if len(desired) == 0 && len(previous) == 0 {
+		s.scope.Debug("no pod identity associations specified, skipping reconcile")
+		return nil
+	}
+
+	s.scope.Info("Reconciling EKS pod identity associations")
+
+	clusterName := s.scope.KubernetesClusterName()
+
+	existing, err := s.getPodIdentityAssociations(ctx, clusterName)
+	if err != nil {
+		return fmt.Errorf("getting existing pod identity associations: %w", err)
+	}
+
+	previousByKey := make(map[string]ekscontrolplanev1.PodIdentityAssociationStatus, len(previous))
+	for _, status := range previous {
+		previousByKey[podIdentityKey(status.ServiceAccountNamespace, status.ServiceAccountName)] = status
+	}
+
+	var errs []error
+	desiredKeys := make(map[string]struct{}, len(desired))
+	statuses := make([]ekscontrolplanev1.PodIdentityAssociationStatus, 0, len(desired))
+	for i := range desired {
+		association := desired[i]
+		key := podIdentityKey(association.ServiceAccountNamespace, association.ServiceAccountName)
+		desiredKeys[key] = struct{}{}
+
+		status := ekscontrolplanev1.PodIdentityAssociationStatus{
+			ServiceAccountNamespace: association.ServiceAccountNamespace,
+			ServiceAccountName:      association.ServiceAccountName,
+		}
+		prev, hasPrev := previousByKey[key]
+
+		current, err := s.reconcilePodIdentityAssociation(ctx, clusterName, association, existing[key])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reconciling pod identity association %s: %w", key, err))
+			status.FailureMessage = ptr.To(err.Error())
+			if hasPrev {
+				// Keep track of what was previously observed so that a role created
+				// for the association is still cleaned up later on.
+				status.AssociationID = prev.AssociationID
+				status.AssociationARN = prev.AssociationARN
+				status.RoleARN = prev.RoleARN
+			}
+			statuses = append(statuses, status)
+			continue
+		}
+
+		status.AssociationID = aws.ToString(current.AssociationId)
+		status.AssociationARN = aws.ToString(current.AssociationArn)
+		status.RoleARN = aws.ToString(current.RoleArn)
+		status.Ready = true
+
+		if hasPrev && prev.RoleARN != "" && prev.RoleARN != status.RoleARN {
+			// The association moved to another role, remove the previous one if we created it.
+			if err := s.deletePodIdentityRole(ctx, roleNameFromARN(prev.RoleARN)); err != nil {
+				errs = append(errs, fmt.Errorf("deleting previous role of pod identity association %s: %w", key, err))
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	for key, current := range existing {
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+		if !s.isOwnedPodIdentityAssociation(current) {
+			continue
+		}
+		if err := s.deletePodIdentityAssociation(ctx, clusterName, current); err != nil {
+			errs = append(errs, fmt.Errorf("deleting pod identity association %s: %w", key, err))
+		}
+	}
+
+	for key, prev := range previousByKey {
+		if _, ok := desiredKeys[key]; ok {
+			continue
+		}
+		if err := s.deletePodIdentityRole(ctx, roleNameFromARN(prev.RoleARN)); err != nil {
+			errs = append(errs, fmt.Errorf("deleting role of pod identity association %s: %w", key, err))
+			// Keep the association in the status so the role deletion is retried.
+			prev.Ready = false
+			prev.FailureMessage = ptr.To(err.Error())
+			statuses = append(statuses, prev)
+		}
+	}
+
+	s.scope.ControlPlane.Status.PodIdentityAssociations = statuses
+	if err := s.scope.PatchObject(); err != nil {
+		return fmt.Errorf("failed to update control plane: %w", err)
+	}
+
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	s.scope.Debug("Reconcile EKS pod identity associations completed successfully")
+	return nil
+}
+
+// reconcilePodIdentityAssociation ensures the association for the service account exists and
+// uses the desired role. Existing associations that are not owned by this cluster are adopted.
+func (s *Service) reconcilePodIdentityAssociation(ctx context.Context, clusterName string, association ekscontrolplanev1.PodIdentityAssociation, current *ekstypes.PodIdentityAssociation) (*ekstypes.PodIdentityAssociation, error) {
+	roleARN, err := s.reconcilePodIdentityRole(ctx, association)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		return s.createPodIdentityAssociation(ctx, clusterName, association, roleARN)
+	}
+
+	if !s.isOwnedPodIdentityAssociation(current) {
+		s.scope.Debug("Adopting existing pod identity association", "namespace", association.ServiceAccountNamespace, "serviceaccount", association.ServiceAccountName)
+		if _, err := s.EKSClient.TagResource(ctx, &eks.TagResourceInput{
+			ResourceArn: current.AssociationArn,
+			Tags:        s.podIdentityAssociationTags(),
+		}); err != nil {
+			return nil, fmt.Errorf("tagging pod identity association: %w", err)
+		}
+	}
+
+	if aws.ToString(current.RoleArn) == roleARN {
+		return current, nil
+	}
+
+	out, err := s.EKSClient.UpdatePodIdentityAssociation(ctx, &eks.UpdatePodIdentityAssociationInput{
+		ClusterName:   aws.String(clusterName),
+		AssociationId: current.AssociationId,
+		RoleArn:       aws.String(roleARN),
+	})
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedUpdateEKSPodIdentityAssociation", "Failed to update pod identity association %s/%s: %v", association.ServiceAccountNamespace, association.ServiceAccountName, err)
+		return nil, fmt.Errorf("updating pod identity association: %w", err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulUpdateEKSPodIdentityAssociation", "Updated pod identity association %s/%s", association.ServiceAccountNamespace, association.ServiceAccountName)
+
+	return out.Association, nil
+}
+
+func (s *Service) createPodIdentityAssociation(ctx context.Context, clusterName string, association ekscontrolplanev1.PodIdentityAssociation, roleARN string) (*ekstypes.PodIdentityAssociation, error) {
+	out, err := s.EKSClient.CreatePodIdentityAssociation(ctx, &eks.CreatePodIdentityAssociationInput{
+		ClusterName:    aws.String(clusterName),
+		Namespace:      aws.String(association.ServiceAccountNamespace),
+		ServiceAccount: aws.String(association.ServiceAccountName),
+		RoleArn:        aws.String(roleARN),
+		Tags:           s.podIdentityAssociationTags(),
+	})
+	if err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedCreateEKSPodIdentityAssociation", "Failed to create pod identity association %s/%s: %v", association.ServiceAccountNamespace, association.ServiceAccountName, err)
+		return nil, fmt.Errorf("creating pod identity association: %w", err)
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulCreateEKSPodIdentityAssociation", "Created pod identity association %s/%s", association.ServiceAccountNamespace, association.ServiceAccountName)
+
+	return out.Association, nil
+}
+
+func (s *Service) deletePodIdentityAssociation(ctx context.Context, clusterName string, association *ekstypes.PodIdentityAssociation) error {
+	s.scope.Info("Deleting pod identity association", "namespace", aws.ToString(association.Namespace), "serviceaccount", aws.ToString(association.ServiceAccount))
+
+	if _, err := s.EKSClient.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+		ClusterName:   aws.String(clusterName),
+		AssociationId: association.AssociationId,
+	}); err != nil {
+		var notFoundErr *ekstypes.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		record.Warnf(s.scope.ControlPlane, "FailedDeleteEKSPodIdentityAssociation", "Failed to delete pod identity association %s/%s: %v", aws.ToString(association.Namespace), aws.ToString(association.ServiceAccount), err)
+		return err
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulDeleteEKSPodIdentityAssociation", "Deleted pod identity association %s/%s", aws.ToString(association.Namespace), aws.ToString(association.ServiceAccount))
+
+	return nil
+}
+
+// getPodIdentityAssociations returns the pod identity associations of the cluster indexed by
+// namespace and service account. Associations created by EKS addons are ignored.
+func (s *Service) getPodIdentityAssociations(ctx context.Context, clusterName string) (map[string]*ekstypes.PodIdentityAssociation, error) {
+	summaries := []ekstypes.PodIdentityAssociationSummary{}
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	for {
+		out, err := s.EKSClient.ListPodIdentityAssociations(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("listing pod identity associations: %w", err)
+		}
+		summaries = append(summaries, out.Associations...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	associations := make(map[string]*ekstypes.PodIdentityAssociation, len(summaries))
+	for _, summary := range summaries {
+		if summary.OwnerArn != nil {
+			// Owned by an EKS addon, which manages the association itself.
+			continue
+		}
+
+		// The summaries don't include the tags nor the role which are needed to reconcile.
+		out, err := s.EKSClient.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+			ClusterName:   aws.String(clusterName),
+			AssociationId: summary.AssociationId,
+		})
+		if err != nil {
+			var notFoundErr *ekstypes.ResourceNotFoundException
+			if errors.As(err, &notFoundErr) {
+				continue
+			}
+			return nil, fmt.Errorf("describing pod identity association %s: %w", aws.ToString(summary.AssociationId), err)
+		}
+		if out.Association == nil {
+			continue
+		}
+		associations[podIdentityKey(aws.ToString(summary.Namespace), aws.ToString(summary.ServiceAccount))] = out.Association
+	}
+
+	return associations, nil
+}
+
+// reconcilePodIdentityRole returns the ARN of the role to use for the association, creating
+// the role and attaching its policies when the association specifies a role to manage.
+func (s *Service) reconcilePodIdentityRole(ctx context.Context, association ekscontrolplanev1.PodIdentityAssociation) (string, error) {
+	if association.Role == nil {
+		return association.RoleARN, nil
+	}
+
+	if !s.scope.EnableIAM() {
+		return "", ErrPodIdentityRoleRequiresIAM
+	}
+
+	roleName, err := s.podIdentityRoleName(association)
+	if err != nil {
+		return "", err
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return "", err
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), eksiam.PodIdentityTrustRelationship(), s.podIdentityRoleTags(), association.Role.Path, association.Role.PermissionsBoundary)
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create pod identity IAM role %q: %v", roleName, err)
+			return "", fmt.Errorf("creating role %s: %w", roleName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created pod identity IAM role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, pod identity role policy assignment as role is unmanaged", "role", roleName)
+		return aws.ToString(role.Arn), nil
+	}
+
+	if _, err := s.EnsurePoliciesAttached(ctx, role, association.Role.Policies); err != nil {
+		return "", fmt.Errorf("ensuring policies are attached to role %s: %w", roleName, err)
+	}
+
+	return aws.ToString(role.Arn), nil
+}
+
+// deletePodIdentityRoles deletes the IAM roles created for pod identity associations. The
+// associations themselves are removed by EKS together with the cluster.
+func (s *Service) deletePodIdentityRoles(ctx context.Context) error {
+	roleNames := []string{}
+	for _, status := range s.scope.ControlPlane.Status.PodIdentityAssociations {
+		if status.RoleARN != "" {
+			roleNames = append(roleNames, roleNameFromARN(status.RoleARN))
+		}
+	}
+	for _, association := range s.scope.PodIdentityAssociations() {
+		if association.Role == nil {
+			continue
+		}
+		roleName, err := s.podIdentityRoleName(association)
+		if err != nil {
+			return err
+		}
+		roleNames = append(roleNames, roleName)
+	}
+
+	deleted := map[string]struct{}{}
+	for _, roleName := range roleNames {
+		if _, ok := deleted[roleName]; ok {
+			continue
+		}
+		if err := s.deletePodIdentityRole(ctx, roleName); err != nil {
+			return err
+		}
+		deleted[roleName] = struct{}{}
+	}
+
+	return nil
+}
+
+// deletePodIdentityRole deletes the role if it has been created for a pod identity association.
+func (s *Service) deletePodIdentityRole(ctx context.Context, roleName string) error {
+	if roleName == "" {
+		return nil
+	}
+	if !s.scope.EnableIAM() {
+		s.scope.Debug("EKS IAM disabled, skipping deleting pod identity IAM role", "role", roleName)
+		return nil
+	}
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("getting pod identity iam role %s: %w", roleName, err)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) || !isPodIdentityRole(role) {
+		s.scope.Debug("Skipping, pod identity iam role deletion as role is unmanaged", "role", roleName)
+		return nil
+	}
+
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Warnf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete pod identity IAM role %q: %v", roleName, err)
+		return err
+	}
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted pod identity IAM role %q", roleName)
+
+	return nil
+}
+
+func (s *Service) podIdentityRoleName(association ekscontrolplanev1.PodIdentityAssociation) (string, error) {
+	if association.Role != nil && association.Role.Name != "" {
+		return association.Role.Name, nil
+	}
+
+	roleName, err := ekshelpers.GenerateEKSName(
+		association.ServiceAccountName,
+		fmt.Sprintf("%s-%s", s.scope.KubernetesClusterName(), association.ServiceAccountNamespace),
+		maxIAMRoleNameLength,
+	)
+	if err != nil {
+		return "", fmt.Errorf("generating pod identity role name: %w", err)
+	}
+	return roleName, nil
+}
+
+func (s *Service) podIdentityRoleTags() infrav1.Tags {
+	tags := infrav1.Tags{}
+	for k, v := range s.scope.AdditionalTags() {
+		tags[k] = v
+	}
+	tags[infrav1.NameAWSClusterAPIRole] = podIdentityRoleTagValue
+	return tags
+}
+
+func (s *Service) podIdentityAssociationTags() map[string]string {
+	params := s.getEKSTagParams("")
+	params.Role = aws.String(podIdentityRoleTagValue)
+	return infrav1.Build(*params)
+}
+
+func (s *Service) isOwnedPodIdentityAssociation(association *ekstypes.PodIdentityAssociation) bool {
+	tags := infrav1.Tags(association.Tags)
+	return tags.HasOwned(s.scope.KubernetesClusterName()) && tags.GetRole() == podIdentityRoleTagValue
+}
+
+func isPodIdentityRole(role *iamtypes.Role) bool {
+	for _, tag := range role.Tags {
+		if aws.ToString(tag.Key) == infrav1.NameAWSClusterAPIRole && aws.ToString(tag.Value) == podIdentityRoleTagValue {
+			return true
+		}
+	}
+	return false
+}
+
+func podIdentityKey(namespace, serviceAccount string) string {
+	return namespace + "/" + serviceAccount
+}
+
+// roleNameFromARN returns the name of the role from its ARN, dropping the role path.
+func roleNameFromARN(roleARN string) string {
+	if roleARN == "" {
+		return ""
+	}
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return ""
+	}
+	resource := parsed.Resource
+	return resource[strings.LastIndex(resource, "/")+1:]
+}
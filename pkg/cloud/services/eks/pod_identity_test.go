@@ -0,0 +1,378 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcilePodIdentityAssociations(t *testing.T) {
+	clusterName := "cluster-test"
+	externalDNSRoleARN := "arn:aws:iam::123456789012:role/external-dns"
+	otherRoleARN := "arn:aws:iam::123456789012:role/other"
+	managedRoleName := "cluster-test-monitoring_prometheus"
+	managedRoleARN := "arn:aws:iam::123456789012:role/" + managedRoleName
+	policyARN := "arn:aws:iam::aws:policy/CloudWatchReadOnlyAccess"
+
+	ownedTags := map[string]string{
+		"Name":                             clusterName,
+		infrav1.ClusterTagKey(clusterName): string(infrav1.ResourceLifecycleOwned),
+		infrav1.NameAWSClusterAPIRole:      podIdentityRoleTagValue,
+	}
+	managedRoleTags := []iamtypes.Tag{
+		{
+			Key:   aws.String(infrav1.ClusterAWSCloudProviderTagKey("capi-name")),
+			Value: aws.String(string(infrav1.ResourceLifecycleOwned)),
+		},
+		{
+			Key:   aws.String(infrav1.NameAWSClusterAPIRole),
+			Value: aws.String(podIdentityRoleTagValue),
+		},
+	}
+
+	tests := []struct {
+		name           string
+		enableIAM      bool
+		associations   []ekscontrolplanev1.PodIdentityAssociation
+		previousStatus []ekscontrolplanev1.PodIdentityAssociationStatus
+		expectEKS      func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectIAM      func(m *mock_iamauth.MockIAMAPIMockRecorder)
+		expectStatus   []ekscontrolplanev1.PodIdentityAssociationStatus
+		expectError    bool
+	}{
+		{
+			name:      "no associations does not reconcile",
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+		},
+		{
+			name: "creates missing association",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					RoleARN:                 externalDNSRoleARN,
+				},
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), &eks.ListPodIdentityAssociationsInput{ClusterName: aws.String(clusterName)}).
+					Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+				m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+					ClusterName:    aws.String(clusterName),
+					Namespace:      aws.String("kube-system"),
+					ServiceAccount: aws.String("external-dns"),
+					RoleArn:        aws.String(externalDNSRoleARN),
+					Tags:           ownedTags,
+				}).Return(&eks.CreatePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						AssociationArn: aws.String("arn:a-1"),
+						RoleArn:        aws.String(externalDNSRoleARN),
+					},
+				}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					AssociationID:           "a-1",
+					AssociationARN:          "arn:a-1",
+					RoleARN:                 externalDNSRoleARN,
+					Ready:                   true,
+				},
+			},
+		},
+		{
+			name: "adopts existing association and deletes only owned associations that are no longer desired",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					RoleARN:                 externalDNSRoleARN,
+				},
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListPodIdentityAssociationsInput{})).
+					Return(&eks.ListPodIdentityAssociationsOutput{
+						Associations: []ekstypes.PodIdentityAssociationSummary{
+							{AssociationId: aws.String("a-1"), Namespace: aws.String("kube-system"), ServiceAccount: aws.String("external-dns")},
+							{AssociationId: aws.String("a-2"), Namespace: aws.String("default"), ServiceAccount: aws.String("old")},
+							{AssociationId: aws.String("a-3"), Namespace: aws.String("default"), ServiceAccount: aws.String("unmanaged")},
+							{AssociationId: aws.String("a-4"), Namespace: aws.String("kube-system"), ServiceAccount: aws.String("aws-node"), OwnerArn: aws.String("arn:addon")},
+						},
+					}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{ClusterName: aws.String(clusterName), AssociationId: aws.String("a-1")}).
+					Return(&eks.DescribePodIdentityAssociationOutput{Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						AssociationArn: aws.String("arn:a-1"),
+						Namespace:      aws.String("kube-system"),
+						ServiceAccount: aws.String("external-dns"),
+						RoleArn:        aws.String(otherRoleARN),
+					}}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{ClusterName: aws.String(clusterName), AssociationId: aws.String("a-2")}).
+					Return(&eks.DescribePodIdentityAssociationOutput{Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-2"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("old"),
+						RoleArn:        aws.String(otherRoleARN),
+						Tags:           ownedTags,
+					}}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), &eks.DescribePodIdentityAssociationInput{ClusterName: aws.String(clusterName), AssociationId: aws.String("a-3")}).
+					Return(&eks.DescribePodIdentityAssociationOutput{Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-3"),
+						Namespace:      aws.String("default"),
+						ServiceAccount: aws.String("unmanaged"),
+						RoleArn:        aws.String(otherRoleARN),
+					}}, nil)
+				m.TagResource(gomock.Any(), &eks.TagResourceInput{
+					ResourceArn: aws.String("arn:a-1"),
+					Tags:        ownedTags,
+				}).Return(&eks.TagResourceOutput{}, nil)
+				m.UpdatePodIdentityAssociation(gomock.Any(), &eks.UpdatePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+					RoleArn:       aws.String(externalDNSRoleARN),
+				}).Return(&eks.UpdatePodIdentityAssociationOutput{Association: &ekstypes.PodIdentityAssociation{
+					AssociationId:  aws.String("a-1"),
+					AssociationArn: aws.String("arn:a-1"),
+					RoleArn:        aws.String(externalDNSRoleARN),
+				}}, nil)
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-2"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					ServiceAccountNamespace: "kube-system",
+					ServiceAccountName:      "external-dns",
+					AssociationID:           "a-1",
+					AssociationARN:          "arn:a-1",
+					RoleARN:                 externalDNSRoleARN,
+					Ready:                   true,
+				},
+			},
+		},
+		{
+			name:      "creates role for association",
+			enableIAM: true,
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "monitoring",
+					ServiceAccountName:      "prometheus",
+					Role: &ekscontrolplanev1.PodIdentityRole{
+						Policies: []string{policyARN},
+					},
+				},
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListPodIdentityAssociationsInput{})).
+					Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+				m.CreatePodIdentityAssociation(gomock.Any(), &eks.CreatePodIdentityAssociationInput{
+					ClusterName:    aws.String(clusterName),
+					Namespace:      aws.String("monitoring"),
+					ServiceAccount: aws.String("prometheus"),
+					RoleArn:        aws.String(managedRoleARN),
+					Tags:           ownedTags,
+				}).Return(&eks.CreatePodIdentityAssociationOutput{
+					Association: &ekstypes.PodIdentityAssociation{
+						AssociationId: aws.String("a-1"),
+						RoleArn:       aws.String(managedRoleARN),
+					},
+				}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String(managedRoleName)}).
+					Return(nil, &iamtypes.NoSuchEntityException{})
+				m.CreateRole(gomock.Any(), gomock.AssignableToTypeOf(&iam.CreateRoleInput{})).
+					Return(&iam.CreateRoleOutput{Role: &iamtypes.Role{
+						RoleName: aws.String(managedRoleName),
+						Arn:      aws.String(managedRoleARN),
+						Tags:     managedRoleTags,
+					}}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(managedRoleName)}).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.GetPolicy(gomock.Any(), &iam.GetPolicyInput{PolicyArn: aws.String(policyARN)}).
+					Return(&iam.GetPolicyOutput{Policy: &iamtypes.Policy{Arn: aws.String(policyARN)}}, nil)
+				m.AttachRolePolicy(gomock.Any(), &iam.AttachRolePolicyInput{
+					RoleName:  aws.String(managedRoleName),
+					PolicyArn: aws.String(policyARN),
+				}).Return(&iam.AttachRolePolicyOutput{}, nil)
+			},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					ServiceAccountNamespace: "monitoring",
+					ServiceAccountName:      "prometheus",
+					AssociationID:           "a-1",
+					RoleARN:                 managedRoleARN,
+					Ready:                   true,
+				},
+			},
+		},
+		{
+			name: "creating role without IAM enabled records failure",
+			associations: []ekscontrolplanev1.PodIdentityAssociation{
+				{
+					ServiceAccountNamespace: "monitoring",
+					ServiceAccountName:      "prometheus",
+					Role:                    &ekscontrolplanev1.PodIdentityRole{},
+				},
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListPodIdentityAssociationsInput{})).
+					Return(&eks.ListPodIdentityAssociationsOutput{}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {},
+			expectStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					ServiceAccountNamespace: "monitoring",
+					ServiceAccountName:      "prometheus",
+					FailureMessage:          aws.String(ErrPodIdentityRoleRequiresIAM.Error()),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name:      "deletes role created for removed association",
+			enableIAM: true,
+			previousStatus: []ekscontrolplanev1.PodIdentityAssociationStatus{
+				{
+					ServiceAccountNamespace: "monitoring",
+					ServiceAccountName:      "prometheus",
+					AssociationID:           "a-1",
+					RoleARN:                 managedRoleARN,
+					Ready:                   true,
+				},
+			},
+			expectEKS: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListPodIdentityAssociations(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListPodIdentityAssociationsInput{})).
+					Return(&eks.ListPodIdentityAssociationsOutput{
+						Associations: []ekstypes.PodIdentityAssociationSummary{
+							{AssociationId: aws.String("a-1"), Namespace: aws.String("monitoring"), ServiceAccount: aws.String("prometheus")},
+						},
+					}, nil)
+				m.DescribePodIdentityAssociation(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribePodIdentityAssociationInput{})).
+					Return(&eks.DescribePodIdentityAssociationOutput{Association: &ekstypes.PodIdentityAssociation{
+						AssociationId:  aws.String("a-1"),
+						Namespace:      aws.String("monitoring"),
+						ServiceAccount: aws.String("prometheus"),
+						RoleArn:        aws.String(managedRoleARN),
+						Tags:           ownedTags,
+					}}, nil)
+				m.DeletePodIdentityAssociation(gomock.Any(), &eks.DeletePodIdentityAssociationInput{
+					ClusterName:   aws.String(clusterName),
+					AssociationId: aws.String("a-1"),
+				}).Return(&eks.DeletePodIdentityAssociationOutput{}, nil)
+			},
+			expectIAM: func(m *mock_iamauth.MockIAMAPIMockRecorder) {
+				m.GetRole(gomock.Any(), &iam.GetRoleInput{RoleName: aws.String(managedRoleName)}).
+					Return(&iam.GetRoleOutput{Role: &iamtypes.Role{
+						RoleName: aws.String(managedRoleName),
+						Arn:      aws.String(managedRoleARN),
+						Tags:     managedRoleTags,
+					}}, nil)
+				m.ListAttachedRolePolicies(gomock.Any(), &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(managedRoleName)}).
+					Return(&iam.ListAttachedRolePoliciesOutput{}, nil)
+				m.DeleteRole(gomock.Any(), &iam.DeleteRoleInput{RoleName: aws.String(managedRoleName)}).
+					Return(&iam.DeleteRoleOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			iamMock := mock_iamauth.NewMockIAMAPI(mockControl)
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			_ = ekscontrolplanev1.AddToScheme(scheme)
+
+			controlPlane := &ekscontrolplanev1.AWSManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns",
+					Name:      "cp",
+				},
+				Spec: ekscontrolplanev1.AWSManagedControlPlaneSpec{
+					EKSClusterName:          clusterName,
+					PodIdentityAssociations: tc.associations,
+				},
+				Status: ekscontrolplanev1.AWSManagedControlPlaneStatus{
+					PodIdentityAssociations: tc.previousStatus,
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(controlPlane).WithStatusSubresource(controlPlane).Build()
+			scope, err := scope.NewManagedControlPlaneScope(scope.ManagedControlPlaneScopeParams{
+				Client: client,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns",
+						Name:      "capi-name",
+					},
+				},
+				ControlPlane: controlPlane,
+				EnableIAM:    tc.enableIAM,
+			})
+			g.Expect(err).To(BeNil())
+
+			tc.expectEKS(eksMock.EXPECT())
+			tc.expectIAM(iamMock.EXPECT())
+			s := NewService(scope)
+			s.EKSClient = eksMock
+			s.IAMService.IAMClient = iamMock
+
+			err = s.reconcilePodIdentityAssociations(context.TODO())
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+
+			if tc.expectStatus == nil {
+				g.Expect(controlPlane.Status.PodIdentityAssociations).To(BeEmpty())
+			} else {
+				g.Expect(controlPlane.Status.PodIdentityAssociations).To(Equal(tc.expectStatus))
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-aws/v2/cmd/clusterawsadm/api/bootstrap/v1beta1"
 	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
 	expinfrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/exp/api/v1beta2"
+	iamv1 "sigs.k8s.io/cluster-api-provider-aws/v2/iam/api/v1beta1"
 	eksiam "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/iam"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks"
 	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
@@ -47,6 +48,25 @@ func NodegroupRolePolicies() []string {
 	}
 }
 
+// AutoModeNodeRolePolicies gives the policies required for the node role of EKS Auto Mode.
+func AutoModeNodeRolePolicies(partition string) []string {
+	return []string{
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSWorkerNodeMinimalPolicy", partition),
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEC2ContainerRegistryPullOnly", partition),
+	}
+}
+
+// AutoModeControlPlaneRolePolicies gives the policies the control plane role requires in addition
+// when EKS Auto Mode is enabled.
+func AutoModeControlPlaneRolePolicies(partition string) []string {
+	return []string{
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSComputePolicy", partition),
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSBlockStoragePolicy", partition),
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSLoadBalancingPolicy", partition),
+		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSNetworkingPolicy", partition),
+	}
+}
+
 // FargateRolePolicies gives the policies required for a fargate role.
 func FargateRolePolicies() []string {
 	return []string{
@@ -95,7 +115,7 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 			return fmt.Errorf("getting role %s: %w", *s.scope.ControlPlane.Spec.RoleName, ErrClusterRoleNotFound)
 		}
 
-		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), eksiam.ControlPlaneTrustRelationship(false), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		role, err = s.CreateRole(ctx, *s.scope.ControlPlane.Spec.RoleName, s.scope.Name(), s.controlPlaneTrustRelationship(), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
 		if err != nil {
 			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create control plane IAM role %q: %v", *s.scope.ControlPlane.Spec.RoleName, err)
 
@@ -115,6 +135,15 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 		fmt.Sprintf("arn:%s:iam::aws:policy/AmazonEKSClusterPolicy", s.scope.Partition()),
 	}
 
+	if s.scope.AutoModeEnabled() {
+		// EKS Auto Mode tags the sessions of the control plane role, which roles created
+		// before auto mode was enabled don't allow yet.
+		if _, err := s.EnsureTagsAndPolicy(ctx, role, s.scope.Name(), s.controlPlaneTrustRelationship(), s.scope.AdditionalTags()); err != nil {
+			return errors.Wrapf(err, "error ensuring tags and policy document are set on control plane role")
+		}
+		policies = append(policies, AutoModeControlPlaneRolePolicies(s.scope.Partition())...)
+	}
+
 	if s.scope.ControlPlane.Spec.RoleAdditionalPolicies != nil {
 		if !s.scope.AllowAdditionalRoles() && len(*s.scope.ControlPlane.Spec.RoleAdditionalPolicies) > 0 {
 			return ErrCannotUseAdditionalRoles
@@ -133,6 +162,109 @@ func (s *Service) reconcileControlPlaneIAMRole(ctx context.Context) error {
 	return nil
 }
 
+// controlPlaneTrustRelationship returns the trust relationship of the control plane role.
+func (s *Service) controlPlaneTrustRelationship() *iamv1.PolicyDocument {
+	policy := eksiam.ControlPlaneTrustRelationship(false)
+	if s.scope.AutoModeEnabled() {
+		policy.Statement[0].Action = append(policy.Statement[0].Action, "sts:TagSession")
+	}
+	return policy
+}
+
+// reconcileAutoModeNodeIAMRole reconciles the role of the nodes of the built-in EKS Auto Mode node pools.
+// The role is only needed, and only allowed by EKS, when built-in node pools are enabled.
+func (s *Service) reconcileAutoModeNodeIAMRole(ctx context.Context) error {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	if !s.scope.AutoModeEnabled() || len(autoMode.Compute.NodePools) == 0 {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling EKS Auto Mode node IAM Role")
+
+	if autoMode.Compute.NodeRoleName == nil {
+		if !s.scope.EnableIAM() {
+			s.scope.Info("no eks auto mode node role specified, using default eks auto mode node role")
+			autoMode.Compute.NodeRoleName = &ekscontrolplanev1.DefaultEKSAutoModeNodeRole
+		} else {
+			s.scope.Info("no eks auto mode node role specified, using role based on cluster name")
+			roleName, err := eks.GenerateEKSName("auto-mode-node-role", s.scope.KubernetesClusterName(), maxIAMRoleNameLength)
+			if err != nil {
+				return errors.Wrap(err, "failed to generate IAM role name")
+			}
+			autoMode.Compute.NodeRoleName = aws.String(roleName)
+		}
+	}
+	roleName := *autoMode.Compute.NodeRoleName
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if !isNotFound(err) {
+			return err
+		}
+
+		// If the disable IAM flag is used then the role must exist
+		if !s.scope.EnableIAM() {
+			return fmt.Errorf("getting role %s: %w", roleName, ErrAutoModeNodeRoleNotFound)
+		}
+
+		role, err = s.CreateRole(ctx, roleName, s.scope.Name(), eksiam.NodegroupTrustRelationship(), s.scope.AdditionalTags(), s.scope.ControlPlane.Spec.RolePath, s.scope.ControlPlane.Spec.RolePermissionsBoundary)
+		if err != nil {
+			record.Warnf(s.scope.ControlPlane, "FailedIAMRoleCreation", "Failed to create auto mode node IAM role %q: %v", roleName, err)
+			return fmt.Errorf("creating role %s: %w", roleName, err)
+		}
+		record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleCreation", "Created auto mode node IAM role %q", roleName)
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.scope.Debug("Skipping, EKS auto mode node role policy assignment as role is unmanaged")
+		return nil
+	}
+
+	policies := AutoModeNodeRolePolicies(s.scope.Partition())
+	if _, err := s.EnsurePoliciesAttached(ctx, role, policies); err != nil {
+		return errors.Wrapf(err, "error ensuring policies are attached: %v", policies)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteAutoModeNodeIAMRole(ctx context.Context) error {
+	autoMode := s.scope.ControlPlane.Spec.AutoMode
+	if autoMode == nil || autoMode.Compute.NodeRoleName == nil {
+		return nil
+	}
+	roleName := *autoMode.Compute.NodeRoleName
+	if !s.scope.EnableIAM() {
+		s.scope.Debug("EKS IAM disabled, skipping deleting EKS Auto Mode node IAM Role")
+		return nil
+	}
+
+	s.scope.Debug("Deleting EKS Auto Mode node IAM Role")
+
+	role, err := s.GetIAMRole(ctx, roleName)
+	if err != nil {
+		if isNotFound(err) {
+			s.Debug("EKS Auto Mode node IAM Role already deleted")
+			return nil
+		}
+
+		return errors.Wrap(err, "getting eks auto mode node iam role")
+	}
+
+	if s.IsUnmanaged(role, s.scope.Name()) {
+		s.Debug("Skipping, EKS auto mode node iam role deletion as role is unmanaged")
+		return nil
+	}
+
+	if err := s.DeleteRole(ctx, roleName); err != nil {
+		record.Eventf(s.scope.ControlPlane, "FailedIAMRoleDeletion", "Failed to delete auto mode node IAM role %q: %v", roleName, err)
+		return err
+	}
+
+	record.Eventf(s.scope.ControlPlane, "SuccessfulIAMRoleDeletion", "Deleted auto mode node IAM role %q", roleName)
+	return nil
+}
+
 func (s *Service) deleteControlPlaneIAMRole(ctx context.Context) error {
 	if s.scope.ControlPlane.Spec.RoleName == nil {
 		return nil
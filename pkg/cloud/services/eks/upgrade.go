@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	eksupgrade "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/upgrade"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileUpgrade upgrades the cluster when the Kubernetes version in the spec is newer than the
+// version of the cluster. The control plane is upgraded first, one minor version at a time, and the
+// addons are reconciled once the control plane runs the new version and is active. Node groups are
+// held by the AWSManagedMachinePool controller until then, see UpgradeStatus.HoldsNodegroupUpgrades,
+// and the upgrade completes once they run the new version. The progress of the upgrade is recorded
+// in the status of the control plane.
+func (s *Service) reconcileUpgrade(ctx context.Context, cluster *ekstypes.Cluster) error {
+	if s.scope.ControlPlane.Spec.Version == nil {
+		return nil
+	}
+	specVersion, err := parseEKSVersion(*s.scope.ControlPlane.Spec.Version)
+	if err != nil {
+		return fmt.Errorf("parsing EKS version from spec: %w", err)
+	}
+	targetVersion := versionToEKS(specVersion)
+	clusterVersion := version.MustParseGeneric(*cluster.Version)
+
+	upgrade := s.scope.ControlPlane.Status.Upgrade
+	if !clusterVersion.LessThan(specVersion) && (upgrade == nil || upgrade.Version != targetVersion || !upgrade.InProgress()) {
+		if upgrade.InProgress() {
+			// The version in the spec was changed back during the upgrade.
+			s.scope.ControlPlane.Status.Upgrade = nil
+		}
+		conditions.Delete(s.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition)
+		return nil
+	}
+
+	s.scope.Debug("creating eks upgrade plan", "cluster", s.scope.KubernetesClusterName(), "version", targetVersion)
+	upgradePlan := eksupgrade.NewPlan(targetVersion, []eksupgrade.Step{
+		&controlPlaneUpgradeStep{service: s, cluster: cluster, version: specVersion},
+		&addonsUpgradeStep{service: s},
+		&nodegroupsUpgradeStep{service: s, version: specVersion},
+	})
+	procedures, err := upgradePlan.Create(ctx)
+	if err != nil {
+		return fmt.Errorf("creating eks upgrade plan: %w", err)
+	}
+	s.scope.ControlPlane.Status.Upgrade = upgradePlan.Status()
+
+	for _, step := range s.scope.ControlPlane.Status.Upgrade.Steps {
+		if step.Phase == ekscontrolplanev1.UpgradeStepPhaseFailed {
+			record.Warnf(s.scope.ControlPlane, "FailedUpgradeEKSCluster", "Upgrade step %s of EKS cluster %s failed: %s", step.Name, s.scope.KubernetesClusterName(), step.Message)
+		}
+	}
+
+	if len(procedures) == 0 {
+		record.Eventf(s.scope.ControlPlane, "SuccessfulUpgradeEKSCluster", "Upgraded EKS cluster %s to version %s", s.scope.KubernetesClusterName(), targetVersion)
+		return nil
+	}
+
+	for _, procedure := range procedures {
+		s.scope.Debug("Executing upgrade procedure", "name", procedure.Name())
+		if err := procedure.Do(ctx); err != nil {
+			s.scope.Error(err, "failed executing upgrade procedure", "name", procedure.Name())
+			return fmt.Errorf("%s: %w", procedure.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// controlPlaneUpgradeStep upgrades the control plane to the version in the spec.
+type controlPlaneUpgradeStep struct {
+	service *Service
+	cluster *ekstypes.Cluster
+	version *version.Version
+}
+
+// Name is the name of the step.
+func (p *controlPlaneUpgradeStep) Name() string {
+	return "control_plane_upgrade"
+}
+
+// Complete returns whether the control plane runs the version in the spec and is active.
+func (p *controlPlaneUpgradeStep) Complete(_ context.Context) (bool, string, error) {
+	clusterVersion := version.MustParseGeneric(*p.cluster.Version)
+	if clusterVersion.LessThan(p.version) {
+		if conditions.IsFalse(p.service.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition) {
+			return false, conditions.GetMessage(p.service.scope.ControlPlane, ekscontrolplanev1.EKSUpgradeInsightsPassedCondition), nil
+		}
+		return false, fmt.Sprintf("Upgrading control plane from version %s", aws.ToString(p.cluster.Version)), nil
+	}
+	if p.cluster.Status != ekstypes.ClusterStatusActive {
+		return false, "Waiting for the control plane to become active", nil
+	}
+	return true, "", nil
+}
+
+// Do upgrades the control plane to the next minor version once it is active.
+func (p *controlPlaneUpgradeStep) Do(ctx context.Context) error {
+	if p.cluster.Status != ekstypes.ClusterStatusActive {
+		return nil
+	}
+	return p.service.reconcileClusterVersion(ctx, p.cluster)
+}
+
+// addonsUpgradeStep upgrades the addons of the cluster to the versions in the spec, resolving the
+// latest compatible versions for the new Kubernetes version.
+type addonsUpgradeStep struct {
+	service *Service
+	// state is shared by Complete and Do, so the addons are only resolved once per reconciliation.
+	state *addonsState
+}
+
+// Name is the name of the step.
+func (p *addonsUpgradeStep) Name() string {
+	return "addons_upgrade"
+}
+
+// Complete returns whether all addons are installed with the desired version and active or degraded,
+// as when waiting for addons to become active after creating or updating them. Addons that failed
+// to be created or updated fail the step.
+func (p *addonsUpgradeStep) Complete(ctx context.Context) (bool, string, error) {
+	state, err := p.getState(ctx)
+	if err != nil {
+		return false, "", err
+	}
+
+	pending := []string{}
+	failed := []string{}
+	for _, desiredAddon := range state.desiredAddons {
+		upgraded := false
+		for _, installedAddon := range state.installed {
+			if aws.ToString(installedAddon.Name) == aws.ToString(desiredAddon.Name) {
+				status := ekstypes.AddonStatus(aws.ToString(installedAddon.Status))
+				if status == ekstypes.AddonStatusCreateFailed || status == ekstypes.AddonStatusUpdateFailed {
+					failed = append(failed, fmt.Sprintf("%s (%s)", aws.ToString(desiredAddon.Name), status))
+				}
+				upgraded = aws.ToString(installedAddon.Version) == aws.ToString(desiredAddon.Version) &&
+					(status == ekstypes.AddonStatusActive || status == ekstypes.AddonStatusDegraded)
+				break
+			}
+		}
+		if !upgraded {
+			pending = append(pending, aws.ToString(desiredAddon.Name))
+		}
+	}
+	if len(failed) > 0 {
+		return false, "", eksupgrade.NewFailedError(fmt.Sprintf("Failed upgrading addons %s", strings.Join(failed, ", ")))
+	}
+	if len(pending) > 0 {
+		return false, fmt.Sprintf("Upgrading addons %s", strings.Join(pending, ", ")), nil
+	}
+	return true, "", nil
+}
+
+// Do reconciles the addons of the cluster.
+func (p *addonsUpgradeStep) Do(ctx context.Context) error {
+	state, err := p.getState(ctx)
+	if err != nil {
+		return err
+	}
+	if err := p.service.applyAddons(ctx, state); err != nil {
+		conditions.MarkFalse(p.service.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition, ekscontrolplanev1.EKSAddonsConfiguredFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return err
+	}
+	conditions.MarkTrue(p.service.scope.ControlPlane, ekscontrolplanev1.EKSAddonsConfiguredCondition)
+	return nil
+}
+
+// getState returns the installed and desired addons of the cluster, which are only resolved the first time.
+func (p *addonsUpgradeStep) getState(ctx context.Context) (*addonsState, error) {
+	if p.state == nil {
+		state, err := p.service.getAddonsState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.state = state
+	}
+	return p.state, nil
+}
+
+// nodegroupsUpgradeStep waits for the node groups of the cluster to run the version in the spec. The
+// node groups are upgraded by the AWSManagedMachinePool controller, which upgrades node groups whose
+// MachinePool doesn't have a newer version to the version of the control plane, see
+// UpgradeStatus.UpgradesNodegroups.
+type nodegroupsUpgradeStep struct {
+	service *Service
+	version *version.Version
+}
+
+// Name is the name of the step.
+func (p *nodegroupsUpgradeStep) Name() string {
+	return ekscontrolplanev1.NodegroupsUpgradeStepName
+}
+
+// Complete returns whether all node groups created by CAPA run the version in the spec.
+func (p *nodegroupsUpgradeStep) Complete(ctx context.Context) (bool, string, error) {
+	eksClusterName := p.service.scope.KubernetesClusterName()
+	ownedTagKey := infrav1.ClusterAWSCloudProviderTagKey(eksClusterName)
+
+	pending := []string{}
+	input := &eks.ListNodegroupsInput{ClusterName: aws.String(eksClusterName)}
+	for {
+		out, err := p.service.EKSClient.ListNodegroups(ctx, input)
+		if err != nil {
+			return false, "", fmt.Errorf("listing nodegroups: %w", err)
+		}
+		for _, name := range out.Nodegroups {
+			ng, err := p.service.EKSClient.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   aws.String(eksClusterName),
+				NodegroupName: aws.String(name),
+			})
+			if err != nil {
+				return false, "", fmt.Errorf("describing nodegroup %s: %w", name, err)
+			}
+			if ng.Nodegroup == nil || ng.Nodegroup.Tags[ownedTagKey] != string(infrav1.ResourceLifecycleOwned) {
+				continue
+			}
+			if version.MustParseGeneric(aws.ToString(ng.Nodegroup.Version)).LessThan(p.version) {
+				pending = append(pending, name)
+			}
+		}
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	if len(pending) > 0 {
+		return false, fmt.Sprintf("Upgrading node groups %s", strings.Join(pending, ", ")), nil
+	}
+	return true, "", nil
+}
+
+// Do does nothing, as the node groups are upgraded by the AWSManagedMachinePool controller.
+func (p *nodegroupsUpgradeStep) Do(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,350 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/eks/mock_eksiface"
+	eksupgrade "sigs.k8s.io/cluster-api-provider-aws/v2/pkg/eks/upgrade"
+)
+
+func TestLatestAddonVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		addons []ekstypes.AddonInfo
+		expect string
+	}{
+		{
+			name:   "no addon versions",
+			expect: "",
+		},
+		{
+			name: "newest version is returned",
+			addons: []ekstypes.AddonInfo{
+				{
+					AddonVersions: []ekstypes.AddonVersionInfo{
+						{AddonVersion: aws.String("v1.18.3-eksbuild.1")},
+						{AddonVersion: aws.String("v1.19.0-eksbuild.2")},
+						{AddonVersion: aws.String("v1.19.0-eksbuild.1")},
+					},
+				},
+			},
+			expect: "v1.19.0-eksbuild.2",
+		},
+		{
+			name: "invalid versions are ignored",
+			addons: []ekstypes.AddonInfo{
+				{
+					AddonVersions: []ekstypes.AddonVersionInfo{
+						{AddonVersion: aws.String("latest")},
+						{AddonVersion: aws.String("v1.11.1-eksbuild.4")},
+					},
+				},
+			},
+			expect: "v1.11.1-eksbuild.4",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(latestAddonVersion(tc.addons)).To(Equal(tc.expect))
+		})
+	}
+}
+
+func TestReconcileUpgrade(t *testing.T) {
+	clusterName := "default.cluster"
+	vpcCNI := ekscontrolplanev1.Addon{
+		Name:               "vpc-cni",
+		VersionPolicy:      ptr.To(ekscontrolplanev1.AddonVersionPolicyLatestCompatible),
+		ConflictResolution: ptr.To(ekscontrolplanev1.AddonResolutionOverwrite),
+	}
+	describeAddons := func(m *mock_eksiface.MockEKSAPIMockRecorder, installedVersion string, status ekstypes.AddonStatus) {
+		m.ListAddons(gomock.Any(), &eks.ListAddonsInput{ClusterName: aws.String(clusterName)}).
+			Return(&eks.ListAddonsOutput{Addons: []string{"vpc-cni"}}, nil)
+		m.DescribeAddon(gomock.Any(), &eks.DescribeAddonInput{ClusterName: aws.String(clusterName), AddonName: aws.String("vpc-cni")}).
+			Return(&eks.DescribeAddonOutput{
+				Addon: &ekstypes.Addon{
+					AddonName:    aws.String("vpc-cni"),
+					AddonVersion: aws.String(installedVersion),
+					Status:       status,
+				},
+			}, nil)
+		m.DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{AddonName: aws.String("vpc-cni"), KubernetesVersion: aws.String("1.16")}).
+			Return(&eks.DescribeAddonVersionsOutput{
+				Addons: []ekstypes.AddonInfo{
+					{
+						AddonVersions: []ekstypes.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.19.0-eksbuild.1")},
+							{AddonVersion: aws.String("v1.18.3-eksbuild.1")},
+						},
+					},
+				},
+			}, nil)
+	}
+	describeNodegroups := func(m *mock_eksiface.MockEKSAPIMockRecorder, ownedVersion string) {
+		m.ListNodegroups(gomock.Any(), &eks.ListNodegroupsInput{ClusterName: aws.String(clusterName)}).
+			Return(&eks.ListNodegroupsOutput{Nodegroups: []string{"owned", "unmanaged"}}, nil)
+		m.DescribeNodegroup(gomock.Any(), &eks.DescribeNodegroupInput{ClusterName: aws.String(clusterName), NodegroupName: aws.String("owned")}).
+			Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &ekstypes.Nodegroup{
+					Version: aws.String(ownedVersion),
+					Tags:    map[string]string{"kubernetes.io/cluster/" + clusterName: "owned"},
+				},
+			}, nil)
+		m.DescribeNodegroup(gomock.Any(), &eks.DescribeNodegroupInput{ClusterName: aws.String(clusterName), NodegroupName: aws.String("unmanaged")}).
+			Return(&eks.DescribeNodegroupOutput{Nodegroup: &ekstypes.Nodegroup{Version: aws.String("1.15")}}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		cluster       *ekstypes.Cluster
+		upgrade       *ekscontrolplanev1.UpgradeStatus
+		expect        func(m *mock_eksiface.MockEKSAPIMockRecorder)
+		expectUpgrade *ekscontrolplanev1.UpgradeStatus
+	}{
+		{
+			name:          "no upgrade necessary",
+			cluster:       &ekstypes.Cluster{Version: aws.String("1.16"), Status: ekstypes.ClusterStatusActive},
+			expect:        func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectUpgrade: nil,
+		},
+		{
+			name:    "upgrade of another version is no longer in progress",
+			cluster: &ekstypes.Cluster{Version: aws.String("1.16"), Status: ekstypes.ClusterStatusActive},
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.17",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+			expect:        func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectUpgrade: nil,
+		},
+		{
+			name:    "control plane is upgraded",
+			cluster: &ekstypes.Cluster{Version: aws.String("1.15"), Status: ekstypes.ClusterStatusActive},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				m.ListInsights(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListInsightsInput{})).
+					Return(&eks.ListInsightsOutput{}, nil)
+				m.UpdateClusterVersion(gomock.Any(), &eks.UpdateClusterVersionInput{Name: aws.String(clusterName), Version: aws.String("1.16")}).
+					Return(&eks.UpdateClusterVersionOutput{}, nil)
+				m.WaitUntilClusterUpdating(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeClusterInput{}), gomock.Any()).
+					Return(nil)
+			},
+			expectUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress, Message: "Upgrading control plane from version 1.15"},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+		},
+		{
+			name:    "control plane isn't upgraded until it is active",
+			cluster: &ekstypes.Cluster{Version: aws.String("1.15"), Status: ekstypes.ClusterStatusUpdating},
+			expect:  func(m *mock_eksiface.MockEKSAPIMockRecorder) {},
+			expectUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress, Message: "Upgrading control plane from version 1.15"},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+		},
+		{
+			name:    "node groups are upgraded once the addons run the latest compatible version",
+			cluster: &ekstypes.Cluster{Version: aws.String("1.16"), Status: ekstypes.ClusterStatusActive},
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describeAddons(m, "v1.19.0-eksbuild.1", ekstypes.AddonStatusActive)
+				describeNodegroups(m, "1.15")
+			},
+			expectUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress, Message: "Upgrading node groups owned"},
+				},
+			},
+		},
+		{
+			name:    "upgrade completes once the node groups are upgraded",
+			cluster: &ekstypes.Cluster{Version: aws.String("1.16"), Status: ekstypes.ClusterStatusActive},
+			upgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress},
+				},
+			},
+			expect: func(m *mock_eksiface.MockEKSAPIMockRecorder) {
+				describeAddons(m, "v1.19.0-eksbuild.1", ekstypes.AddonStatusActive)
+				describeNodegroups(m, "1.16")
+			},
+			expectUpgrade: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.16",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			scope := newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+				EKSClusterName: clusterName,
+				Version:        aws.String("1.16"),
+				Addons:         &[]ekscontrolplanev1.Addon{vpcCNI},
+			})
+			scope.ControlPlane.Status.Upgrade = tc.upgrade
+			scope.ControlPlane.Status.Version = tc.cluster.Version
+			s := NewService(scope)
+			s.EKSClient = eksMock
+
+			g.Expect(s.reconcileUpgrade(context.TODO(), tc.cluster)).To(Succeed())
+			g.Expect(scope.ControlPlane.Status.Upgrade).To(Equal(tc.expectUpgrade))
+		})
+	}
+}
+
+func TestAddonsUpgradeStepComplete(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	clusterName := "default.cluster"
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().ListAddons(gomock.Any(), gomock.Any()).
+		Return(&eks.ListAddonsOutput{Addons: []string{"vpc-cni"}}, nil)
+	eksMock.EXPECT().DescribeAddon(gomock.Any(), gomock.Any()).
+		Return(&eks.DescribeAddonOutput{
+			Addon: &ekstypes.Addon{
+				AddonName:    aws.String("vpc-cni"),
+				AddonVersion: aws.String("v1.18.3-eksbuild.1"),
+				Status:       ekstypes.AddonStatusActive,
+			},
+		}, nil)
+	eksMock.EXPECT().DescribeAddonVersions(gomock.Any(), &eks.DescribeAddonVersionsInput{AddonName: aws.String("vpc-cni"), KubernetesVersion: aws.String("1.16")}).
+		Return(&eks.DescribeAddonVersionsOutput{
+			Addons: []ekstypes.AddonInfo{
+				{AddonVersions: []ekstypes.AddonVersionInfo{{AddonVersion: aws.String("v1.19.0-eksbuild.1")}}},
+			},
+		}, nil)
+
+	scope := newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: clusterName,
+		Version:        aws.String("1.16"),
+		Addons: &[]ekscontrolplanev1.Addon{
+			{
+				Name:               "vpc-cni",
+				VersionPolicy:      ptr.To(ekscontrolplanev1.AddonVersionPolicyLatestCompatible),
+				ConflictResolution: ptr.To(ekscontrolplanev1.AddonResolutionOverwrite),
+			},
+		},
+	})
+	s := NewService(scope)
+	s.EKSClient = eksMock
+
+	step := &addonsUpgradeStep{service: s}
+	complete, message, err := step.Complete(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(complete).To(BeFalse())
+	g.Expect(message).To(Equal("Upgrading addons vpc-cni"))
+
+	// The addons are resolved once per reconciliation, the mocks fail if they are called again.
+	complete, _, err = step.Complete(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(complete).To(BeFalse())
+}
+
+func TestAddonsUpgradeStepCompleteFailedAddon(t *testing.T) {
+	g := NewWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	clusterName := "default.cluster"
+	eksMock := mock_eksiface.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().ListAddons(gomock.Any(), gomock.Any()).
+		Return(&eks.ListAddonsOutput{Addons: []string{"vpc-cni"}}, nil)
+	eksMock.EXPECT().DescribeAddon(gomock.Any(), gomock.Any()).
+		Return(&eks.DescribeAddonOutput{
+			Addon: &ekstypes.Addon{
+				AddonName:    aws.String("vpc-cni"),
+				AddonVersion: aws.String("v1.19.0-eksbuild.1"),
+				Status:       ekstypes.AddonStatusCreateFailed,
+			},
+		}, nil)
+
+	scope := newClusterConfigTestScope(g, ekscontrolplanev1.AWSManagedControlPlaneSpec{
+		EKSClusterName: clusterName,
+		Version:        aws.String("1.16"),
+		Addons: &[]ekscontrolplanev1.Addon{
+			{
+				Name:               "vpc-cni",
+				Version:            "v1.19.0-eksbuild.1",
+				ConflictResolution: ptr.To(ekscontrolplanev1.AddonResolutionOverwrite),
+			},
+		},
+	})
+	s := NewService(scope)
+	s.EKSClient = eksMock
+
+	step := &addonsUpgradeStep{service: s}
+	complete, _, err := step.Complete(context.TODO())
+	var failedErr *eksupgrade.FailedError
+	g.Expect(errors.As(err, &failedErr)).To(BeTrue())
+	g.Expect(failedErr.Error()).To(Equal("Failed upgrading addons vpc-cni (CREATE_FAILED)"))
+	g.Expect(complete).To(BeFalse())
+}
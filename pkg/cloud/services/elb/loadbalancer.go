@@ -494,6 +494,7 @@ func (s *Service) createLB(ctx context.Context, spec *infrav1.LoadBalancer, lbSp
 	s.scope.Debug("applying load balancer DNS to result", "dns", dnsName)
 	res.DNSName = dnsName
 	res.ARN = arn
+	res.CanonicalHostedZoneID = aws.ToString(out.LoadBalancers[0].CanonicalHostedZoneId)
 	return res, nil
 }
 
@@ -1834,13 +1835,14 @@ func (s *Service) getHealthCheckTarget() string {
 
 func fromSDKTypeToClassicELB(v *elbtypes.LoadBalancerDescription, attrs *elbtypes.LoadBalancerAttributes, tags []elbtypes.Tag) *infrav1.LoadBalancer {
 	res := &infrav1.LoadBalancer{
-		Name:             aws.ToString(v.LoadBalancerName),
-		Scheme:           infrav1.ELBScheme(*v.Scheme),
-		SubnetIDs:        v.Subnets,
-		SecurityGroupIDs: v.SecurityGroups,
-		DNSName:          aws.ToString(v.DNSName),
-		Tags:             converters.ELBTagsToMap(tags),
-		LoadBalancerType: infrav1.LoadBalancerTypeClassic,
+		Name:                  aws.ToString(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(*v.Scheme),
+		SubnetIDs:             v.Subnets,
+		SecurityGroupIDs:      v.SecurityGroups,
+		DNSName:               aws.ToString(v.DNSName),
+		Tags:                  converters.ELBTagsToMap(tags),
+		LoadBalancerType:      infrav1.LoadBalancerTypeClassic,
+		CanonicalHostedZoneID: aws.ToString(v.CanonicalHostedZoneNameID),
 	}
 
 	if attrs.ConnectionSettings != nil && attrs.ConnectionSettings.IdleTimeout != nil {
@@ -1860,14 +1862,15 @@ func fromSDKTypeToLB(v elbv2types.LoadBalancer, attrs []elbv2types.LoadBalancerA
 		availabilityZones[i] = aws.ToString(az.ZoneName)
 	}
 	res := &infrav1.LoadBalancer{
-		ARN:               aws.ToString(v.LoadBalancerArn),
-		Name:              aws.ToString(v.LoadBalancerName),
-		Scheme:            infrav1.ELBScheme(v.Scheme),
-		SubnetIDs:         subnetIDs,
-		SecurityGroupIDs:  v.SecurityGroups,
-		AvailabilityZones: availabilityZones,
-		DNSName:           aws.ToString(v.DNSName),
-		Tags:              converters.V2TagsToMap(tags),
+		ARN:                   aws.ToString(v.LoadBalancerArn),
+		Name:                  aws.ToString(v.LoadBalancerName),
+		Scheme:                infrav1.ELBScheme(v.Scheme),
+		SubnetIDs:             subnetIDs,
+		SecurityGroupIDs:      v.SecurityGroups,
+		AvailabilityZones:     availabilityZones,
+		DNSName:               aws.ToString(v.DNSName),
+		Tags:                  converters.V2TagsToMap(tags),
+		CanonicalHostedZoneID: aws.ToString(v.CanonicalHostedZoneId),
 	}
 
 	infraAttrs := make(map[string]*string, len(attrs))
@@ -0,0 +1,306 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/utils"
+)
+
+const (
+	// AccessEntryRoleTagValue is the role tag value of access entries created from
+	// aws-iam-authenticator mappings.
+	AccessEntryRoleTagValue = "aws-iam-authenticator"
+
+	systemGroupPrefix  = "system:"
+	systemMastersGroup = "system:masters"
+
+	clusterAdminPolicyFormat = "arn:%s:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"
+)
+
+// EKSAPI defines the EKS access entry operations used by the access entry backend.
+type EKSAPI interface {
+	CreateAccessEntry(ctx context.Context, params *eks.CreateAccessEntryInput, optFns ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	DescribeAccessEntry(ctx context.Context, params *eks.DescribeAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	ListAccessEntries(ctx context.Context, params *eks.ListAccessEntriesInput, optFns ...func(*eks.Options)) (*eks.ListAccessEntriesOutput, error)
+	DeleteAccessEntry(ctx context.Context, params *eks.DeleteAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+	UpdateAccessEntry(ctx context.Context, params *eks.UpdateAccessEntryInput, optFns ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	AssociateAccessPolicy(ctx context.Context, params *eks.AssociateAccessPolicyInput, optFns ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	ListAssociatedAccessPolicies(ctx context.Context, params *eks.ListAssociatedAccessPoliciesInput, optFns ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+}
+
+// accessEntryBackend translates aws-iam-authenticator mappings into EKS access entries. It is
+// used for clusters in the API authentication mode where the aws-auth config map is ignored.
+type accessEntryBackend struct {
+	client      EKSAPI
+	clusterName string
+	tags        map[string]string
+
+	// mapped are the principals mapped with the backend, the access entries owned by the
+	// cluster for other principals are deleted by deleteUnmappedAccessEntries.
+	mapped sets.Set[string]
+}
+
+// NewAccessEntryBackend will create a new authenticator backend that maps roles and users
+// using EKS access entries of the given cluster.
+func NewAccessEntryBackend(client EKSAPI, clusterName string, tags map[string]string) (AuthenticatorBackend, error) {
+	if client == nil {
+		return nil, ErrEKSClientRequired
+	}
+
+	return &accessEntryBackend{
+		client:      client,
+		clusterName: clusterName,
+		tags:        tags,
+		mapped:      sets.New[string](),
+	}, nil
+}
+
+func (b *accessEntryBackend) MapRole(mapping ekscontrolplanev1.RoleMapping) error {
+	if errs := mapping.Validate(); errs != nil {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return b.ensureAccessEntry(mapping.RoleARN, mapping.KubernetesMapping)
+}
+
+func (b *accessEntryBackend) MapUser(mapping ekscontrolplanev1.UserMapping) error {
+	if errs := mapping.Validate(); errs != nil {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return b.ensureAccessEntry(mapping.UserARN, mapping.KubernetesMapping)
+}
+
+func (b *accessEntryBackend) ensureAccessEntry(principalARN string, mapping ekscontrolplanev1.KubernetesMapping) error {
+	ctx := context.Background()
+	b.mapped.Insert(principalARN)
+
+	entryType := ekscontrolplanev1.AccessEntryTypeStandard
+	if isNodeMapping(mapping) {
+		entryType = ekscontrolplanev1.AccessEntryTypeEC2Linux
+	}
+
+	var (
+		groups       []string
+		clusterAdmin bool
+		username     *string
+	)
+	if entryType == ekscontrolplanev1.AccessEntryTypeStandard {
+		var err error
+		groups, clusterAdmin, err = accessEntryGroups(mapping.Groups)
+		if err != nil {
+			return fmt.Errorf("mapping %s: %w", principalARN, err)
+		}
+		if mapping.UserName != "" {
+			username = aws.String(mapping.UserName)
+		}
+	}
+
+	out, err := b.client.DescribeAccessEntry(ctx, &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(b.clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+	switch {
+	case err != nil && !isNotFound(err):
+		return fmt.Errorf("describing access entry %s: %w", principalARN, err)
+	case err != nil || out.AccessEntry == nil:
+		if _, err := b.client.CreateAccessEntry(ctx, &eks.CreateAccessEntryInput{
+			ClusterName:      aws.String(b.clusterName),
+			PrincipalArn:     aws.String(principalARN),
+			Type:             aws.String(string(entryType)),
+			Username:         username,
+			KubernetesGroups: groups,
+			Tags:             b.tags,
+		}); err != nil {
+			return fmt.Errorf("creating access entry %s: %w", principalARN, err)
+		}
+	case aws.ToString(out.AccessEntry.Type) != string(entryType):
+		// An access entry of another type already exists for the principal, it
+		// has been created outside of the aws-iam-authenticator config so leave it.
+		return nil
+	case entryType == ekscontrolplanev1.AccessEntryTypeStandard && standardEntryNeedsUpdate(out.AccessEntry, username, groups):
+		if _, err := b.client.UpdateAccessEntry(ctx, &eks.UpdateAccessEntryInput{
+			ClusterName:      aws.String(b.clusterName),
+			PrincipalArn:     aws.String(principalARN),
+			Username:         username,
+			KubernetesGroups: groups,
+		}); err != nil {
+			return fmt.Errorf("updating access entry %s: %w", principalARN, err)
+		}
+	}
+
+	if !clusterAdmin {
+		return nil
+	}
+
+	return b.ensureClusterAdminPolicy(ctx, principalARN)
+}
+
+// ensureClusterAdminPolicy associates the cluster admin access policy, which is the
+// access entry equivalent of mapping a principal to the system:masters group.
+func (b *accessEntryBackend) ensureClusterAdminPolicy(ctx context.Context, principalARN string) error {
+	parsed, err := arn.Parse(principalARN)
+	if err != nil {
+		return fmt.Errorf("parsing principal arn %s: %w", principalARN, err)
+	}
+	policyARN := fmt.Sprintf(clusterAdminPolicyFormat, parsed.Partition)
+
+	out, err := b.client.ListAssociatedAccessPolicies(ctx, &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(b.clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+	if err != nil {
+		return fmt.Errorf("listing associated access policies for %s: %w", principalARN, err)
+	}
+	for _, policy := range out.AssociatedAccessPolicies {
+		if aws.ToString(policy.PolicyArn) == policyARN {
+			return nil
+		}
+	}
+
+	if _, err := b.client.AssociateAccessPolicy(ctx, &eks.AssociateAccessPolicyInput{
+		ClusterName:  aws.String(b.clusterName),
+		PrincipalArn: aws.String(principalARN),
+		PolicyArn:    aws.String(policyARN),
+		AccessScope: &ekstypes.AccessScope{
+			Type: ekstypes.AccessScopeTypeCluster,
+		},
+	}); err != nil {
+		return fmt.Errorf("associating cluster admin policy with %s: %w", principalARN, err)
+	}
+
+	return nil
+}
+
+// deleteUnmappedAccessEntries deletes the access entries created by the backend for principals
+// that weren't mapped, e.g. because their mapping was removed from the spec or because no
+// machines use the node role anymore.
+func (b *accessEntryBackend) deleteUnmappedAccessEntries() error {
+	ctx := context.Background()
+
+	principals := []string{}
+	input := &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(b.clusterName),
+	}
+	for {
+		out, err := b.client.ListAccessEntries(ctx, input)
+		if err != nil {
+			return fmt.Errorf("listing access entries: %w", err)
+		}
+		principals = append(principals, out.AccessEntries...)
+		if out.NextToken == nil {
+			break
+		}
+		input.NextToken = out.NextToken
+	}
+
+	for _, principal := range principals {
+		if b.mapped.Has(principal) {
+			continue
+		}
+
+		out, err := b.client.DescribeAccessEntry(ctx, &eks.DescribeAccessEntryInput{
+			ClusterName:  aws.String(b.clusterName),
+			PrincipalArn: aws.String(principal),
+		})
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("describing access entry %s: %w", principal, err)
+		}
+		if out.AccessEntry == nil || !b.isOwned(out.AccessEntry) {
+			continue
+		}
+
+		if _, err := b.client.DeleteAccessEntry(ctx, &eks.DeleteAccessEntryInput{
+			ClusterName:  aws.String(b.clusterName),
+			PrincipalArn: aws.String(principal),
+		}); err != nil && !isNotFound(err) {
+			return fmt.Errorf("deleting access entry %s: %w", principal, err)
+		}
+	}
+
+	return nil
+}
+
+// isOwned returns true if the access entry was created by the backend for the cluster.
+func (b *accessEntryBackend) isOwned(entry *ekstypes.AccessEntry) bool {
+	tags := infrav1.Tags(entry.Tags)
+	return tags.HasOwned(b.clusterName) && tags.GetRole() == AccessEntryRoleTagValue
+}
+
+func isNodeMapping(mapping ekscontrolplanev1.KubernetesMapping) bool {
+	if mapping.UserName != EC2NodeUserName {
+		return false
+	}
+	for _, group := range NodeGroups {
+		if !slices.Contains(mapping.Groups, group) {
+			return false
+		}
+	}
+	return true
+}
+
+// accessEntryGroups returns the groups that can be set on an access entry. Groups
+// prefixed with system: are reserved by EKS, system:masters is translated into the
+// cluster admin access policy and any other system group is rejected.
+func accessEntryGroups(groups []string) ([]string, bool, error) {
+	var (
+		result       []string
+		clusterAdmin bool
+	)
+	for _, group := range groups {
+		switch {
+		case group == systemMastersGroup:
+			clusterAdmin = true
+		case strings.HasPrefix(group, systemGroupPrefix):
+			return nil, false, fmt.Errorf("group %s cannot be used with access entries", group)
+		default:
+			result = append(result, group)
+		}
+	}
+	return result, clusterAdmin, nil
+}
+
+func standardEntryNeedsUpdate(entry *ekstypes.AccessEntry, username *string, groups []string) bool {
+	if username != nil && aws.ToString(username) != aws.ToString(entry.Username) {
+		return true
+	}
+
+	return !utils.StringSetsEqual(groups, entry.KubernetesGroups)
+}
+
+func isNotFound(err error) bool {
+	var notFoundErr *ekstypes.ResourceNotFoundException
+	return errors.As(err, &notFoundErr)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iamauth
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/iamauth/mock_iamauth"
+)
+
+func TestAddRoleMappingAccessEntry(t *testing.T) {
+	clusterName := "cluster-test"
+	nodeRoleARN := "arn:aws:iam::000000000000:role/KubernetesNode"
+	adminRoleARN := "arn:aws:iam::000000000000:role/KubernetesAdmin"
+	tags := map[string]string{"key": "value"}
+
+	testCases := []struct {
+		name        string
+		roleToMap   ekscontrolplanev1.RoleMapping
+		expect      func(m *mock_iamauth.MockEKSAPIMockRecorder)
+		expectError bool
+	}{
+		{
+			name: "node role mapping creates EC2_LINUX access entry",
+			roleToMap: ekscontrolplanev1.RoleMapping{
+				RoleARN: nodeRoleARN,
+				KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+					UserName: EC2NodeUserName,
+					Groups:   NodeGroups,
+				},
+			},
+			expect: func(m *mock_iamauth.MockEKSAPIMockRecorder) {
+				m.DescribeAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeAccessEntryInput{})).
+					Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("not found")})
+				m.CreateAccessEntry(gomock.Any(), &eks.CreateAccessEntryInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(nodeRoleARN),
+					Type:         aws.String("EC2_LINUX"),
+					Tags:         tags,
+				}).Return(&eks.CreateAccessEntryOutput{}, nil)
+			},
+		},
+		{
+			name: "existing node role access entry is left as is",
+			roleToMap: ekscontrolplanev1.RoleMapping{
+				RoleARN: nodeRoleARN,
+				KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+					UserName: EC2NodeUserName,
+					Groups:   NodeGroups,
+				},
+			},
+			expect: func(m *mock_iamauth.MockEKSAPIMockRecorder) {
+				m.DescribeAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeAccessEntryInput{})).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn: aws.String(nodeRoleARN),
+						Type:         aws.String("EC2_LINUX"),
+					}}, nil)
+			},
+		},
+		{
+			name: "system:masters mapping associates the cluster admin policy",
+			roleToMap: ekscontrolplanev1.RoleMapping{
+				RoleARN: adminRoleARN,
+				KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+					UserName: "admin:{{SessionName}}",
+					Groups:   []string{"system:masters", "admins"},
+				},
+			},
+			expect: func(m *mock_iamauth.MockEKSAPIMockRecorder) {
+				m.DescribeAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeAccessEntryInput{})).
+					Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+						PrincipalArn:     aws.String(adminRoleARN),
+						Type:             aws.String("STANDARD"),
+						Username:         aws.String("admin"),
+						KubernetesGroups: []string{"admins"},
+					}}, nil)
+				m.UpdateAccessEntry(gomock.Any(), &eks.UpdateAccessEntryInput{
+					ClusterName:      aws.String(clusterName),
+					PrincipalArn:     aws.String(adminRoleARN),
+					Username:         aws.String("admin:{{SessionName}}"),
+					KubernetesGroups: []string{"admins"},
+				}).Return(&eks.UpdateAccessEntryOutput{}, nil)
+				m.ListAssociatedAccessPolicies(gomock.Any(), gomock.AssignableToTypeOf(&eks.ListAssociatedAccessPoliciesInput{})).
+					Return(&eks.ListAssociatedAccessPoliciesOutput{}, nil)
+				m.AssociateAccessPolicy(gomock.Any(), &eks.AssociateAccessPolicyInput{
+					ClusterName:  aws.String(clusterName),
+					PrincipalArn: aws.String(adminRoleARN),
+					PolicyArn:    aws.String("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+					AccessScope: &ekstypes.AccessScope{
+						Type: ekstypes.AccessScopeTypeCluster,
+					},
+				}).Return(&eks.AssociateAccessPolicyOutput{}, nil)
+			},
+		},
+		{
+			name: "reserved system group is rejected",
+			roleToMap: ekscontrolplanev1.RoleMapping{
+				RoleARN: adminRoleARN,
+				KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+					UserName: "admin",
+					Groups:   []string{"system:bootstrappers"},
+				},
+			},
+			expect:      func(m *mock_iamauth.MockEKSAPIMockRecorder) {},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			mockControl := gomock.NewController(t)
+			defer mockControl.Finish()
+
+			eksMock := mock_iamauth.NewMockEKSAPI(mockControl)
+			tc.expect(eksMock.EXPECT())
+
+			backend, err := NewAccessEntryBackend(eksMock, clusterName, tags)
+			g.Expect(err).To(BeNil())
+
+			err = backend.MapRole(tc.roleToMap)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+				return
+			}
+			g.Expect(err).To(BeNil())
+		})
+	}
+}
+
+func TestAddUserMappingAccessEntry(t *testing.T) {
+	clusterName := "cluster-test"
+	userARN := "arn:aws:iam::000000000000:user/Alice"
+
+	g := NewGomegaWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_iamauth.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().DescribeAccessEntry(gomock.Any(), gomock.AssignableToTypeOf(&eks.DescribeAccessEntryInput{})).
+		Return(nil, &ekstypes.ResourceNotFoundException{Message: aws.String("not found")})
+	eksMock.EXPECT().CreateAccessEntry(gomock.Any(), &eks.CreateAccessEntryInput{
+		ClusterName:      aws.String(clusterName),
+		PrincipalArn:     aws.String(userARN),
+		Type:             aws.String("STANDARD"),
+		Username:         aws.String("alice"),
+		KubernetesGroups: []string{"developers"},
+	}).Return(&eks.CreateAccessEntryOutput{}, nil)
+
+	backend, err := NewAccessEntryBackend(eksMock, clusterName, nil)
+	g.Expect(err).To(BeNil())
+
+	err = backend.MapUser(ekscontrolplanev1.UserMapping{
+		UserARN: userARN,
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: "alice",
+			Groups:   []string{"developers"},
+		},
+	})
+	g.Expect(err).To(BeNil())
+}
+
+func TestDeleteUnmappedAccessEntries(t *testing.T) {
+	clusterName := "cluster-test"
+	nodeRoleARN := "arn:aws:iam::000000000000:role/KubernetesNode"
+	oldRoleARN := "arn:aws:iam::000000000000:role/Removed"
+	otherRoleARN := "arn:aws:iam::000000000000:role/Other"
+	specRoleARN := "arn:aws:iam::000000000000:role/Spec"
+	ownedTags := map[string]string{
+		"sigs.k8s.io/cluster-api-provider-aws/cluster/cluster-test": "owned",
+		"sigs.k8s.io/cluster-api-provider-aws/role":                 AccessEntryRoleTagValue,
+	}
+
+	g := NewGomegaWithT(t)
+
+	mockControl := gomock.NewController(t)
+	defer mockControl.Finish()
+
+	eksMock := mock_iamauth.NewMockEKSAPI(mockControl)
+	eksMock.EXPECT().DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(nodeRoleARN),
+	}).Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+		PrincipalArn: aws.String(nodeRoleARN),
+		Type:         aws.String("EC2_LINUX"),
+		Tags:         ownedTags,
+	}}, nil)
+	eksMock.EXPECT().ListAccessEntries(gomock.Any(), &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+	}).Return(&eks.ListAccessEntriesOutput{
+		AccessEntries: []string{nodeRoleARN, oldRoleARN},
+		NextToken:     aws.String("next"),
+	}, nil)
+	eksMock.EXPECT().ListAccessEntries(gomock.Any(), &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(clusterName),
+		NextToken:   aws.String("next"),
+	}).Return(&eks.ListAccessEntriesOutput{
+		AccessEntries: []string{otherRoleARN, specRoleARN},
+	}, nil)
+	eksMock.EXPECT().DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(oldRoleARN),
+	}).Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+		PrincipalArn: aws.String(oldRoleARN),
+		Type:         aws.String("STANDARD"),
+		Tags:         ownedTags,
+	}}, nil)
+	eksMock.EXPECT().DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(otherRoleARN),
+	}).Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+		PrincipalArn: aws.String(otherRoleARN),
+		Type:         aws.String("STANDARD"),
+	}}, nil)
+	eksMock.EXPECT().DescribeAccessEntry(gomock.Any(), &eks.DescribeAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(specRoleARN),
+	}).Return(&eks.DescribeAccessEntryOutput{AccessEntry: &ekstypes.AccessEntry{
+		PrincipalArn: aws.String(specRoleARN),
+		Type:         aws.String("STANDARD"),
+		Tags: map[string]string{
+			"sigs.k8s.io/cluster-api-provider-aws/cluster/cluster-test": "owned",
+			"sigs.k8s.io/cluster-api-provider-aws/role":                 "access-entry",
+		},
+	}}, nil)
+	eksMock.EXPECT().DeleteAccessEntry(gomock.Any(), &eks.DeleteAccessEntryInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(oldRoleARN),
+	}).Return(&eks.DeleteAccessEntryOutput{}, nil)
+
+	backend, err := NewAccessEntryBackend(eksMock, clusterName, ownedTags)
+	g.Expect(err).To(BeNil())
+
+	err = backend.MapRole(ekscontrolplanev1.RoleMapping{
+		RoleARN: nodeRoleARN,
+		KubernetesMapping: ekscontrolplanev1.KubernetesMapping{
+			UserName: EC2NodeUserName,
+			Groups:   NodeGroups,
+		},
+	})
+	g.Expect(err).To(BeNil())
+
+	err = backend.(*accessEntryBackend).deleteUnmappedAccessEntries()
+	g.Expect(err).To(BeNil())
+}
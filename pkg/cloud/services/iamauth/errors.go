@@ -25,4 +25,8 @@ var (
 	// ErrClientRequired defines an error for when a k8s client is required but
 	// not supplied.
 	ErrClientRequired = errors.New("k8s client required")
+
+	// ErrEKSClientRequired defines an error for when an EKS client is required but
+	// not supplied.
+	ErrEKSClientRequired = errors.New("eks client required")
 )
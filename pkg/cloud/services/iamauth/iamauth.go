@@ -48,6 +48,9 @@ var (
 	BackendTypeConfigMap = BackendType("config-map")
 	// BackendTypeCRD is the CRD based backend.
 	BackendTypeCRD = BackendType("crd")
+	// BackendTypeAccessEntry is the EKS access entry backend. It is created with
+	// NewAccessEntryBackend and is used for clusters in the API authentication mode.
+	BackendTypeAccessEntry = BackendType("access-entry")
 )
 
 // NewBackend will create a new authenticate backend for a given type. Only use BackendTypeConfigMap
@@ -38,15 +38,9 @@ import (
 func (s *Service) ReconcileIAMAuthenticator(ctx context.Context) error {
 	s.scope.Info("Reconciling aws-iam-authenticator configuration", "cluster", klog.KRef(s.scope.Namespace(), s.scope.Name()))
 
-	remoteClient, err := s.scope.RemoteClient()
-	if err != nil {
-		s.scope.Error(err, "getting client for remote cluster")
-		return fmt.Errorf("getting client for remote cluster: %w", err)
-	}
-
-	authBackend, err := NewBackend(s.backend, remoteClient)
+	authBackend, err := s.newAuthenticatorBackend()
 	if err != nil {
-		return fmt.Errorf("getting aws-iam-authenticator backend: %w", err)
+		return err
 	}
 	nodeRoles, err := s.getRolesForWorkers(ctx)
 	if err != nil {
@@ -87,6 +81,13 @@ func (s *Service) ReconcileIAMAuthenticator(ctx context.Context) error {
 		}
 	}
 
+	// The access entries of mappings that were removed, or of node roles that aren't used anymore, are deleted.
+	if backend, ok := authBackend.(*accessEntryBackend); ok {
+		if err := backend.deleteUnmappedAccessEntries(); err != nil {
+			return fmt.Errorf("deleting unmapped access entries: %w", err)
+		}
+	}
+
 	s.scope.Info("Reconciled aws-iam-authenticator configuration", "cluster", klog.KRef("", s.scope.Name()))
 
 	return nil
@@ -106,6 +107,34 @@ func (s *Service) getARNForRole(ctx context.Context, role string) (string, error
 	return *out.Role.Arn, nil
 }
 
+func (s *Service) newAuthenticatorBackend() (AuthenticatorBackend, error) {
+	if s.backend == BackendTypeAccessEntry {
+		tags := infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.scope.KubernetesClusterName(),
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Role:        aws.String(AccessEntryRoleTagValue),
+			Additional:  s.scope.AdditionalTags(),
+		})
+		authBackend, err := NewAccessEntryBackend(s.EKSClient, s.scope.KubernetesClusterName(), tags)
+		if err != nil {
+			return nil, fmt.Errorf("getting aws-iam-authenticator backend: %w", err)
+		}
+		return authBackend, nil
+	}
+
+	remoteClient, err := s.scope.RemoteClient()
+	if err != nil {
+		s.scope.Error(err, "getting client for remote cluster")
+		return nil, fmt.Errorf("getting client for remote cluster: %w", err)
+	}
+
+	authBackend, err := NewBackend(s.backend, remoteClient)
+	if err != nil {
+		return nil, fmt.Errorf("getting aws-iam-authenticator backend: %w", err)
+	}
+	return authBackend, nil
+}
+
 func (s *Service) getRolesForWorkers(ctx context.Context) (map[string]struct{}, error) {
 	allRoles := map[string]struct{}{}
 	if err := s.getRolesForMachineDeployments(ctx, allRoles); err != nil {
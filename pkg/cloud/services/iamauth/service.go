@@ -32,6 +32,7 @@ type Service struct {
 	backend   BackendType
 	client    client.Client
 	IAMClient IAMAPI
+	EKSClient EKSAPI
 }
 
 // IAMAPI defines the interface for IAM operations.
@@ -60,5 +61,6 @@ func NewService(iamScope scope.IAMAuthScope, backend BackendType, client client.
 		backend:   backend,
 		client:    client,
 		IAMClient: scope.NewIAMClient(iamScope, iamScope, iamScope, iamScope.InfraCluster()),
+		EKSClient: scope.NewEKSClient(iamScope, iamScope, iamScope, iamScope.InfraCluster()),
 	}
 }
@@ -16,7 +16,11 @@ limitations under the License.
 
 package instancestate
 
-import "context"
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/feature"
+)
 
 // ReconcileEC2Events will reconcile a Service's EC2 events.
 func (s Service) ReconcileEC2Events(ctx context.Context) error {
@@ -24,11 +28,23 @@ func (s Service) ReconcileEC2Events(ctx context.Context) error {
 		return err
 	}
 
-	return s.reconcileRules(ctx)
+	if err := s.reconcileRules(ctx); err != nil {
+		return err
+	}
+
+	if feature.Gates.Enabled(feature.EventBridgeInterruptionHandling) {
+		return s.reconcileInterruptionRule(ctx)
+	}
+
+	return nil
 }
 
 // DeleteEC2Events will delete a Service's EC2 events.
 func (s Service) DeleteEC2Events(ctx context.Context) error {
+	if err := s.deleteInterruptionRule(ctx); err != nil {
+		return err
+	}
+
 	if err := s.deleteRules(ctx); err != nil {
 		return err
 	}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Ec2SpotInterruptionWarning defines the EC2 Spot instance interruption warning.
+	Ec2SpotInterruptionWarning = "EC2 Spot Instance Interruption Warning"
+	// Ec2RebalanceRecommendation defines the EC2 instance rebalance recommendation.
+	Ec2RebalanceRecommendation = "EC2 Instance Rebalance Recommendation"
+	// HealthEvent defines an AWS Health event, which includes scheduled EC2 maintenance.
+	HealthEvent = "AWS Health Event"
+	// AutoScalingTerminateLifecycleAction defines the Auto Scaling lifecycle action for terminating instances.
+	AutoScalingTerminateLifecycleAction = "EC2 Instance-terminate Lifecycle Action"
+)
+
+// interruptionEventPattern matches all events announcing that an instance is about to go away. Unlike the EC2 state
+// change rule, it cannot be restricted to the instances of the cluster because the instance ID is found in a different
+// field for each source, so the consumer has to ignore events for unknown instances.
+var interruptionEventPattern = eventPattern{
+	Source: []string{"aws.ec2", "aws.health", "aws.autoscaling"},
+	DetailType: []string{
+		Ec2SpotInterruptionWarning,
+		Ec2RebalanceRecommendation,
+		HealthEvent,
+		AutoScalingTerminateLifecycleAction,
+	},
+}
+
+// reconcileInterruptionRule creates the rule for interruption events and attaches the queue as a target.
+func (s Service) reconcileInterruptionRule(ctx context.Context) error {
+	data, err := json.Marshal(interruptionEventPattern)
+	if err != nil {
+		return err
+	}
+
+	var ruleArn string
+	ruleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name: aws.String(s.getInterruptionRuleName()),
+	})
+	switch {
+	case err != nil && !resourceNotFoundError(err):
+		return errors.Wrapf(err, "unable to describe rule %s", s.getInterruptionRuleName())
+	case err == nil && aws.ToString(ruleResp.EventPattern) == string(data):
+		ruleArn = aws.ToString(ruleResp.Arn)
+	default:
+		putResp, err := s.EventBridgeClient.PutRule(ctx, &eventbridge.PutRuleInput{
+			Name:         aws.String(s.getInterruptionRuleName()),
+			EventPattern: aws.String(string(data)),
+			State:        eventbridgetypes.RuleStateEnabled,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to put rule %s", s.getInterruptionRuleName())
+		}
+		ruleArn = aws.ToString(putResp.RuleArn)
+	}
+
+	queueURLResp, err := s.SQSClient.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName: aws.String(GenerateQueueName(s.scope.Name())),
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get queue URL")
+	}
+	queueAttrs, err := s.SQSClient.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn, sqstypes.QueueAttributeNamePolicy},
+		QueueUrl:       queueURLResp.QueueUrl,
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to get queue attributes")
+	}
+	queueArn, ok := queueAttrs.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+	if !ok {
+		return errors.New("queue ARN not exist in queue attributes response")
+	}
+
+	targetsResp, err := s.EventBridgeClient.ListTargetsByRule(ctx, &eventbridge.ListTargetsByRuleInput{
+		Rule: aws.String(s.getInterruptionRuleName()),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to list targets for rule %s", s.getInterruptionRuleName())
+	}
+
+	targetFound := false
+	for _, target := range targetsResp.Targets {
+		if aws.ToString(target.Id) == GenerateQueueName(s.scope.Name()) && aws.ToString(target.Arn) == queueArn {
+			targetFound = true
+		}
+	}
+
+	if !targetFound {
+		_, err = s.EventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+			Rule: aws.String(s.getInterruptionRuleName()),
+			Targets: []eventbridgetypes.Target{{
+				Arn: aws.String(queueArn),
+				Id:  aws.String(GenerateQueueName(s.scope.Name())),
+			}},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to add SQS target %s to rule %s", GenerateQueueName(s.scope.Name()), s.getInterruptionRuleName())
+		}
+	}
+
+	// The queue policy is created together with the EC2 state change rule. Extend it so the interruption rule
+	// is authorized to emit messages to the queue as well.
+	if policy := queueAttrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)]; !strings.Contains(policy, ruleArn) {
+		ec2RuleResp, err := s.EventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+			Name: aws.String(s.getEC2RuleName()),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "unable to describe rule %s", s.getEC2RuleName())
+		}
+		return s.createPolicyForRule(ctx, &createPolicyForRuleInput{
+			QueueArn:            queueArn,
+			QueueURL:            aws.ToString(queueURLResp.QueueUrl),
+			RuleArn:             aws.ToString(ec2RuleResp.Arn),
+			InterruptionRuleArn: ruleArn,
+		})
+	}
+
+	return nil
+}
+
+func (s Service) deleteInterruptionRule(ctx context.Context) error {
+	_, err := s.EventBridgeClient.RemoveTargets(ctx, &eventbridge.RemoveTargetsInput{
+		Rule: aws.String(s.getInterruptionRuleName()),
+		Ids:  []string{GenerateQueueName(s.scope.Name())},
+	})
+	if err != nil && !resourceNotFoundError(err) {
+		return errors.Wrapf(err, "unable to remove target %s for rule %s", GenerateQueueName(s.scope.Name()), s.getInterruptionRuleName())
+	}
+	_, err = s.EventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
+		Name: aws.String(s.getInterruptionRuleName()),
+	})
+	if err != nil && resourceNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (s Service) getInterruptionRuleName() string {
+	return fmt.Sprintf("%s-interruption-rule", s.scope.Name())
+}
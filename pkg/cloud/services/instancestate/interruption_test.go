@@ -0,0 +1,238 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instancestate
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_eventbridgeiface"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/instancestate/mock_sqsiface"
+)
+
+func TestReconcileInterruptionRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ruleName := "test-cluster-interruption-rule"
+	ctx := context.TODO()
+
+	pattern, err := json.Marshal(interruptionEventPattern)
+	if err != nil {
+		t.Fatalf("got an unexpected error: %v", err)
+	}
+
+	queueExpect := func(policy string) func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+		return func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+			m.GetQueueUrl(ctx, gomock.Eq(&sqs.GetQueueUrlInput{
+				QueueName: aws.String("test-cluster-queue"),
+			})).Return(&sqs.GetQueueUrlOutput{QueueUrl: aws.String("test-cluster-queue-url")}, nil)
+			attrs := map[string]string{string(sqstypes.QueueAttributeNameQueueArn): "test-cluster-queue-arn"}
+			if policy != "" {
+				attrs[string(sqstypes.QueueAttributeNamePolicy)] = policy
+			}
+			m.GetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.GetQueueAttributesInput{})).Return(&sqs.GetQueueAttributesOutput{Attributes: attrs}, nil)
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		eventBridgeExpect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+		sqsExpect         func(m *mock_sqsiface.MockSQSAPIMockRecorder)
+		expectErr         bool
+	}{
+		{
+			name: "creates missing rule and target and extends the queue policy",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+				m.PutRule(ctx, gomock.Eq(&eventbridge.PutRuleInput{
+					Name:         aws.String(ruleName),
+					State:        eventbridgetypes.RuleStateEnabled,
+					EventPattern: aws.String(string(pattern)),
+				})).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String("interruption-rule-arn")}, nil)
+				m.ListTargetsByRule(ctx, gomock.Eq(&eventbridge.ListTargetsByRuleInput{
+					Rule: aws.String(ruleName),
+				})).Return(&eventbridge.ListTargetsByRuleOutput{}, nil)
+				m.PutTargets(ctx, gomock.Eq(&eventbridge.PutTargetsInput{
+					Rule: aws.String(ruleName),
+					Targets: []eventbridgetypes.Target{{
+						Arn: aws.String("test-cluster-queue-arn"),
+						Id:  aws.String("test-cluster-queue"),
+					}},
+				}))
+				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String("test-cluster-ec2-rule"),
+				})).Return(&eventbridge.DescribeRuleOutput{Arn: aws.String("ec2-rule-arn")}, nil)
+			},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {
+				queueExpect(`{"Statement":[{"Condition":{"ArnEquals":{"aws:SourceArn":"ec2-rule-arn"}}}]}`)(m)
+				m.SetQueueAttributes(ctx, gomock.AssignableToTypeOf(&sqs.SetQueueAttributesInput{})).
+					DoAndReturn(func(_ context.Context, input *sqs.SetQueueAttributesInput, _ ...func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+						policy := input.Attributes[string(sqstypes.QueueAttributeNamePolicy)]
+						if !strings.Contains(policy, "ec2-rule-arn") || !strings.Contains(policy, "interruption-rule-arn") {
+							return nil, errors.Errorf("policy does not allow both rules: %s", policy)
+						}
+						return nil, nil
+					})
+			},
+		},
+		{
+			name: "skips updates if rule, target and queue policy are up to date",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(&eventbridge.DescribeRuleOutput{
+					Name:         aws.String(ruleName),
+					Arn:          aws.String("interruption-rule-arn"),
+					EventPattern: aws.String(string(pattern)),
+				}, nil)
+				m.ListTargetsByRule(ctx, gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []eventbridgetypes.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil)
+			},
+			sqsExpect: queueExpect(`{"Statement":[{"Condition":{"ArnEquals":{"aws:SourceArn":"interruption-rule-arn"}}}]}`),
+		},
+		{
+			name: "updates the rule if the event pattern changed",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(&eventbridge.DescribeRuleOutput{
+					Name:         aws.String(ruleName),
+					Arn:          aws.String("interruption-rule-arn"),
+					EventPattern: aws.String(`{"source":["aws.ec2"]}`),
+				}, nil)
+				m.PutRule(ctx, gomock.AssignableToTypeOf(&eventbridge.PutRuleInput{})).Return(&eventbridge.PutRuleOutput{RuleArn: aws.String("interruption-rule-arn")}, nil)
+				m.ListTargetsByRule(ctx, gomock.AssignableToTypeOf(&eventbridge.ListTargetsByRuleInput{})).Return(&eventbridge.ListTargetsByRuleOutput{
+					Targets: []eventbridgetypes.Target{{
+						Id:  aws.String("test-cluster-queue"),
+						Arn: aws.String("test-cluster-queue-arn"),
+					}},
+				}, nil)
+			},
+			sqsExpect: queueExpect(`{"Statement":[{"Condition":{"ArnEquals":{"aws:SourceArn":"interruption-rule-arn"}}}]}`),
+		},
+		{
+			name: "returns error if DescribeRule runs into unexpected error",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.DescribeRule(ctx, gomock.Eq(&eventbridge.DescribeRuleInput{
+					Name: aws.String(ruleName),
+				})).Return(nil, errors.New("some error"))
+			},
+			sqsExpect: func(m *mock_sqsiface.MockSQSAPIMockRecorder) {},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			sqsMock := mock_sqsiface.NewMockSQSAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster")
+			g.Expect(err).To(Not(HaveOccurred()))
+			tc.sqsExpect(sqsMock.EXPECT())
+			tc.eventBridgeExpect(eventbridgeMock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EventBridgeClient = eventbridgeMock
+			s.SQSClient = sqsMock
+
+			err = s.reconcileInterruptionRule(ctx)
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestDeleteInterruptionRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	ctx := context.TODO()
+
+	testCases := []struct {
+		name              string
+		eventBridgeExpect func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder)
+		expectErr         bool
+	}{
+		{
+			name: "removes target and rule",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(ctx, gomock.Eq(&eventbridge.RemoveTargetsInput{
+					Rule: aws.String("test-cluster-interruption-rule"),
+					Ids:  []string{"test-cluster-queue"},
+				})).Return(nil, nil)
+				m.DeleteRule(ctx, gomock.Eq(&eventbridge.DeleteRuleInput{
+					Name: aws.String("test-cluster-interruption-rule"),
+				})).Return(nil, nil)
+			},
+		},
+		{
+			name: "does not error if the rule does not exist",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(ctx, gomock.Any()).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+				m.DeleteRule(ctx, gomock.Any()).Return(nil, &eventbridgetypes.ResourceNotFoundException{})
+			},
+		},
+		{
+			name: "returns error if removing the target fails",
+			eventBridgeExpect: func(m *mock_eventbridgeiface.MockEventBridgeAPIMockRecorder) {
+				m.RemoveTargets(ctx, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			eventbridgeMock := mock_eventbridgeiface.NewMockEventBridgeAPI(mockCtrl)
+			clusterScope, err := setupCluster("test-cluster")
+			g.Expect(err).To(Not(HaveOccurred()))
+			tc.eventBridgeExpect(eventbridgeMock.EXPECT())
+
+			s := NewService(clusterScope)
+			s.EventBridgeClient = eventbridgeMock
+
+			err = s.deleteInterruptionRule(ctx)
+			if tc.expectErr {
+				g.Expect(err).NotTo(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+		})
+	}
+}
@@ -83,6 +83,18 @@ func (s *Service) createPolicyForRule(ctx context.Context, input *createPolicyFo
 			},
 		},
 	}
+	if input.InterruptionRuleArn != "" {
+		policy.Statement = append(policy.Statement, iamv1.StatementEntry{
+			Sid:       fmt.Sprintf("CAPAEvents_%s_%s", s.getInterruptionRuleName(), GenerateQueueName(s.scope.Name())),
+			Effect:    iamv1.EffectAllow,
+			Principal: iamv1.Principals{iamv1.PrincipalService: iamv1.PrincipalID{"events.amazonaws.com"}},
+			Action:    iamv1.Actions{"sqs:SendMessage"},
+			Resource:  iamv1.Resources{input.QueueArn},
+			Condition: iamv1.Conditions{
+				"ArnEquals": map[string]string{"aws:SourceArn": input.InterruptionRuleArn},
+			},
+		})
+	}
 	policyData, err := json.Marshal(policy)
 	if err != nil {
 		return errors.Wrap(err, "unable to JSON marshal policy")
@@ -112,7 +124,8 @@ func queueNotFoundError(err error) bool {
 }
 
 type createPolicyForRuleInput struct {
-	QueueArn string
-	QueueURL string
-	RuleArn  string
+	QueueArn            string
+	QueueURL            string
+	RuleArn             string
+	InterruptionRuleArn string
 }
@@ -58,6 +58,15 @@ type ASGInterface interface {
 	CreateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	UpdateLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
 	DeleteLifecycleHook(ctx context.Context, asgName string, hook *expinfrav1.AWSLifecycleHook) error
+	DescribeWarmPool(asgName string) (*expinfrav1.WarmPool, error)
+	PutWarmPool(ctx context.Context, asgName string, warmPool *expinfrav1.WarmPool) error
+	DeleteWarmPool(ctx context.Context, asgName string) error
+	DescribeScheduledActions(asgName string) ([]*expinfrav1.AWSScheduledAction, error)
+	PutScheduledAction(ctx context.Context, asgName string, action *expinfrav1.AWSScheduledAction) error
+	DeleteScheduledAction(ctx context.Context, asgName string, actionName string) error
+	DescribeScalingPolicies(asgName string) ([]*expinfrav1.AWSScalingPolicy, error)
+	PutScalingPolicy(ctx context.Context, asgName string, policy *expinfrav1.AWSScalingPolicy) error
+	DeleteScalingPolicy(ctx context.Context, asgName string, policyName string) error
 }
 
 // EC2Interface encapsulates the methods exposed to the machine
@@ -76,6 +85,10 @@ type EC2Interface interface {
 	ModifyInstanceMetadataOptions(instanceID string, options *infrav1.InstanceMetadataOptions) error
 
 	TerminateInstanceAndWait(instanceID string) error
+	StopInstance(instanceID string, hibernate bool) error
+	StartInstance(instanceID string) error
+	ModifyVolumes(instanceID string, rootVolume *infrav1.Volume, nonRootVolumes []infrav1.Volume) ([]infrav1.VolumeModification, error)
+	SnapshotVolumes(instanceID string, deviceNames []string, tags infrav1.Tags) ([]infrav1.VolumeSnapshot, error)
 	DetachSecurityGroupsFromNetworkInterface(groups []string, interfaceID string) error
 
 	DiscoverLaunchTemplateAMI(ctx context.Context, scope scope.LaunchTemplateScope) (*string, error)
@@ -94,6 +107,9 @@ type EC2Interface interface {
 
 	// ReleaseElasticIP reconciles the elastic IP from a custom Public IPv4 Pool.
 	ReleaseElasticIP(instanceID string) error
+
+	// GetInstanceTypeInfo returns the EC2 description of the given instance type.
+	GetInstanceTypeInfo(instanceType string) (*ec2types.InstanceTypeInfo, error)
 }
 
 // MachinePoolReconcileInterface encapsulates high-level reconciliation functions regarding EC2 reconciliation. It is
@@ -155,6 +155,48 @@ func (mr *MockASGInterfaceMockRecorder) DeleteLifecycleHook(arg0, arg1, arg2 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLifecycleHook", reflect.TypeOf((*MockASGInterface)(nil).DeleteLifecycleHook), arg0, arg1, arg2)
 }
 
+// DeleteScalingPolicy mocks base method.
+func (m *MockASGInterface) DeleteScalingPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScalingPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScalingPolicy indicates an expected call of DeleteScalingPolicy.
+func (mr *MockASGInterfaceMockRecorder) DeleteScalingPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScalingPolicy", reflect.TypeOf((*MockASGInterface)(nil).DeleteScalingPolicy), arg0, arg1, arg2)
+}
+
+// DeleteScheduledAction mocks base method.
+func (m *MockASGInterface) DeleteScheduledAction(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledAction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledAction indicates an expected call of DeleteScheduledAction.
+func (mr *MockASGInterfaceMockRecorder) DeleteScheduledAction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledAction", reflect.TypeOf((*MockASGInterface)(nil).DeleteScheduledAction), arg0, arg1, arg2)
+}
+
+// DeleteWarmPool mocks base method.
+func (m *MockASGInterface) DeleteWarmPool(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWarmPool", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWarmPool indicates an expected call of DeleteWarmPool.
+func (mr *MockASGInterfaceMockRecorder) DeleteWarmPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWarmPool", reflect.TypeOf((*MockASGInterface)(nil).DeleteWarmPool), arg0, arg1)
+}
+
 // DescribeLifecycleHooks mocks base method.
 func (m *MockASGInterface) DescribeLifecycleHooks(arg0 string) ([]*v1beta2.AWSLifecycleHook, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +212,51 @@ func (mr *MockASGInterfaceMockRecorder) DescribeLifecycleHooks(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLifecycleHooks", reflect.TypeOf((*MockASGInterface)(nil).DescribeLifecycleHooks), arg0)
 }
 
+// DescribeScalingPolicies mocks base method.
+func (m *MockASGInterface) DescribeScalingPolicies(arg0 string) ([]*v1beta2.AWSScalingPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScalingPolicies", arg0)
+	ret0, _ := ret[0].([]*v1beta2.AWSScalingPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScalingPolicies indicates an expected call of DescribeScalingPolicies.
+func (mr *MockASGInterfaceMockRecorder) DescribeScalingPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScalingPolicies", reflect.TypeOf((*MockASGInterface)(nil).DescribeScalingPolicies), arg0)
+}
+
+// DescribeScheduledActions mocks base method.
+func (m *MockASGInterface) DescribeScheduledActions(arg0 string) ([]*v1beta2.AWSScheduledAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduledActions", arg0)
+	ret0, _ := ret[0].([]*v1beta2.AWSScheduledAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduledActions indicates an expected call of DescribeScheduledActions.
+func (mr *MockASGInterfaceMockRecorder) DescribeScheduledActions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduledActions", reflect.TypeOf((*MockASGInterface)(nil).DescribeScheduledActions), arg0)
+}
+
+// DescribeWarmPool mocks base method.
+func (m *MockASGInterface) DescribeWarmPool(arg0 string) (*v1beta2.WarmPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeWarmPool", arg0)
+	ret0, _ := ret[0].(*v1beta2.WarmPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeWarmPool indicates an expected call of DescribeWarmPool.
+func (mr *MockASGInterfaceMockRecorder) DescribeWarmPool(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWarmPool", reflect.TypeOf((*MockASGInterface)(nil).DescribeWarmPool), arg0)
+}
+
 // GetASGByName mocks base method.
 func (m *MockASGInterface) GetASGByName(arg0 *scope.MachinePoolScope) (*v1beta2.AutoScalingGroup, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +272,48 @@ func (mr *MockASGInterfaceMockRecorder) GetASGByName(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetASGByName", reflect.TypeOf((*MockASGInterface)(nil).GetASGByName), arg0)
 }
 
+// PutScalingPolicy mocks base method.
+func (m *MockASGInterface) PutScalingPolicy(arg0 context.Context, arg1 string, arg2 *v1beta2.AWSScalingPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScalingPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutScalingPolicy indicates an expected call of PutScalingPolicy.
+func (mr *MockASGInterfaceMockRecorder) PutScalingPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScalingPolicy", reflect.TypeOf((*MockASGInterface)(nil).PutScalingPolicy), arg0, arg1, arg2)
+}
+
+// PutScheduledAction mocks base method.
+func (m *MockASGInterface) PutScheduledAction(arg0 context.Context, arg1 string, arg2 *v1beta2.AWSScheduledAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutScheduledAction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutScheduledAction indicates an expected call of PutScheduledAction.
+func (mr *MockASGInterfaceMockRecorder) PutScheduledAction(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutScheduledAction", reflect.TypeOf((*MockASGInterface)(nil).PutScheduledAction), arg0, arg1, arg2)
+}
+
+// PutWarmPool mocks base method.
+func (m *MockASGInterface) PutWarmPool(arg0 context.Context, arg1 string, arg2 *v1beta2.WarmPool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutWarmPool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWarmPool indicates an expected call of PutWarmPool.
+func (mr *MockASGInterfaceMockRecorder) PutWarmPool(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWarmPool", reflect.TypeOf((*MockASGInterface)(nil).PutWarmPool), arg0, arg1, arg2)
+}
+
 // ResumeProcesses mocks base method.
 func (m *MockASGInterface) ResumeProcesses(arg0 string, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -201,6 +201,21 @@ func (mr *MockEC2InterfaceMockRecorder) GetInstanceSecurityGroups(arg0 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSecurityGroups", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceSecurityGroups), arg0)
 }
 
+// GetInstanceTypeInfo mocks base method.
+func (m *MockEC2Interface) GetInstanceTypeInfo(arg0 string) (*types.InstanceTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTypeInfo", arg0)
+	ret0, _ := ret[0].(*types.InstanceTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceTypeInfo indicates an expected call of GetInstanceTypeInfo.
+func (mr *MockEC2InterfaceMockRecorder) GetInstanceTypeInfo(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTypeInfo", reflect.TypeOf((*MockEC2Interface)(nil).GetInstanceTypeInfo), arg0)
+}
+
 // GetLaunchTemplate mocks base method.
 func (m *MockEC2Interface) GetLaunchTemplate(arg0 string) (*v1beta20.AWSLaunchTemplate, string, *types0.NamespacedName, *string, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +324,21 @@ func (mr *MockEC2InterfaceMockRecorder) ModifyInstanceMetadataOptions(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMetadataOptions", reflect.TypeOf((*MockEC2Interface)(nil).ModifyInstanceMetadataOptions), arg0, arg1)
 }
 
+// ModifyVolumes mocks base method.
+func (m *MockEC2Interface) ModifyVolumes(arg0 string, arg1 *v1beta2.Volume, arg2 []v1beta2.Volume) ([]v1beta2.VolumeModification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVolumes", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v1beta2.VolumeModification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVolumes indicates an expected call of ModifyVolumes.
+func (mr *MockEC2InterfaceMockRecorder) ModifyVolumes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolumes", reflect.TypeOf((*MockEC2Interface)(nil).ModifyVolumes), arg0, arg1, arg2)
+}
+
 // PruneLaunchTemplateVersions mocks base method.
 func (m *MockEC2Interface) PruneLaunchTemplateVersions(arg0 string) (*types.LaunchTemplateVersion, error) {
 	m.ctrl.T.Helper()
@@ -367,6 +397,49 @@ func (mr *MockEC2InterfaceMockRecorder) ReleaseElasticIP(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseElasticIP", reflect.TypeOf((*MockEC2Interface)(nil).ReleaseElasticIP), arg0)
 }
 
+// SnapshotVolumes mocks base method.
+func (m *MockEC2Interface) SnapshotVolumes(arg0 string, arg1 []string, arg2 v1beta2.Tags) ([]v1beta2.VolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotVolumes", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v1beta2.VolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotVolumes indicates an expected call of SnapshotVolumes.
+func (mr *MockEC2InterfaceMockRecorder) SnapshotVolumes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotVolumes", reflect.TypeOf((*MockEC2Interface)(nil).SnapshotVolumes), arg0, arg1, arg2)
+}
+
+// StartInstance mocks base method.
+func (m *MockEC2Interface) StartInstance(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartInstance indicates an expected call of StartInstance.
+func (mr *MockEC2InterfaceMockRecorder) StartInstance(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstance", reflect.TypeOf((*MockEC2Interface)(nil).StartInstance), arg0)
+}
+
+// StopInstance mocks base method.
+func (m *MockEC2Interface) StopInstance(arg0 string, arg1 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopInstance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopInstance indicates an expected call of StopInstance.
+func (mr *MockEC2InterfaceMockRecorder) StopInstance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstance", reflect.TypeOf((*MockEC2Interface)(nil).StopInstance), arg0, arg1)
+}
+
 // TerminateInstance mocks base method.
 func (m *MockEC2Interface) TerminateInstance(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -79,6 +79,12 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NatGatewaysReadyCondition)
 
+	// Transit gateway attachment. The condition reflects the state of the attachment, so it's only marked here on errors.
+	if err := s.reconcileTransitGatewayAttachment(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+
 	// Routing tables.
 	if err := s.reconcileRouteTables(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, infrav1.RouteTableReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
@@ -86,6 +92,18 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition)
 
+	// Network ACLs.
+	if err := s.reconcileNetworkACLs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, infrav1.NetworkACLsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+	if len(s.scope.Network().NetworkACLIDs) > 0 {
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition)
+	} else {
+		// No network ACLs are configured anymore, the condition doesn't apply.
+		conditions.Delete(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition)
+	}
+
 	// VPC Endpoints.
 	if err := s.reconcileVPCEndpoints(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, infrav1.VpcEndpointsReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
@@ -93,6 +111,15 @@ func (s *Service) ReconcileNetwork() (err error) {
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition)
 
+	// Managed prefix list.
+	if err := s.reconcilePrefixList(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PrefixListReadyCondition, infrav1.PrefixListReconciliationFailedReason, infrautilconditions.ErrorConditionAfterInit(s.scope.ClusterObj()), "%s", err.Error())
+		return err
+	}
+	if s.scope.PrefixList() != nil {
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.PrefixListReadyCondition)
+	}
+
 	s.scope.Debug("Reconcile network completed successfully")
 	return nil
 }
@@ -101,6 +128,12 @@ func (s *Service) ReconcileNetwork() (err error) {
 func (s *Service) DeleteNetwork() (err error) {
 	s.scope.Debug("Deleting network")
 
+	// Managed prefix list. The prefix list isn't attached to the VPC, so it's deleted even if the VPC is gone.
+	if err := s.deletePrefixList(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PrefixListReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+
 	vpc := &infrav1.VPCSpec{}
 	// Get VPC used for the cluster
 	if s.scope.VPC().ID != "" {
@@ -131,6 +164,15 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcEndpointsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Transit gateway attachment.
+	if err := s.deleteTransitGatewayAttachments(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+	if conditions.Has(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition) {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
 	// Routing tables.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.RouteTablesReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.scope.PatchObject(); err != nil {
@@ -205,6 +247,15 @@ func (s *Service) DeleteNetwork() (err error) {
 	}
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SubnetsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
 
+	// Network ACLs. They can only be deleted once they aren't associated with subnets anymore.
+	if err := s.deleteNetworkACLs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+	if conditions.Has(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition) {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.NetworkACLsReadyCondition, clusterv1.DeletedReason, clusterv1.ConditionSeverityInfo, "")
+	}
+
 	// Secondary CIDR.
 	conditions.MarkFalse(s.scope.InfraCluster(), infrav1.SecondaryCidrsReadyCondition, clusterv1.DeletingReason, clusterv1.ConditionSeverityInfo, "")
 	if err := s.disassociateSecondaryCidrs(); err != nil {
@@ -218,6 +269,11 @@ func (s *Service) DeleteNetwork() (err error) {
 		return err
 	}
 
+	if err := s.deleteFlowLogs(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return err
+	}
+
 	if err := s.deleteVPC(); err != nil {
 		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.VpcReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
 		return err
@@ -0,0 +1,385 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+const (
+	// maxNetworkACLRuleNumber is the highest rule number of the entries managed by the cluster,
+	// higher rule numbers are reserved for the default entries of the network ACL.
+	maxNetworkACLRuleNumber = 32766
+)
+
+// networkACLProtocolNumbers maps the protocols of the network ACL entries to their protocol numbers.
+var networkACLProtocolNumbers = map[infrav1.NetworkACLProtocol]string{
+	infrav1.NetworkACLProtocolAll:  "-1",
+	infrav1.NetworkACLProtocolTCP:  "6",
+	infrav1.NetworkACLProtocolUDP:  "17",
+	infrav1.NetworkACLProtocolICMP: "1",
+}
+
+// networkACLAssociation is the association of a subnet with a network ACL.
+type networkACLAssociation struct {
+	id           string
+	networkACLID string
+}
+
+// vpcNetworkACLs are the network ACLs of the VPC.
+type vpcNetworkACLs struct {
+	defaultID    string
+	owned        map[string]types.NetworkAcl
+	associations map[string]networkACLAssociation
+}
+
+// reconcileNetworkACLs creates a network ACL owned by the cluster for each subnet with a network ACL in the spec,
+// associates it with the subnet and reconciles its entries. The subnets without a network ACL in the spec are
+// associated back with the default network ACL of the VPC, and the network ACLs that aren't used anymore are deleted.
+// If the VPC is unmanaged, this is a no-op.
+func (s *Service) reconcileNetworkACLs() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping network ACLs reconcile in unmanaged mode")
+		return nil
+	}
+
+	subnets := s.scope.Subnets()
+	desired := map[string]*infrav1.NetworkACLSpec{}
+	for i := range subnets {
+		sn := &subnets[i]
+		if sn.ResourceID == "" {
+			continue
+		}
+		if spec := s.scope.NetworkACLs().ForSubnet(sn); spec != nil {
+			desired[sn.ResourceID] = spec
+		}
+	}
+
+	if len(desired) == 0 && len(s.scope.Network().NetworkACLIDs) == 0 {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling network ACLs")
+
+	acls, err := s.describeVPCNetworkACLs()
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+	used := sets.New[string]()
+	for i := range subnets {
+		subnetID := subnets[i].ResourceID
+		spec, ok := desired[subnetID]
+		if !ok {
+			continue
+		}
+
+		association, ok := acls.associations[subnetID]
+		if !ok {
+			return errors.Errorf("failed to find the network ACL association of subnet %q", subnetID)
+		}
+
+		acl, ok := acls.owned[association.networkACLID]
+		if !ok {
+			created, err := s.createNetworkACL(subnetID)
+			if err != nil {
+				return err
+			}
+			if err := s.replaceNetworkACLAssociation(association, subnetID, aws.ToString(created.NetworkAclId)); err != nil {
+				return err
+			}
+			acl = *created
+		}
+
+		if err := s.reconcileNetworkACLEntries(acl, spec); err != nil {
+			return err
+		}
+
+		ids = append(ids, aws.ToString(acl.NetworkAclId))
+		used.Insert(aws.ToString(acl.NetworkAclId))
+	}
+
+	if err := s.releaseNetworkACLs(acls, used); err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		ids = nil
+	}
+	s.scope.Network().NetworkACLIDs = ids
+	return nil
+}
+
+func (s *Service) deleteNetworkACLs() error {
+	// If the VPC is unmanaged or not yet populated, return early.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	acls, err := s.describeVPCNetworkACLs()
+	if err != nil {
+		return err
+	}
+
+	if err := s.releaseNetworkACLs(acls, sets.New[string]()); err != nil {
+		return err
+	}
+
+	s.scope.Network().NetworkACLIDs = nil
+	return nil
+}
+
+// releaseNetworkACLs associates the subnets using an owned network ACL that isn't used anymore with the default
+// network ACL of the VPC, and deletes the owned network ACLs that aren't used anymore.
+func (s *Service) releaseNetworkACLs(acls *vpcNetworkACLs, used sets.Set[string]) error {
+	for _, subnetID := range sets.List(sets.KeySet(acls.associations)) {
+		association := acls.associations[subnetID]
+		if _, ok := acls.owned[association.networkACLID]; !ok || used.Has(association.networkACLID) {
+			continue
+		}
+		if err := s.replaceNetworkACLAssociation(association, subnetID, acls.defaultID); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range sets.List(sets.KeySet(acls.owned)) {
+		if used.Has(id) {
+			continue
+		}
+		if _, err := s.EC2Client.DeleteNetworkAcl(context.TODO(), &ec2.DeleteNetworkAclInput{
+			NetworkAclId: aws.String(id),
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACL", "Failed to delete managed network ACL %q: %v", id, err)
+			return errors.Wrapf(err, "failed to delete network ACL %q", id)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteNetworkACL", "Deleted managed network ACL %q", id)
+	}
+
+	return nil
+}
+
+// describeVPCNetworkACLs returns the default network ACL of the VPC, the network ACLs owned by the cluster,
+// and the network ACL associations of the subnets.
+func (s *Service) describeVPCNetworkACLs() (*vpcNetworkACLs, error) {
+	acls := &vpcNetworkACLs{
+		owned:        map[string]types.NetworkAcl{},
+		associations: map[string]networkACLAssociation{},
+	}
+
+	input := &ec2.DescribeNetworkAclsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+		},
+	}
+
+	paginator := ec2.NewDescribeNetworkAclsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeNetworkACLs", "Failed to describe network ACLs in vpc %q: %v", s.scope.VPC().ID, err)
+			return nil, errors.Wrapf(err, "failed to describe network ACLs in vpc %q", s.scope.VPC().ID)
+		}
+		for _, acl := range page.NetworkAcls {
+			id := aws.ToString(acl.NetworkAclId)
+			if aws.ToBool(acl.IsDefault) {
+				acls.defaultID = id
+			}
+			if converters.TagsToMap(acl.Tags).HasOwned(s.scope.Name()) {
+				acls.owned[id] = acl
+			}
+			for _, association := range acl.Associations {
+				acls.associations[aws.ToString(association.SubnetId)] = networkACLAssociation{
+					id:           aws.ToString(association.NetworkAclAssociationId),
+					networkACLID: id,
+				}
+			}
+		}
+	}
+
+	return acls, nil
+}
+
+func (s *Service) createNetworkACL(subnetID string) (*types.NetworkAcl, error) {
+	out, err := s.EC2Client.CreateNetworkAcl(context.TODO(), &ec2.CreateNetworkAclInput{
+		VpcId: aws.String(s.scope.VPC().ID),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeNetworkAcl, s.getNetworkACLTagParams(subnetID)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateNetworkACL", "Failed to create managed network ACL for subnet %q: %v", subnetID, err)
+		return nil, errors.Wrapf(err, "failed to create network ACL for subnet %q", subnetID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateNetworkACL", "Created new managed network ACL %q for subnet %q", aws.ToString(out.NetworkAcl.NetworkAclId), subnetID)
+	return out.NetworkAcl, nil
+}
+
+func (s *Service) replaceNetworkACLAssociation(association networkACLAssociation, subnetID, networkACLID string) error {
+	if _, err := s.EC2Client.ReplaceNetworkAclAssociation(context.TODO(), &ec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(association.id),
+		NetworkAclId:  aws.String(networkACLID),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAssociateNetworkACL", "Failed to associate network ACL %q with subnet %q: %v", networkACLID, subnetID, err)
+		return errors.Wrapf(err, "failed to associate network ACL %q with subnet %q", networkACLID, subnetID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAssociateNetworkACL", "Associated network ACL %q with subnet %q", networkACLID, subnetID)
+	return nil
+}
+
+// reconcileNetworkACLEntries creates, replaces and deletes the entries of the network ACL to match the spec.
+// The default entries of the network ACL, which deny all the traffic, are left untouched.
+func (s *Service) reconcileNetworkACLEntries(acl types.NetworkAcl, spec *infrav1.NetworkACLSpec) error {
+	id := aws.ToString(acl.NetworkAclId)
+
+	for _, egress := range []bool{false, true} {
+		entries := spec.Ingress
+		if egress {
+			entries = spec.Egress
+		}
+
+		current := map[int32]types.NetworkAclEntry{}
+		for _, entry := range acl.Entries {
+			if aws.ToBool(entry.Egress) == egress && aws.ToInt32(entry.RuleNumber) <= maxNetworkACLRuleNumber {
+				current[aws.ToInt32(entry.RuleNumber)] = entry
+			}
+		}
+
+		ruleNumbers := sets.New[int32]()
+		for i := range entries {
+			input := getNetworkACLEntryInput(id, egress, i, &entries[i])
+			ruleNumber := aws.ToInt32(input.RuleNumber)
+			ruleNumbers.Insert(ruleNumber)
+
+			existing, ok := current[ruleNumber]
+			if ok && networkACLEntryMatches(existing, input) {
+				continue
+			}
+
+			var err error
+			if ok {
+				_, err = s.EC2Client.ReplaceNetworkAclEntry(context.TODO(), &ec2.ReplaceNetworkAclEntryInput{
+					NetworkAclId:  input.NetworkAclId,
+					RuleNumber:    input.RuleNumber,
+					Egress:        input.Egress,
+					Protocol:      input.Protocol,
+					RuleAction:    input.RuleAction,
+					CidrBlock:     input.CidrBlock,
+					Ipv6CidrBlock: input.Ipv6CidrBlock,
+					PortRange:     input.PortRange,
+					IcmpTypeCode:  input.IcmpTypeCode,
+				})
+			} else {
+				_, err = s.EC2Client.CreateNetworkAclEntry(context.TODO(), input)
+			}
+			if err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedSetNetworkACLEntry", "Failed to set entry %d of managed network ACL %q: %v", ruleNumber, id, err)
+				return errors.Wrapf(err, "failed to set entry %d of network ACL %q", ruleNumber, id)
+			}
+		}
+
+		for ruleNumber := range current {
+			if ruleNumbers.Has(ruleNumber) {
+				continue
+			}
+			if _, err := s.EC2Client.DeleteNetworkAclEntry(context.TODO(), &ec2.DeleteNetworkAclEntryInput{
+				NetworkAclId: aws.String(id),
+				RuleNumber:   aws.Int32(ruleNumber),
+				Egress:       aws.Bool(egress),
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteNetworkACLEntry", "Failed to delete entry %d of managed network ACL %q: %v", ruleNumber, id, err)
+				return errors.Wrapf(err, "failed to delete entry %d of network ACL %q", ruleNumber, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getNetworkACLEntryInput(networkACLID string, egress bool, index int, entry *infrav1.NetworkACLEntry) *ec2.CreateNetworkAclEntryInput {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId: aws.String(networkACLID),
+		RuleNumber:   aws.Int32(entry.GetRuleNumber(index)),
+		Egress:       aws.Bool(egress),
+		Protocol:     aws.String(networkACLProtocolNumbers[entry.GetProtocol()]),
+		RuleAction:   types.RuleAction(entry.Action),
+	}
+	if entry.CidrBlock != "" {
+		input.CidrBlock = aws.String(entry.CidrBlock)
+	}
+	if entry.IPv6CidrBlock != "" {
+		input.Ipv6CidrBlock = aws.String(entry.IPv6CidrBlock)
+	}
+
+	switch entry.GetProtocol() {
+	case infrav1.NetworkACLProtocolTCP, infrav1.NetworkACLProtocolUDP:
+		input.PortRange = &types.PortRange{
+			From: entry.FromPort,
+			To:   entry.ToPort,
+		}
+	case infrav1.NetworkACLProtocolICMP:
+		input.IcmpTypeCode = &types.IcmpTypeCode{
+			Type: aws.Int32(-1),
+			Code: aws.Int32(-1),
+		}
+	}
+
+	return input
+}
+
+// networkACLEntryMatches returns true if the existing entry of the network ACL matches the desired entry.
+func networkACLEntryMatches(existing types.NetworkAclEntry, desired *ec2.CreateNetworkAclEntryInput) bool {
+	if aws.ToString(existing.Protocol) != aws.ToString(desired.Protocol) ||
+		existing.RuleAction != desired.RuleAction ||
+		aws.ToString(existing.CidrBlock) != aws.ToString(desired.CidrBlock) ||
+		aws.ToString(existing.Ipv6CidrBlock) != aws.ToString(desired.Ipv6CidrBlock) {
+		return false
+	}
+
+	if desired.PortRange != nil {
+		return existing.PortRange != nil &&
+			aws.ToInt32(existing.PortRange.From) == aws.ToInt32(desired.PortRange.From) &&
+			aws.ToInt32(existing.PortRange.To) == aws.ToInt32(desired.PortRange.To)
+	}
+
+	return true
+}
+
+func (s *Service) getNetworkACLTagParams(subnetID string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-nacl-%s", s.scope.Name(), subnetID)),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
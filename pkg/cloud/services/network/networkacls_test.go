@@ -0,0 +1,387 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestReconcileNetworkACLs(t *testing.T) {
+	ownedTags := []types.Tag{
+		{
+			Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+			Value: aws.String("owned"),
+		},
+	}
+	defaultEntries := []types.NetworkAclEntry{
+		{RuleNumber: aws.Int32(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: types.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		{RuleNumber: aws.Int32(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: types.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+	}
+	describeNetworkACLsInput := &ec2.DescribeNetworkAclsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-1"},
+			},
+		},
+	}
+	privateACL := &infrav1.NetworkACLSpec{
+		Ingress: []infrav1.NetworkACLEntry{
+			{
+				Action:    infrav1.NetworkACLRuleActionDeny,
+				Protocol:  infrav1.NetworkACLProtocolTCP,
+				CidrBlock: "10.0.0.0/24",
+				FromPort:  aws.Int32(22),
+				ToPort:    aws.Int32(22),
+			},
+			{
+				Action:    infrav1.NetworkACLRuleActionAllow,
+				CidrBlock: "0.0.0.0/0",
+			},
+		},
+		Egress: []infrav1.NetworkACLEntry{
+			{
+				Action:    infrav1.NetworkACLRuleActionAllow,
+				CidrBlock: "0.0.0.0/0",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		networkACLs   *infrav1.NetworkACLsSpec
+		subnetACL     *infrav1.NetworkACLSpec
+		networkACLIDs []string
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		expectedIDs   []string
+		wantErr       bool
+	}{
+		{
+			name: "no network ACLs specified, nothing to do",
+		},
+		{
+			name:        "network ACL doesn't exist, creates and associates it",
+			networkACLs: &infrav1.NetworkACLsSpec{Private: privateACL},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.Any(), gomock.Eq(describeNetworkACLsInput), gomock.Any()).
+					Return(&ec2.DescribeNetworkAclsOutput{
+						NetworkAcls: []types.NetworkAcl{
+							{
+								NetworkAclId: aws.String("acl-default"),
+								IsDefault:    aws.Bool(true),
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), SubnetId: aws.String("subnet-private")},
+									{NetworkAclAssociationId: aws.String("aclassoc-public"), SubnetId: aws.String("subnet-public")},
+								},
+							},
+						},
+					}, nil)
+				m.CreateNetworkAcl(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkAclInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateNetworkAclInput, _ ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-1")))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeNetworkAcl))
+						return &ec2.CreateNetworkAclOutput{
+							NetworkAcl: &types.NetworkAcl{
+								NetworkAclId: aws.String("acl-1"),
+								Entries:      defaultEntries,
+							},
+						}, nil
+					})
+				m.ReplaceNetworkAclAssociation(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+					AssociationId: aws.String("aclassoc-private"),
+					NetworkAclId:  aws.String("acl-1"),
+				})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil)
+				m.CreateNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(100),
+					Egress:       aws.Bool(false),
+					Protocol:     aws.String("6"),
+					RuleAction:   types.RuleActionDeny,
+					CidrBlock:    aws.String("10.0.0.0/24"),
+					PortRange:    &types.PortRange{From: aws.Int32(22), To: aws.Int32(22)},
+				})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil)
+				m.CreateNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(200),
+					Egress:       aws.Bool(false),
+					Protocol:     aws.String("-1"),
+					RuleAction:   types.RuleActionAllow,
+					CidrBlock:    aws.String("0.0.0.0/0"),
+				})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil)
+				m.CreateNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.CreateNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(100),
+					Egress:       aws.Bool(true),
+					Protocol:     aws.String("-1"),
+					RuleAction:   types.RuleActionAllow,
+					CidrBlock:    aws.String("0.0.0.0/0"),
+				})).Return(&ec2.CreateNetworkAclEntryOutput{}, nil)
+			},
+			expectedIDs: []string{"acl-1"},
+		},
+		{
+			name:          "entries changed, replaces and deletes entries",
+			networkACLs:   &infrav1.NetworkACLsSpec{Private: privateACL},
+			networkACLIDs: []string{"acl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.Any(), gomock.Eq(describeNetworkACLsInput), gomock.Any()).
+					Return(&ec2.DescribeNetworkAclsOutput{
+						NetworkAcls: []types.NetworkAcl{
+							{
+								NetworkAclId: aws.String("acl-default"),
+								IsDefault:    aws.Bool(true),
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-public"), SubnetId: aws.String("subnet-public")},
+								},
+							},
+							{
+								NetworkAclId: aws.String("acl-1"),
+								Tags:         ownedTags,
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), SubnetId: aws.String("subnet-private")},
+								},
+								Entries: append([]types.NetworkAclEntry{
+									// In sync.
+									{RuleNumber: aws.Int32(100), Egress: aws.Bool(false), Protocol: aws.String("6"), RuleAction: types.RuleActionDeny, CidrBlock: aws.String("10.0.0.0/24"), PortRange: &types.PortRange{From: aws.Int32(22), To: aws.Int32(22)}},
+									// Action changed.
+									{RuleNumber: aws.Int32(200), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: types.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+									// Removed from the spec.
+									{RuleNumber: aws.Int32(300), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: types.RuleActionAllow, CidrBlock: aws.String("10.1.0.0/16")},
+									// In sync.
+									{RuleNumber: aws.Int32(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: types.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+								}, defaultEntries...),
+							},
+						},
+					}, nil)
+				m.ReplaceNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(200),
+					Egress:       aws.Bool(false),
+					Protocol:     aws.String("-1"),
+					RuleAction:   types.RuleActionAllow,
+					CidrBlock:    aws.String("0.0.0.0/0"),
+				})).Return(&ec2.ReplaceNetworkAclEntryOutput{}, nil)
+				m.DeleteNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(300),
+					Egress:       aws.Bool(false),
+				})).Return(&ec2.DeleteNetworkAclEntryOutput{}, nil)
+			},
+			expectedIDs: []string{"acl-1"},
+		},
+		{
+			name: "subnet overrides the network ACL of its role",
+			networkACLs: &infrav1.NetworkACLsSpec{
+				Private: privateACL,
+			},
+			subnetACL:     &infrav1.NetworkACLSpec{},
+			networkACLIDs: []string{"acl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.Any(), gomock.Eq(describeNetworkACLsInput), gomock.Any()).
+					Return(&ec2.DescribeNetworkAclsOutput{
+						NetworkAcls: []types.NetworkAcl{
+							{
+								NetworkAclId: aws.String("acl-1"),
+								Tags:         ownedTags,
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), SubnetId: aws.String("subnet-private")},
+								},
+								Entries: append([]types.NetworkAclEntry{
+									{RuleNumber: aws.Int32(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: types.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+								}, defaultEntries...),
+							},
+						},
+					}, nil)
+				m.DeleteNetworkAclEntry(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclEntryInput{
+					NetworkAclId: aws.String("acl-1"),
+					RuleNumber:   aws.Int32(100),
+					Egress:       aws.Bool(true),
+				})).Return(&ec2.DeleteNetworkAclEntryOutput{}, nil)
+			},
+			expectedIDs: []string{"acl-1"},
+		},
+		{
+			name:          "network ACLs removed from the spec, restores the default network ACL and deletes them",
+			networkACLIDs: []string{"acl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.Any(), gomock.Eq(describeNetworkACLsInput), gomock.Any()).
+					Return(&ec2.DescribeNetworkAclsOutput{
+						NetworkAcls: []types.NetworkAcl{
+							{
+								NetworkAclId: aws.String("acl-default"),
+								IsDefault:    aws.Bool(true),
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-public"), SubnetId: aws.String("subnet-public")},
+								},
+							},
+							{
+								NetworkAclId: aws.String("acl-1"),
+								Tags:         ownedTags,
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), SubnetId: aws.String("subnet-private")},
+								},
+							},
+						},
+					}, nil)
+				m.ReplaceNetworkAclAssociation(context.TODO(), gomock.Eq(&ec2.ReplaceNetworkAclAssociationInput{
+					AssociationId: aws.String("aclassoc-private"),
+					NetworkAclId:  aws.String("acl-default"),
+				})).Return(&ec2.ReplaceNetworkAclAssociationOutput{}, nil)
+				m.DeleteNetworkAcl(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclInput{
+					NetworkAclId: aws.String("acl-1"),
+				})).Return(&ec2.DeleteNetworkAclOutput{}, nil)
+			},
+		},
+		{
+			name:        "failed to create network ACL",
+			networkACLs: &infrav1.NetworkACLsSpec{Private: privateACL},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeNetworkAcls(gomock.Any(), gomock.Eq(describeNetworkACLsInput), gomock.Any()).
+					Return(&ec2.DescribeNetworkAclsOutput{
+						NetworkAcls: []types.NetworkAcl{
+							{
+								NetworkAclId: aws.String("acl-default"),
+								IsDefault:    aws.Bool(true),
+								Associations: []types.NetworkAclAssociation{
+									{NetworkAclAssociationId: aws.String("aclassoc-private"), SubnetId: aws.String("subnet-private")},
+								},
+							},
+						},
+					}, nil)
+				m.CreateNetworkAcl(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateNetworkAclInput{})).
+					Return(nil, &smithy.GenericAPIError{Code: "NetworkAclLimitExceeded"})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := newNetworkACLTestScope(tc.networkACLs, tc.subnetACL, tc.networkACLIDs)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileNetworkACLs()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().NetworkACLIDs).To(Equal(tc.expectedIDs))
+		})
+	}
+}
+
+func TestDeleteNetworkACLs(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeNetworkAcls(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeNetworkAclsInput{}), gomock.Any()).
+		Return(&ec2.DescribeNetworkAclsOutput{
+			NetworkAcls: []types.NetworkAcl{
+				{
+					NetworkAclId: aws.String("acl-default"),
+					IsDefault:    aws.Bool(true),
+				},
+				{
+					NetworkAclId: aws.String("acl-1"),
+					Tags: []types.Tag{
+						{
+							Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+							Value: aws.String("owned"),
+						},
+					},
+				},
+			},
+		}, nil)
+	ec2Mock.EXPECT().DeleteNetworkAcl(context.TODO(), gomock.Eq(&ec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String("acl-1"),
+	})).Return(&ec2.DeleteNetworkAclOutput{}, nil)
+
+	clusterScope, err := newNetworkACLTestScope(nil, nil, []string{"acl-1"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.deleteNetworkACLs()).To(Succeed())
+	g.Expect(clusterScope.Network().NetworkACLIDs).To(BeNil())
+}
+
+func newNetworkACLTestScope(networkACLs *infrav1.NetworkACLsSpec, subnetACL *infrav1.NetworkACLSpec, networkACLIDs []string) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ID: "vpc-1",
+						Tags: infrav1.Tags{
+							infrav1.ClusterTagKey("test-cluster"): "owned",
+						},
+					},
+					Subnets: infrav1.Subnets{
+						{ID: "private", ResourceID: "subnet-private", AvailabilityZone: "us-east-1a", NetworkACL: subnetACL},
+						{ID: "public", ResourceID: "subnet-public", AvailabilityZone: "us-east-1a", IsPublic: true},
+						{ID: "pending", AvailabilityZone: "us-east-1b"},
+					},
+					NetworkACLs: networkACLs,
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					NetworkACLIDs: networkACLIDs,
+				},
+			},
+		},
+	})
+}
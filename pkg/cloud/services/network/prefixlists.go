@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/awserrors"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/converters"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	defaultPrefixListMaxEntries    = 20
+	defaultPrefixListAddressFamily = "IPv4"
+)
+
+// reconcilePrefixList creates the managed prefix list owned by the cluster and keeps its entries
+// in sync with the spec. Every modification of a prefix list creates a new version of the prefix list,
+// so a single modification is made per reconciliation.
+func (s *Service) reconcilePrefixList() error {
+	spec := s.scope.PrefixList()
+	if spec == nil {
+		if s.scope.Network().PrefixListID == "" {
+			return nil
+		}
+		s.deleteRemovedPrefixList()
+		return nil
+	}
+
+	s.scope.Debug("Reconciling managed prefix list")
+
+	prefixList, err := s.describeOwnedPrefixList()
+	if err != nil {
+		return err
+	}
+
+	if prefixList == nil {
+		prefixList, err = s.createPrefixList(spec)
+		if err != nil {
+			return err
+		}
+		s.scope.Network().PrefixListID = aws.ToString(prefixList.PrefixListId)
+		return nil
+	}
+
+	s.scope.Network().PrefixListID = aws.ToString(prefixList.PrefixListId)
+
+	switch prefixList.State {
+	case types.PrefixListStateCreateComplete, types.PrefixListStateModifyComplete, types.PrefixListStateRestoreComplete:
+	default:
+		s.scope.Debug("Managed prefix list can't be modified yet", "prefix-list-id", aws.ToString(prefixList.PrefixListId), "state", prefixList.State)
+		return nil
+	}
+
+	maxEntries := prefixListMaxEntries(spec)
+	currentMaxEntries := aws.ToInt32(prefixList.MaxEntries)
+
+	// The maximum number of entries must be increased before adding entries, and decreased
+	// after removing them.
+	if maxEntries > currentMaxEntries {
+		return s.modifyPrefixListMaxEntries(prefixList, maxEntries)
+	}
+
+	currentEntries, err := s.getPrefixListEntries(aws.ToString(prefixList.PrefixListId))
+	if err != nil {
+		return err
+	}
+
+	toAdd, toRemove := prefixListEntriesDiff(currentEntries, spec.Entries)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		return s.modifyPrefixListEntries(prefixList, toAdd, toRemove)
+	}
+
+	if maxEntries < currentMaxEntries {
+		return s.modifyPrefixListMaxEntries(prefixList, maxEntries)
+	}
+
+	return nil
+}
+
+func (s *Service) deletePrefixList() error {
+	prefixList, err := s.describeOwnedPrefixList()
+	if err != nil {
+		return err
+	}
+	if prefixList == nil {
+		s.scope.Network().PrefixListID = ""
+		return nil
+	}
+
+	id := aws.ToString(prefixList.PrefixListId)
+	if prefixList.State == types.PrefixListStateDeleteInProgress || prefixList.State == types.PrefixListStateDeleteComplete {
+		s.scope.Network().PrefixListID = ""
+		return nil
+	}
+
+	if _, err := s.EC2Client.DeleteManagedPrefixList(context.TODO(), &ec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(id),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeletePrefixList", "Failed to delete managed prefix list %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete managed prefix list %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeletePrefixList", "Deleted managed prefix list %q", id)
+	s.scope.Network().PrefixListID = ""
+	return nil
+}
+
+// deleteRemovedPrefixList deletes the managed prefix list owned by the cluster once it is removed from the spec.
+// Security group rules referencing the prefix list are only updated after the network, so a failed deletion
+// is reported in the condition and retried on the next reconciliation instead of blocking the reconciliation.
+func (s *Service) deleteRemovedPrefixList() {
+	s.scope.Debug("Deleting managed prefix list removed from the spec", "prefix-list-id", s.scope.Network().PrefixListID)
+
+	if err := s.deletePrefixList(); err != nil {
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.PrefixListReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		return
+	}
+	conditions.Delete(s.scope.InfraCluster(), infrav1.PrefixListReadyCondition)
+}
+
+// describeOwnedPrefixList returns the managed prefix list owned by the cluster, or nil if it doesn't exist.
+// The prefix list is looked up by ID once known, and by name otherwise.
+func (s *Service) describeOwnedPrefixList() (*types.ManagedPrefixList, error) {
+	input := &ec2.DescribeManagedPrefixListsInput{}
+	if id := s.scope.Network().PrefixListID; id != "" {
+		input.PrefixListIds = []string{id}
+	} else {
+		if s.scope.PrefixList() == nil {
+			return nil, nil
+		}
+		input.Filters = []types.Filter{
+			{
+				Name:   aws.String("prefix-list-name"),
+				Values: []string{s.getPrefixListName(s.scope.PrefixList())},
+			},
+		}
+	}
+
+	out, err := s.EC2Client.DescribeManagedPrefixLists(context.TODO(), input)
+	if err != nil {
+		if code, ok := awserrors.Code(err); ok && code == awserrors.PrefixListNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to describe managed prefix lists")
+	}
+
+	for i := range out.PrefixLists {
+		prefixList := out.PrefixLists[i]
+		if prefixList.State == types.PrefixListStateDeleteComplete {
+			continue
+		}
+		if !converters.TagsToMap(prefixList.Tags).HasOwned(s.scope.Name()) {
+			return nil, errors.Errorf("managed prefix list %q already exists and isn't owned by the cluster", aws.ToString(prefixList.PrefixListName))
+		}
+		return &prefixList, nil
+	}
+
+	return nil, nil
+}
+
+func (s *Service) createPrefixList(spec *infrav1.PrefixListSpec) (*types.ManagedPrefixList, error) {
+	addressFamily := spec.AddressFamily
+	if addressFamily == "" {
+		addressFamily = defaultPrefixListAddressFamily
+	}
+
+	name := s.getPrefixListName(spec)
+	out, err := s.EC2Client.CreateManagedPrefixList(context.TODO(), &ec2.CreateManagedPrefixListInput{
+		PrefixListName: aws.String(name),
+		AddressFamily:  aws.String(addressFamily),
+		MaxEntries:     aws.Int32(prefixListMaxEntries(spec)),
+		Entries:        prefixListEntriesToSDKType(spec.Entries),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypePrefixList, s.getPrefixListTagParams(name)),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreatePrefixList", "Failed to create new managed prefix list %q: %v", name, err)
+		return nil, errors.Wrapf(err, "failed to create managed prefix list %q", name)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreatePrefixList", "Created new managed prefix list %q with id %q", name, aws.ToString(out.PrefixList.PrefixListId))
+	return out.PrefixList, nil
+}
+
+func (s *Service) modifyPrefixListMaxEntries(prefixList *types.ManagedPrefixList, maxEntries int32) error {
+	id := aws.ToString(prefixList.PrefixListId)
+	if _, err := s.EC2Client.ModifyManagedPrefixList(context.TODO(), &ec2.ModifyManagedPrefixListInput{
+		PrefixListId: aws.String(id),
+		MaxEntries:   aws.Int32(maxEntries),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyPrefixList", "Failed to modify the maximum number of entries of managed prefix list %q: %v", id, err)
+		return errors.Wrapf(err, "failed to modify the maximum number of entries of managed prefix list %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyPrefixList", "Modified the maximum number of entries of managed prefix list %q to %d", id, maxEntries)
+	return nil
+}
+
+func (s *Service) modifyPrefixListEntries(prefixList *types.ManagedPrefixList, toAdd []infrav1.PrefixListEntry, toRemove []string) error {
+	id := aws.ToString(prefixList.PrefixListId)
+	input := &ec2.ModifyManagedPrefixListInput{
+		PrefixListId:   aws.String(id),
+		CurrentVersion: prefixList.Version,
+		AddEntries:     prefixListEntriesToSDKType(toAdd),
+	}
+	for _, cidr := range toRemove {
+		input.RemoveEntries = append(input.RemoveEntries, types.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+	}
+
+	if _, err := s.EC2Client.ModifyManagedPrefixList(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyPrefixList", "Failed to modify the entries of managed prefix list %q: %v", id, err)
+		return errors.Wrapf(err, "failed to modify the entries of managed prefix list %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyPrefixList", "Modified the entries of managed prefix list %q", id)
+	return nil
+}
+
+func (s *Service) getPrefixListEntries(id string) ([]types.PrefixListEntry, error) {
+	entries := []types.PrefixListEntry{}
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(s.EC2Client, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the entries of managed prefix list %q", id)
+		}
+		entries = append(entries, page.Entries...)
+	}
+	return entries, nil
+}
+
+func (s *Service) getPrefixListName(spec *infrav1.PrefixListSpec) string {
+	if spec.Name != "" {
+		return spec.Name
+	}
+	return fmt.Sprintf("%s-prefix-list", s.scope.Name())
+}
+
+func (s *Service) getPrefixListTagParams(name string) infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(name),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
+func prefixListMaxEntries(spec *infrav1.PrefixListSpec) int32 {
+	if spec.MaxEntries > 0 {
+		return spec.MaxEntries
+	}
+	return defaultPrefixListMaxEntries
+}
+
+// prefixListEntriesDiff returns the entries to add to the prefix list and the CIDR blocks to remove from it.
+// An entry is added again when its description changed, which updates the description of the existing entry.
+func prefixListEntriesDiff(current []types.PrefixListEntry, desired []infrav1.PrefixListEntry) ([]infrav1.PrefixListEntry, []string) {
+	currentDescriptions := make(map[string]string, len(current))
+	for _, entry := range current {
+		currentDescriptions[aws.ToString(entry.Cidr)] = aws.ToString(entry.Description)
+	}
+
+	desiredCidrs := make(map[string]struct{}, len(desired))
+	toAdd := []infrav1.PrefixListEntry{}
+	for _, entry := range desired {
+		desiredCidrs[entry.CIDR] = struct{}{}
+		if description, ok := currentDescriptions[entry.CIDR]; !ok || description != entry.Description {
+			toAdd = append(toAdd, entry)
+		}
+	}
+
+	toRemove := []string{}
+	for _, entry := range current {
+		if _, ok := desiredCidrs[aws.ToString(entry.Cidr)]; !ok {
+			toRemove = append(toRemove, aws.ToString(entry.Cidr))
+		}
+	}
+
+	return toAdd, toRemove
+}
+
+func prefixListEntriesToSDKType(entries []infrav1.PrefixListEntry) []types.AddPrefixListEntry {
+	var res []types.AddPrefixListEntry
+	for _, entry := range entries {
+		e := types.AddPrefixListEntry{
+			Cidr: aws.String(entry.CIDR),
+		}
+		if entry.Description != "" {
+			e.Description = aws.String(entry.Description)
+		}
+		res = append(res, e)
+	}
+	return res
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcilePrefixList(t *testing.T) {
+	ownedTags := []types.Tag{
+		{
+			Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+			Value: aws.String("owned"),
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		prefixList           *infrav1.PrefixListSpec
+		prefixListID         string
+		expect               func(m *mocks.MockEC2APIMockRecorder)
+		expectedPrefixListID string
+		expectedNotReady     bool
+		wantErr              bool
+	}{
+		{
+			name: "no prefix list specified, nothing to do",
+		},
+		{
+			name:         "prefix list removed from the spec, deletes it",
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					PrefixListIds: []string{"pl-1"},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{
+						{
+							PrefixListId: aws.String("pl-1"),
+							State:        types.PrefixListStateCreateComplete,
+							Tags:         ownedTags,
+						},
+					},
+				}, nil)
+				m.DeleteManagedPrefixList(context.TODO(), gomock.Eq(&ec2.DeleteManagedPrefixListInput{
+					PrefixListId: aws.String("pl-1"),
+				})).Return(&ec2.DeleteManagedPrefixListOutput{}, nil)
+			},
+		},
+		{
+			name:         "prefix list removed from the spec is still in use, retries later",
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId: aws.String("pl-1"),
+								State:        types.PrefixListStateCreateComplete,
+								Tags:         ownedTags,
+							},
+						},
+					}, nil)
+				m.DeleteManagedPrefixList(context.TODO(), gomock.AssignableToTypeOf(&ec2.DeleteManagedPrefixListInput{})).
+					Return(nil, errors.New("InvalidPrefixListModification: the prefix list is in use"))
+			},
+			expectedPrefixListID: "pl-1",
+			expectedNotReady:     true,
+		},
+		{
+			name: "prefix list doesn't exist, creates it",
+			prefixList: &infrav1.PrefixListSpec{
+				Entries: []infrav1.PrefixListEntry{
+					{CIDR: "10.0.0.0/16", Description: "office"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					Filters: []types.Filter{
+						{
+							Name:   aws.String("prefix-list-name"),
+							Values: []string{"test-cluster-prefix-list"},
+						},
+					},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{}, nil)
+				m.CreateManagedPrefixList(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateManagedPrefixListInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateManagedPrefixListInput, _ ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.PrefixListName).To(Equal(aws.String("test-cluster-prefix-list")))
+						g.Expect(input.AddressFamily).To(Equal(aws.String("IPv4")))
+						g.Expect(input.MaxEntries).To(Equal(aws.Int32(20)))
+						g.Expect(input.Entries).To(Equal([]types.AddPrefixListEntry{
+							{Cidr: aws.String("10.0.0.0/16"), Description: aws.String("office")},
+						}))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypePrefixList))
+						return &ec2.CreateManagedPrefixListOutput{
+							PrefixList: &types.ManagedPrefixList{
+								PrefixListId:   aws.String("pl-1"),
+								PrefixListName: input.PrefixListName,
+								State:          types.PrefixListStateCreateInProgress,
+							},
+						}, nil
+					})
+			},
+			expectedPrefixListID: "pl-1",
+		},
+		{
+			name: "prefix list is in sync, nothing to do",
+			prefixList: &infrav1.PrefixListSpec{
+				Name: "corporate",
+				Entries: []infrav1.PrefixListEntry{
+					{CIDR: "10.0.0.0/16"},
+				},
+			},
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					PrefixListIds: []string{"pl-1"},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{
+						{
+							PrefixListId:   aws.String("pl-1"),
+							PrefixListName: aws.String("corporate"),
+							MaxEntries:     aws.Int32(20),
+							State:          types.PrefixListStateCreateComplete,
+							Version:        aws.Int64(1),
+							Tags:           ownedTags,
+						},
+					},
+				}, nil)
+				m.GetManagedPrefixListEntries(gomock.Any(), gomock.Eq(&ec2.GetManagedPrefixListEntriesInput{
+					PrefixListId: aws.String("pl-1"),
+				}), gomock.Any()).Return(&ec2.GetManagedPrefixListEntriesOutput{
+					Entries: []types.PrefixListEntry{
+						{Cidr: aws.String("10.0.0.0/16")},
+					},
+				}, nil)
+			},
+			expectedPrefixListID: "pl-1",
+		},
+		{
+			name: "entries changed, modifies the prefix list",
+			prefixList: &infrav1.PrefixListSpec{
+				Entries: []infrav1.PrefixListEntry{
+					{CIDR: "10.0.0.0/16", Description: "office"},
+					{CIDR: "10.1.0.0/16"},
+				},
+			},
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId: aws.String("pl-1"),
+								MaxEntries:   aws.Int32(20),
+								State:        types.PrefixListStateModifyComplete,
+								Version:      aws.Int64(3),
+								Tags:         ownedTags,
+							},
+						},
+					}, nil)
+				m.GetManagedPrefixListEntries(gomock.Any(), gomock.AssignableToTypeOf(&ec2.GetManagedPrefixListEntriesInput{}), gomock.Any()).
+					Return(&ec2.GetManagedPrefixListEntriesOutput{
+						Entries: []types.PrefixListEntry{
+							{Cidr: aws.String("10.0.0.0/16")},
+							{Cidr: aws.String("192.168.0.0/16")},
+						},
+					}, nil)
+				m.ModifyManagedPrefixList(context.TODO(), gomock.Eq(&ec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String("pl-1"),
+					CurrentVersion: aws.Int64(3),
+					AddEntries: []types.AddPrefixListEntry{
+						{Cidr: aws.String("10.0.0.0/16"), Description: aws.String("office")},
+						{Cidr: aws.String("10.1.0.0/16")},
+					},
+					RemoveEntries: []types.RemovePrefixListEntry{
+						{Cidr: aws.String("192.168.0.0/16")},
+					},
+				})).Return(&ec2.ModifyManagedPrefixListOutput{}, nil)
+			},
+			expectedPrefixListID: "pl-1",
+		},
+		{
+			name: "max entries increased, modifies the max entries first",
+			prefixList: &infrav1.PrefixListSpec{
+				MaxEntries: 50,
+			},
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId: aws.String("pl-1"),
+								MaxEntries:   aws.Int32(20),
+								State:        types.PrefixListStateCreateComplete,
+								Tags:         ownedTags,
+							},
+						},
+					}, nil)
+				m.ModifyManagedPrefixList(context.TODO(), gomock.Eq(&ec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String("pl-1"),
+					MaxEntries:   aws.Int32(50),
+				})).Return(&ec2.ModifyManagedPrefixListOutput{}, nil)
+			},
+			expectedPrefixListID: "pl-1",
+		},
+		{
+			name: "prefix list is being modified, nothing to do",
+			prefixList: &infrav1.PrefixListSpec{
+				MaxEntries: 50,
+			},
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId: aws.String("pl-1"),
+								MaxEntries:   aws.Int32(20),
+								State:        types.PrefixListStateModifyInProgress,
+								Tags:         ownedTags,
+							},
+						},
+					}, nil)
+			},
+			expectedPrefixListID: "pl-1",
+		},
+		{
+			name:       "prefix list with the same name isn't owned by the cluster",
+			prefixList: &infrav1.PrefixListSpec{},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId:   aws.String("pl-other"),
+								PrefixListName: aws.String("test-cluster-prefix-list"),
+								State:          types.PrefixListStateCreateComplete,
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							PrefixList: tc.prefixList,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							PrefixListID: tc.prefixListID,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcilePrefixList()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().PrefixListID).To(Equal(tc.expectedPrefixListID))
+			g.Expect(conditions.IsFalse(clusterScope.InfraCluster(), infrav1.PrefixListReadyCondition)).To(Equal(tc.expectedNotReady))
+		})
+	}
+}
+
+func TestDeletePrefixList(t *testing.T) {
+	testCases := []struct {
+		name         string
+		prefixListID string
+		expect       func(m *mocks.MockEC2APIMockRecorder)
+		wantErr      bool
+	}{
+		{
+			name: "no prefix list, nothing to do",
+		},
+		{
+			name:         "deletes the prefix list",
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					PrefixListIds: []string{"pl-1"},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{
+						{
+							PrefixListId: aws.String("pl-1"),
+							State:        types.PrefixListStateCreateComplete,
+							Tags: []types.Tag{
+								{
+									Key:   aws.String(infrav1.ClusterTagKey("test-cluster")),
+									Value: aws.String("owned"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.DeleteManagedPrefixList(context.TODO(), gomock.Eq(&ec2.DeleteManagedPrefixListInput{
+					PrefixListId: aws.String("pl-1"),
+				})).Return(&ec2.DeleteManagedPrefixListOutput{}, nil)
+			},
+		},
+		{
+			name:         "prefix list is already gone",
+			prefixListID: "pl-1",
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{
+						PrefixLists: []types.ManagedPrefixList{
+							{
+								PrefixListId: aws.String("pl-1"),
+								State:        types.PrefixListStateDeleteComplete,
+							},
+						},
+					}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							PrefixListID: tc.prefixListID,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.deletePrefixList()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().PrefixListID).To(BeEmpty())
+		})
+	}
+}
@@ -84,6 +84,12 @@ func (s *Service) reconcileRouteTables() error {
 				}
 			}
 
+			// Routes through the transit gateway are added once the attachment is available, and removed when
+			// they aren't part of the spec anymore.
+			if err := s.reconcileTransitGatewayRoutes(sn, rt); err != nil {
+				return err
+			}
+
 			// Make sure tags are up-to-date.
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getRouteTableTagParams(aws.ToString(rt.RouteTableId), sn.IsPublic, sn.AvailabilityZone)
@@ -127,6 +133,10 @@ func (s *Service) reconcileRouteTables() error {
 }
 
 func (s *Service) fixMismatchedRouting(specRoute *ec2.CreateRouteInput, currentRoute types.Route, rt types.RouteTable) error {
+	// Routes through the transit gateway never replace existing routes, see reconcileTransitGatewayRoutes.
+	if specRoute.TransitGatewayId != nil {
+		return nil
+	}
 	var input *ec2.ReplaceRouteInput
 	if specRoute.DestinationCidrBlock != nil {
 		if (currentRoute.DestinationCidrBlock != nil &&
@@ -427,8 +437,27 @@ func (s *Service) getRoutesToPrivateSubnet(sn *infrav1.SubnetSpec) (routes []*ec
 }
 
 func (s *Service) getRoutesForSubnet(sn *infrav1.SubnetSpec) ([]*ec2.CreateRouteInput, error) {
+	var routes []*ec2.CreateRouteInput
+	var err error
 	if sn.IsPublic {
-		return s.getRoutesToPublicSubnet(sn)
+		routes, err = s.getRoutesToPublicSubnet(sn)
+	} else {
+		routes, err = s.getRoutesToPrivateSubnet(sn)
+	}
+	if err != nil {
+		return routes, err
+	}
+
+	transitGatewayRoutes, _ := s.getTransitGatewayRoutes(sn)
+	return append(routes, transitGatewayRoutes...), nil
+}
+
+// hasRouteTo returns true if one of the routes has the given IPv4 destination.
+func hasRouteTo(routes []*ec2.CreateRouteInput, cidrBlock string) bool {
+	for _, route := range routes {
+		if aws.ToString(route.DestinationCidrBlock) == cidrBlock {
+			return true
+		}
 	}
-	return s.getRoutesToPrivateSubnet(sn)
+	return false
 }
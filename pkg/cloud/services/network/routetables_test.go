@@ -48,6 +48,7 @@ func TestReconcileRouteTables(t *testing.T) {
 	testCases := []struct {
 		name   string
 		input  *infrav1.NetworkSpec
+		status infrav1.NetworkStatus
 		expect func(m *mocks.MockEC2APIMockRecorder)
 		err    error
 	}{
@@ -522,6 +523,256 @@ func TestReconcileRouteTables(t *testing.T) {
 					}, nil)
 			},
 		},
+		{
+			name: "transit gateway attachment is available, routes through the transit gateway",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					TransitGateway: &infrav1.TransitGatewaySpec{
+						ID:                     "tgw-01",
+						PrivateRouteCidrBlocks: []string{"10.100.0.0/16", "192.168.0.0/16"},
+						PublicRouteCidrBlocks:  []string{"10.100.0.0/16"},
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						AvailabilityZone: "us-east-1a",
+						NatGatewayID:     aws.String("nat-01"),
+					},
+				},
+			},
+			status: infrav1.NetworkStatus{
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachment{
+					ID:               "tgw-attach-01",
+					TransitGatewayID: "tgw-01",
+					State:            "available",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []types.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []types.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+									{
+										DestinationCidrBlock: aws.String("10.200.0.0/16"),
+										TransitGatewayId:     aws.String("tgw-01"),
+									},
+									{
+										DestinationCidrBlock:   aws.String("192.168.0.0/16"),
+										VpcPeeringConnectionId: aws.String("pcx-01"),
+									},
+									{
+										DestinationCidrBlock: aws.String("172.16.0.0/12"),
+										TransitGatewayId:     aws.String("tgw-02"),
+									},
+								},
+								Tags: []types.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []types.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []types.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []types.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+
+				m.CreateRoute(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+					RouteTableId:         aws.String("route-table-private"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})).
+					Return(&ec2.CreateRouteOutput{}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					DestinationCidrBlock: aws.String("10.200.0.0/16"),
+					RouteTableId:         aws.String("route-table-private"),
+				})).
+					Return(&ec2.DeleteRouteOutput{}, nil)
+				m.CreateRoute(context.TODO(), gomock.Eq(&ec2.CreateRouteInput{
+					DestinationCidrBlock: aws.String("10.100.0.0/16"),
+					RouteTableId:         aws.String("route-table-public"),
+					TransitGatewayId:     aws.String("tgw-01"),
+				})).
+					Return(&ec2.CreateRouteOutput{}, nil)
+			},
+		},
+		{
+			name: "transit gateway attachment isn't available, routes through the transit gateway are left unchanged",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{
+					InternetGatewayID: aws.String("igw-01"),
+					ID:                "vpc-routetables",
+					Tags: infrav1.Tags{
+						infrav1.ClusterTagKey("test-cluster"): "owned",
+					},
+					TransitGateway: &infrav1.TransitGatewaySpec{
+						ID:                     "tgw-01",
+						PrivateRouteCidrBlocks: []string{"10.100.0.0/16"},
+						PublicRouteCidrBlocks:  []string{"10.100.0.0/16"},
+					},
+				},
+				Subnets: infrav1.Subnets{
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-private",
+						IsPublic:         false,
+						AvailabilityZone: "us-east-1a",
+					},
+					infrav1.SubnetSpec{
+						ID:               "subnet-routetables-public",
+						IsPublic:         true,
+						AvailabilityZone: "us-east-1a",
+						NatGatewayID:     aws.String("nat-01"),
+					},
+				},
+			},
+			status: infrav1.NetworkStatus{
+				TransitGatewayAttachment: &infrav1.TransitGatewayAttachment{
+					ID:               "tgw-attach-01",
+					TransitGatewayID: "tgw-01",
+					State:            "pendingAcceptance",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("route-table-private"),
+								Associations: []types.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-private"),
+									},
+								},
+								Routes: []types.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										NatGatewayId:         aws.String("nat-01"),
+									},
+									{
+										DestinationCidrBlock: aws.String("10.200.0.0/16"),
+										TransitGatewayId:     aws.String("tgw-01"),
+									},
+								},
+								Tags: []types.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-private-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+							{
+								RouteTableId: aws.String("route-table-public"),
+								Associations: []types.RouteTableAssociation{
+									{
+										SubnetId: aws.String("subnet-routetables-public"),
+									},
+								},
+								Routes: []types.Route{
+									{
+										DestinationCidrBlock: aws.String("0.0.0.0/0"),
+										GatewayId:            aws.String("igw-01"),
+									},
+								},
+								Tags: []types.Tag{
+									{
+										Key:   aws.String("kubernetes.io/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+										Value: aws.String("common"),
+									},
+									{
+										Key:   aws.String("Name"),
+										Value: aws.String("test-cluster-rt-public-us-east-1a"),
+									},
+									{
+										Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+										Value: aws.String("owned"),
+									},
+								},
+							},
+						},
+					}, nil)
+			},
+		},
 		{
 			name: "failed to create route, delete route table and fail",
 			input: &infrav1.NetworkSpec{
@@ -579,6 +830,9 @@ func TestReconcileRouteTables(t *testing.T) {
 					Spec: infrav1.AWSClusterSpec{
 						NetworkSpec: *tc.input,
 					},
+					Status: infrav1.AWSClusterStatus{
+						Network: tc.status,
+					},
 				},
 			})
 			if err != nil {
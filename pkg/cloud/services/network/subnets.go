@@ -132,10 +132,12 @@ func (s *Service) reconcileSubnets() error {
 
 			// Make sure tags are up-to-date.
 			subnetTags := sub.Tags
+			networkACL := sub.NetworkACL
 
 			// Update subnet spec with the existing subnet details
 			existingSubnet.DeepCopyInto(sub)
 			sub.Tags = subnetTags
+			sub.NetworkACL = networkACL
 
 			if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
 				buildParams := s.getSubnetTagParams(unmanagedVPC, existingSubnet.GetResourceID(), existingSubnet.IsPublic, existingSubnet.AvailabilityZone, subnetTags, existingSubnet.IsEdge())
@@ -0,0 +1,379 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/filter"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/tags"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileTransitGatewayAttachment attaches the VPC to the transit gateway specified in the VPC spec, and
+// reports the state of the attachment in the TransitGatewayAttachmentReady condition. The attachment and the
+// routes through it are deleted when the transit gateway is removed from the spec or replaced by another one.
+// If the VPC is unmanaged, this is a no-op.
+func (s *Service) reconcileTransitGatewayAttachment() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment reconcile in unmanaged mode")
+		return nil
+	}
+
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil && s.scope.Network().TransitGatewayAttachment == nil {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling transit gateway attachment")
+
+	attachments, err := s.describeTransitGatewayAttachments()
+	if err != nil {
+		return err
+	}
+
+	// Delete the attachments to transit gateways that aren't in the spec anymore, after the routes through them
+	// so they don't remain as blackhole routes. The attachment is only deleted once its routes are, so the
+	// deletion of the routes is retried as long as the attachment exists.
+	var attachment *types.TransitGatewayVpcAttachment
+	for i := range attachments {
+		if spec != nil && aws.ToString(attachments[i].TransitGatewayId) == spec.ID {
+			attachment = &attachments[i]
+			continue
+		}
+		if err := s.deleteTransitGatewayRoutes(aws.ToString(attachments[i].TransitGatewayId)); err != nil {
+			return err
+		}
+		if err := s.deleteTransitGatewayAttachment(&attachments[i]); err != nil {
+			return err
+		}
+	}
+
+	if spec == nil {
+		s.scope.Network().TransitGatewayAttachment = nil
+		conditions.Delete(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+		return nil
+	}
+
+	subnets := s.getTransitGatewayAttachmentSubnets(spec)
+	if subnets.Len() == 0 {
+		return errors.Errorf("no subnets available to attach VPC %q to transit gateway %q", s.scope.VPC().ID, spec.ID)
+	}
+
+	if attachment == nil {
+		attachment, err = s.createTransitGatewayAttachment(spec, subnets)
+		if err != nil {
+			return err
+		}
+	} else if attachment.State == types.TransitGatewayAttachmentStateAvailable {
+		if err := s.modifyTransitGatewayAttachmentSubnets(attachment, subnets); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().TransitGatewayAttachment = &infrav1.TransitGatewayAttachment{
+		ID:               aws.ToString(attachment.TransitGatewayAttachmentId),
+		TransitGatewayID: spec.ID,
+		State:            string(attachment.State),
+	}
+
+	switch attachment.State {
+	case types.TransitGatewayAttachmentStateAvailable:
+		conditions.MarkTrue(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+	case types.TransitGatewayAttachmentStatePendingAcceptance:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentPendingAcceptanceReason, clusterv1.ConditionSeverityInfo,
+			"Waiting for the owner of transit gateway %q to accept attachment %q", spec.ID, aws.ToString(attachment.TransitGatewayAttachmentId))
+	case types.TransitGatewayAttachmentStateRejected, types.TransitGatewayAttachmentStateFailed, types.TransitGatewayAttachmentStateFailing:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentFailedReason, clusterv1.ConditionSeverityWarning,
+			"Attachment %q to transit gateway %q is in state %q", aws.ToString(attachment.TransitGatewayAttachmentId), spec.ID, attachment.State)
+	default:
+		conditions.MarkFalse(s.scope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition, infrav1.TransitGatewayAttachmentPendingReason, clusterv1.ConditionSeverityInfo,
+			"Attachment %q to transit gateway %q is in state %q", aws.ToString(attachment.TransitGatewayAttachmentId), spec.ID, attachment.State)
+	}
+
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachments() error {
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) {
+		s.scope.Trace("Skipping transit gateway attachment deletion in unmanaged mode")
+		return nil
+	}
+
+	attachments, err := s.describeTransitGatewayAttachments()
+	if err != nil {
+		return err
+	}
+
+	for i := range attachments {
+		if err := s.deleteTransitGatewayAttachment(&attachments[i]); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Network().TransitGatewayAttachment = nil
+	return nil
+}
+
+// describeTransitGatewayAttachments returns the attachments of the VPC owned by the cluster, except the deleted ones.
+func (s *Service) describeTransitGatewayAttachments() ([]types.TransitGatewayVpcAttachment, error) {
+	if s.scope.VPC().ID == "" {
+		return nil, nil
+	}
+
+	input := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	attachments := []types.TransitGatewayVpcAttachment{}
+	paginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeTransitGatewayAttachments", "Failed to describe transit gateway attachments in vpc %q: %v", s.scope.VPC().ID, err)
+			return nil, errors.Wrapf(err, "failed to describe transit gateway attachments in vpc %q", s.scope.VPC().ID)
+		}
+		for _, attachment := range page.TransitGatewayVpcAttachments {
+			switch attachment.State {
+			case types.TransitGatewayAttachmentStateDeleting, types.TransitGatewayAttachmentStateDeleted:
+				continue
+			}
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, nil
+}
+
+func (s *Service) createTransitGatewayAttachment(spec *infrav1.TransitGatewaySpec, subnets sets.Set[string]) (*types.TransitGatewayVpcAttachment, error) {
+	out, err := s.EC2Client.CreateTransitGatewayVpcAttachment(context.TODO(), &ec2.CreateTransitGatewayVpcAttachmentInput{
+		TransitGatewayId: aws.String(spec.ID),
+		VpcId:            aws.String(s.scope.VPC().ID),
+		SubnetIds:        sets.List(subnets),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeTransitGatewayAttachment, s.getTransitGatewayAttachmentTagParams()),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateTransitGatewayAttachment", "Failed to attach vpc %q to transit gateway %q: %v", s.scope.VPC().ID, spec.ID, err)
+		return nil, errors.Wrapf(err, "failed to attach vpc %q to transit gateway %q", s.scope.VPC().ID, spec.ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateTransitGatewayAttachment", "Created new transit gateway attachment %q to transit gateway %q",
+		aws.ToString(out.TransitGatewayVpcAttachment.TransitGatewayAttachmentId), spec.ID)
+	return out.TransitGatewayVpcAttachment, nil
+}
+
+func (s *Service) modifyTransitGatewayAttachmentSubnets(attachment *types.TransitGatewayVpcAttachment, subnets sets.Set[string]) error {
+	existing := sets.New(attachment.SubnetIds...)
+	additions := subnets.Difference(existing)
+	removals := existing.Difference(subnets)
+	if additions.Len() == 0 && removals.Len() == 0 {
+		return nil
+	}
+
+	id := aws.ToString(attachment.TransitGatewayAttachmentId)
+	input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}
+	if additions.Len() > 0 {
+		input.AddSubnetIds = sets.List(additions)
+	}
+	if removals.Len() > 0 {
+		input.RemoveSubnetIds = sets.List(removals)
+	}
+
+	out, err := s.EC2Client.ModifyTransitGatewayVpcAttachment(context.TODO(), input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyTransitGatewayAttachment", "Failed to modify the subnets of transit gateway attachment %q: %v", id, err)
+		return errors.Wrapf(err, "failed to modify the subnets of transit gateway attachment %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyTransitGatewayAttachment", "Modified the subnets of transit gateway attachment %q", id)
+	if out.TransitGatewayVpcAttachment != nil {
+		attachment.State = out.TransitGatewayVpcAttachment.State
+	}
+	return nil
+}
+
+func (s *Service) deleteTransitGatewayAttachment(attachment *types.TransitGatewayVpcAttachment) error {
+	id := aws.ToString(attachment.TransitGatewayAttachmentId)
+	if _, err := s.EC2Client.DeleteTransitGatewayVpcAttachment(context.TODO(), &ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteTransitGatewayAttachment", "Failed to delete transit gateway attachment %q: %v", id, err)
+		return errors.Wrapf(err, "failed to delete transit gateway attachment %q", id)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteTransitGatewayAttachment", "Deleted transit gateway attachment %q", id)
+	return nil
+}
+
+// getTransitGatewayAttachmentSubnets returns the subnets of the transit gateway attachment. When no subnets
+// are specified, a private subnet of the cluster is used in each availability zone.
+func (s *Service) getTransitGatewayAttachmentSubnets(spec *infrav1.TransitGatewaySpec) sets.Set[string] {
+	if len(spec.SubnetIDs) > 0 {
+		return sets.New(spec.SubnetIDs...)
+	}
+
+	subnets := sets.New[string]()
+	private := s.scope.Subnets().FilterPrivate().FilterNonCni()
+	for _, zone := range private.GetUniqueZones() {
+		ids := sets.New(private.FilterByZone(zone).IDs()...)
+		ids.Delete("")
+		if ids.Len() > 0 {
+			subnets.Insert(sets.List(ids)[0])
+		}
+	}
+	return subnets
+}
+
+// getTransitGatewayRoutes returns the routes through the transit gateway for a subnet, and whether the
+// attachment is ready to route traffic. Routes are only returned once the attachment is available, and
+// kept while its subnets are modified.
+func (s *Service) getTransitGatewayRoutes(sn *infrav1.SubnetSpec) ([]*ec2.CreateRouteInput, bool) {
+	spec := s.scope.VPC().TransitGateway
+	attachment := s.scope.Network().TransitGatewayAttachment
+	if spec == nil || attachment == nil || attachment.TransitGatewayID != spec.ID {
+		return nil, false
+	}
+	switch types.TransitGatewayAttachmentState(attachment.State) {
+	case types.TransitGatewayAttachmentStateAvailable, types.TransitGatewayAttachmentStateModifying:
+	default:
+		return nil, false
+	}
+
+	cidrBlocks := spec.PrivateRouteCidrBlocks
+	if sn.IsPublic {
+		cidrBlocks = spec.PublicRouteCidrBlocks
+	}
+
+	routes := make([]*ec2.CreateRouteInput, 0, len(cidrBlocks))
+	for _, cidrBlock := range cidrBlocks {
+		routes = append(routes, &ec2.CreateRouteInput{
+			DestinationCidrBlock: aws.String(cidrBlock),
+			TransitGatewayId:     aws.String(spec.ID),
+		})
+	}
+	return routes, true
+}
+
+// reconcileTransitGatewayRoutes creates the routes through the transit gateway that are missing from the route
+// table, and deletes the routes through the transit gateway that aren't part of the spec anymore. Routes with
+// another target are left alone, and so are all routes while the attachment isn't ready.
+func (s *Service) reconcileTransitGatewayRoutes(sn *infrav1.SubnetSpec, rt types.RouteTable) error {
+	spec := s.scope.VPC().TransitGateway
+	if spec == nil {
+		return nil
+	}
+	routes, ready := s.getTransitGatewayRoutes(sn)
+	if !ready {
+		return nil
+	}
+
+	current := map[string]types.Route{}
+	for _, route := range rt.Routes {
+		if route.DestinationCidrBlock != nil {
+			current[aws.ToString(route.DestinationCidrBlock)] = route
+		}
+	}
+
+	for _, route := range routes {
+		if currentRoute, ok := current[aws.ToString(route.DestinationCidrBlock)]; ok {
+			if aws.ToString(currentRoute.TransitGatewayId) != spec.ID {
+				record.Warnf(s.scope.InfraCluster(), "ConflictingRoute", "Route to %q on managed RouteTable %q doesn't go through transit gateway %q, leaving it unchanged",
+					aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId), spec.ID)
+			}
+			continue
+		}
+		input := *route
+		input.RouteTableId = rt.RouteTableId
+		if _, err := s.EC2Client.CreateRoute(context.TODO(), &input); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateRoute", "Failed to create route to %q on managed RouteTable %q: %v", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId), err)
+			return errors.Wrapf(err, "failed to create route to %q on route table %q", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateRoute", "Created route to %q through transit gateway %q on managed RouteTable %q",
+			aws.ToString(route.DestinationCidrBlock), spec.ID, aws.ToString(rt.RouteTableId))
+	}
+
+	for _, route := range rt.Routes {
+		if aws.ToString(route.TransitGatewayId) != spec.ID || route.DestinationCidrBlock == nil || hasRouteTo(routes, aws.ToString(route.DestinationCidrBlock)) {
+			continue
+		}
+		if _, err := s.EC2Client.DeleteRoute(context.TODO(), &ec2.DeleteRouteInput{
+			RouteTableId:         rt.RouteTableId,
+			DestinationCidrBlock: route.DestinationCidrBlock,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %q on managed RouteTable %q: %v", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId), err)
+			return errors.Wrapf(err, "failed to delete route to %q on route table %q", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId))
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %q through transit gateway %q on managed RouteTable %q",
+			aws.ToString(route.DestinationCidrBlock), spec.ID, aws.ToString(rt.RouteTableId))
+	}
+
+	return nil
+}
+
+// deleteTransitGatewayRoutes deletes the routes through a transit gateway from the managed route tables.
+func (s *Service) deleteTransitGatewayRoutes(transitGatewayID string) error {
+	routeTables, err := s.describeVpcRouteTables()
+	if err != nil {
+		return err
+	}
+
+	for _, rt := range routeTables {
+		for _, route := range rt.Routes {
+			if aws.ToString(route.TransitGatewayId) != transitGatewayID || route.DestinationCidrBlock == nil {
+				continue
+			}
+			if _, err := s.EC2Client.DeleteRoute(context.TODO(), &ec2.DeleteRouteInput{
+				RouteTableId:         rt.RouteTableId,
+				DestinationCidrBlock: route.DestinationCidrBlock,
+			}); err != nil {
+				record.Warnf(s.scope.InfraCluster(), "FailedDeleteRoute", "Failed to delete route to %q on managed RouteTable %q: %v", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId), err)
+				return errors.Wrapf(err, "failed to delete route to %q on route table %q", aws.ToString(route.DestinationCidrBlock), aws.ToString(rt.RouteTableId))
+			}
+			record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteRoute", "Deleted route to %q through transit gateway %q on managed RouteTable %q",
+				aws.ToString(route.DestinationCidrBlock), transitGatewayID, aws.ToString(rt.RouteTableId))
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getTransitGatewayAttachmentTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-tgw-attachment", s.scope.Name())),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
@@ -0,0 +1,521 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/test/mocks"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileTransitGatewayAttachment(t *testing.T) {
+	describeInput := &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-1"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		transitGateway     *infrav1.TransitGatewaySpec
+		attachment         *infrav1.TransitGatewayAttachment
+		expect             func(m *mocks.MockEC2APIMockRecorder)
+		expectedAttachment *infrav1.TransitGatewayAttachment
+		expectedCondition  *clusterv1.Condition
+		wantErr            bool
+	}{
+		{
+			name: "no transit gateway specified, nothing to do",
+		},
+		{
+			name:           "attachment doesn't exist, creates it in a private subnet of each zone",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateTransitGatewayVpcAttachmentInput, _ ...func(*ec2.Options)) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.TransitGatewayId).To(Equal(aws.String("tgw-1")))
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-1")))
+						g.Expect(input.SubnetIds).To(Equal([]string{"subnet-private-a", "subnet-private-b"}))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeTransitGatewayAttachment))
+						return &ec2.CreateTransitGatewayVpcAttachmentOutput{
+							TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           input.TransitGatewayId,
+								State:                      types.TransitGatewayAttachmentStatePending,
+							},
+						}, nil
+					})
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "pending",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1.TransitGatewayAttachmentPendingReason,
+			},
+		},
+		{
+			name:           "attachment waits for acceptance",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+								State:                      types.TransitGatewayAttachmentStatePendingAcceptance,
+							},
+						},
+					}, nil)
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "pendingAcceptance",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1.TransitGatewayAttachmentPendingAcceptanceReason,
+			},
+		},
+		{
+			name: "subnets changed, modifies the attachment",
+			transitGateway: &infrav1.TransitGatewaySpec{
+				ID:        "tgw-1",
+				SubnetIDs: []string{"subnet-private-a", "subnet-private-c"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+						},
+					}, nil)
+				m.ModifyTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.ModifyTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					AddSubnetIds:               []string{"subnet-private-c"},
+					RemoveSubnetIds:            []string{"subnet-private-b"},
+				})).Return(&ec2.ModifyTransitGatewayVpcAttachmentOutput{
+					TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+						TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+						State:                      types.TransitGatewayAttachmentStateModifying,
+					},
+				}, nil)
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "modifying",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1.TransitGatewayAttachmentPendingReason,
+			},
+		},
+		{
+			name:           "attachment is available, nothing to do",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								SubnetIds:                  []string{"subnet-private-b", "subnet-private-a"},
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+						},
+					}, nil)
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionTrue,
+			},
+		},
+		{
+			name:           "attachment was rejected",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								SubnetIds:                  []string{"subnet-private-a", "subnet-private-b"},
+								State:                      types.TransitGatewayAttachmentStateRejected,
+							},
+						},
+					}, nil)
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "rejected",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1.TransitGatewayAttachmentFailedReason,
+			},
+		},
+		{
+			name:           "transit gateway changed, replaces the attachment",
+			transitGateway: &infrav1.TransitGatewaySpec{ID: "tgw-2"},
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+						},
+					}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-1"),
+								Routes: []types.Route{
+									{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1")},
+									{DestinationCidrBlock: aws.String("10.0.0.0/8"), TransitGatewayId: aws.String("tgw-1")},
+									{DestinationCidrBlock: aws.String("172.16.0.0/12"), TransitGatewayId: aws.String("tgw-3")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-1"),
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+				m.CreateTransitGatewayVpcAttachment(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateTransitGatewayVpcAttachmentInput{})).
+					Return(&ec2.CreateTransitGatewayVpcAttachmentOutput{
+						TransitGatewayVpcAttachment: &types.TransitGatewayVpcAttachment{
+							TransitGatewayAttachmentId: aws.String("tgw-attach-2"),
+							TransitGatewayId:           aws.String("tgw-2"),
+							State:                      types.TransitGatewayAttachmentStatePending,
+						},
+					}, nil)
+			},
+			expectedAttachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-2",
+				TransitGatewayID: "tgw-2",
+				State:            "pending",
+			},
+			expectedCondition: &clusterv1.Condition{
+				Status: corev1.ConditionFalse,
+				Reason: infrav1.TransitGatewayAttachmentPendingReason,
+			},
+		},
+		{
+			name: "transit gateway removed from the spec, deletes the attachment",
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-0"),
+								TransitGatewayId:           aws.String("tgw-0"),
+								State:                      types.TransitGatewayAttachmentStateDeleting,
+							},
+						},
+					}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-1"),
+								Routes: []types.Route{
+									{DestinationCidrBlock: aws.String("10.0.0.0/8"), TransitGatewayId: aws.String("tgw-1")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Eq(&ec2.DeleteRouteInput{
+					RouteTableId:         aws.String("rtb-1"),
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+				})).Return(&ec2.DeleteRouteOutput{}, nil)
+				m.DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+				})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+			},
+		},
+		{
+			name: "deleting the routes through the removed transit gateway fails, keeps the attachment",
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.Eq(describeInput), gomock.Any()).
+					Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+						TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+							{
+								TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+								TransitGatewayId:           aws.String("tgw-1"),
+								State:                      types.TransitGatewayAttachmentStateAvailable,
+							},
+						},
+					}, nil)
+				m.DescribeRouteTables(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeRouteTablesInput{})).
+					Return(&ec2.DescribeRouteTablesOutput{
+						RouteTables: []types.RouteTable{
+							{
+								RouteTableId: aws.String("rtb-1"),
+								Routes: []types.Route{
+									{DestinationCidrBlock: aws.String("10.0.0.0/8"), TransitGatewayId: aws.String("tgw-1")},
+								},
+							},
+						},
+					}, nil)
+				m.DeleteRoute(context.TODO(), gomock.Any()).Return(nil, errors.New("access denied"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := newTransitGatewayTestScope(tc.transitGateway, tc.attachment)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileTransitGatewayAttachment()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().TransitGatewayAttachment).To(Equal(tc.expectedAttachment))
+
+			condition := conditions.Get(clusterScope.InfraCluster(), infrav1.TransitGatewayAttachmentReadyCondition)
+			if tc.expectedCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tc.expectedCondition.Status))
+			g.Expect(condition.Reason).To(Equal(tc.expectedCondition.Reason))
+		})
+	}
+}
+
+func TestDeleteTransitGatewayAttachments(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeTransitGatewayVpcAttachments(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeTransitGatewayVpcAttachmentsInput{}), gomock.Any()).
+		Return(&ec2.DescribeTransitGatewayVpcAttachmentsOutput{
+			TransitGatewayVpcAttachments: []types.TransitGatewayVpcAttachment{
+				{
+					TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+					TransitGatewayId:           aws.String("tgw-1"),
+					State:                      types.TransitGatewayAttachmentStateAvailable,
+				},
+			},
+		}, nil)
+	ec2Mock.EXPECT().DeleteTransitGatewayVpcAttachment(context.TODO(), gomock.Eq(&ec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+	})).Return(&ec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil)
+
+	clusterScope, err := newTransitGatewayTestScope(&infrav1.TransitGatewaySpec{ID: "tgw-1"}, &infrav1.TransitGatewayAttachment{
+		ID:               "tgw-attach-1",
+		TransitGatewayID: "tgw-1",
+		State:            "available",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s := NewService(clusterScope)
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.deleteTransitGatewayAttachments()).To(Succeed())
+	g.Expect(clusterScope.Network().TransitGatewayAttachment).To(BeNil())
+}
+
+func TestGetTransitGatewayRoutes(t *testing.T) {
+	testCases := []struct {
+		name           string
+		attachment     *infrav1.TransitGatewayAttachment
+		subnet         *infrav1.SubnetSpec
+		expectedRoutes []*ec2.CreateRouteInput
+	}{
+		{
+			name: "attachment isn't available yet, no routes",
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "pendingAcceptance",
+			},
+			subnet: &infrav1.SubnetSpec{ID: "subnet-private-a"},
+		},
+		{
+			name: "private subnet routes",
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			subnet: &infrav1.SubnetSpec{ID: "subnet-private-a"},
+			expectedRoutes: []*ec2.CreateRouteInput{
+				{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+				{DestinationCidrBlock: aws.String("172.16.0.0/12"), TransitGatewayId: aws.String("tgw-1")},
+			},
+		},
+		{
+			name: "public subnet routes",
+			attachment: &infrav1.TransitGatewayAttachment{
+				ID:               "tgw-attach-1",
+				TransitGatewayID: "tgw-1",
+				State:            "available",
+			},
+			subnet: &infrav1.SubnetSpec{ID: "subnet-public-a", IsPublic: true},
+			expectedRoutes: []*ec2.CreateRouteInput{
+				{DestinationCidrBlock: aws.String("10.100.0.0/16"), TransitGatewayId: aws.String("tgw-1")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope, err := newTransitGatewayTestScope(&infrav1.TransitGatewaySpec{
+				ID:                     "tgw-1",
+				PrivateRouteCidrBlocks: []string{"10.100.0.0/16", "172.16.0.0/12"},
+				PublicRouteCidrBlocks:  []string{"10.100.0.0/16"},
+			}, tc.attachment)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			routes, ready := s.getTransitGatewayRoutes(tc.subnet)
+			if tc.expectedRoutes == nil {
+				g.Expect(ready).To(BeFalse())
+				g.Expect(routes).To(BeEmpty())
+				return
+			}
+			g.Expect(ready).To(BeTrue())
+			g.Expect(routes).To(Equal(tc.expectedRoutes))
+		})
+	}
+}
+
+func newTransitGatewayTestScope(transitGateway *infrav1.TransitGatewaySpec, attachment *infrav1.TransitGatewayAttachment) (*scope.ClusterScope, error) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	return scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{
+						ID:             "vpc-1",
+						TransitGateway: transitGateway,
+						Tags: infrav1.Tags{
+							infrav1.ClusterTagKey("test-cluster"): "owned",
+						},
+					},
+					Subnets: infrav1.Subnets{
+						{ID: "subnet-private-a", AvailabilityZone: "us-east-1a"},
+						{ID: "subnet-private-b", AvailabilityZone: "us-east-1b"},
+						{ID: "subnet-private-c", AvailabilityZone: "us-east-1b"},
+						{ID: "subnet-public-a", AvailabilityZone: "us-east-1a", IsPublic: true},
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					TransitGatewayAttachment: attachment,
+				},
+			},
+		},
+	})
+}
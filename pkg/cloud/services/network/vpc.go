@@ -19,6 +19,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -102,7 +103,7 @@ func (s *Service) reconcileVPC() error {
 			return errors.Wrapf(err, "failed to set vpc attributes for %q", vpc.ID)
 		}
 
-		return nil
+		return s.reconcileFlowLogs()
 	}
 
 	// .spec.vpc.id is nil. This means no managed VPC exists or we failed to save its ID before. Check if a managed VPC
@@ -154,7 +155,7 @@ func (s *Service) reconcileVPC() error {
 		return errors.Wrapf(err, "failed to set vpc attributes for %q", vpc.ID)
 	}
 
-	return nil
+	return s.reconcileFlowLogs()
 }
 
 func (s *Service) describeVPCEndpoints(filters ...types.Filter) ([]types.VpcEndpoint, error) {
@@ -182,7 +183,9 @@ func (s *Service) describeVPCEndpoints(filters ...types.Filter) ([]types.VpcEndp
 }
 
 // reconcileVPCEndpoints registers the AWS endpoints for the services that need to be enabled
-// in the VPC routing tables. If the VPC is unmanaged, this is a no-op.
+// in the VPC. Gateway endpoints are associated with the routing tables of the subnets, and interface
+// endpoints create network interfaces in the subnets. Endpoints owned by the cluster that are no longer
+// needed are deleted. If the VPC is unmanaged, this is a no-op.
 // For more information, see: https://docs.aws.amazon.com/vpc/latest/privatelink/gateway-endpoints.html
 func (s *Service) reconcileVPCEndpoints() error {
 	// If the VPC is unmanaged or not yet populated, return early.
@@ -191,14 +194,29 @@ func (s *Service) reconcileVPCEndpoints() error {
 	}
 
 	// Gather all services that need to be enabled.
-	services := sets.New[string]()
-	if s.scope.Bucket() != nil {
-		services.Insert(fmt.Sprintf("com.amazonaws.%s.s3", s.scope.Region()))
-	}
-	if services.Len() == 0 {
+	desired := s.getDesiredVPCEndpoints()
+	if len(desired) == 0 && len(s.scope.Network().VPCEndpoints) == 0 {
 		return nil
 	}
 
+	// Interface endpoints without security groups use the security group of the cluster for VPC endpoints.
+	securityGroupID := ""
+	for _, service := range sets.List(sets.KeySet(desired)) {
+		spec := desired[service]
+		if spec.Type != infrav1.VPCEndpointTypeInterface || len(spec.SecurityGroupIDs) > 0 {
+			continue
+		}
+		if securityGroupID == "" {
+			id, err := s.reconcileVPCEndpointSecurityGroup()
+			if err != nil {
+				return err
+			}
+			securityGroupID = id
+		}
+		spec.SecurityGroupIDs = []string{securityGroupID}
+		desired[service] = spec
+	}
+
 	// Gather the current routes.
 	routeTables := sets.New[string]()
 	for _, rt := range s.scope.Subnets() {
@@ -206,75 +224,234 @@ func (s *Service) reconcileVPCEndpoints() error {
 			routeTables.Insert(*rt.RouteTableID)
 		}
 	}
-	if routeTables.Len() == 0 {
-		return nil
-	}
-
-	// Build the filters based on all the services we need to enable.
-	// A single filter with multiple values functions as an OR.
-	filters := []types.Filter{
-		{
-			Name:   aws.String("service-name"),
-			Values: services.UnsortedList(),
-		},
-	}
 
-	// Get all existing endpoints.
-	endpoints, err := s.describeVPCEndpoints(filters...)
+	// Get all existing endpoints owned by the cluster.
+	endpoints, err := s.describeVPCEndpoints(filter.EC2.ClusterOwned(s.scope.Name()))
 	if err != nil {
 		return errors.Wrap(err, "failed to describe vpc endpoints")
 	}
 
-	// Iterate over all services and create missing endpoints.
-	for _, service := range services.UnsortedList() {
-		var existing *types.VpcEndpoint
-		for _, ep := range endpoints {
-			if aws.ToString(ep.ServiceName) == service {
-				existing = &ep
-				break
+	// Delete the endpoints that aren't needed anymore, e.g. because their type changed.
+	stale := []string{}
+	existing := map[string]types.VpcEndpoint{}
+	for _, ep := range endpoints {
+		if vpcEndpointIsDeleted(ep) {
+			continue
+		}
+		spec, ok := desired[aws.ToString(ep.ServiceName)]
+		if !ok || string(ep.VpcEndpointType) != string(spec.Type) {
+			stale = append(stale, aws.ToString(ep.VpcEndpointId))
+			continue
+		}
+		existing[aws.ToString(ep.ServiceName)] = ep
+	}
+	if len(stale) > 0 {
+		if _, err := s.EC2Client.DeleteVpcEndpoints(context.TODO(), &ec2.DeleteVpcEndpointsInput{
+			VpcEndpointIds: stale,
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteVPCEndpoints", "Failed to delete VPC endpoints %v: %v", stale, err)
+			return errors.Wrapf(err, "failed to delete vpc endpoints %+v", stale)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteVPCEndpoints", "Deleted VPC endpoints %v", stale)
+	}
+
+	// Iterate over all services, create missing endpoints and update existing ones.
+	status := []infrav1.VPCEndpoint{}
+	for _, service := range sets.List(sets.KeySet(desired)) {
+		spec := desired[service]
+
+		var subnets sets.Set[string]
+		if spec.Type == infrav1.VPCEndpointTypeGateway {
+			// Gateway endpoints are only reachable through the routing tables.
+			if routeTables.Len() == 0 {
+				continue
+			}
+		} else {
+			subnets = s.getVPCEndpointSubnets(spec)
+			if subnets.Len() == 0 {
+				continue
 			}
 		}
 
-		// Handle the case where the endpoint already exists.
-		// If the route tables are different, modify the endpoint.
-		if existing != nil {
-			existingRouteTables := sets.New(existing.RouteTableIds...)
-			existingRouteTables.Delete("")
-			additions := routeTables.Difference(existingRouteTables)
-			removals := existingRouteTables.Difference(routeTables)
-			if additions.Len() > 0 || removals.Len() > 0 {
-				modify := &ec2.ModifyVpcEndpointInput{
-					VpcEndpointId: existing.VpcEndpointId,
-				}
-				if additions.Len() > 0 {
-					modify.AddRouteTableIds = additions.UnsortedList()
-				}
-				if removals.Len() > 0 {
-					modify.RemoveRouteTableIds = removals.UnsortedList()
-				}
-				if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
-					return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", service)
-				}
+		if ep, ok := existing[service]; ok {
+			if err := s.modifyVPCEndpoint(ep, spec, routeTables, subnets); err != nil {
+				return err
 			}
+			status = append(status, infrav1.VPCEndpoint{
+				ID:          aws.ToString(ep.VpcEndpointId),
+				ServiceName: service,
+				Type:        spec.Type,
+				State:       string(ep.State),
+			})
 			continue
 		}
 
-		// Create the endpoint.
-		if _, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), &ec2.CreateVpcEndpointInput{
-			VpcId:         aws.String(s.scope.VPC().ID),
-			ServiceName:   aws.String(service),
-			RouteTableIds: routeTables.UnsortedList(),
-			TagSpecifications: []types.TagSpecification{
-				tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
-			},
-		}); err != nil {
-			return errors.Wrapf(err, "failed to create vpc endpoint for service %q", service)
+		ep, err := s.createVPCEndpoint(spec, routeTables, subnets)
+		if err != nil {
+			return err
 		}
+		status = append(status, infrav1.VPCEndpoint{
+			ID:          aws.ToString(ep.VpcEndpointId),
+			ServiceName: service,
+			Type:        spec.Type,
+			State:       string(ep.State),
+		})
 	}
+	s.scope.Network().VPCEndpoints = status
 
 	return nil
 }
 
+// getDesiredVPCEndpoints returns the VPC endpoints needed by the cluster, indexed by their fully qualified service name.
+func (s *Service) getDesiredVPCEndpoints() map[string]infrav1.VPCEndpointSpec {
+	desired := map[string]infrav1.VPCEndpointSpec{}
+
+	// The S3 gateway endpoint is required to reach the bucket used for bootstrap data.
+	if s.scope.Bucket() != nil {
+		service := s.getVPCEndpointServiceName("s3")
+		desired[service] = infrav1.VPCEndpointSpec{
+			ServiceName: service,
+			Type:        infrav1.VPCEndpointTypeGateway,
+		}
+	}
+
+	for _, ep := range s.scope.VPCEndpoints() {
+		spec := *ep.DeepCopy()
+		spec.ServiceName = s.getVPCEndpointServiceName(ep.ServiceName)
+		if spec.Type == "" {
+			spec.Type = infrav1.VPCEndpointTypeInterface
+		}
+		desired[spec.ServiceName] = spec
+	}
+
+	return desired
+}
+
+// getVPCEndpointServiceName returns the fully qualified name of an AWS service in the region of the cluster.
+func (s *Service) getVPCEndpointServiceName(name string) string {
+	if strings.HasPrefix(name, "com.amazonaws.") || strings.HasPrefix(name, "aws.") {
+		return name
+	}
+	return fmt.Sprintf("com.amazonaws.%s.%s", s.scope.Region(), name)
+}
+
+// getVPCEndpointSubnets returns the subnets of an interface endpoint. When no subnets are specified,
+// a private subnet of the cluster is used in each availability zone.
+func (s *Service) getVPCEndpointSubnets(spec infrav1.VPCEndpointSpec) sets.Set[string] {
+	if len(spec.SubnetIDs) > 0 {
+		return sets.New(spec.SubnetIDs...)
+	}
+
+	subnets := sets.New[string]()
+	private := s.scope.Subnets().FilterPrivate().FilterNonCni()
+	for _, zone := range private.GetUniqueZones() {
+		ids := sets.New(private.FilterByZone(zone).IDs()...)
+		ids.Delete("")
+		if ids.Len() > 0 {
+			subnets.Insert(sets.List(ids)[0])
+		}
+	}
+	return subnets
+}
+
+func (s *Service) createVPCEndpoint(spec infrav1.VPCEndpointSpec, routeTables, subnets sets.Set[string]) (*types.VpcEndpoint, error) {
+	input := &ec2.CreateVpcEndpointInput{
+		VpcId:           aws.String(s.scope.VPC().ID),
+		ServiceName:     aws.String(spec.ServiceName),
+		VpcEndpointType: types.VpcEndpointType(spec.Type),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcEndpoint, s.getVPCEndpointTagParams()),
+		},
+	}
+	if spec.Type == infrav1.VPCEndpointTypeGateway {
+		input.RouteTableIds = sets.List(routeTables)
+	} else {
+		input.SubnetIds = sets.List(subnets)
+		input.SecurityGroupIds = spec.SecurityGroupIDs
+		input.PrivateDnsEnabled = aws.Bool(spec.PrivateDNSEnabled == nil || *spec.PrivateDNSEnabled)
+	}
+
+	out, err := s.EC2Client.CreateVpcEndpoint(context.TODO(), input)
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateVPCEndpoint", "Failed to create VPC endpoint for service %q: %v", spec.ServiceName, err)
+		return nil, errors.Wrapf(err, "failed to create vpc endpoint for service %q", spec.ServiceName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateVPCEndpoint", "Created new VPC endpoint %q for service %q", aws.ToString(out.VpcEndpoint.VpcEndpointId), spec.ServiceName)
+	return out.VpcEndpoint, nil
+}
+
+// modifyVPCEndpoint updates the route tables of a gateway endpoint, or the subnets, security groups and
+// private DNS of an interface endpoint, if they are different from the desired ones.
+func (s *Service) modifyVPCEndpoint(ep types.VpcEndpoint, spec infrav1.VPCEndpointSpec, routeTables, subnets sets.Set[string]) error {
+	modify := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: ep.VpcEndpointId,
+	}
+	changed := false
+
+	if spec.Type == infrav1.VPCEndpointTypeGateway {
+		existingRouteTables := sets.New(ep.RouteTableIds...)
+		existingRouteTables.Delete("")
+		if additions := routeTables.Difference(existingRouteTables); additions.Len() > 0 {
+			modify.AddRouteTableIds = sets.List(additions)
+			changed = true
+		}
+		if removals := existingRouteTables.Difference(routeTables); removals.Len() > 0 {
+			modify.RemoveRouteTableIds = sets.List(removals)
+			changed = true
+		}
+	} else {
+		existingSubnets := sets.New(ep.SubnetIds...)
+		if additions := subnets.Difference(existingSubnets); additions.Len() > 0 {
+			modify.AddSubnetIds = sets.List(additions)
+			changed = true
+		}
+		if removals := existingSubnets.Difference(subnets); removals.Len() > 0 {
+			modify.RemoveSubnetIds = sets.List(removals)
+			changed = true
+		}
+
+		securityGroups := sets.New(spec.SecurityGroupIDs...)
+		existingSecurityGroups := sets.New[string]()
+		for _, group := range ep.Groups {
+			existingSecurityGroups.Insert(aws.ToString(group.GroupId))
+		}
+		if additions := securityGroups.Difference(existingSecurityGroups); additions.Len() > 0 {
+			modify.AddSecurityGroupIds = sets.List(additions)
+			changed = true
+		}
+		if removals := existingSecurityGroups.Difference(securityGroups); removals.Len() > 0 {
+			modify.RemoveSecurityGroupIds = sets.List(removals)
+			changed = true
+		}
+
+		privateDNSEnabled := spec.PrivateDNSEnabled == nil || *spec.PrivateDNSEnabled
+		if aws.ToBool(ep.PrivateDnsEnabled) != privateDNSEnabled {
+			modify.PrivateDnsEnabled = aws.Bool(privateDNSEnabled)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if _, err := s.EC2Client.ModifyVpcEndpoint(context.TODO(), modify); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedModifyVPCEndpoint", "Failed to modify VPC endpoint %q: %v", aws.ToString(ep.VpcEndpointId), err)
+		return errors.Wrapf(err, "failed to modify vpc endpoint for service %q", spec.ServiceName)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulModifyVPCEndpoint", "Modified VPC endpoint %q for service %q", aws.ToString(ep.VpcEndpointId), spec.ServiceName)
+	return nil
+}
+
+// vpcEndpointIsDeleted returns true if the VPC endpoint is being deleted or is deleted.
+// The states returned by the API don't always match the case of the SDK constants.
+func vpcEndpointIsDeleted(ep types.VpcEndpoint) bool {
+	return strings.EqualFold(string(ep.State), string(types.StateDeleting)) ||
+		strings.EqualFold(string(ep.State), string(types.StateDeleted))
+}
+
 func (s *Service) deleteVPCEndpoints() error {
 	// If the VPC is unmanaged or not yet populated, return early.
 	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
@@ -293,11 +470,16 @@ func (s *Service) deleteVPCEndpoints() error {
 		if ep.VpcEndpointId == nil || *ep.VpcEndpointId == "" {
 			continue
 		}
+		if vpcEndpointIsDeleted(ep) {
+			continue
+		}
 		ids = append(ids, *ep.VpcEndpointId)
 	}
 
+	// The security group of the VPC endpoints is deleted once the endpoints using it are deleted.
 	if len(ids) == 0 {
-		return nil
+		s.scope.Network().VPCEndpoints = nil
+		return s.deleteVPCEndpointSecurityGroup()
 	}
 
 	// Iterate over all services and delete endpoints.
@@ -306,9 +488,351 @@ func (s *Service) deleteVPCEndpoints() error {
 	}); err != nil {
 		return errors.Wrapf(err, "failed to delete vpc endpoints %+v", ids)
 	}
+	s.scope.Network().VPCEndpoints = nil
+	return nil
+}
+
+// reconcileVPCEndpointSecurityGroup returns the security group used by the interface endpoints for which no
+// security groups are specified, and creates it if it doesn't exist. The security group allows HTTPS traffic
+// from the CIDR blocks of the VPC, so that the instances of the cluster can reach the endpoints.
+func (s *Service) reconcileVPCEndpointSecurityGroup() (string, error) {
+	sg, err := s.describeVPCEndpointSecurityGroup()
+	if err != nil {
+		return "", err
+	}
+	if sg == nil {
+		if sg, err = s.createVPCEndpointSecurityGroup(); err != nil {
+			return "", err
+		}
+	}
+
+	// Allow HTTPS traffic from the CIDR blocks of the VPC that aren't allowed yet, e.g. new secondary CIDR blocks.
+	ipv4CidrBlocks, ipv6CidrBlocks := s.getVPCCidrBlocks()
+	for _, permission := range sg.IpPermissions {
+		if aws.ToString(permission.IpProtocol) != string(infrav1.SecurityGroupProtocolTCP) ||
+			aws.ToInt32(permission.FromPort) != 443 || aws.ToInt32(permission.ToPort) != 443 {
+			continue
+		}
+		for _, r := range permission.IpRanges {
+			ipv4CidrBlocks.Delete(aws.ToString(r.CidrIp))
+		}
+		for _, r := range permission.Ipv6Ranges {
+			ipv6CidrBlocks.Delete(aws.ToString(r.CidrIpv6))
+		}
+	}
+	if ipv4CidrBlocks.Len() == 0 && ipv6CidrBlocks.Len() == 0 {
+		return aws.ToString(sg.GroupId), nil
+	}
+
+	permission := types.IpPermission{
+		IpProtocol: aws.String(string(infrav1.SecurityGroupProtocolTCP)),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+	}
+	for _, cidr := range sets.List(ipv4CidrBlocks) {
+		permission.IpRanges = append(permission.IpRanges, types.IpRange{
+			CidrIp:      aws.String(cidr),
+			Description: aws.String("HTTPS from the VPC"),
+		})
+	}
+	for _, cidr := range sets.List(ipv6CidrBlocks) {
+		permission.Ipv6Ranges = append(permission.Ipv6Ranges, types.Ipv6Range{
+			CidrIpv6:    aws.String(cidr),
+			Description: aws.String("HTTPS from the VPC"),
+		})
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupIngress(context.TODO(), &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       sg.GroupId,
+		IpPermissions: []types.IpPermission{permission},
+	}); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupIngressRules", "Failed to authorize security group ingress rules for VPC endpoints in security group %q: %v", aws.ToString(sg.GroupId), err)
+		return "", errors.Wrapf(err, "failed to authorize ingress rules in security group %q", aws.ToString(sg.GroupId))
+	}
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupIngressRules", "Authorized security group ingress rules for VPC endpoints in security group %q", aws.ToString(sg.GroupId))
+
+	return aws.ToString(sg.GroupId), nil
+}
+
+// getVPCCidrBlocks returns the IPv4 and IPv6 CIDR blocks of the VPC.
+func (s *Service) getVPCCidrBlocks() (sets.Set[string], sets.Set[string]) {
+	ipv4CidrBlocks := sets.New[string]()
+	if s.scope.VPC().CidrBlock != "" {
+		ipv4CidrBlocks.Insert(s.scope.VPC().CidrBlock)
+	}
+	for _, block := range s.scope.VPC().SecondaryCidrBlocks {
+		ipv4CidrBlocks.Insert(block.IPv4CidrBlock)
+	}
+
+	ipv6CidrBlocks := sets.New[string]()
+	if s.scope.VPC().IsIPv6Enabled() && s.scope.VPC().IPv6.CidrBlock != "" {
+		ipv6CidrBlocks.Insert(s.scope.VPC().IPv6.CidrBlock)
+	}
+
+	return ipv4CidrBlocks, ipv6CidrBlocks
+}
+
+// describeVPCEndpointSecurityGroup returns the security group of the VPC endpoints owned by the cluster,
+// or nil if it doesn't exist.
+func (s *Service) describeVPCEndpointSecurityGroup() (*types.SecurityGroup, error) {
+	out, err := s.EC2Client.DescribeSecurityGroups(context.TODO(), &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			filter.EC2.VPC(s.scope.VPC().ID),
+			filter.EC2.ClusterOwned(s.scope.Name()),
+			filter.EC2.ProviderRole(infrav1.VPCEndpointRoleTagValue),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe security group of vpc endpoints in vpc %q", s.scope.VPC().ID)
+	}
+	if len(out.SecurityGroups) == 0 {
+		return nil, nil
+	}
+
+	return &out.SecurityGroups[0], nil
+}
+
+func (s *Service) createVPCEndpointSecurityGroup() (*types.SecurityGroup, error) {
+	name := fmt.Sprintf("%s-%s", s.scope.Name(), infrav1.VPCEndpointRoleTagValue)
+	out, err := s.EC2Client.CreateSecurityGroup(context.TODO(), &ec2.CreateSecurityGroupInput{
+		VpcId:       aws.String(s.scope.VPC().ID),
+		GroupName:   aws.String(name),
+		Description: aws.String(fmt.Sprintf("Kubernetes cluster %s: %s", s.scope.Name(), infrav1.VPCEndpointRoleTagValue)),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeSecurityGroup, infrav1.BuildParams{
+				ClusterName: s.scope.Name(),
+				Lifecycle:   infrav1.ResourceLifecycleOwned,
+				Name:        aws.String(name),
+				Role:        aws.String(infrav1.VPCEndpointRoleTagValue),
+				Additional:  s.scope.AdditionalTags(),
+			}),
+		},
+	})
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateSecurityGroup", "Failed to create managed SecurityGroup for VPC endpoints: %v", err)
+		return nil, errors.Wrapf(err, "failed to create security group of vpc endpoints in vpc %q", s.scope.VPC().ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateSecurityGroup", "Created managed SecurityGroup %q for VPC endpoints", aws.ToString(out.GroupId))
+	return &types.SecurityGroup{GroupId: out.GroupId, GroupName: aws.String(name)}, nil
+}
+
+func (s *Service) deleteVPCEndpointSecurityGroup() error {
+	sg, err := s.describeVPCEndpointSecurityGroup()
+	if err != nil || sg == nil {
+		return err
+	}
+
+	if _, err := s.EC2Client.DeleteSecurityGroup(context.TODO(), &ec2.DeleteSecurityGroupInput{
+		GroupId: sg.GroupId,
+	}); awserrors.IsIgnorableSecurityGroupError(err) != nil { //nolint:gocritic
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteSecurityGroup", "Failed to delete managed SecurityGroup %q for VPC endpoints: %v", aws.ToString(sg.GroupId), err)
+		return errors.Wrapf(err, "failed to delete security group %q of vpc endpoints", aws.ToString(sg.GroupId))
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteSecurityGroup", "Deleted managed SecurityGroup %q for VPC endpoints", aws.ToString(sg.GroupId))
 	return nil
 }
 
+// reconcileFlowLogs creates the flow logs specified in the VPC spec, and deletes the flow logs owned by the cluster
+// that aren't specified anymore. Flow logs can't be modified, so they're replaced when their spec changes.
+func (s *Service) reconcileFlowLogs() error {
+	if len(s.scope.VPC().FlowLogs) == 0 && len(s.scope.Network().FlowLogIDs) == 0 {
+		return nil
+	}
+
+	s.scope.Debug("Reconciling VPC flow logs")
+
+	existing, err := s.describeFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]infrav1.VPCFlowLogSpec{}
+	for _, spec := range s.scope.VPC().FlowLogs {
+		desired[normalizeFlowLogDestination(spec.Destination)] = spec
+	}
+
+	ids := []string{}
+	stale := []string{}
+	published := sets.New[string]()
+	for _, fl := range existing {
+		destination := normalizeFlowLogDestination(aws.ToString(fl.LogDestination))
+		if spec, ok := desired[destination]; ok && !published.Has(destination) && flowLogMatchesSpec(fl, spec) {
+			ids = append(ids, aws.ToString(fl.FlowLogId))
+			published.Insert(destination)
+			continue
+		}
+		stale = append(stale, aws.ToString(fl.FlowLogId))
+	}
+
+	if err := s.deleteFlowLogsByID(stale); err != nil {
+		return err
+	}
+
+	for _, spec := range s.scope.VPC().FlowLogs {
+		if published.Has(normalizeFlowLogDestination(spec.Destination)) {
+			continue
+		}
+		id, err := s.createFlowLog(spec)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 {
+		ids = nil
+	}
+	s.scope.Network().FlowLogIDs = ids
+	return nil
+}
+
+func (s *Service) deleteFlowLogs() error {
+	// If the VPC is unmanaged or not yet populated, return early.
+	if s.scope.VPC().IsUnmanaged(s.scope.Name()) || s.scope.VPC().ID == "" {
+		return nil
+	}
+
+	existing, err := s.describeFlowLogs()
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0, len(existing))
+	for _, fl := range existing {
+		ids = append(ids, aws.ToString(fl.FlowLogId))
+	}
+	if err := s.deleteFlowLogsByID(ids); err != nil {
+		return err
+	}
+
+	s.scope.Network().FlowLogIDs = nil
+	return nil
+}
+
+// describeFlowLogs returns the flow logs of the VPC owned by the cluster.
+func (s *Service) describeFlowLogs() ([]types.FlowLog, error) {
+	input := &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []string{s.scope.VPC().ID},
+			},
+			filter.EC2.ClusterOwned(s.scope.Name()),
+		},
+	}
+
+	flowLogs := []types.FlowLog{}
+	paginator := ec2.NewDescribeFlowLogsPaginator(s.EC2Client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDescribeFlowLogs", "Failed to describe flow logs of managed VPC %q: %v", s.scope.VPC().ID, err)
+			return nil, errors.Wrapf(err, "failed to describe flow logs of vpc %q", s.scope.VPC().ID)
+		}
+		flowLogs = append(flowLogs, page.FlowLogs...)
+	}
+
+	return flowLogs, nil
+}
+
+func (s *Service) createFlowLog(spec infrav1.VPCFlowLogSpec) (string, error) {
+	input := &ec2.CreateFlowLogsInput{
+		ResourceIds:            []string{s.scope.VPC().ID},
+		ResourceType:           types.FlowLogsResourceTypeVpc,
+		LogDestinationType:     types.LogDestinationType(flowLogDestinationType(spec)),
+		LogDestination:         aws.String(spec.Destination),
+		TrafficType:            types.TrafficType(flowLogTrafficType(spec)),
+		MaxAggregationInterval: aws.Int32(flowLogMaxAggregationInterval(spec)),
+		TagSpecifications: []types.TagSpecification{
+			tags.BuildParamsToTagSpecification(types.ResourceTypeVpcFlowLog, s.getFlowLogTagParams()),
+		},
+	}
+	if spec.LogFormat != "" {
+		input.LogFormat = aws.String(spec.LogFormat)
+	}
+	if spec.DeliverLogsPermissionARN != "" {
+		input.DeliverLogsPermissionArn = aws.String(spec.DeliverLogsPermissionARN)
+	}
+
+	out, err := s.EC2Client.CreateFlowLogs(context.TODO(), input)
+	if err == nil && len(out.Unsuccessful) > 0 && out.Unsuccessful[0].Error != nil {
+		err = errors.New(aws.ToString(out.Unsuccessful[0].Error.Message))
+	}
+	if err == nil && len(out.FlowLogIds) == 0 {
+		err = errors.New("no flow log was created")
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedCreateFlowLog", "Failed to create flow log to %q for managed VPC %q: %v", spec.Destination, s.scope.VPC().ID, err)
+		return "", errors.Wrapf(err, "failed to create flow log to %q for vpc %q", spec.Destination, s.scope.VPC().ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateFlowLog", "Created new flow log %q to %q for managed VPC %q", out.FlowLogIds[0], spec.Destination, s.scope.VPC().ID)
+	return out.FlowLogIds[0], nil
+}
+
+func (s *Service) deleteFlowLogsByID(ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DeleteFlowLogs(context.TODO(), &ec2.DeleteFlowLogsInput{
+		FlowLogIds: ids,
+	})
+	if err == nil {
+		for _, item := range out.Unsuccessful {
+			// Ignore the flow logs that are already gone.
+			if item.Error != nil && aws.ToString(item.Error.Code) != "InvalidFlowLogId.NotFound" {
+				err = errors.New(aws.ToString(item.Error.Message))
+				break
+			}
+		}
+	}
+	if err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedDeleteFlowLogs", "Failed to delete flow logs %v of managed VPC %q: %v", ids, s.scope.VPC().ID, err)
+		return errors.Wrapf(err, "failed to delete flow logs %v of vpc %q", ids, s.scope.VPC().ID)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteFlowLogs", "Deleted flow logs %v of managed VPC %q", ids, s.scope.VPC().ID)
+	return nil
+}
+
+// flowLogMatchesSpec returns true if the flow log is configured as specified. The format is only compared
+// when it's specified, since AWS reports the default format otherwise.
+func flowLogMatchesSpec(fl types.FlowLog, spec infrav1.VPCFlowLogSpec) bool {
+	return string(fl.LogDestinationType) == string(flowLogDestinationType(spec)) &&
+		string(fl.TrafficType) == string(flowLogTrafficType(spec)) &&
+		aws.ToInt32(fl.MaxAggregationInterval) == flowLogMaxAggregationInterval(spec) &&
+		(spec.LogFormat == "" || aws.ToString(fl.LogFormat) == spec.LogFormat) &&
+		aws.ToString(fl.DeliverLogsPermissionArn) == spec.DeliverLogsPermissionARN
+}
+
+// normalizeFlowLogDestination strips the suffixes AWS may add to or remove from the destination ARN.
+func normalizeFlowLogDestination(destination string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(destination, ":*"), "/")
+}
+
+func flowLogDestinationType(spec infrav1.VPCFlowLogSpec) infrav1.FlowLogDestinationType {
+	if spec.DestinationType == "" {
+		return infrav1.FlowLogDestinationTypeCloudWatchLogs
+	}
+	return spec.DestinationType
+}
+
+func flowLogTrafficType(spec infrav1.VPCFlowLogSpec) infrav1.FlowLogTrafficType {
+	if spec.TrafficType == "" {
+		return infrav1.FlowLogTrafficTypeAll
+	}
+	return spec.TrafficType
+}
+
+func flowLogMaxAggregationInterval(spec infrav1.VPCFlowLogSpec) int32 {
+	if spec.MaxAggregationInterval == 0 {
+		return 600
+	}
+	return spec.MaxAggregationInterval
+}
+
 func (s *Service) ensureManagedVPCAttributes(vpc *infrav1.VPCSpec) error {
 	var (
 		errs    []error
@@ -668,6 +1192,16 @@ func (s *Service) getVPCTagParams(id string) infrav1.BuildParams {
 	}
 }
 
+func (s *Service) getFlowLogTagParams() infrav1.BuildParams {
+	return infrav1.BuildParams{
+		ClusterName: s.scope.Name(),
+		Lifecycle:   infrav1.ResourceLifecycleOwned,
+		Name:        aws.String(fmt.Sprintf("%s-flow-log", s.scope.Name())),
+		Role:        aws.String(infrav1.CommonRoleTagValue),
+		Additional:  s.scope.AdditionalTags(),
+	}
+}
+
 func (s *Service) getVPCEndpointTagParams() infrav1.BuildParams {
 	return infrav1.BuildParams{
 		ClusterName: s.scope.Name(),
@@ -660,6 +660,621 @@ func TestDeleteVPC(t *testing.T) {
 	}
 }
 
+func TestReconcileVPCEndpoints(t *testing.T) {
+	ownedTags := map[string]string{
+		"sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster": "owned",
+	}
+	describeOwnedVPCEndpointsInput := &ec2.DescribeVpcEndpointsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-1"},
+			},
+		},
+	}
+	describeVPCEndpointSecurityGroupInput := &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []string{"vpc-1"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/role"),
+				Values: []string{"vpc-endpoint"},
+			},
+		},
+	}
+	subnets := infrav1.Subnets{
+		{
+			ResourceID:       "subnet-private-a",
+			AvailabilityZone: "us-east-1a",
+			RouteTableID:     aws.String("rtb-private-a"),
+		},
+		{
+			ResourceID:       "subnet-private-b",
+			AvailabilityZone: "us-east-1b",
+			RouteTableID:     aws.String("rtb-private-b"),
+		},
+		{
+			ResourceID:       "subnet-public-a",
+			AvailabilityZone: "us-east-1a",
+			IsPublic:         true,
+			RouteTableID:     aws.String("rtb-public"),
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		vpc            infrav1.VPCSpec
+		vpcEndpoints   []infrav1.VPCEndpointSpec
+		statusEntries  []infrav1.VPCEndpoint
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus []infrav1.VPCEndpoint
+		wantErr        bool
+	}{
+		{
+			name: "no vpc endpoints, nothing to do",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", Tags: ownedTags},
+		},
+		{
+			name: "unmanaged vpc, nothing to do",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1"},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{ServiceName: "ecr.api"},
+			},
+		},
+		{
+			name: "creates gateway and interface endpoints",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", Tags: ownedTags},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{ServiceName: "s3", Type: infrav1.VPCEndpointTypeGateway},
+				{ServiceName: "ecr.api", Type: infrav1.VPCEndpointTypeInterface, SecurityGroupIDs: []string{"sg-1"}},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-1")))
+						g.Expect(input.ServiceName).To(Equal(aws.String("com.amazonaws.us-east-1.ecr.api")))
+						g.Expect(input.VpcEndpointType).To(Equal(types.VpcEndpointTypeInterface))
+						g.Expect(input.SubnetIds).To(Equal([]string{"subnet-private-a", "subnet-private-b"}))
+						g.Expect(input.SecurityGroupIds).To(Equal([]string{"sg-1"}))
+						g.Expect(input.PrivateDnsEnabled).To(Equal(aws.Bool(true)))
+						g.Expect(input.RouteTableIds).To(BeEmpty())
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &types.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-ecr"),
+								State:         types.StatePending,
+							},
+						}, nil
+					})
+				m.CreateVpcEndpoint(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ServiceName).To(Equal(aws.String("com.amazonaws.us-east-1.s3")))
+						g.Expect(input.VpcEndpointType).To(Equal(types.VpcEndpointTypeGateway))
+						g.Expect(input.RouteTableIds).To(Equal([]string{"rtb-private-a", "rtb-private-b", "rtb-public"}))
+						g.Expect(input.SubnetIds).To(BeEmpty())
+						g.Expect(input.PrivateDnsEnabled).To(BeNil())
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &types.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-s3"),
+								State:         types.StateAvailable,
+							},
+						}, nil
+					})
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{ID: "vpce-ecr", ServiceName: "com.amazonaws.us-east-1.ecr.api", Type: infrav1.VPCEndpointTypeInterface, State: string(types.StatePending)},
+				{ID: "vpce-s3", ServiceName: "com.amazonaws.us-east-1.s3", Type: infrav1.VPCEndpointTypeGateway, State: string(types.StateAvailable)},
+			},
+		},
+		{
+			name: "modifies the subnets, security groups and private dns of an interface endpoint",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", Tags: ownedTags},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{
+					ServiceName:       "com.amazonaws.us-east-1.sts",
+					Type:              infrav1.VPCEndpointTypeInterface,
+					SubnetIDs:         []string{"subnet-private-a"},
+					SecurityGroupIDs:  []string{"sg-2"},
+					PrivateDNSEnabled: aws.Bool(false),
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{
+								VpcEndpointId:     aws.String("vpce-sts"),
+								ServiceName:       aws.String("com.amazonaws.us-east-1.sts"),
+								VpcEndpointType:   types.VpcEndpointTypeInterface,
+								State:             types.StateAvailable,
+								SubnetIds:         []string{"subnet-private-a", "subnet-private-b"},
+								Groups:            []types.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+								PrivateDnsEnabled: aws.Bool(true),
+							},
+						},
+					}, nil)
+				m.ModifyVpcEndpoint(context.TODO(), gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:          aws.String("vpce-sts"),
+					RemoveSubnetIds:        []string{"subnet-private-b"},
+					AddSecurityGroupIds:    []string{"sg-2"},
+					RemoveSecurityGroupIds: []string{"sg-1"},
+					PrivateDnsEnabled:      aws.Bool(false),
+				})).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{ID: "vpce-sts", ServiceName: "com.amazonaws.us-east-1.sts", Type: infrav1.VPCEndpointTypeInterface, State: string(types.StateAvailable)},
+			},
+		},
+		{
+			name: "creates the security group of an interface endpoint without security groups",
+			vpc: infrav1.VPCSpec{
+				ID:                  "vpc-1",
+				CidrBlock:           "10.0.0.0/16",
+				SecondaryCidrBlocks: []infrav1.VpcCidrBlock{{IPv4CidrBlock: "100.64.0.0/16"}},
+				Tags:                ownedTags,
+			},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{ServiceName: "ecr.api"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeVPCEndpointSecurityGroupInput)).
+					Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+				m.CreateSecurityGroup(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateSecurityGroupInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateSecurityGroupInput, _ ...func(*ec2.Options)) (*ec2.CreateSecurityGroupOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.VpcId).To(Equal(aws.String("vpc-1")))
+						g.Expect(input.GroupName).To(Equal(aws.String("test-cluster-vpc-endpoint")))
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].Tags).To(ContainElement(types.Tag{
+							Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+							Value: aws.String("vpc-endpoint"),
+						}))
+						return &ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-vpce")}, nil
+					})
+				m.AuthorizeSecurityGroupIngress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupIngressInput{
+					GroupId: aws.String("sg-vpce"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(443),
+							ToPort:     aws.Int32(443),
+							IpRanges: []types.IpRange{
+								{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS from the VPC")},
+								{CidrIp: aws.String("100.64.0.0/16"), Description: aws.String("HTTPS from the VPC")},
+							},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateVpcEndpointInput, _ ...func(*ec2.Options)) (*ec2.CreateVpcEndpointOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.SecurityGroupIds).To(Equal([]string{"sg-vpce"}))
+						return &ec2.CreateVpcEndpointOutput{
+							VpcEndpoint: &types.VpcEndpoint{
+								VpcEndpointId: aws.String("vpce-ecr"),
+								State:         types.StatePending,
+							},
+						}, nil
+					})
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{ID: "vpce-ecr", ServiceName: "com.amazonaws.us-east-1.ecr.api", Type: infrav1.VPCEndpointTypeInterface, State: string(types.StatePending)},
+			},
+		},
+		{
+			name: "replaces the default security group of an interface endpoint with the existing security group of the cluster",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", CidrBlock: "10.0.0.0/16", Tags: ownedTags},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{ServiceName: "sts", SubnetIDs: []string{"subnet-private-a"}},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(context.TODO(), gomock.Eq(describeVPCEndpointSecurityGroupInput)).
+					Return(&ec2.DescribeSecurityGroupsOutput{
+						SecurityGroups: []types.SecurityGroup{
+							{
+								GroupId: aws.String("sg-vpce"),
+								IpPermissions: []types.IpPermission{
+									{
+										IpProtocol: aws.String("tcp"),
+										FromPort:   aws.Int32(443),
+										ToPort:     aws.Int32(443),
+										IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+									},
+								},
+							},
+						},
+					}, nil)
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{
+								VpcEndpointId:     aws.String("vpce-sts"),
+								ServiceName:       aws.String("com.amazonaws.us-east-1.sts"),
+								VpcEndpointType:   types.VpcEndpointTypeInterface,
+								State:             types.StateAvailable,
+								SubnetIds:         []string{"subnet-private-a"},
+								Groups:            []types.SecurityGroupIdentifier{{GroupId: aws.String("sg-default")}},
+								PrivateDnsEnabled: aws.Bool(true),
+							},
+						},
+					}, nil)
+				m.ModifyVpcEndpoint(context.TODO(), gomock.Eq(&ec2.ModifyVpcEndpointInput{
+					VpcEndpointId:          aws.String("vpce-sts"),
+					AddSecurityGroupIds:    []string{"sg-vpce"},
+					RemoveSecurityGroupIds: []string{"sg-default"},
+				})).Return(&ec2.ModifyVpcEndpointOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{
+				{ID: "vpce-sts", ServiceName: "com.amazonaws.us-east-1.sts", Type: infrav1.VPCEndpointTypeInterface, State: string(types.StateAvailable)},
+			},
+		},
+		{
+			name: "deletes endpoints that are no longer needed",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", Tags: ownedTags},
+			statusEntries: []infrav1.VPCEndpoint{
+				{ID: "vpce-sts", ServiceName: "com.amazonaws.us-east-1.sts", Type: infrav1.VPCEndpointTypeInterface},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{
+						VpcEndpoints: []types.VpcEndpoint{
+							{
+								VpcEndpointId:   aws.String("vpce-sts"),
+								ServiceName:     aws.String("com.amazonaws.us-east-1.sts"),
+								VpcEndpointType: types.VpcEndpointTypeInterface,
+								State:           types.StateAvailable,
+							},
+							{
+								VpcEndpointId:   aws.String("vpce-old"),
+								ServiceName:     aws.String("com.amazonaws.us-east-1.ssm"),
+								VpcEndpointType: types.VpcEndpointTypeInterface,
+								State:           "deleting",
+							},
+						},
+					}, nil)
+				m.DeleteVpcEndpoints(context.TODO(), gomock.Eq(&ec2.DeleteVpcEndpointsInput{
+					VpcEndpointIds: []string{"vpce-sts"},
+				})).Return(&ec2.DeleteVpcEndpointsOutput{}, nil)
+			},
+			expectedStatus: []infrav1.VPCEndpoint{},
+		},
+		{
+			name: "returns an error when the endpoint can't be created",
+			vpc:  infrav1.VPCSpec{ID: "vpc-1", Tags: ownedTags},
+			vpcEndpoints: []infrav1.VPCEndpointSpec{
+				{ServiceName: "dynamodb", Type: infrav1.VPCEndpointTypeGateway},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeVpcEndpoints(context.TODO(), gomock.Eq(describeOwnedVPCEndpointsInput), gomock.Any()).
+					Return(&ec2.DescribeVpcEndpointsOutput{}, nil)
+				m.CreateVpcEndpoint(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateVpcEndpointInput{})).
+					Return(nil, errors.New("some error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+			clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrav1.AWSClusterSpec{
+						Region: "us-east-1",
+						NetworkSpec: infrav1.NetworkSpec{
+							VPC:          tc.vpc,
+							Subnets:      subnets,
+							VPCEndpoints: tc.vpcEndpoints,
+						},
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							VPCEndpoints: tc.statusEntries,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileVPCEndpoints()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().VPCEndpoints).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestReconcileFlowLogs(t *testing.T) {
+	describeFlowLogsInput := &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{
+				Name:   aws.String("resource-id"),
+				Values: []string{"vpc-1"},
+			},
+			{
+				Name:   aws.String("tag:sigs.k8s.io/cluster-api-provider-aws/cluster/test-cluster"),
+				Values: []string{"owned"},
+			},
+		},
+	}
+	cloudWatchFlowLog := infrav1.VPCFlowLogSpec{
+		Destination:              "arn:aws:logs:us-east-1:123456789012:log-group:flow-logs",
+		DeliverLogsPermissionARN: "arn:aws:iam::123456789012:role/flow-logs",
+	}
+	s3FlowLog := infrav1.VPCFlowLogSpec{
+		DestinationType:        infrav1.FlowLogDestinationTypeS3,
+		Destination:            "arn:aws:s3:::flow-logs/test-cluster/",
+		TrafficType:            infrav1.FlowLogTrafficTypeReject,
+		MaxAggregationInterval: 60,
+	}
+
+	testCases := []struct {
+		name           string
+		flowLogs       []infrav1.VPCFlowLogSpec
+		flowLogIDs     []string
+		expect         func(m *mocks.MockEC2APIMockRecorder)
+		expectedStatus []string
+		wantErr        bool
+	}{
+		{
+			name: "no flow logs specified, nothing to do",
+		},
+		{
+			name:     "flow logs don't exist, creates them",
+			flowLogs: []infrav1.VPCFlowLogSpec{cloudWatchFlowLog, s3FlowLog},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ResourceIds).To(Equal([]string{"vpc-1"}))
+						g.Expect(input.ResourceType).To(Equal(types.FlowLogsResourceTypeVpc))
+						g.Expect(input.LogDestinationType).To(Equal(types.LogDestinationTypeCloudWatchLogs))
+						g.Expect(input.LogDestination).To(Equal(aws.String("arn:aws:logs:us-east-1:123456789012:log-group:flow-logs")))
+						g.Expect(input.TrafficType).To(Equal(types.TrafficTypeAll))
+						g.Expect(input.MaxAggregationInterval).To(Equal(aws.Int32(600)))
+						g.Expect(input.DeliverLogsPermissionArn).To(Equal(aws.String("arn:aws:iam::123456789012:role/flow-logs")))
+						g.Expect(input.LogFormat).To(BeNil())
+						g.Expect(input.TagSpecifications).To(HaveLen(1))
+						g.Expect(input.TagSpecifications[0].ResourceType).To(Equal(types.ResourceTypeVpcFlowLog))
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-1"}}, nil
+					})
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					DoAndReturn(func(_ context.Context, input *ec2.CreateFlowLogsInput, _ ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.LogDestinationType).To(Equal(types.LogDestinationTypeS3))
+						g.Expect(input.TrafficType).To(Equal(types.TrafficTypeReject))
+						g.Expect(input.MaxAggregationInterval).To(Equal(aws.Int32(60)))
+						g.Expect(input.DeliverLogsPermissionArn).To(BeNil())
+						return &ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-2"}}, nil
+					})
+			},
+			expectedStatus: []string{"fl-1", "fl-2"},
+		},
+		{
+			name:       "flow logs are in sync, nothing to do",
+			flowLogs:   []infrav1.VPCFlowLogSpec{cloudWatchFlowLog},
+			flowLogIDs: []string{"fl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{
+								FlowLogId:                aws.String("fl-1"),
+								LogDestinationType:       types.LogDestinationTypeCloudWatchLogs,
+								LogDestination:           aws.String("arn:aws:logs:us-east-1:123456789012:log-group:flow-logs:*"),
+								TrafficType:              types.TrafficTypeAll,
+								MaxAggregationInterval:   aws.Int32(600),
+								LogFormat:                aws.String("${version} ${account-id} ${interface-id}"),
+								DeliverLogsPermissionArn: aws.String("arn:aws:iam::123456789012:role/flow-logs"),
+							},
+						},
+					}, nil)
+			},
+			expectedStatus: []string{"fl-1"},
+		},
+		{
+			name:       "flow log spec changed, replaces it",
+			flowLogs:   []infrav1.VPCFlowLogSpec{s3FlowLog},
+			flowLogIDs: []string{"fl-2"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{
+								FlowLogId:              aws.String("fl-2"),
+								LogDestinationType:     types.LogDestinationTypeS3,
+								LogDestination:         aws.String("arn:aws:s3:::flow-logs/test-cluster/"),
+								TrafficType:            types.TrafficTypeAll,
+								MaxAggregationInterval: aws.Int32(60),
+							},
+						},
+					}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: []string{"fl-2"},
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{FlowLogIds: []string{"fl-3"}}, nil)
+			},
+			expectedStatus: []string{"fl-3"},
+		},
+		{
+			name:       "flow logs removed from the spec, deletes them",
+			flowLogIDs: []string{"fl-1"},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{
+								FlowLogId:          aws.String("fl-1"),
+								LogDestinationType: types.LogDestinationTypeCloudWatchLogs,
+								LogDestination:     aws.String("arn:aws:logs:us-east-1:123456789012:log-group:flow-logs"),
+							},
+						},
+					}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: []string{"fl-1"},
+				})).Return(&ec2.DeleteFlowLogsOutput{}, nil)
+			},
+		},
+		{
+			name:     "failed to create flow log",
+			flowLogs: []infrav1.VPCFlowLogSpec{cloudWatchFlowLog},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.Eq(describeFlowLogsInput), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{}, nil)
+				m.CreateFlowLogs(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateFlowLogsInput{})).
+					Return(&ec2.CreateFlowLogsOutput{
+						Unsuccessful: []types.UnsuccessfulItem{
+							{
+								ResourceId: aws.String("vpc-1"),
+								Error: &types.UnsuccessfulItemError{
+									Code:    aws.String("AccessDenied"),
+									Message: aws.String("Access Denied for LogDestination"),
+								},
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := getClusterScope(&infrav1.VPCSpec{
+				ID: "vpc-1",
+				Tags: infrav1.Tags{
+					infrav1.ClusterTagKey("test-cluster"): "owned",
+				},
+				FlowLogs: tc.flowLogs,
+			}, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+			clusterScope.Network().FlowLogIDs = tc.flowLogIDs
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileFlowLogs()
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().FlowLogIDs).To(Equal(tc.expectedStatus))
+		})
+	}
+}
+
+func TestDeleteFlowLogs(t *testing.T) {
+	testCases := []struct {
+		name   string
+		vpc    *infrav1.VPCSpec
+		expect func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name: "unmanaged vpc, nothing to do",
+			vpc:  &infrav1.VPCSpec{ID: "vpc-1"},
+		},
+		{
+			name: "deletes the flow logs owned by the cluster",
+			vpc: &infrav1.VPCSpec{
+				ID: "vpc-1",
+				Tags: infrav1.Tags{
+					infrav1.ClusterTagKey("test-cluster"): "owned",
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeFlowLogs(gomock.Any(), gomock.AssignableToTypeOf(&ec2.DescribeFlowLogsInput{}), gomock.Any()).
+					Return(&ec2.DescribeFlowLogsOutput{
+						FlowLogs: []types.FlowLog{
+							{FlowLogId: aws.String("fl-1")},
+							{FlowLogId: aws.String("fl-2")},
+						},
+					}, nil)
+				m.DeleteFlowLogs(context.TODO(), gomock.Eq(&ec2.DeleteFlowLogsInput{
+					FlowLogIds: []string{"fl-1", "fl-2"},
+				})).Return(&ec2.DeleteFlowLogsOutput{
+					Unsuccessful: []types.UnsuccessfulItem{
+						{
+							ResourceId: aws.String("fl-2"),
+							Error: &types.UnsuccessfulItemError{
+								Code:    aws.String("InvalidFlowLogId.NotFound"),
+								Message: aws.String("flow log fl-2 not found"),
+							},
+						},
+					},
+				}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(ec2Mock.EXPECT())
+			}
+
+			clusterScope, err := getClusterScope(tc.vpc, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(clusterScope)
+			s.EC2Client = ec2Mock
+
+			g.Expect(s.deleteFlowLogs()).To(Succeed())
+		})
+	}
+}
+
 func getClusterScope(vpcSpec *infrav1.VPCSpec, additionalTags map[string]string) (*scope.ClusterScope, error) {
 	scheme := runtime.NewScheme()
 	_ = infrav1.AddToScheme(scheme)
@@ -0,0 +1,293 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package route53 provides a way to interact with AWS Route53.
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/record"
+)
+
+// Service holds a collection of interfaces.
+// The interfaces are broken down like this to group functions together.
+// One alternative is to have a large list of functions from the route53 client.
+type Service struct {
+	scope         scope.Route53Scope
+	Route53Client Route53API
+}
+
+// Route53API is the subset of the AWS Route53 API that is used by CAPA.
+type Route53API interface {
+	ChangeResourceRecordSets(ctx context.Context, params *route53.ChangeResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error)
+	GetHostedZone(ctx context.Context, params *route53.GetHostedZoneInput, optFns ...func(*route53.Options)) (*route53.GetHostedZoneOutput, error)
+	ListHostedZonesByName(ctx context.Context, params *route53.ListHostedZonesByNameInput, optFns ...func(*route53.Options)) (*route53.ListHostedZonesByNameOutput, error)
+	ListResourceRecordSets(ctx context.Context, params *route53.ListResourceRecordSetsInput, optFns ...func(*route53.Options)) (*route53.ListResourceRecordSetsOutput, error)
+}
+
+var _ Route53API = &route53.Client{}
+
+// NewService returns a new service given the api clients.
+func NewService(route53Scope scope.Route53Scope) *Service {
+	return &Service{
+		scope:         route53Scope,
+		Route53Client: scope.NewRoute53Client(route53Scope, route53Scope, route53Scope, route53Scope.InfraCluster()),
+	}
+}
+
+// ReconcileControlPlaneDNSRecord reconciles the alias record pointing at the API server load balancer.
+// The record is reported in the network status once it exists, and is used as the control plane endpoint.
+func (s *Service) ReconcileControlPlaneDNSRecord(ctx context.Context) error {
+	lbSpec := s.scope.ControlPlaneLoadBalancer()
+	if lbSpec == nil || lbSpec.DNS == nil {
+		return nil
+	}
+
+	lb := s.scope.Network().APIServerELB
+	if lb.DNSName == "" || lb.CanonicalHostedZoneID == "" {
+		s.scope.Debug("Waiting for the API server load balancer before reconciling its DNS record")
+		return nil
+	}
+
+	s.scope.Debug("Reconciling API server load balancer DNS record")
+
+	hostedZoneID, err := s.getHostedZoneID(ctx, lbSpec.DNS)
+	if err != nil {
+		return err
+	}
+	recordName := normalizeDNSName(lbSpec.DNS.RecordName)
+
+	existing, err := s.describeAliasRecord(ctx, hostedZoneID, recordName)
+	if err != nil {
+		return err
+	}
+
+	owned := s.isOwnedRecord(hostedZoneID, recordName)
+	switch {
+	case existing == nil:
+		if err := s.changeAliasRecord(ctx, route53types.ChangeActionCreate, hostedZoneID, recordName, &lb); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedCreateDNSRecord", "Failed to create DNS record %q: %v", recordName, err)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulCreateDNSRecord", "Created DNS record %q", recordName)
+	case aliasTargets(existing, &lb):
+		// The record is up to date. A record targeting the load balancer is adopted, as it was created
+		// by a previous reconciliation whose status wasn't persisted.
+	case !owned:
+		return errors.Errorf("DNS record %q already exists in hosted zone %q and is not owned by the cluster", recordName, hostedZoneID)
+	default:
+		if err := s.changeAliasRecord(ctx, route53types.ChangeActionUpsert, hostedZoneID, recordName, &lb); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedUpdateDNSRecord", "Failed to update DNS record %q: %v", recordName, err)
+			return err
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulUpdateDNSRecord", "Updated DNS record %q", recordName)
+	}
+
+	s.scope.Network().APIServerDNSRecord = &infrav1.DNSRecord{
+		HostedZoneID: hostedZoneID,
+		Name:         recordName,
+	}
+
+	return nil
+}
+
+// DeleteControlPlaneDNSRecord deletes the alias record owned by the cluster, if any.
+func (s *Service) DeleteControlPlaneDNSRecord(ctx context.Context) error {
+	owned := s.scope.Network().APIServerDNSRecord
+	if owned == nil {
+		return nil
+	}
+
+	s.scope.Debug("Deleting API server load balancer DNS record", "name", owned.Name)
+
+	existing, err := s.describeAliasRecord(ctx, owned.HostedZoneID, owned.Name)
+	if err != nil && !isNoSuchHostedZone(err) {
+		return err
+	}
+
+	if existing != nil {
+		if _, err := s.Route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: aws.String(owned.HostedZoneID),
+			ChangeBatch: &route53types.ChangeBatch{
+				Changes: []route53types.Change{
+					{
+						Action:            route53types.ChangeActionDelete,
+						ResourceRecordSet: existing,
+					},
+				},
+			},
+		}); err != nil {
+			record.Warnf(s.scope.InfraCluster(), "FailedDeleteDNSRecord", "Failed to delete DNS record %q: %v", owned.Name, err)
+			return errors.Wrapf(err, "failed to delete DNS record %q in hosted zone %q", owned.Name, owned.HostedZoneID)
+		}
+		record.Eventf(s.scope.InfraCluster(), "SuccessfulDeleteDNSRecord", "Deleted DNS record %q", owned.Name)
+	}
+
+	s.scope.Network().APIServerDNSRecord = nil
+
+	return nil
+}
+
+// getHostedZoneID returns the ID of the hosted zone of the record. The hosted zone of an owned record
+// is reused, as the DNS spec is immutable.
+func (s *Service) getHostedZoneID(ctx context.Context, dns *infrav1.LoadBalancerDNSSpec) (string, error) {
+	if owned := s.scope.Network().APIServerDNSRecord; owned != nil {
+		return owned.HostedZoneID, nil
+	}
+
+	recordName := normalizeDNSName(dns.RecordName)
+
+	if dns.HostedZoneID != "" {
+		hostedZoneID := trimHostedZoneIDPrefix(dns.HostedZoneID)
+		out, err := s.Route53Client.GetHostedZone(ctx, &route53.GetHostedZoneInput{
+			Id: aws.String(hostedZoneID),
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get hosted zone %q", hostedZoneID)
+		}
+		if !inZone(recordName, aws.ToString(out.HostedZone.Name)) {
+			return "", errors.Errorf("DNS record %q doesn't belong to hosted zone %q (%s)", recordName, hostedZoneID, aws.ToString(out.HostedZone.Name))
+		}
+		return hostedZoneID, nil
+	}
+
+	zoneName := normalizeDNSName(dns.HostedZoneName)
+	out, err := s.Route53Client.ListHostedZonesByName(ctx, &route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(zoneName),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list hosted zones named %q", zoneName)
+	}
+
+	var matches []string
+	for _, zone := range out.HostedZones {
+		// Hosted zones are listed in lexicographic order starting from the given name.
+		if normalizeDNSName(aws.ToString(zone.Name)) != zoneName {
+			break
+		}
+		if zone.Config != nil && zone.Config.PrivateZone != dns.PrivateZone {
+			continue
+		}
+		matches = append(matches, trimHostedZoneIDPrefix(aws.ToString(zone.Id)))
+	}
+
+	zoneType := "public"
+	if dns.PrivateZone {
+		zoneType = "private"
+	}
+	switch len(matches) {
+	case 0:
+		return "", errors.Errorf("no %s hosted zone named %q found", zoneType, zoneName)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", errors.Errorf("found %d %s hosted zones named %q, the hosted zone ID must be set: %v", len(matches), zoneType, zoneName, matches)
+	}
+}
+
+// describeAliasRecord returns the A record with the given name, or nil if it doesn't exist.
+func (s *Service) describeAliasRecord(ctx context.Context, hostedZoneID, name string) (*route53types.ResourceRecordSet, error) {
+	out, err := s.Route53Client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(hostedZoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: route53types.RRTypeA,
+		MaxItems:        aws.Int32(1),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe DNS record %q in hosted zone %q", name, hostedZoneID)
+	}
+
+	for i := range out.ResourceRecordSets {
+		rrs := &out.ResourceRecordSets[i]
+		if normalizeDNSName(aws.ToString(rrs.Name)) == name && rrs.Type == route53types.RRTypeA {
+			return rrs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Service) changeAliasRecord(ctx context.Context, action route53types.ChangeAction, hostedZoneID, name string, lb *infrav1.LoadBalancer) error {
+	_, err := s.Route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Comment: aws.String(fmt.Sprintf("Kubernetes API server endpoint of cluster %s", s.scope.Name())),
+			Changes: []route53types.Change{
+				{
+					Action: action,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name: aws.String(name),
+						Type: route53types.RRTypeA,
+						AliasTarget: &route53types.AliasTarget{
+							DNSName:              aws.String(lb.DNSName),
+							HostedZoneId:         aws.String(lb.CanonicalHostedZoneID),
+							EvaluateTargetHealth: false,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s DNS record %q in hosted zone %q", strings.ToLower(string(action)), name, hostedZoneID)
+	}
+
+	return nil
+}
+
+func (s *Service) isOwnedRecord(hostedZoneID, name string) bool {
+	owned := s.scope.Network().APIServerDNSRecord
+	return owned != nil && owned.HostedZoneID == hostedZoneID && owned.Name == name
+}
+
+// aliasTargets returns true if the record is an alias of the load balancer.
+func aliasTargets(rrs *route53types.ResourceRecordSet, lb *infrav1.LoadBalancer) bool {
+	if rrs.AliasTarget == nil {
+		return false
+	}
+	// Route53 returns the DNS name of classic load balancers with a dualstack prefix.
+	target := strings.TrimPrefix(normalizeDNSName(aws.ToString(rrs.AliasTarget.DNSName)), "dualstack.")
+	return target == normalizeDNSName(lb.DNSName) && aws.ToString(rrs.AliasTarget.HostedZoneId) == lb.CanonicalHostedZoneID
+}
+
+func isNoSuchHostedZone(err error) bool {
+	var noSuchHostedZone *route53types.NoSuchHostedZone
+	return errors.As(err, &noSuchHostedZone)
+}
+
+// normalizeDNSName returns the lower case, fully qualified form of a DNS name used by Route53.
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, ".")) + "."
+}
+
+func trimHostedZoneIDPrefix(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
+
+func inZone(name, zone string) bool {
+	zone = normalizeDNSName(zone)
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
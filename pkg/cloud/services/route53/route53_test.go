@@ -0,0 +1,424 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route53_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53svc "github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-aws/v2/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/cloud/services/route53/mock_route53iface"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	testClusterName      = "test-cluster"
+	testClusterNamespace = "test-namespace"
+	testHostedZoneID     = "Z0123456789ABCDEFGHIJ"
+	testLBDNSName        = "test-cluster-apiserver-123456789.us-east-1.elb.amazonaws.com"
+	testLBHostedZoneID   = "Z35SXDOTRQ7X7K"
+)
+
+var testLoadBalancer = infrav1.LoadBalancer{
+	Name:                  "test-cluster-apiserver",
+	DNSName:               testLBDNSName,
+	CanonicalHostedZoneID: testLBHostedZoneID,
+}
+
+func TestReconcileControlPlaneDNSRecord(t *testing.T) {
+	listRecordInput := &route53svc.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(testHostedZoneID),
+		StartRecordName: aws.String("api.cluster.example.com."),
+		StartRecordType: types.RRTypeA,
+		MaxItems:        aws.Int32(1),
+	}
+	aliasRecord := func(dnsName, hostedZoneID string) types.ResourceRecordSet {
+		return types.ResourceRecordSet{
+			Name: aws.String("api.cluster.example.com."),
+			Type: types.RRTypeA,
+			AliasTarget: &types.AliasTarget{
+				DNSName:      aws.String(dnsName),
+				HostedZoneId: aws.String(hostedZoneID),
+			},
+		}
+	}
+	ownedRecord := &infrav1.DNSRecord{
+		HostedZoneID: testHostedZoneID,
+		Name:         "api.cluster.example.com.",
+	}
+
+	testCases := []struct {
+		name           string
+		dns            *infrav1.LoadBalancerDNSSpec
+		loadBalancer   infrav1.LoadBalancer
+		record         *infrav1.DNSRecord
+		expect         func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		expectedRecord *infrav1.DNSRecord
+		wantErr        bool
+	}{
+		{
+			name:         "no DNS record specified, nothing to do",
+			loadBalancer: testLoadBalancer,
+		},
+		{
+			name: "load balancer not created yet, waits for it",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: infrav1.LoadBalancer{DNSName: testLBDNSName},
+		},
+		{
+			name: "record doesn't exist, looks up the hosted zone by name and creates the record",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneName: "example.com",
+				RecordName:     "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListHostedZonesByName(context.TODO(), gomock.Eq(&route53svc.ListHostedZonesByNameInput{
+					DNSName: aws.String("example.com."),
+				})).Return(&route53svc.ListHostedZonesByNameOutput{
+					HostedZones: []types.HostedZone{
+						{
+							Id:     aws.String("/hostedzone/ZPRIVATE"),
+							Name:   aws.String("example.com."),
+							Config: &types.HostedZoneConfig{PrivateZone: true},
+						},
+						{
+							Id:     aws.String("/hostedzone/" + testHostedZoneID),
+							Name:   aws.String("example.com."),
+							Config: &types.HostedZoneConfig{PrivateZone: false},
+						},
+						{
+							Id:   aws.String("/hostedzone/ZOTHER"),
+							Name: aws.String("example.org."),
+						},
+					},
+				}, nil)
+				m.ListResourceRecordSets(context.TODO(), gomock.Eq(listRecordInput)).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{
+							{
+								Name: aws.String("www.cluster.example.com."),
+								Type: types.RRTypeA,
+							},
+						},
+					}, nil)
+				m.ChangeResourceRecordSets(context.TODO(), gomock.AssignableToTypeOf(&route53svc.ChangeResourceRecordSetsInput{})).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.HostedZoneId).To(Equal(aws.String(testHostedZoneID)))
+						g.Expect(input.ChangeBatch.Changes).To(HaveLen(1))
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionCreate))
+						rrs := aliasRecord(testLBDNSName, testLBHostedZoneID)
+						g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet).To(Equal(&rrs))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectedRecord: ownedRecord,
+		},
+		{
+			name: "hosted zone ID doesn't match the record name",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(context.TODO(), gomock.Eq(&route53svc.GetHostedZoneInput{
+					Id: aws.String(testHostedZoneID),
+				})).Return(&route53svc.GetHostedZoneOutput{
+					HostedZone: &types.HostedZone{
+						Id:   aws.String("/hostedzone/" + testHostedZoneID),
+						Name: aws.String("example.org."),
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "owned record is up to date, nothing to do",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			record:       ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Eq(listRecordInput)).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{
+							aliasRecord("dualstack."+testLBDNSName+".", testLBHostedZoneID),
+						},
+					}, nil)
+			},
+			expectedRecord: ownedRecord,
+		},
+		{
+			name: "owned record targets another load balancer, updates it",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			record:       ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Eq(listRecordInput)).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{
+							aliasRecord("old-apiserver.us-east-1.elb.amazonaws.com.", testLBHostedZoneID),
+						},
+					}, nil)
+				m.ChangeResourceRecordSets(context.TODO(), gomock.AssignableToTypeOf(&route53svc.ChangeResourceRecordSetsInput{})).
+					DoAndReturn(func(_ context.Context, input *route53svc.ChangeResourceRecordSetsInput, _ ...func(*route53svc.Options)) (*route53svc.ChangeResourceRecordSetsOutput, error) {
+						g := NewWithT(t)
+						g.Expect(input.ChangeBatch.Changes[0].Action).To(Equal(types.ChangeActionUpsert))
+						g.Expect(input.ChangeBatch.Changes[0].ResourceRecordSet.AliasTarget.DNSName).To(Equal(aws.String(testLBDNSName)))
+						return &route53svc.ChangeResourceRecordSetsOutput{}, nil
+					})
+			},
+			expectedRecord: ownedRecord,
+		},
+		{
+			name: "record targeting the load balancer exists but isn't in the status, adopts it",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(context.TODO(), gomock.Any()).Return(&route53svc.GetHostedZoneOutput{
+					HostedZone: &types.HostedZone{
+						Id:   aws.String("/hostedzone/" + testHostedZoneID),
+						Name: aws.String("example.com."),
+					},
+				}, nil)
+				m.ListResourceRecordSets(context.TODO(), gomock.Eq(listRecordInput)).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{
+							aliasRecord(testLBDNSName, testLBHostedZoneID),
+						},
+					}, nil)
+			},
+			expectedRecord: ownedRecord,
+		},
+		{
+			name: "record exists and isn't owned by the cluster",
+			dns: &infrav1.LoadBalancerDNSSpec{
+				HostedZoneID: testHostedZoneID,
+				RecordName:   "api.cluster.example.com",
+			},
+			loadBalancer: testLoadBalancer,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.GetHostedZone(context.TODO(), gomock.Any()).Return(&route53svc.GetHostedZoneOutput{
+					HostedZone: &types.HostedZone{
+						Id:   aws.String("/hostedzone/" + testHostedZoneID),
+						Name: aws.String("example.com."),
+					},
+				}, nil)
+				m.ListResourceRecordSets(context.TODO(), gomock.Eq(listRecordInput)).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{
+							{
+								Name:            aws.String("api.cluster.example.com."),
+								Type:            types.RRTypeA,
+								ResourceRecords: []types.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+							},
+						},
+					}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(route53Mock.EXPECT())
+			}
+
+			clusterScope := newClusterScope(t, tc.dns, tc.loadBalancer, tc.record)
+			svc := route53.NewService(clusterScope)
+			svc.Route53Client = route53Mock
+
+			err := svc.ReconcileControlPlaneDNSRecord(context.TODO())
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().APIServerDNSRecord).To(Equal(tc.expectedRecord))
+		})
+	}
+}
+
+func TestDeleteControlPlaneDNSRecord(t *testing.T) {
+	dns := &infrav1.LoadBalancerDNSSpec{
+		HostedZoneID: testHostedZoneID,
+		RecordName:   "api.cluster.example.com",
+	}
+	ownedRecord := &infrav1.DNSRecord{
+		HostedZoneID: testHostedZoneID,
+		Name:         "api.cluster.example.com.",
+	}
+	existing := types.ResourceRecordSet{
+		Name: aws.String("api.cluster.example.com."),
+		Type: types.RRTypeA,
+		AliasTarget: &types.AliasTarget{
+			DNSName:      aws.String(testLBDNSName + "."),
+			HostedZoneId: aws.String(testLBHostedZoneID),
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		record  *infrav1.DNSRecord
+		expect  func(m *mock_route53iface.MockRoute53APIMockRecorder)
+		wantErr bool
+	}{
+		{
+			name: "no owned record, nothing to do",
+		},
+		{
+			name:   "owned record exists, deletes it",
+			record: ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Any()).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{existing},
+					}, nil)
+				m.ChangeResourceRecordSets(context.TODO(), gomock.Eq(&route53svc.ChangeResourceRecordSetsInput{
+					HostedZoneId: aws.String(testHostedZoneID),
+					ChangeBatch: &types.ChangeBatch{
+						Changes: []types.Change{
+							{
+								Action:            types.ChangeActionDelete,
+								ResourceRecordSet: &existing,
+							},
+						},
+					},
+				})).Return(&route53svc.ChangeResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:   "owned record was already deleted",
+			record: ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Any()).
+					Return(&route53svc.ListResourceRecordSetsOutput{}, nil)
+			},
+		},
+		{
+			name:   "hosted zone was deleted",
+			record: ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Any()).
+					Return(nil, &types.NoSuchHostedZone{})
+			},
+		},
+		{
+			name:   "failed to delete the record",
+			record: ownedRecord,
+			expect: func(m *mock_route53iface.MockRoute53APIMockRecorder) {
+				m.ListResourceRecordSets(context.TODO(), gomock.Any()).
+					Return(&route53svc.ListResourceRecordSetsOutput{
+						ResourceRecordSets: []types.ResourceRecordSet{existing},
+					}, nil)
+				m.ChangeResourceRecordSets(context.TODO(), gomock.Any()).
+					Return(nil, &types.InvalidChangeBatch{})
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			route53Mock := mock_route53iface.NewMockRoute53API(mockCtrl)
+			if tc.expect != nil {
+				tc.expect(route53Mock.EXPECT())
+			}
+
+			clusterScope := newClusterScope(t, dns, testLoadBalancer, tc.record)
+			svc := route53.NewService(clusterScope)
+			svc.Route53Client = route53Mock
+
+			err := svc.DeleteControlPlaneDNSRecord(context.TODO())
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(clusterScope.Network().APIServerDNSRecord).To(Equal(tc.record))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clusterScope.Network().APIServerDNSRecord).To(BeNil())
+		})
+	}
+}
+
+func newClusterScope(t *testing.T, dns *infrav1.LoadBalancerDNSSpec, lb infrav1.LoadBalancer, record *infrav1.DNSRecord) *scope.ClusterScope {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: client,
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testClusterName,
+				Namespace: testClusterNamespace,
+			},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				Region: "us-east-1",
+				ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{
+					LoadBalancerType: infrav1.LoadBalancerTypeNLB,
+					DNS:              dns,
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					APIServerELB:       lb,
+					APIServerDNSRecord: record,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create test context: %v", err)
+	}
+
+	return clusterScope
+}
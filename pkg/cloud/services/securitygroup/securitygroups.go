@@ -63,6 +63,7 @@ func (s *Service) ReconcileSecurityGroups() error {
 	if s.scope.Network().SecurityGroups == nil {
 		s.scope.Network().SecurityGroups = make(map[infrav1.SecurityGroupRole]infrav1.SecurityGroup)
 	}
+	s.prefixListIDsByName = nil
 
 	var err error
 
@@ -115,14 +116,20 @@ func (s *Service) ReconcileSecurityGroups() error {
 				return err
 			}
 
+			// New security groups allow all outbound traffic, which is replaced by the egress rules of the
+			// role in the second pass.
 			s.scope.SecurityGroups()[role] = infrav1.SecurityGroup{
-				ID:   *sg.GroupId,
-				Name: *sg.GroupName,
+				ID:          *sg.GroupId,
+				Name:        *sg.GroupName,
+				EgressRules: s.defaultEgressRules(),
 			}
 			continue
 		}
 
 		// TODO(vincepri): validate / update security group if necessary.
+		if previous, ok := s.scope.SecurityGroups()[role]; ok && previous.ID == existing.ID {
+			existing.EgressRulesManaged = previous.EgressRulesManaged
+		}
 		s.scope.SecurityGroups()[role] = existing
 
 		if s.isEKSOwned(existing) {
@@ -196,11 +203,129 @@ func (s *Service) ReconcileSecurityGroups() error {
 
 			s.scope.Debug("Authorized ingress rules in security group", "authorized-ingress-rules", toAuthorize, "security-group-id", sg.ID)
 		}
+
+		if err := s.reconcileSecurityGroupEgressRules(role, sg); err != nil {
+			return err
+		}
 	}
 	conditions.MarkTrue(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition)
 	return nil
 }
 
+// reconcileSecurityGroupEgressRules creates or updates the egress rules of the security group to match the
+// specified egress rules. Egress rules are only reconciled when they are specified for the role, or to
+// restore the default egress rules once they aren't specified anymore.
+func (s *Service) reconcileSecurityGroupEgressRules(role infrav1.SecurityGroupRole, sg infrav1.SecurityGroup) error {
+	specRules, ok, err := s.getSecurityGroupEgressRules(role)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if !sg.EgressRulesManaged {
+			return nil
+		}
+		specRules = s.defaultEgressRules()
+	}
+
+	current := sg.EgressRules
+	// Duplicate rules with multiple destinations so that we are comparing similar sets.
+	want := expandEgressRules(specRules)
+
+	toRevoke := current.Difference(want)
+	if len(toRevoke) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.revokeSecurityGroupEgressRules(sg.ID, toRevoke); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return errors.Wrapf(err, "failed to revoke security group egress rules for %q", sg.ID)
+		}
+
+		s.scope.Debug("Revoked egress rules from security group", "revoked-egress-rules", toRevoke, "security-group-id", sg.ID)
+	}
+
+	toAuthorize := want.Difference(current)
+	if len(toAuthorize) > 0 {
+		if err := wait.WaitForWithRetryable(wait.NewBackoff(), func() (bool, error) {
+			if err := s.authorizeSecurityGroupEgressRules(sg.ID, toAuthorize); err != nil {
+				return false, err
+			}
+			return true, nil
+		}, awserrors.GroupNotFound); err != nil {
+			return err
+		}
+
+		s.scope.Debug("Authorized egress rules in security group", "authorized-egress-rules", toAuthorize, "security-group-id", sg.ID)
+	}
+
+	sg.EgressRulesManaged = ok
+	s.scope.SecurityGroups()[role] = sg
+	return nil
+}
+
+// defaultEgressRules returns the egress rules of a new security group, allowing all outbound traffic.
+func (s *Service) defaultEgressRules() infrav1.EgressRules {
+	rules := infrav1.EgressRules{
+		{
+			Protocol:   infrav1.SecurityGroupProtocolAll,
+			FromPort:   -1,
+			ToPort:     -1,
+			CidrBlocks: []string{services.AnyIPv4CidrBlock},
+		},
+	}
+	if s.scope.VPC().IsIPv6Enabled() {
+		rules = append(rules, infrav1.EgressRule{
+			Protocol:       infrav1.SecurityGroupProtocolAll,
+			FromPort:       -1,
+			ToPort:         -1,
+			IPv6CidrBlocks: []string{services.AnyIPv6CidrBlock},
+		})
+	}
+	return rules
+}
+
+// expandEgressRules expand the given egress rules so that it's compatible with the list generated by
+// egressRulesFromSDKType.
+// We assume that processEgressRulesSGs has been already called on the input, so the DestinationSecurityGroupRoles
+// have been translated into Security Group IDs.
+func expandEgressRules(rules infrav1.EgressRules) infrav1.EgressRules {
+	res := make(infrav1.EgressRules, 0, len(rules))
+	for _, rule := range rules {
+		base := infrav1.EgressRule{
+			Description: rule.Description,
+			Protocol:    rule.Protocol,
+			FromPort:    rule.FromPort,
+			ToPort:      rule.ToPort,
+		}
+
+		for _, dst := range rule.CidrBlocks {
+			rcopy := base
+			rcopy.CidrBlocks = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.IPv6CidrBlocks {
+			rcopy := base
+			rcopy.IPv6CidrBlocks = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.DestinationSecurityGroupIDs {
+			rcopy := base
+			rcopy.DestinationSecurityGroupIDs = []string{dst}
+			res = append(res, rcopy)
+		}
+
+		for _, dst := range rule.PrefixListIDs {
+			rcopy := base
+			rcopy.PrefixListIDs = []string{dst}
+			res = append(res, rcopy)
+		}
+	}
+	return res
+}
+
 // expandIngressRules expand the given ingress rules so that it's compatible with the list generated by
 // ingressRulesFromSDKType.
 // We assume that processIngressRulesSGs has been already called on the input, so the SourceSecurityGroupRoles have
@@ -216,7 +341,7 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 		}
 
 		// Nothing to expand
-		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 {
+		if len(rule.CidrBlocks) == 0 && len(rule.IPv6CidrBlocks) == 0 && len(rule.SourceSecurityGroupIDs) == 0 && len(rule.SourcePrefixListIDs) == 0 {
 			res = append(res, base)
 			continue
 		}
@@ -238,6 +363,12 @@ func expandIngressRules(rules infrav1.IngressRules) infrav1.IngressRules {
 			rcopy.SourceSecurityGroupIDs = []string{src}
 			res = append(res, rcopy)
 		}
+
+		for _, src := range rule.SourcePrefixListIDs {
+			rcopy := base
+			rcopy.SourcePrefixListIDs = []string{src}
+			res = append(res, rcopy)
+		}
 	}
 	return res
 }
@@ -301,6 +432,9 @@ func (s *Service) ec2SecurityGroupToSecurityGroup(ec2SecurityGroup types.Securit
 	for _, ec2rule := range ec2SecurityGroup.IpPermissions {
 		sg.IngressRules = append(sg.IngressRules, ingressRulesFromSDKType(ec2rule)...)
 	}
+	for _, ec2rule := range ec2SecurityGroup.IpPermissionsEgress {
+		sg.EgressRules = append(sg.EgressRules, egressRulesFromSDKType(ec2rule)...)
+	}
 	return sg
 }
 
@@ -329,6 +463,10 @@ func (s *Service) DeleteSecurityGroups() error {
 
 	for i := range clusterGroups {
 		sg := clusterGroups[i]
+		// The security group of the VPC endpoints is deleted with the network, once the endpoints using it are deleted.
+		if sg.Tags.GetRole() == infrav1.VPCEndpointRoleTagValue {
+			continue
+		}
 		current := sg.IngressRules
 		if err := s.revokeAllSecurityGroupIngressRules(sg.ID); awserrors.IsIgnorableSecurityGroupError(err) != nil { //nolint:gocritic
 			conditions.MarkFalse(s.scope.InfraCluster(), infrav1.ClusterSecurityGroupsReadyCondition, "DeletingFailed", clusterv1.ConditionSeverityWarning, "%s", err.Error())
@@ -447,7 +585,7 @@ func (s *Service) revokeIngressAndEgressRulesFromVPCDefaultSecurityGroup() error
 		return errors.Wrapf(err, "failed to revoke ingress rules from vpc default security group %q in VPC %q", defaultSecurityGroupID, s.scope.VPC().ID)
 	}
 
-	egressRules := infrav1.IngressRules{
+	egressRules := infrav1.EgressRules{
 		{
 			Protocol:   infrav1.SecurityGroupProtocolAll,
 			FromPort:   -1,
@@ -526,11 +664,26 @@ func (s *Service) revokeSecurityGroupIngressRules(id string, rules infrav1.Ingre
 	return nil
 }
 
-func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.IngressRules) error {
+func (s *Service) authorizeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
+	input := &ec2.AuthorizeSecurityGroupEgressInput{GroupId: aws.String(id)}
+	for i := range rules {
+		rule := rules[i]
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
+	}
+	if _, err := s.EC2Client.AuthorizeSecurityGroupEgress(context.TODO(), input); err != nil {
+		record.Warnf(s.scope.InfraCluster(), "FailedAuthorizeSecurityGroupEgressRules", "Failed to authorize security group egress rules %v for SecurityGroup %q: %v", rules, id, err)
+		return errors.Wrapf(err, "failed to authorize security group %q egress rules: %v", id, rules)
+	}
+
+	record.Eventf(s.scope.InfraCluster(), "SuccessfulAuthorizeSecurityGroupEgressRules", "Authorized security group egress rules %v for SecurityGroup %q", rules, id)
+	return nil
+}
+
+func (s *Service) revokeSecurityGroupEgressRules(id string, rules infrav1.EgressRules) error {
 	input := &ec2.RevokeSecurityGroupEgressInput{GroupId: aws.String(id)}
 	for i := range rules {
 		rule := rules[i]
-		input.IpPermissions = append(input.IpPermissions, *ingressRuleToSDKType(s.scope, &rule))
+		input.IpPermissions = append(input.IpPermissions, *egressRuleToSDKType(s.scope, &rule))
 	}
 
 	if _, err := s.EC2Client.RevokeSecurityGroupEgress(context.TODO(), input); err != nil && !awserrors.IsPermissionNotFoundError(errors.Cause(err)) {
@@ -598,11 +751,12 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 	case infrav1.SecurityGroupBastion:
 		return infrav1.IngressRules{
 			{
-				Description: "SSH",
-				Protocol:    infrav1.SecurityGroupProtocolTCP,
-				FromPort:    22,
-				ToPort:      22,
-				CidrBlocks:  s.scope.Bastion().AllowedCIDRBlocks,
+				Description:         "SSH",
+				Protocol:            infrav1.SecurityGroupProtocolTCP,
+				FromPort:            22,
+				ToPort:              22,
+				CidrBlocks:          s.scope.Bastion().AllowedCIDRBlocks,
+				SourcePrefixListIDs: s.scope.Bastion().AllowedPrefixListIDs,
 			},
 		}, nil
 	case infrav1.SecurityGroupControlPlane:
@@ -648,16 +802,18 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 
 	case infrav1.SecurityGroupNode:
 		cidrBlocks := []string{services.AnyIPv4CidrBlock}
-		if scopeCidrBlocks := s.scope.NodePortIngressRuleCidrBlocks(); len(scopeCidrBlocks) > 0 {
+		prefixListIDs := s.scope.NodePortIngressRulePrefixListIDs()
+		if scopeCidrBlocks := s.scope.NodePortIngressRuleCidrBlocks(); len(scopeCidrBlocks) > 0 || len(prefixListIDs) > 0 {
 			cidrBlocks = scopeCidrBlocks
 		}
 		rules := infrav1.IngressRules{
 			{
-				Description: "Node Port Services",
-				Protocol:    infrav1.SecurityGroupProtocolTCP,
-				FromPort:    30000,
-				ToPort:      32767,
-				CidrBlocks:  cidrBlocks,
+				Description:         "Node Port Services",
+				Protocol:            infrav1.SecurityGroupProtocolTCP,
+				FromPort:            30000,
+				ToPort:              32767,
+				CidrBlocks:          cidrBlocks,
+				SourcePrefixListIDs: prefixListIDs,
 			},
 			{
 				Description: "Kubelet API",
@@ -693,6 +849,11 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 		return append(cniRules, rules...), nil
 	case infrav1.SecurityGroupEKSNodeAdditional:
 		ingressRules := s.scope.AdditionalControlPlaneIngressRules()
+		for i := range ingressRules {
+			if err := s.resolveSourcePrefixListNames(&ingressRules[i]); err != nil {
+				return nil, err
+			}
+		}
 		if s.scope.Bastion().Enabled {
 			ingressRules = append(ingressRules, s.defaultSSHIngressRule(s.scope.SecurityGroups()[infrav1.SecurityGroupBastion].ID))
 		}
@@ -760,6 +921,34 @@ func (s *Service) getSecurityGroupIngressRules(role infrav1.SecurityGroupRole) (
 	return nil, errors.Errorf("Cannot determine ingress rules for unknown security group role %q", role)
 }
 
+// getSecurityGroupEgressRules returns the egress rules of the security group of the role and whether they
+// are specified. When no egress rules are specified, the egress rules of the security group aren't changed,
+// which keeps the default rule allowing all outbound traffic.
+func (s *Service) getSecurityGroupEgressRules(role infrav1.SecurityGroupRole) (infrav1.EgressRules, bool, error) {
+	var rules infrav1.EgressRules
+	if overrides, ok := s.scope.SecurityGroupEgressRules()[role]; ok {
+		rules = overrides
+	} else {
+		switch role {
+		case infrav1.SecurityGroupControlPlane:
+			rules = s.scope.AdditionalControlPlaneEgressRules()
+		case infrav1.SecurityGroupNode:
+			rules = s.scope.AdditionalNodeEgressRules()
+		}
+
+		if len(rules) == 0 {
+			return nil, false, nil
+		}
+	}
+
+	rules, err := s.processEgressRulesSGs(rules)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return rules, true, nil
+}
+
 func (s *Service) getSecurityGroupName(clusterName string, role infrav1.SecurityGroupRole) string {
 	groupPrefix := clusterName
 	if strings.HasPrefix(clusterName, "sg-") {
@@ -870,9 +1059,131 @@ func ingressRuleToSDKType(scope scope.SGScope, i *infrav1.IngressRule) (res *typ
 		res.UserIdGroupPairs = append(res.UserIdGroupPairs, userIDGroupPair)
 	}
 
+	for _, prefixListID := range i.SourcePrefixListIDs {
+		prefixList := types.PrefixListId{
+			PrefixListId: aws.String(prefixListID),
+		}
+
+		if i.Description != "" {
+			prefixList.Description = aws.String(i.Description)
+		}
+
+		res.PrefixListIds = append(res.PrefixListIds, prefixList)
+	}
+
 	return res
 }
 
+func egressRuleToSDKType(scope scope.SGScope, e *infrav1.EgressRule) (res *types.IpPermission) {
+	// AWS seems to ignore the From/To port when set on protocols where it doesn't apply, but
+	// we avoid serializing it out for clarity's sake.
+	// See: https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_IpPermission.html
+	switch e.Protocol {
+	case infrav1.SecurityGroupProtocolTCP,
+		infrav1.SecurityGroupProtocolUDP,
+		infrav1.SecurityGroupProtocolICMP,
+		infrav1.SecurityGroupProtocolICMPv6:
+		res = &types.IpPermission{
+			IpProtocol: aws.String(string(e.Protocol)),
+			FromPort:   utils.ToInt32Pointer(&e.FromPort),
+			ToPort:     utils.ToInt32Pointer(&e.ToPort),
+		}
+	case infrav1.SecurityGroupProtocolIPinIP,
+		infrav1.SecurityGroupProtocolESP,
+		infrav1.SecurityGroupProtocolAll:
+		res = &types.IpPermission{
+			IpProtocol: aws.String(string(e.Protocol)),
+		}
+	default:
+		scope.Error(fmt.Errorf("invalid protocol '%s'", e.Protocol), "invalid protocol for security group", "protocol", e.Protocol)
+		return nil
+	}
+
+	var description *string
+	if e.Description != "" {
+		description = aws.String(e.Description)
+	}
+
+	for _, cidr := range e.CidrBlocks {
+		res.IpRanges = append(res.IpRanges, types.IpRange{
+			CidrIp:      aws.String(cidr),
+			Description: description,
+		})
+	}
+
+	for _, cidr := range e.IPv6CidrBlocks {
+		res.Ipv6Ranges = append(res.Ipv6Ranges, types.Ipv6Range{
+			CidrIpv6:    aws.String(cidr),
+			Description: description,
+		})
+	}
+
+	for _, groupID := range e.DestinationSecurityGroupIDs {
+		res.UserIdGroupPairs = append(res.UserIdGroupPairs, types.UserIdGroupPair{
+			GroupId:     aws.String(groupID),
+			Description: description,
+		})
+	}
+
+	for _, prefixListID := range e.PrefixListIDs {
+		res.PrefixListIds = append(res.PrefixListIds, types.PrefixListId{
+			PrefixListId: aws.String(prefixListID),
+			Description:  description,
+		})
+	}
+
+	return res
+}
+
+func egressRulesFromSDKType(v types.IpPermission) (res infrav1.EgressRules) {
+	for _, ec2range := range v.IpRanges {
+		rule := egressRuleFromSDKProtocol(v)
+		rule.Description = aws.ToString(ec2range.Description)
+		rule.CidrBlocks = []string{*ec2range.CidrIp}
+		res = append(res, rule)
+	}
+
+	for _, ec2range := range v.Ipv6Ranges {
+		rule := egressRuleFromSDKProtocol(v)
+		rule.Description = aws.ToString(ec2range.Description)
+		rule.IPv6CidrBlocks = []string{*ec2range.CidrIpv6}
+		res = append(res, rule)
+	}
+
+	for _, pair := range v.UserIdGroupPairs {
+		if pair.GroupId == nil {
+			continue
+		}
+
+		rule := egressRuleFromSDKProtocol(v)
+		rule.Description = aws.ToString(pair.Description)
+		rule.DestinationSecurityGroupIDs = []string{*pair.GroupId}
+		res = append(res, rule)
+	}
+
+	for _, prefixList := range v.PrefixListIds {
+		if prefixList.PrefixListId == nil {
+			continue
+		}
+
+		rule := egressRuleFromSDKProtocol(v)
+		rule.Description = aws.ToString(prefixList.Description)
+		rule.PrefixListIDs = []string{*prefixList.PrefixListId}
+		res = append(res, rule)
+	}
+
+	return res
+}
+
+func egressRuleFromSDKProtocol(v types.IpPermission) infrav1.EgressRule {
+	ingressRule := ingressRuleFromSDKProtocol(v)
+	return infrav1.EgressRule{
+		Protocol: ingressRule.Protocol,
+		FromPort: ingressRule.FromPort,
+		ToPort:   ingressRule.ToPort,
+	}
+}
+
 func ingressRulesFromSDKType(v types.IpPermission) (res infrav1.IngressRules) {
 	for _, ec2range := range v.IpRanges {
 		rule := ingressRuleFromSDKProtocol(v)
@@ -908,6 +1219,20 @@ func ingressRulesFromSDKType(v types.IpPermission) (res infrav1.IngressRules) {
 		res = append(res, rule)
 	}
 
+	for _, prefixList := range v.PrefixListIds {
+		rule := ingressRuleFromSDKProtocol(v)
+		if prefixList.PrefixListId == nil {
+			continue
+		}
+
+		if prefixList.Description != nil && *prefixList.Description != "" {
+			rule.Description = *prefixList.Description
+		}
+
+		rule.SourcePrefixListIDs = []string{*prefixList.PrefixListId}
+		res = append(res, rule)
+	}
+
 	return res
 }
 
@@ -1031,6 +1356,10 @@ func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (in
 	output := []infrav1.IngressRule{}
 
 	for _, rule := range ingressRules {
+		if err := s.resolveSourcePrefixListNames(&rule); err != nil {
+			return nil, err
+		}
+
 		if rule.NatGatewaysIPsSource { // if the rule has NatGatewaysIPsSource set to true, use the NAT Gateway IPs as the source
 			natGatewaysCidrs := []string{}
 			natGatewaysIPs := s.scope.GetNatGatewaysIPs()
@@ -1046,7 +1375,8 @@ func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (in
 			return nil, errors.New("NAT Gateway IPs are not available yet")
 		}
 
-		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 { // don't set source security group if cidr blocks are set
+		// don't set source security group if cidr blocks or prefix lists are set
+		if len(rule.CidrBlocks) != 0 || len(rule.IPv6CidrBlocks) != 0 || len(rule.SourcePrefixListIDs) != 0 {
 			output = append(output, rule)
 			continue
 		}
@@ -1068,3 +1398,108 @@ func (s *Service) processIngressRulesSGs(ingressRules []infrav1.IngressRule) (in
 
 	return output, nil
 }
+
+// resolveSourcePrefixListNames translates the source prefix list names of the ingress rule into prefix list IDs.
+func (s *Service) resolveSourcePrefixListNames(rule *infrav1.IngressRule) error {
+	if len(rule.SourcePrefixListNames) == 0 {
+		return nil
+	}
+
+	if err := s.describeSourcePrefixLists(rule.SourcePrefixListNames); err != nil {
+		return err
+	}
+
+	prefixListIDs := sets.New(rule.SourcePrefixListIDs...)
+	for _, name := range rule.SourcePrefixListNames {
+		id := s.prefixListIDsByName[name]
+		if id == "" {
+			return errors.Errorf("managed prefix list %q not found", name)
+		}
+		prefixListIDs.Insert(id)
+	}
+	rule.SourcePrefixListIDs = sets.List(prefixListIDs)
+	rule.SourcePrefixListNames = nil
+
+	return nil
+}
+
+// describeSourcePrefixLists looks up the managed prefix lists referenced by name in the ingress rules of the
+// cluster, so the prefix lists are described once per reconciliation rather than once per rule.
+func (s *Service) describeSourcePrefixLists(names []string) error {
+	toDescribe := sets.New[string]()
+	addNames := func(names []string) {
+		for _, name := range names {
+			if _, ok := s.prefixListIDsByName[name]; !ok {
+				toDescribe.Insert(name)
+			}
+		}
+	}
+
+	addNames(names)
+	for _, rules := range []infrav1.IngressRules{
+		s.scope.AdditionalControlPlaneIngressRules(),
+		s.scope.AdditionalNodeIngressRules(),
+		s.getControlPlaneLBIngressRules(),
+	} {
+		for _, rule := range rules {
+			addNames(rule.SourcePrefixListNames)
+		}
+	}
+	if toDescribe.Len() == 0 {
+		return nil
+	}
+
+	out, err := s.EC2Client.DescribeManagedPrefixLists(context.TODO(), &ec2.DescribeManagedPrefixListsInput{
+		Filters: []types.Filter{
+			{
+				Name:   aws.String("prefix-list-name"),
+				Values: sets.List(toDescribe),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe managed prefix lists %v", sets.List(toDescribe))
+	}
+
+	if s.prefixListIDsByName == nil {
+		s.prefixListIDsByName = make(map[string]string)
+	}
+	for name := range toDescribe {
+		s.prefixListIDsByName[name] = ""
+	}
+	for _, prefixList := range out.PrefixLists {
+		name := aws.ToString(prefixList.PrefixListName)
+		if !toDescribe.Has(name) || prefixList.State == types.PrefixListStateDeleteComplete || s.prefixListIDsByName[name] != "" {
+			continue
+		}
+		s.prefixListIDsByName[name] = aws.ToString(prefixList.PrefixListId)
+	}
+
+	return nil
+}
+
+func (s *Service) processEgressRulesSGs(egressRules []infrav1.EgressRule) (infrav1.EgressRules, error) {
+	output := infrav1.EgressRules{}
+
+	for _, rule := range egressRules {
+		if len(rule.DestinationSecurityGroupRoles) == 0 {
+			output = append(output, rule)
+			continue
+		}
+
+		securityGroupIDs := sets.New(rule.DestinationSecurityGroupIDs...)
+		for _, destinationSGRole := range rule.DestinationSecurityGroupRoles {
+			sg, ok := s.scope.SecurityGroups()[destinationSGRole]
+			if !ok {
+				return nil, errors.Errorf("security group for role %q is not available", destinationSGRole)
+			}
+			securityGroupIDs.Insert(sg.ID)
+		}
+		rule.DestinationSecurityGroupIDs = sets.List(securityGroupIDs)
+		rule.DestinationSecurityGroupRoles = nil
+
+		output = append(output, rule)
+	}
+
+	return output, nil
+}
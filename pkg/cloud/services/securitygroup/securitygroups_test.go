@@ -1986,6 +1986,28 @@ func TestDeleteSecurityGroups(t *testing.T) {
 				m.DeleteSecurityGroup(context.TODO(), gomock.AssignableToTypeOf(&ec2.DeleteSecurityGroupInput{}), gomock.Any()).Return(nil, nil)
 			},
 		},
+		{
+			name: "do not delete the security group of the vpc endpoints",
+			input: &infrav1.NetworkSpec{
+				VPC: infrav1.VPCSpec{ID: "vpc-id"},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{}), gomock.Any()).Return(&ec2.DescribeSecurityGroupsOutput{
+					SecurityGroups: []types.SecurityGroup{
+						{
+							GroupId:   aws.String("sg-vpce"),
+							GroupName: aws.String("test-cluster-vpc-endpoint"),
+							Tags: []types.Tag{
+								{
+									Key:   aws.String("sigs.k8s.io/cluster-api-provider-aws/role"),
+									Value: aws.String("vpc-endpoint"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+		},
 		{
 			name: "Should return error if failed to revoke Ingress rules for a SG",
 			input: &infrav1.NetworkSpec{
@@ -2119,6 +2141,38 @@ func TestIngressRulesFromSDKType(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "two prefix lists",
+			input: types.IpPermission{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(22),
+				ToPort:     aws.Int32(22),
+				PrefixListIds: []types.PrefixListId{
+					{
+						PrefixListId: aws.String("pl-1"),
+						Description:  aws.String("Office"),
+					},
+					{
+						PrefixListId: aws.String("pl-2"),
+					},
+				},
+			},
+			expected: infrav1.IngressRules{
+				{
+					Description:         "Office",
+					Protocol:            "tcp",
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Protocol:            "tcp",
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-2"},
+				},
+			},
+		},
 		{
 			name: "Two group pairs",
 			input: types.IpPermission{
@@ -2326,6 +2380,34 @@ func TestExpandIngressRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "prefix list ids expand",
+			input: infrav1.IngressRules{
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1", "pl-2"},
+				},
+			},
+			expected: infrav1.IngressRules{
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-2"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2345,6 +2427,7 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 	testCases := []struct {
 		name                string
 		cidrBlocks          []string
+		prefixListIDs       []string
 		expectedIngresRules infrav1.IngressRules
 	}{
 		{
@@ -2387,6 +2470,26 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:          "node port prefix list provided, no default cidr block used for node port services ingress rule",
+			prefixListIDs: []string{"pl-1"},
+			expectedIngresRules: infrav1.IngressRules{
+				{
+					Description:         "Node Port Services",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            30000,
+					ToPort:              32767,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:            "Kubelet API",
+					Protocol:               infrav1.SecurityGroupProtocolTCP,
+					FromPort:               10250,
+					ToPort:                 10250,
+					SourceSecurityGroupIDs: []string{"Id1", "Id2"},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2403,7 +2506,8 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 							VPC: infrav1.VPCSpec{
 								CidrBlock: "10.0.0.0/16",
 							},
-							NodePortIngressRuleCidrBlocks: tc.cidrBlocks,
+							NodePortIngressRuleCidrBlocks:    tc.cidrBlocks,
+							NodePortIngressRulePrefixListIDs: tc.prefixListIDs,
 						},
 					},
 					Status: infrav1.AWSClusterStatus{
@@ -2431,3 +2535,625 @@ func TestNodePortServicesIngressRules(t *testing.T) {
 		})
 	}
 }
+
+func TestSecurityGroupEgressRules(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	securityGroups := map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+		infrav1.SecurityGroupControlPlane: {ID: "cp-sg-id"},
+		infrav1.SecurityGroupNode:         {ID: "node-sg-id"},
+	}
+
+	testCases := []struct {
+		name          string
+		networkSpec   infrav1.NetworkSpec
+		role          infrav1.SecurityGroupRole
+		expectedRules infrav1.EgressRules
+		expectManaged bool
+		wantErr       bool
+	}{
+		{
+			name:          "egress rules are not managed when none are specified",
+			networkSpec:   infrav1.NetworkSpec{},
+			role:          infrav1.SecurityGroupControlPlane,
+			expectManaged: false,
+		},
+		{
+			name: "additional node egress rules resolve destination security group roles",
+			networkSpec: infrav1.NetworkSpec{
+				AdditionalNodeEgressRules: []infrav1.EgressRule{
+					{
+						Description:                   "control plane",
+						Protocol:                      infrav1.SecurityGroupProtocolTCP,
+						FromPort:                      6443,
+						ToPort:                        6443,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupControlPlane},
+					},
+				},
+			},
+			role: infrav1.SecurityGroupNode,
+			expectedRules: infrav1.EgressRules{
+				{
+					Description:                 "control plane",
+					Protocol:                    infrav1.SecurityGroupProtocolTCP,
+					FromPort:                    6443,
+					ToPort:                      6443,
+					DestinationSecurityGroupIDs: []string{"cp-sg-id"},
+				},
+			},
+			expectManaged: true,
+		},
+		{
+			name: "egress rules override takes precedence over additional egress rules",
+			networkSpec: infrav1.NetworkSpec{
+				AdditionalControlPlaneEgressRules: []infrav1.EgressRule{
+					{
+						Protocol:   infrav1.SecurityGroupProtocolAll,
+						CidrBlocks: []string{"0.0.0.0/0"},
+					},
+				},
+				SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+					infrav1.SecurityGroupControlPlane: {
+						{
+							Protocol:      infrav1.SecurityGroupProtocolTCP,
+							FromPort:      443,
+							ToPort:        443,
+							PrefixListIDs: []string{"pl-1234"},
+						},
+					},
+				},
+			},
+			role: infrav1.SecurityGroupControlPlane,
+			expectedRules: infrav1.EgressRules{
+				{
+					Protocol:      infrav1.SecurityGroupProtocolTCP,
+					FromPort:      443,
+					ToPort:        443,
+					PrefixListIDs: []string{"pl-1234"},
+				},
+			},
+			expectManaged: true,
+		},
+		{
+			name: "empty egress rules override removes all egress rules",
+			networkSpec: infrav1.NetworkSpec{
+				SecurityGroupEgressRules: map[infrav1.SecurityGroupRole]infrav1.EgressRules{
+					infrav1.SecurityGroupBastion: {},
+				},
+			},
+			role:          infrav1.SecurityGroupBastion,
+			expectedRules: infrav1.EgressRules{},
+			expectManaged: true,
+		},
+		{
+			name: "error if the destination security group role is not available",
+			networkSpec: infrav1.NetworkSpec{
+				AdditionalNodeEgressRules: []infrav1.EgressRule{
+					{
+						Protocol:                      infrav1.SecurityGroupProtocolTCP,
+						FromPort:                      443,
+						ToPort:                        443,
+						DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{infrav1.SecurityGroupBastion},
+					},
+				},
+			},
+			role:    infrav1.SecurityGroupNode,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						NetworkSpec: tc.networkSpec,
+					},
+					Status: infrav1.AWSClusterStatus{
+						Network: infrav1.NetworkStatus{
+							SecurityGroups: securityGroups,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			rules, managed, err := s.getSecurityGroupEgressRules(tc.role)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(managed).To(Equal(tc.expectManaged))
+			g.Expect(rules).To(Equal(tc.expectedRules))
+		})
+	}
+}
+
+func TestReconcileSecurityGroupEgressRules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	g := NewWithT(t)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					AdditionalNodeEgressRules: []infrav1.EgressRule{
+						{
+							Description: "HTTPS",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    443,
+							ToPort:      443,
+							CidrBlocks:  []string{"10.0.0.0/16"},
+							DestinationSecurityGroupRoles: []infrav1.SecurityGroupRole{
+								infrav1.SecurityGroupControlPlane,
+							},
+						},
+					},
+				},
+			},
+			Status: infrav1.AWSClusterStatus{
+				Network: infrav1.NetworkStatus{
+					SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{
+						infrav1.SecurityGroupControlPlane: {ID: "sg-cp"},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId: aws.String("sg-node"),
+		IpPermissions: []types.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			},
+		},
+	})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+	ec2Mock.EXPECT().AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId: aws.String("sg-node"),
+		IpPermissions: []types.IpPermission{
+			{
+				IpProtocol:       aws.String("tcp"),
+				FromPort:         aws.Int32(443),
+				ToPort:           aws.Int32(443),
+				UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-cp"), Description: aws.String("HTTPS")}},
+			},
+		},
+	})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+
+	s := NewService(cs, testSecurityGroupRoles)
+	s.EC2Client = ec2Mock
+
+	// The CIDR block rule already exists and the default rule allowing all outbound traffic is removed.
+	err = s.reconcileSecurityGroupEgressRules(infrav1.SecurityGroupNode, infrav1.SecurityGroup{
+		ID: "sg-node",
+		EgressRules: infrav1.EgressRules{
+			{
+				Protocol:   infrav1.SecurityGroupProtocolAll,
+				CidrBlocks: []string{"0.0.0.0/0"},
+			},
+			{
+				Description: "HTTPS",
+				Protocol:    infrav1.SecurityGroupProtocolTCP,
+				FromPort:    443,
+				ToPort:      443,
+				CidrBlocks:  []string{"10.0.0.0/16"},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cs.SecurityGroups()[infrav1.SecurityGroupNode].EgressRulesManaged).To(BeTrue())
+}
+
+func TestReconcileSecurityGroupsReplacesEgressRulesOfNewSecurityGroups(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	g := NewWithT(t)
+
+	cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		},
+		AWSCluster: &infrav1.AWSCluster{
+			Spec: infrav1.AWSClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					VPC: infrav1.VPCSpec{ID: "vpc-securitygroups"},
+					AdditionalNodeEgressRules: []infrav1.EgressRule{
+						{
+							Description: "HTTPS",
+							Protocol:    infrav1.SecurityGroupProtocolTCP,
+							FromPort:    443,
+							ToPort:      443,
+							CidrBlocks:  []string{"10.0.0.0/16"},
+						},
+					},
+				},
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ec2Mock := mocks.NewMockEC2API(mockCtrl)
+	ec2Mock.EXPECT().DescribeSecurityGroups(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeSecurityGroupsInput{})).
+		Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+	ec2Mock.EXPECT().CreateSecurityGroup(context.TODO(), gomock.AssignableToTypeOf(&ec2.CreateSecurityGroupInput{})).
+		Return(&ec2.CreateSecurityGroupOutput{GroupId: aws.String("sg-node")}, nil)
+	ec2Mock.EXPECT().AuthorizeSecurityGroupIngress(context.TODO(), gomock.AssignableToTypeOf(&ec2.AuthorizeSecurityGroupIngressInput{})).
+		Return(&ec2.AuthorizeSecurityGroupIngressOutput{}, nil)
+	// The rule allowing all outbound traffic that AWS adds to new security groups is replaced right away.
+	ec2Mock.EXPECT().RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+		GroupId: aws.String("sg-node"),
+		IpPermissions: []types.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				IpRanges:   []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			},
+		},
+	})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+	ec2Mock.EXPECT().AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+		GroupId: aws.String("sg-node"),
+		IpPermissions: []types.IpPermission{
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int32(443),
+				ToPort:     aws.Int32(443),
+				IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS")}},
+			},
+		},
+	})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+
+	s := NewService(cs, []infrav1.SecurityGroupRole{infrav1.SecurityGroupNode})
+	s.EC2Client = ec2Mock
+
+	g.Expect(s.ReconcileSecurityGroups()).To(Succeed())
+	g.Expect(cs.SecurityGroups()[infrav1.SecurityGroupNode].EgressRulesManaged).To(BeTrue())
+}
+
+func TestReconcileSecurityGroupEgressRulesRestoresDefaults(t *testing.T) {
+	httpsRule := infrav1.EgressRule{
+		Description: "HTTPS",
+		Protocol:    infrav1.SecurityGroupProtocolTCP,
+		FromPort:    443,
+		ToPort:      443,
+		CidrBlocks:  []string{"10.0.0.0/16"},
+	}
+
+	testCases := []struct {
+		name    string
+		managed bool
+		ipv6    bool
+		expect  func(m *mocks.MockEC2APIMockRecorder)
+	}{
+		{
+			name:    "egress rules were never managed",
+			managed: false,
+			expect:  func(m *mocks.MockEC2APIMockRecorder) {},
+		},
+		{
+			name:    "default egress rule is restored",
+			managed: true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.RevokeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("tcp"),
+							FromPort:   aws.Int32(443),
+							ToPort:     aws.Int32(443),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("HTTPS")}},
+						},
+					},
+				})).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+		{
+			name:    "default IPv6 egress rule is restored",
+			managed: true,
+			ipv6:    true,
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.RevokeSecurityGroupEgress(context.TODO(), gomock.Any()).Return(&ec2.RevokeSecurityGroupEgressOutput{}, nil)
+				m.AuthorizeSecurityGroupEgress(context.TODO(), gomock.Eq(&ec2.AuthorizeSecurityGroupEgressInput{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []types.IpPermission{
+						{
+							IpProtocol: aws.String("-1"),
+							IpRanges:   []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+						},
+						{
+							IpProtocol: aws.String("-1"),
+							Ipv6Ranges: []types.Ipv6Range{{CidrIpv6: aws.String("::/0")}},
+						},
+					},
+				})).Return(&ec2.AuthorizeSecurityGroupEgressOutput{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scheme := runtime.NewScheme()
+			_ = infrav1.AddToScheme(scheme)
+
+			awsCluster := &infrav1.AWSCluster{
+				Status: infrav1.AWSClusterStatus{
+					Network: infrav1.NetworkStatus{
+						SecurityGroups: map[infrav1.SecurityGroupRole]infrav1.SecurityGroup{},
+					},
+				},
+			}
+			if tc.ipv6 {
+				awsCluster.Spec.NetworkSpec.VPC.IPv6 = &infrav1.IPv6{}
+			}
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: awsCluster,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			err = s.reconcileSecurityGroupEgressRules(infrav1.SecurityGroupNode, infrav1.SecurityGroup{
+				ID:                 "sg-node",
+				EgressRules:        infrav1.EgressRules{httpsRule},
+				EgressRulesManaged: tc.managed,
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(cs.SecurityGroups()[infrav1.SecurityGroupNode].EgressRulesManaged).To(BeFalse())
+		})
+	}
+}
+
+func TestEgressRulesFromSDKType(t *testing.T) {
+	g := NewWithT(t)
+
+	output := egressRulesFromSDKType(types.IpPermission{
+		IpProtocol: aws.String("tcp"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+		IpRanges: []types.IpRange{
+			{CidrIp: aws.String("10.0.0.0/16"), Description: aws.String("VPC")},
+		},
+		UserIdGroupPairs: []types.UserIdGroupPair{
+			{GroupId: aws.String("sg-destination-1")},
+		},
+		PrefixListIds: []types.PrefixListId{
+			{PrefixListId: aws.String("pl-1234"), Description: aws.String("S3")},
+		},
+	})
+
+	g.Expect(output).To(Equal(infrav1.EgressRules{
+		{
+			Description: "VPC",
+			Protocol:    infrav1.SecurityGroupProtocolTCP,
+			FromPort:    443,
+			ToPort:      443,
+			CidrBlocks:  []string{"10.0.0.0/16"},
+		},
+		{
+			Protocol:                    infrav1.SecurityGroupProtocolTCP,
+			FromPort:                    443,
+			ToPort:                      443,
+			DestinationSecurityGroupIDs: []string{"sg-destination-1"},
+		},
+		{
+			Description:   "S3",
+			Protocol:      infrav1.SecurityGroupProtocolTCP,
+			FromPort:      443,
+			ToPort:        443,
+			PrefixListIDs: []string{"pl-1234"},
+		},
+	}))
+}
+
+func TestAdditionalIngressRulesSourcePrefixListNames(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	testCases := []struct {
+		name          string
+		rules         infrav1.IngressRules
+		expect        func(m *mocks.MockEC2APIMockRecorder)
+		expectedRules infrav1.IngressRules
+		wantErr       bool
+	}{
+		{
+			name: "prefix list names are resolved to ids",
+			rules: infrav1.IngressRules{
+				{
+					Description:           "SSH",
+					Protocol:              infrav1.SecurityGroupProtocolTCP,
+					FromPort:              22,
+					ToPort:                22,
+					SourcePrefixListIDs:   []string{"pl-2"},
+					SourcePrefixListNames: []string{"office"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					Filters: []types.Filter{
+						{
+							Name:   aws.String("prefix-list-name"),
+							Values: []string{"office"},
+						},
+					},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{
+						{
+							PrefixListId:   aws.String("pl-1"),
+							PrefixListName: aws.String("office"),
+							State:          types.PrefixListStateCreateComplete,
+						},
+					},
+				}, nil)
+			},
+			expectedRules: infrav1.IngressRules{
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1", "pl-2"},
+				},
+			},
+		},
+		{
+			name: "prefix lists are described once for all rules",
+			rules: infrav1.IngressRules{
+				{
+					Description:           "SSH",
+					Protocol:              infrav1.SecurityGroupProtocolTCP,
+					FromPort:              22,
+					ToPort:                22,
+					SourcePrefixListNames: []string{"office"},
+				},
+				{
+					Description:           "HTTPS",
+					Protocol:              infrav1.SecurityGroupProtocolTCP,
+					FromPort:              443,
+					ToPort:                443,
+					SourcePrefixListNames: []string{"office", "vpn"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.Eq(&ec2.DescribeManagedPrefixListsInput{
+					Filters: []types.Filter{
+						{
+							Name:   aws.String("prefix-list-name"),
+							Values: []string{"office", "vpn"},
+						},
+					},
+				})).Return(&ec2.DescribeManagedPrefixListsOutput{
+					PrefixLists: []types.ManagedPrefixList{
+						{
+							PrefixListId:   aws.String("pl-1"),
+							PrefixListName: aws.String("office"),
+							State:          types.PrefixListStateCreateComplete,
+						},
+						{
+							PrefixListId:   aws.String("pl-2"),
+							PrefixListName: aws.String("vpn"),
+							State:          types.PrefixListStateModifyComplete,
+						},
+					},
+				}, nil).Times(1)
+			},
+			expectedRules: infrav1.IngressRules{
+				{
+					Description:         "SSH",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            22,
+					ToPort:              22,
+					SourcePrefixListIDs: []string{"pl-1"},
+				},
+				{
+					Description:         "HTTPS",
+					Protocol:            infrav1.SecurityGroupProtocolTCP,
+					FromPort:            443,
+					ToPort:              443,
+					SourcePrefixListIDs: []string{"pl-1", "pl-2"},
+				},
+			},
+		},
+		{
+			name: "error if a prefix list name can't be found",
+			rules: infrav1.IngressRules{
+				{
+					Description:           "SSH",
+					Protocol:              infrav1.SecurityGroupProtocolTCP,
+					FromPort:              22,
+					ToPort:                22,
+					SourcePrefixListNames: []string{"office"},
+				},
+			},
+			expect: func(m *mocks.MockEC2APIMockRecorder) {
+				m.DescribeManagedPrefixLists(context.TODO(), gomock.AssignableToTypeOf(&ec2.DescribeManagedPrefixListsInput{})).
+					Return(&ec2.DescribeManagedPrefixListsOutput{}, nil)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ec2Mock := mocks.NewMockEC2API(mockCtrl)
+			tc.expect(ec2Mock.EXPECT())
+
+			cs, err := scope.NewClusterScope(scope.ClusterScopeParams{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				},
+				AWSCluster: &infrav1.AWSCluster{
+					Spec: infrav1.AWSClusterSpec{
+						ControlPlaneLoadBalancer: &infrav1.AWSLoadBalancerSpec{},
+						NetworkSpec: infrav1.NetworkSpec{
+							AdditionalControlPlaneIngressRules: tc.rules,
+						},
+					},
+				},
+			})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			s := NewService(cs, testSecurityGroupRoles)
+			s.EC2Client = ec2Mock
+
+			rules, err := s.processIngressRulesSGs(tc.rules)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(rules).To(Equal(tc.expectedRules))
+		})
+	}
+}
@@ -30,6 +30,10 @@ type Service struct {
 	scope     scope.SGScope
 	roles     []infrav1.SecurityGroupRole
 	EC2Client common.EC2API
+
+	// prefixListIDsByName caches the IDs of the managed prefix lists referenced by name in ingress rules
+	// for the duration of a reconciliation. Names that don't match a prefix list map to an empty ID.
+	prefixListIDsByName map[string]string
 }
 
 // NewService returns a new service given the api clients with a defined
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	definitionsRefPrefix = "#/definitions/"
+
+	// maxSchemaRefDepth limits how often references are resolved within each other, as
+	// definitions can be recursive. Deeper values are not validated.
+	maxSchemaRefDepth = 10
+)
+
+// ParseConfigurationSchema parses the JSON schema of the configuration of an addon, as returned by
+// DescribeAddonConfiguration. References to the definitions of the schema are resolved, as
+// they aren't supported by the validation.
+func ParseConfigurationSchema(schema string) (*spec.Schema, error) {
+	parsed := &spec.Schema{}
+	if err := json.Unmarshal([]byte(schema), parsed); err != nil {
+		return nil, fmt.Errorf("parsing addon configuration schema: %w", err)
+	}
+
+	definitions := parsed.Definitions
+	if err := expandSchemaRefs(parsed, definitions, 0); err != nil {
+		return nil, fmt.Errorf("resolving addon configuration schema references: %w", err)
+	}
+
+	return parsed, nil
+}
+
+// ValidateConfiguration validates the configuration of an addon, formatted as JSON or YAML, against
+// the schema of the addon version. It returns a sorted message for each validation error.
+func ValidateConfiguration(schema *spec.Schema, configuration string) []string {
+	data, err := yaml.YAMLToJSON([]byte(configuration))
+	if err != nil {
+		return []string{fmt.Sprintf("configuration is neither valid JSON nor YAML: %v", err)}
+	}
+
+	var values interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return []string{fmt.Sprintf("configuration is neither valid JSON nor YAML: %v", err)}
+	}
+
+	result := validate.NewSchemaValidator(schema, nil, "configuration", strfmt.Default).Validate(values)
+	messages := make([]string, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		messages = append(messages, err.Error())
+	}
+	// Sort the messages, as their order depends on the order of the properties in the schema.
+	sort.Strings(messages)
+	return messages
+}
+
+// expandSchemaRefs replaces the references to definitions within the schema by the definitions.
+func expandSchemaRefs(schema *spec.Schema, definitions spec.Definitions, depth int) error {
+	if ref := schema.Ref.String(); ref != "" {
+		if depth >= maxSchemaRefDepth {
+			*schema = spec.Schema{}
+			return nil
+		}
+
+		name, found := strings.CutPrefix(ref, definitionsRefPrefix)
+		definition, ok := definitions[name]
+		if !found || !ok {
+			return fmt.Errorf("unsupported reference %q", ref)
+		}
+
+		// Copy the definition, as it is expanded in place and may be referenced several times.
+		raw, err := json.Marshal(definition)
+		if err != nil {
+			return err
+		}
+		expanded := spec.Schema{}
+		if err := json.Unmarshal(raw, &expanded); err != nil {
+			return err
+		}
+		*schema = expanded
+		return expandSchemaRefs(schema, definitions, depth+1)
+	}
+
+	schema.Definitions = nil
+
+	for name, property := range schema.Properties {
+		if err := expandSchemaRefs(&property, definitions, depth); err != nil {
+			return err
+		}
+		schema.Properties[name] = property
+	}
+	for pattern, property := range schema.PatternProperties {
+		if err := expandSchemaRefs(&property, definitions, depth); err != nil {
+			return err
+		}
+		schema.PatternProperties[pattern] = property
+	}
+	for name, dependency := range schema.Dependencies {
+		if dependency.Schema != nil {
+			if err := expandSchemaRefs(dependency.Schema, definitions, depth); err != nil {
+				return err
+			}
+			schema.Dependencies[name] = dependency
+		}
+	}
+
+	nested := []*spec.Schema{schema.Not}
+	if schema.Items != nil {
+		nested = append(nested, schema.Items.Schema)
+		for i := range schema.Items.Schemas {
+			nested = append(nested, &schema.Items.Schemas[i])
+		}
+	}
+	if schema.AdditionalProperties != nil {
+		nested = append(nested, schema.AdditionalProperties.Schema)
+	}
+	if schema.AdditionalItems != nil {
+		nested = append(nested, schema.AdditionalItems.Schema)
+	}
+	for _, schemas := range [][]spec.Schema{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for i := range schemas {
+			nested = append(nested, &schemas[i])
+		}
+	}
+
+	for _, nestedSchema := range nested {
+		if nestedSchema == nil {
+			continue
+		}
+		if err := expandSchemaRefs(nestedSchema, definitions, depth); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const testConfigurationSchema = `{
+  "$schema": "http://json-schema.org/draft-06/schema#",
+  "$ref": "#/definitions/Coredns",
+  "definitions": {
+    "Coredns": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "replicaCount": {"type": "integer", "minimum": 1},
+        "resources": {"$ref": "#/definitions/Resources"},
+        "tolerations": {"type": "array", "items": {"$ref": "#/definitions/Toleration"}}
+      }
+    },
+    "Resources": {
+      "type": "object",
+      "properties": {
+        "limits": {"$ref": "#/definitions/Limits"}
+      }
+    },
+    "Limits": {
+      "type": "object",
+      "properties": {
+        "memory": {"type": ["string", "integer"]}
+      }
+    },
+    "Toleration": {
+      "type": "object",
+      "required": ["key"],
+      "properties": {
+        "key": {"type": "string"},
+        "effect": {"type": "string", "enum": ["NoSchedule", "NoExecute"]}
+      }
+    }
+  }
+}`
+
+func TestValidateConfiguration(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration string
+		expectErrors  []string
+	}{
+		{
+			name:          "valid JSON configuration",
+			configuration: `{"replicaCount": 3, "resources": {"limits": {"memory": "170Mi"}}}`,
+		},
+		{
+			name: "valid YAML configuration",
+			configuration: `replicaCount: 3
+tolerations:
+- key: node-role.kubernetes.io/control-plane
+  effect: NoSchedule
+`,
+		},
+		{
+			name:          "unknown property",
+			configuration: `{"replicas": 3}`,
+			expectErrors:  []string{`configuration.replicas in body is a forbidden property`},
+		},
+		{
+			name: "invalid nested values",
+			configuration: `replicaCount: 0
+resources:
+  limits:
+    memory: true
+tolerations:
+- effect: NoWait
+`,
+			expectErrors: []string{
+				`configuration.replicaCount in body should be greater than or equal to 1`,
+				`configuration.resources.limits.memory in body must be of type string,integer: "boolean"`,
+				`configuration.tolerations[0].key in body is required`,
+				`configuration.tolerations[0].effect in body should be one of [NoSchedule NoExecute]`,
+			},
+		},
+		{
+			name:          "malformed configuration",
+			configuration: `{"replicaCount": 3`,
+			expectErrors:  []string{`configuration is neither valid JSON nor YAML: yaml: line 1: did not find expected ',' or '}'`},
+		},
+	}
+
+	g := NewWithT(t)
+	schema, err := ParseConfigurationSchema(testConfigurationSchema)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(ValidateConfiguration(schema, tc.configuration)).To(ConsistOf(tc.expectErrors))
+		})
+	}
+}
+
+func TestParseConfigurationSchema(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      string
+		expectError bool
+	}{
+		{
+			name:   "schema with references",
+			schema: testConfigurationSchema,
+		},
+		{
+			name:   "recursive definitions",
+			schema: `{"$ref": "#/definitions/Node", "definitions": {"Node": {"type": "object", "properties": {"child": {"$ref": "#/definitions/Node"}}}}}`,
+		},
+		{
+			name:        "reference to missing definition",
+			schema:      `{"type": "object", "properties": {"resources": {"$ref": "#/definitions/Resources"}}}`,
+			expectError: true,
+		},
+		{
+			name:        "remote reference",
+			schema:      `{"$ref": "https://example.com/schema.json"}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid JSON",
+			schema:      `{"type": "object"`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			schema, err := ParseConfigurationSchema(tc.schema)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(schema.Ref.String()).To(BeEmpty())
+			g.Expect(schema.Definitions).To(BeEmpty())
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package upgrade provides a plan to upgrade the Kubernetes version of an EKS cluster.
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-aws/v2/pkg/planner"
+)
+
+// Step is a step of an upgrade of an EKS cluster. Doing the step moves it towards completion,
+// which may take several reconciliations.
+type Step interface {
+	planner.Procedure
+	// Complete returns whether the step is complete, and otherwise a message describing its progress.
+	Complete(ctx context.Context) (bool, string, error)
+}
+
+// FailedError is returned by Step.Complete when the step failed and can't complete until the cause
+// of the failure is fixed.
+type FailedError struct {
+	message string
+}
+
+// NewFailedError returns a FailedError with the given message.
+func NewFailedError(message string) error {
+	return &FailedError{message: message}
+}
+
+// Error returns the message of the error.
+func (e *FailedError) Error() string {
+	return e.message
+}
+
+// NewPlan creates a new Plan to upgrade an EKS cluster to the given Kubernetes version.
+// The steps are carried out in order, and a step is only started once the steps before
+// it are complete.
+func NewPlan(version string, steps []Step) *Plan {
+	return &Plan{
+		version: version,
+		steps:   steps,
+	}
+}
+
+// Plan is a plan that will upgrade an EKS cluster.
+type Plan struct {
+	version string
+	steps   []Step
+	status  *ekscontrolplanev1.UpgradeStatus
+}
+
+var _ planner.Plan = &Plan{}
+
+// Create will create the plan (i.e. list of procedures) for upgrading the EKS cluster. It contains
+// the first step that isn't complete, or nothing once the upgrade is complete. The progress of all
+// steps is recorded in the status of the plan, including steps that failed.
+func (p *Plan) Create(ctx context.Context) ([]planner.Procedure, error) {
+	procedures := []planner.Procedure{}
+	status := &ekscontrolplanev1.UpgradeStatus{Version: p.version}
+
+	for _, step := range p.steps {
+		if len(procedures) > 0 {
+			status.Steps = append(status.Steps, ekscontrolplanev1.UpgradeStep{
+				Name:  step.Name(),
+				Phase: ekscontrolplanev1.UpgradeStepPhasePending,
+			})
+			continue
+		}
+
+		complete, message, err := step.Complete(ctx)
+		var failedErr *FailedError
+		if errors.As(err, &failedErr) {
+			// The step is still carried out, as doing it again may fix the failure.
+			status.Steps = append(status.Steps, ekscontrolplanev1.UpgradeStep{
+				Name:    step.Name(),
+				Phase:   ekscontrolplanev1.UpgradeStepPhaseFailed,
+				Message: failedErr.Error(),
+			})
+			procedures = append(procedures, step)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("checking upgrade step %s: %w", step.Name(), err)
+		}
+		if complete {
+			status.Steps = append(status.Steps, ekscontrolplanev1.UpgradeStep{
+				Name:  step.Name(),
+				Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted,
+			})
+			continue
+		}
+
+		status.Steps = append(status.Steps, ekscontrolplanev1.UpgradeStep{
+			Name:    step.Name(),
+			Phase:   ekscontrolplanev1.UpgradeStepPhaseInProgress,
+			Message: message,
+		})
+		procedures = append(procedures, step)
+	}
+
+	p.status = status
+	return procedures, nil
+}
+
+// Status returns the progress of the upgrade recorded when creating the plan.
+func (p *Plan) Status() *ekscontrolplanev1.UpgradeStatus {
+	return p.status
+}
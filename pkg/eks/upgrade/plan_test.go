@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ekscontrolplanev1 "sigs.k8s.io/cluster-api-provider-aws/v2/controlplane/eks/api/v1beta2"
+)
+
+type fakeStep struct {
+	name     string
+	complete bool
+	message  string
+	err      error
+}
+
+func (s *fakeStep) Name() string {
+	return s.name
+}
+
+func (s *fakeStep) Do(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeStep) Complete(_ context.Context) (bool, string, error) {
+	return s.complete, s.message, s.err
+}
+
+func TestUpgradePlan(t *testing.T) {
+	testCases := []struct {
+		name              string
+		steps             []*fakeStep
+		expectCreateError bool
+		expectProcedures  []string
+		expectStatus      *ekscontrolplanev1.UpgradeStatus
+	}{
+		{
+			name: "first step is in progress",
+			steps: []*fakeStep{
+				{name: "control_plane_upgrade", message: "Upgrading control plane"},
+				{name: "addons_upgrade"},
+			},
+			expectProcedures: []string{"control_plane_upgrade"},
+			expectStatus: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.30",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress, Message: "Upgrading control plane"},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+		},
+		{
+			name: "second step is in progress",
+			steps: []*fakeStep{
+				{name: "control_plane_upgrade", complete: true},
+				{name: "addons_upgrade", message: "Upgrading addons"},
+			},
+			expectProcedures: []string{"addons_upgrade"},
+			expectStatus: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.30",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseInProgress, Message: "Upgrading addons"},
+				},
+			},
+		},
+		{
+			name: "all steps are complete",
+			steps: []*fakeStep{
+				{name: "control_plane_upgrade", complete: true},
+				{name: "addons_upgrade", complete: true},
+			},
+			expectProcedures: []string{},
+			expectStatus: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.30",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+				},
+			},
+		},
+		{
+			name: "second step failed",
+			steps: []*fakeStep{
+				{name: "control_plane_upgrade", complete: true},
+				{name: "addons_upgrade", err: NewFailedError("Addons vpc-cni failed")},
+				{name: "nodegroups_upgrade"},
+			},
+			expectProcedures: []string{"addons_upgrade"},
+			expectStatus: &ekscontrolplanev1.UpgradeStatus{
+				Version: "1.30",
+				Steps: []ekscontrolplanev1.UpgradeStep{
+					{Name: "control_plane_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseCompleted},
+					{Name: "addons_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhaseFailed, Message: "Addons vpc-cni failed"},
+					{Name: "nodegroups_upgrade", Phase: ekscontrolplanev1.UpgradeStepPhasePending},
+				},
+			},
+		},
+		{
+			name: "checking a step fails",
+			steps: []*fakeStep{
+				{name: "control_plane_upgrade", err: errors.New("describe cluster failed")},
+				{name: "addons_upgrade"},
+			},
+			expectCreateError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			steps := []Step{}
+			for _, step := range tc.steps {
+				steps = append(steps, step)
+			}
+
+			plan := NewPlan("1.30", steps)
+			procedures, err := plan.Create(context.TODO())
+			if tc.expectCreateError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+
+			names := []string{}
+			for _, procedure := range procedures {
+				names = append(names, procedure.Name())
+				g.Expect(procedure.Do(context.TODO())).To(Succeed())
+			}
+			g.Expect(names).To(Equal(tc.expectProcedures))
+			g.Expect(plan.Status()).To(Equal(tc.expectStatus))
+			g.Expect(plan.Status().InProgress()).To(Equal(len(tc.expectProcedures) > 0))
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"sort"
 
 	"k8s.io/utils/ptr"
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -56,3 +58,15 @@ func ToInt32Pointer(to *int64) *int32 {
 
 	return ptr.To(int32(*to))
 }
+
+// StringSetsEqual returns true if both slices contain the same strings, regardless of their order.
+func StringSetsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	as := slices.Clone(a)
+	bs := slices.Clone(b)
+	sort.Strings(as)
+	sort.Strings(bs)
+	return slices.Equal(as, bs)
+}
@@ -151,6 +151,26 @@ func (mr *MockEC2APIMockRecorder) AttachInternetGateway(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachInternetGateway", reflect.TypeOf((*MockEC2API)(nil).AttachInternetGateway), varargs...)
 }
 
+// AuthorizeSecurityGroupEgress mocks base method.
+func (m *MockEC2API) AuthorizeSecurityGroupEgress(arg0 context.Context, arg1 *ec2.AuthorizeSecurityGroupEgressInput, arg2 ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupEgressOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgress", varargs...)
+	ret0, _ := ret[0].(*ec2.AuthorizeSecurityGroupEgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupEgress indicates an expected call of AuthorizeSecurityGroupEgress.
+func (mr *MockEC2APIMockRecorder) AuthorizeSecurityGroupEgress(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockEC2API)(nil).AuthorizeSecurityGroupEgress), varargs...)
+}
+
 // AuthorizeSecurityGroupIngress mocks base method.
 func (m *MockEC2API) AuthorizeSecurityGroupIngress(arg0 context.Context, arg1 *ec2.AuthorizeSecurityGroupIngressInput, arg2 ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +231,26 @@ func (mr *MockEC2APIMockRecorder) CreateEgressOnlyInternetGateway(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressOnlyInternetGateway", reflect.TypeOf((*MockEC2API)(nil).CreateEgressOnlyInternetGateway), varargs...)
 }
 
+// CreateFlowLogs mocks base method.
+func (m *MockEC2API) CreateFlowLogs(arg0 context.Context, arg1 *ec2.CreateFlowLogsInput, arg2 ...func(*ec2.Options)) (*ec2.CreateFlowLogsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateFlowLogs", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlowLogs indicates an expected call of CreateFlowLogs.
+func (mr *MockEC2APIMockRecorder) CreateFlowLogs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLogs", reflect.TypeOf((*MockEC2API)(nil).CreateFlowLogs), varargs...)
+}
+
 // CreateInternetGateway mocks base method.
 func (m *MockEC2API) CreateInternetGateway(arg0 context.Context, arg1 *ec2.CreateInternetGatewayInput, arg2 ...func(*ec2.Options)) (*ec2.CreateInternetGatewayOutput, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +311,26 @@ func (mr *MockEC2APIMockRecorder) CreateLaunchTemplateVersion(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLaunchTemplateVersion", reflect.TypeOf((*MockEC2API)(nil).CreateLaunchTemplateVersion), varargs...)
 }
 
+// CreateManagedPrefixList mocks base method.
+func (m *MockEC2API) CreateManagedPrefixList(arg0 context.Context, arg1 *ec2.CreateManagedPrefixListInput, arg2 ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateManagedPrefixList", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateManagedPrefixListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagedPrefixList indicates an expected call of CreateManagedPrefixList.
+func (mr *MockEC2APIMockRecorder) CreateManagedPrefixList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagedPrefixList", reflect.TypeOf((*MockEC2API)(nil).CreateManagedPrefixList), varargs...)
+}
+
 // CreateNatGateway mocks base method.
 func (m *MockEC2API) CreateNatGateway(arg0 context.Context, arg1 *ec2.CreateNatGatewayInput, arg2 ...func(*ec2.Options)) (*ec2.CreateNatGatewayOutput, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +351,46 @@ func (mr *MockEC2APIMockRecorder) CreateNatGateway(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockEC2API)(nil).CreateNatGateway), varargs...)
 }
 
+// CreateNetworkAcl mocks base method.
+func (m *MockEC2API) CreateNetworkAcl(arg0 context.Context, arg1 *ec2.CreateNetworkAclInput, arg2 ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkAcl", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateNetworkAclOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkAcl indicates an expected call of CreateNetworkAcl.
+func (mr *MockEC2APIMockRecorder) CreateNetworkAcl(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAcl", reflect.TypeOf((*MockEC2API)(nil).CreateNetworkAcl), varargs...)
+}
+
+// CreateNetworkAclEntry mocks base method.
+func (m *MockEC2API) CreateNetworkAclEntry(arg0 context.Context, arg1 *ec2.CreateNetworkAclEntryInput, arg2 ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetworkAclEntry", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkAclEntry indicates an expected call of CreateNetworkAclEntry.
+func (mr *MockEC2APIMockRecorder) CreateNetworkAclEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkAclEntry", reflect.TypeOf((*MockEC2API)(nil).CreateNetworkAclEntry), varargs...)
+}
+
 // CreateRoute mocks base method.
 func (m *MockEC2API) CreateRoute(arg0 context.Context, arg1 *ec2.CreateRouteInput, arg2 ...func(*ec2.Options)) (*ec2.CreateRouteOutput, error) {
 	m.ctrl.T.Helper()
@@ -351,6 +451,26 @@ func (mr *MockEC2APIMockRecorder) CreateSecurityGroup(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockEC2API)(nil).CreateSecurityGroup), varargs...)
 }
 
+// CreateSnapshot mocks base method.
+func (m *MockEC2API) CreateSnapshot(arg0 context.Context, arg1 *ec2.CreateSnapshotInput, arg2 ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSnapshot", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateSnapshotOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockEC2APIMockRecorder) CreateSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockEC2API)(nil).CreateSnapshot), varargs...)
+}
+
 // CreateSubnet mocks base method.
 func (m *MockEC2API) CreateSubnet(arg0 context.Context, arg1 *ec2.CreateSubnetInput, arg2 ...func(*ec2.Options)) (*ec2.CreateSubnetOutput, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +511,26 @@ func (mr *MockEC2APIMockRecorder) CreateTags(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockEC2API)(nil).CreateTags), varargs...)
 }
 
+// CreateTransitGatewayVpcAttachment mocks base method.
+func (m *MockEC2API) CreateTransitGatewayVpcAttachment(arg0 context.Context, arg1 *ec2.CreateTransitGatewayVpcAttachmentInput, arg2 ...func(*ec2.Options)) (*ec2.CreateTransitGatewayVpcAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateTransitGatewayVpcAttachment", varargs...)
+	ret0, _ := ret[0].(*ec2.CreateTransitGatewayVpcAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransitGatewayVpcAttachment indicates an expected call of CreateTransitGatewayVpcAttachment.
+func (mr *MockEC2APIMockRecorder) CreateTransitGatewayVpcAttachment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitGatewayVpcAttachment", reflect.TypeOf((*MockEC2API)(nil).CreateTransitGatewayVpcAttachment), varargs...)
+}
+
 // CreateVpc mocks base method.
 func (m *MockEC2API) CreateVpc(arg0 context.Context, arg1 *ec2.CreateVpcInput, arg2 ...func(*ec2.Options)) (*ec2.CreateVpcOutput, error) {
 	m.ctrl.T.Helper()
@@ -471,6 +611,26 @@ func (mr *MockEC2APIMockRecorder) DeleteEgressOnlyInternetGateway(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressOnlyInternetGateway", reflect.TypeOf((*MockEC2API)(nil).DeleteEgressOnlyInternetGateway), varargs...)
 }
 
+// DeleteFlowLogs mocks base method.
+func (m *MockEC2API) DeleteFlowLogs(arg0 context.Context, arg1 *ec2.DeleteFlowLogsInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteFlowLogsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteFlowLogs", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFlowLogs indicates an expected call of DeleteFlowLogs.
+func (mr *MockEC2APIMockRecorder) DeleteFlowLogs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLogs", reflect.TypeOf((*MockEC2API)(nil).DeleteFlowLogs), varargs...)
+}
+
 // DeleteInternetGateway mocks base method.
 func (m *MockEC2API) DeleteInternetGateway(arg0 context.Context, arg1 *ec2.DeleteInternetGatewayInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteInternetGatewayOutput, error) {
 	m.ctrl.T.Helper()
@@ -531,6 +691,26 @@ func (mr *MockEC2APIMockRecorder) DeleteLaunchTemplateVersions(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLaunchTemplateVersions", reflect.TypeOf((*MockEC2API)(nil).DeleteLaunchTemplateVersions), varargs...)
 }
 
+// DeleteManagedPrefixList mocks base method.
+func (m *MockEC2API) DeleteManagedPrefixList(arg0 context.Context, arg1 *ec2.DeleteManagedPrefixListInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteManagedPrefixList", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteManagedPrefixListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManagedPrefixList indicates an expected call of DeleteManagedPrefixList.
+func (mr *MockEC2APIMockRecorder) DeleteManagedPrefixList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagedPrefixList", reflect.TypeOf((*MockEC2API)(nil).DeleteManagedPrefixList), varargs...)
+}
+
 // DeleteNatGateway mocks base method.
 func (m *MockEC2API) DeleteNatGateway(arg0 context.Context, arg1 *ec2.DeleteNatGatewayInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteNatGatewayOutput, error) {
 	m.ctrl.T.Helper()
@@ -551,6 +731,66 @@ func (mr *MockEC2APIMockRecorder) DeleteNatGateway(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockEC2API)(nil).DeleteNatGateway), varargs...)
 }
 
+// DeleteNetworkAcl mocks base method.
+func (m *MockEC2API) DeleteNetworkAcl(arg0 context.Context, arg1 *ec2.DeleteNetworkAclInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkAcl", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteNetworkAclOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkAcl indicates an expected call of DeleteNetworkAcl.
+func (mr *MockEC2APIMockRecorder) DeleteNetworkAcl(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAcl", reflect.TypeOf((*MockEC2API)(nil).DeleteNetworkAcl), varargs...)
+}
+
+// DeleteNetworkAclEntry mocks base method.
+func (m *MockEC2API) DeleteNetworkAclEntry(arg0 context.Context, arg1 *ec2.DeleteNetworkAclEntryInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetworkAclEntry", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkAclEntry indicates an expected call of DeleteNetworkAclEntry.
+func (mr *MockEC2APIMockRecorder) DeleteNetworkAclEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAclEntry", reflect.TypeOf((*MockEC2API)(nil).DeleteNetworkAclEntry), varargs...)
+}
+
+// DeleteRoute mocks base method.
+func (m *MockEC2API) DeleteRoute(arg0 context.Context, arg1 *ec2.DeleteRouteInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteRouteOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRoute", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteRouteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoute indicates an expected call of DeleteRoute.
+func (mr *MockEC2APIMockRecorder) DeleteRoute(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoute", reflect.TypeOf((*MockEC2API)(nil).DeleteRoute), varargs...)
+}
+
 // DeleteRouteTable mocks base method.
 func (m *MockEC2API) DeleteRouteTable(arg0 context.Context, arg1 *ec2.DeleteRouteTableInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteRouteTableOutput, error) {
 	m.ctrl.T.Helper()
@@ -631,6 +871,26 @@ func (mr *MockEC2APIMockRecorder) DeleteTags(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockEC2API)(nil).DeleteTags), varargs...)
 }
 
+// DeleteTransitGatewayVpcAttachment mocks base method.
+func (m *MockEC2API) DeleteTransitGatewayVpcAttachment(arg0 context.Context, arg1 *ec2.DeleteTransitGatewayVpcAttachmentInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayVpcAttachment", varargs...)
+	ret0, _ := ret[0].(*ec2.DeleteTransitGatewayVpcAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransitGatewayVpcAttachment indicates an expected call of DeleteTransitGatewayVpcAttachment.
+func (mr *MockEC2APIMockRecorder) DeleteTransitGatewayVpcAttachment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayVpcAttachment", reflect.TypeOf((*MockEC2API)(nil).DeleteTransitGatewayVpcAttachment), varargs...)
+}
+
 // DeleteVpc mocks base method.
 func (m *MockEC2API) DeleteVpc(arg0 context.Context, arg1 *ec2.DeleteVpcInput, arg2 ...func(*ec2.Options)) (*ec2.DeleteVpcOutput, error) {
 	m.ctrl.T.Helper()
@@ -771,6 +1031,26 @@ func (mr *MockEC2APIMockRecorder) DescribeEgressOnlyInternetGateways(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEgressOnlyInternetGateways", reflect.TypeOf((*MockEC2API)(nil).DescribeEgressOnlyInternetGateways), varargs...)
 }
 
+// DescribeFlowLogs mocks base method.
+func (m *MockEC2API) DescribeFlowLogs(arg0 context.Context, arg1 *ec2.DescribeFlowLogsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeFlowLogsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeFlowLogs", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeFlowLogsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFlowLogs indicates an expected call of DescribeFlowLogs.
+func (mr *MockEC2APIMockRecorder) DescribeFlowLogs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFlowLogs", reflect.TypeOf((*MockEC2API)(nil).DescribeFlowLogs), varargs...)
+}
+
 // DescribeImages mocks base method.
 func (m *MockEC2API) DescribeImages(arg0 context.Context, arg1 *ec2.DescribeImagesInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
 	m.ctrl.T.Helper()
@@ -891,6 +1171,26 @@ func (mr *MockEC2APIMockRecorder) DescribeLaunchTemplateVersions(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLaunchTemplateVersions", reflect.TypeOf((*MockEC2API)(nil).DescribeLaunchTemplateVersions), varargs...)
 }
 
+// DescribeManagedPrefixLists mocks base method.
+func (m *MockEC2API) DescribeManagedPrefixLists(arg0 context.Context, arg1 *ec2.DescribeManagedPrefixListsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeManagedPrefixLists", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeManagedPrefixListsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeManagedPrefixLists indicates an expected call of DescribeManagedPrefixLists.
+func (mr *MockEC2APIMockRecorder) DescribeManagedPrefixLists(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeManagedPrefixLists", reflect.TypeOf((*MockEC2API)(nil).DescribeManagedPrefixLists), varargs...)
+}
+
 // DescribeNatGateways mocks base method.
 func (m *MockEC2API) DescribeNatGateways(arg0 context.Context, arg1 *ec2.DescribeNatGatewaysInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeNatGatewaysOutput, error) {
 	m.ctrl.T.Helper()
@@ -911,6 +1211,26 @@ func (mr *MockEC2APIMockRecorder) DescribeNatGateways(arg0, arg1 interface{}, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockEC2API)(nil).DescribeNatGateways), varargs...)
 }
 
+// DescribeNetworkAcls mocks base method.
+func (m *MockEC2API) DescribeNetworkAcls(arg0 context.Context, arg1 *ec2.DescribeNetworkAclsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeNetworkAcls", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeNetworkAclsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNetworkAcls indicates an expected call of DescribeNetworkAcls.
+func (mr *MockEC2APIMockRecorder) DescribeNetworkAcls(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkAcls", reflect.TypeOf((*MockEC2API)(nil).DescribeNetworkAcls), varargs...)
+}
+
 // DescribeNetworkInterfaceAttribute mocks base method.
 func (m *MockEC2API) DescribeNetworkInterfaceAttribute(arg0 context.Context, arg1 *ec2.DescribeNetworkInterfaceAttributeInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeNetworkInterfaceAttributeOutput, error) {
 	m.ctrl.T.Helper()
@@ -1011,6 +1331,26 @@ func (mr *MockEC2APIMockRecorder) DescribeSecurityGroups(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroups", reflect.TypeOf((*MockEC2API)(nil).DescribeSecurityGroups), varargs...)
 }
 
+// DescribeSnapshots mocks base method.
+func (m *MockEC2API) DescribeSnapshots(arg0 context.Context, arg1 *ec2.DescribeSnapshotsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSnapshots", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeSnapshotsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSnapshots indicates an expected call of DescribeSnapshots.
+func (mr *MockEC2APIMockRecorder) DescribeSnapshots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSnapshots", reflect.TypeOf((*MockEC2API)(nil).DescribeSnapshots), varargs...)
+}
+
 // DescribeSubnets mocks base method.
 func (m *MockEC2API) DescribeSubnets(arg0 context.Context, arg1 *ec2.DescribeSubnetsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
 	m.ctrl.T.Helper()
@@ -1031,6 +1371,66 @@ func (mr *MockEC2APIMockRecorder) DescribeSubnets(arg0, arg1 interface{}, arg2 .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSubnets", reflect.TypeOf((*MockEC2API)(nil).DescribeSubnets), varargs...)
 }
 
+// DescribeTransitGatewayVpcAttachments mocks base method.
+func (m *MockEC2API) DescribeTransitGatewayVpcAttachments(arg0 context.Context, arg1 *ec2.DescribeTransitGatewayVpcAttachmentsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTransitGatewayVpcAttachments", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeTransitGatewayVpcAttachmentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTransitGatewayVpcAttachments indicates an expected call of DescribeTransitGatewayVpcAttachments.
+func (mr *MockEC2APIMockRecorder) DescribeTransitGatewayVpcAttachments(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTransitGatewayVpcAttachments", reflect.TypeOf((*MockEC2API)(nil).DescribeTransitGatewayVpcAttachments), varargs...)
+}
+
+// DescribeVolumes mocks base method.
+func (m *MockEC2API) DescribeVolumes(arg0 context.Context, arg1 *ec2.DescribeVolumesInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVolumes", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVolumes indicates an expected call of DescribeVolumes.
+func (mr *MockEC2APIMockRecorder) DescribeVolumes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVolumes", reflect.TypeOf((*MockEC2API)(nil).DescribeVolumes), varargs...)
+}
+
+// DescribeVolumesModifications mocks base method.
+func (m *MockEC2API) DescribeVolumesModifications(arg0 context.Context, arg1 *ec2.DescribeVolumesModificationsInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeVolumesModificationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVolumesModifications", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeVolumesModificationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVolumesModifications indicates an expected call of DescribeVolumesModifications.
+func (mr *MockEC2APIMockRecorder) DescribeVolumesModifications(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVolumesModifications", reflect.TypeOf((*MockEC2API)(nil).DescribeVolumesModifications), varargs...)
+}
+
 // DescribeVpcAttribute mocks base method.
 func (m *MockEC2API) DescribeVpcAttribute(arg0 context.Context, arg1 *ec2.DescribeVpcAttributeInput, arg2 ...func(*ec2.Options)) (*ec2.DescribeVpcAttributeOutput, error) {
 	m.ctrl.T.Helper()
@@ -1171,6 +1571,26 @@ func (mr *MockEC2APIMockRecorder) DisassociateVpcCidrBlock(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateVpcCidrBlock", reflect.TypeOf((*MockEC2API)(nil).DisassociateVpcCidrBlock), varargs...)
 }
 
+// GetManagedPrefixListEntries mocks base method.
+func (m *MockEC2API) GetManagedPrefixListEntries(arg0 context.Context, arg1 *ec2.GetManagedPrefixListEntriesInput, arg2 ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagedPrefixListEntries", varargs...)
+	ret0, _ := ret[0].(*ec2.GetManagedPrefixListEntriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagedPrefixListEntries indicates an expected call of GetManagedPrefixListEntries.
+func (mr *MockEC2APIMockRecorder) GetManagedPrefixListEntries(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagedPrefixListEntries", reflect.TypeOf((*MockEC2API)(nil).GetManagedPrefixListEntries), varargs...)
+}
+
 // ModifyInstanceMetadataOptions mocks base method.
 func (m *MockEC2API) ModifyInstanceMetadataOptions(arg0 context.Context, arg1 *ec2.ModifyInstanceMetadataOptionsInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyInstanceMetadataOptionsOutput, error) {
 	m.ctrl.T.Helper()
@@ -1191,6 +1611,26 @@ func (mr *MockEC2APIMockRecorder) ModifyInstanceMetadataOptions(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMetadataOptions", reflect.TypeOf((*MockEC2API)(nil).ModifyInstanceMetadataOptions), varargs...)
 }
 
+// ModifyManagedPrefixList mocks base method.
+func (m *MockEC2API) ModifyManagedPrefixList(arg0 context.Context, arg1 *ec2.ModifyManagedPrefixListInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyManagedPrefixList", varargs...)
+	ret0, _ := ret[0].(*ec2.ModifyManagedPrefixListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyManagedPrefixList indicates an expected call of ModifyManagedPrefixList.
+func (mr *MockEC2APIMockRecorder) ModifyManagedPrefixList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyManagedPrefixList", reflect.TypeOf((*MockEC2API)(nil).ModifyManagedPrefixList), varargs...)
+}
+
 // ModifyNetworkInterfaceAttribute mocks base method.
 func (m *MockEC2API) ModifyNetworkInterfaceAttribute(arg0 context.Context, arg1 *ec2.ModifyNetworkInterfaceAttributeInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyNetworkInterfaceAttributeOutput, error) {
 	m.ctrl.T.Helper()
@@ -1231,6 +1671,46 @@ func (mr *MockEC2APIMockRecorder) ModifySubnetAttribute(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySubnetAttribute", reflect.TypeOf((*MockEC2API)(nil).ModifySubnetAttribute), varargs...)
 }
 
+// ModifyTransitGatewayVpcAttachment mocks base method.
+func (m *MockEC2API) ModifyTransitGatewayVpcAttachment(arg0 context.Context, arg1 *ec2.ModifyTransitGatewayVpcAttachmentInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyTransitGatewayVpcAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyTransitGatewayVpcAttachment", varargs...)
+	ret0, _ := ret[0].(*ec2.ModifyTransitGatewayVpcAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyTransitGatewayVpcAttachment indicates an expected call of ModifyTransitGatewayVpcAttachment.
+func (mr *MockEC2APIMockRecorder) ModifyTransitGatewayVpcAttachment(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyTransitGatewayVpcAttachment", reflect.TypeOf((*MockEC2API)(nil).ModifyTransitGatewayVpcAttachment), varargs...)
+}
+
+// ModifyVolume mocks base method.
+func (m *MockEC2API) ModifyVolume(arg0 context.Context, arg1 *ec2.ModifyVolumeInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyVolumeOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyVolume", varargs...)
+	ret0, _ := ret[0].(*ec2.ModifyVolumeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVolume indicates an expected call of ModifyVolume.
+func (mr *MockEC2APIMockRecorder) ModifyVolume(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolume", reflect.TypeOf((*MockEC2API)(nil).ModifyVolume), varargs...)
+}
+
 // ModifyVpcAttribute mocks base method.
 func (m *MockEC2API) ModifyVpcAttribute(arg0 context.Context, arg1 *ec2.ModifyVpcAttributeInput, arg2 ...func(*ec2.Options)) (*ec2.ModifyVpcAttributeOutput, error) {
 	m.ctrl.T.Helper()
@@ -1291,6 +1771,46 @@ func (mr *MockEC2APIMockRecorder) ReleaseAddress(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAddress", reflect.TypeOf((*MockEC2API)(nil).ReleaseAddress), varargs...)
 }
 
+// ReplaceNetworkAclAssociation mocks base method.
+func (m *MockEC2API) ReplaceNetworkAclAssociation(arg0 context.Context, arg1 *ec2.ReplaceNetworkAclAssociationInput, arg2 ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclAssociation", varargs...)
+	ret0, _ := ret[0].(*ec2.ReplaceNetworkAclAssociationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceNetworkAclAssociation indicates an expected call of ReplaceNetworkAclAssociation.
+func (mr *MockEC2APIMockRecorder) ReplaceNetworkAclAssociation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclAssociation", reflect.TypeOf((*MockEC2API)(nil).ReplaceNetworkAclAssociation), varargs...)
+}
+
+// ReplaceNetworkAclEntry mocks base method.
+func (m *MockEC2API) ReplaceNetworkAclEntry(arg0 context.Context, arg1 *ec2.ReplaceNetworkAclEntryInput, arg2 ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceNetworkAclEntry", varargs...)
+	ret0, _ := ret[0].(*ec2.ReplaceNetworkAclEntryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceNetworkAclEntry indicates an expected call of ReplaceNetworkAclEntry.
+func (mr *MockEC2APIMockRecorder) ReplaceNetworkAclEntry(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkAclEntry", reflect.TypeOf((*MockEC2API)(nil).ReplaceNetworkAclEntry), varargs...)
+}
+
 // ReplaceRoute mocks base method.
 func (m *MockEC2API) ReplaceRoute(arg0 context.Context, arg1 *ec2.ReplaceRouteInput, arg2 ...func(*ec2.Options)) (*ec2.ReplaceRouteOutput, error) {
 	m.ctrl.T.Helper()
@@ -1371,6 +1891,46 @@ func (mr *MockEC2APIMockRecorder) RunInstances(arg0, arg1 interface{}, arg2 ...i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInstances", reflect.TypeOf((*MockEC2API)(nil).RunInstances), varargs...)
 }
 
+// StartInstances mocks base method.
+func (m *MockEC2API) StartInstances(arg0 context.Context, arg1 *ec2.StartInstancesInput, arg2 ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartInstances", varargs...)
+	ret0, _ := ret[0].(*ec2.StartInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartInstances indicates an expected call of StartInstances.
+func (mr *MockEC2APIMockRecorder) StartInstances(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstances", reflect.TypeOf((*MockEC2API)(nil).StartInstances), varargs...)
+}
+
+// StopInstances mocks base method.
+func (m *MockEC2API) StopInstances(arg0 context.Context, arg1 *ec2.StopInstancesInput, arg2 ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopInstances", varargs...)
+	ret0, _ := ret[0].(*ec2.StopInstancesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopInstances indicates an expected call of StopInstances.
+func (mr *MockEC2APIMockRecorder) StopInstances(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstances", reflect.TypeOf((*MockEC2API)(nil).StopInstances), varargs...)
+}
+
 // TerminateInstances mocks base method.
 func (m *MockEC2API) TerminateInstances(arg0 context.Context, arg1 *ec2.TerminateInstancesInput, arg2 ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
 	m.ctrl.T.Helper()
@@ -44,3 +44,44 @@ var (
 	// It should be used in all relevant controllers (and possibly disabled for unit tests).
 	InstanceTypeArchitectureCacheSingleton InstanceTypeArchitectureCache = capicache.New[InstanceTypeArchitectureCacheEntry](2 * time.Hour)
 )
+
+// InstanceTypeInfoCacheEntry caches the full DescribeInstanceTypes result of an instance type.
+type InstanceTypeInfoCacheEntry struct {
+	InstanceType ec2types.InstanceType
+	Info         ec2types.InstanceTypeInfo
+}
+
+// Key returns the cache key of a InstanceTypeInfoCacheEntry.
+func (e InstanceTypeInfoCacheEntry) Key() string {
+	return string(e.InstanceType)
+}
+
+// InstanceTypeInfoCache stores InstanceTypeInfoCacheEntry items.
+type InstanceTypeInfoCache = capicache.Cache[InstanceTypeInfoCacheEntry]
+
+var (
+	// InstanceTypeInfoCacheSingleton is the singleton cache for InstanceTypeInfoCacheEntry items.
+	// It should be used in all relevant controllers (and possibly disabled for unit tests).
+	InstanceTypeInfoCacheSingleton InstanceTypeInfoCache = capicache.New[InstanceTypeInfoCacheEntry](2 * time.Hour)
+)
+
+// AddonConfigurationSchemaCacheEntry caches DescribeAddonConfiguration results since the schema of an addon version is not expected to change.
+type AddonConfigurationSchemaCacheEntry struct {
+	AddonName    string
+	AddonVersion string
+	Schema       string
+}
+
+// Key returns the cache key of a AddonConfigurationSchemaCacheEntry.
+func (e AddonConfigurationSchemaCacheEntry) Key() string {
+	return e.AddonName + "/" + e.AddonVersion
+}
+
+// AddonConfigurationSchemaCache stores AddonConfigurationSchemaCacheEntry items.
+type AddonConfigurationSchemaCache = capicache.Cache[AddonConfigurationSchemaCacheEntry]
+
+var (
+	// AddonConfigurationSchemaCacheSingleton is the singleton cache for AddonConfigurationSchemaCacheEntry items.
+	// It should be used in all relevant controllers (and possibly disabled for unit tests).
+	AddonConfigurationSchemaCacheSingleton AddonConfigurationSchemaCache = capicache.New[AddonConfigurationSchemaCacheEntry](2 * time.Hour)
+)